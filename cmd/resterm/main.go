@@ -28,6 +28,11 @@ import (
 	"github.com/unkn0wn-root/resterm/internal/openapi/generator"
 	"github.com/unkn0wn-root/resterm/internal/openapi/parser"
 	"github.com/unkn0wn-root/resterm/internal/openapi/writer"
+	restparser "github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/postman"
+	postmangen "github.com/unkn0wn-root/resterm/internal/postman/generator"
+	postmanparser "github.com/unkn0wn-root/resterm/internal/postman/parser"
+	postmanwriter "github.com/unkn0wn-root/resterm/internal/postman/writer"
 	"github.com/unkn0wn-root/resterm/internal/rtfmt"
 	"github.com/unkn0wn-root/resterm/internal/telemetry"
 	"github.com/unkn0wn-root/resterm/internal/theme"
@@ -102,6 +107,9 @@ func run(a []string) error {
 		doUpdate                 bool
 		curlSrc                  string
 		openapiSpec              string
+		postmanSrc               string
+		postmanEnv               string
+		postmanOut               string
 		httpOut                  string
 		openapiBase              string
 		openapiResolveRefs       bool
@@ -112,6 +120,10 @@ func run(a []string) error {
 		traceOTService           string
 		compareTargetsRaw        string
 		compareBaseline          string
+		validate                 bool
+		replayPath               string
+		replaySince              string
+		replayUntil              string
 	)
 
 	tc := telemetry.ConfigFromEnv(os.Getenv)
@@ -156,7 +168,25 @@ func run(a []string) error {
 		"",
 		"Path to OpenAPI specification file to convert",
 	)
+	fs.StringVar(
+		&postmanSrc,
+		"from-postman",
+		"",
+		"Path to Postman v2.1 collection to convert",
+	)
+	fs.StringVar(
+		&postmanEnv,
+		"postman-env",
+		"",
+		"Postman environment to merge into resterm.env.json next to the generated file",
+	)
 	fs.StringVar(&httpOut, "http-out", "", "Destination path for generated .http file")
+	fs.StringVar(
+		&postmanOut,
+		"to-postman",
+		"",
+		"Export requests from --file to a Postman v2.1 collection at this path",
+	)
 	fs.StringVar(
 		&openapiBase,
 		"openapi-base-var",
@@ -211,6 +241,30 @@ func run(a []string) error {
 		"",
 		"Baseline environment when --compare is used (defaults to first target)",
 	)
+	fs.BoolVar(
+		&validate,
+		"validate",
+		false,
+		"Parse --file, print parse errors with line numbers and exit non-zero if any",
+	)
+	fs.StringVar(
+		&replayPath,
+		"replay",
+		"",
+		"Send the requests in a history export (JSON) in order and print their status",
+	)
+	fs.StringVar(
+		&replaySince,
+		"replay-since",
+		"",
+		"With --replay, skip entries before this RFC 3339 time or duration ago (e.g. 2h)",
+	)
+	fs.StringVar(
+		&replayUntil,
+		"replay-until",
+		"",
+		"With --replay, skip entries after this RFC 3339 time or duration ago",
+	)
 	if err := fs.Parse(a); err != nil {
 		if errors.Is(err, flag.ErrHelp) {
 			printMainUsage(os.Stderr, fs)
@@ -289,6 +343,17 @@ func run(a []string) error {
 	if curlSrc != "" && openapiSpec != "" {
 		return errors.New("import error: choose either --from-curl or --from-openapi")
 	}
+	if postmanSrc != "" && (curlSrc != "" || openapiSpec != "") {
+		return errors.New(
+			"import error: --from-postman cannot be combined with --from-curl or --from-openapi",
+		)
+	}
+	if postmanOut != "" && (curlSrc != "" || openapiSpec != "" || postmanSrc != "") {
+		return errors.New("export error: --to-postman cannot be combined with an import")
+	}
+	if postmanEnv != "" && postmanSrc == "" {
+		return errors.New("import error: --postman-env requires --from-postman")
+	}
 
 	if curlSrc != "" {
 		cmd, err := readCurlCommand(curlSrc)
@@ -351,10 +416,64 @@ func run(a []string) error {
 		return nil
 	}
 
+	if postmanSrc != "" {
+		targetOut := httpOut
+		if targetOut == "" {
+			targetOut = defaultHTTPOutputPath(postmanSrc)
+		}
+
+		opts := postman.WriterOptions{
+			HeaderComment:     fmt.Sprintf("Generated by resterm %s", version),
+			OverwriteExisting: true,
+		}
+
+		if err := convertPostmanCollection(
+			context.Background(),
+			postmanSrc,
+			targetOut,
+			version,
+			opts); err != nil {
+			return fmt.Errorf("postman import error: %w", err)
+		}
+
+		_ = rtfmt.Fprintf(os.Stdout, "Generated %s from %s\n", nil, targetOut, postmanSrc)
+
+		if postmanEnv != "" {
+			envOut, name, err := importPostmanEnvironment(postmanEnv, targetOut)
+			if err != nil {
+				return fmt.Errorf("postman import error: %w", err)
+			}
+			_ = rtfmt.Fprintf(os.Stdout, "Wrote environment %s to %s\n", nil, name, envOut)
+		}
+		return nil
+	}
+
 	if filePath == "" && fs.NArg() > 0 {
 		filePath = fs.Arg(0)
 	}
 
+	if validate {
+		if filePath == "" {
+			return cliExitErr{err: errors.New("validate: --validate requires --file"), code: 2}
+		}
+		return validateFile(os.Stdout, filePath)
+	}
+
+	if postmanOut != "" {
+		if filePath == "" {
+			return errors.New("postman export error: --to-postman requires --file")
+		}
+		warnings, err := exportPostmanCollection(filePath, postmanOut)
+		if err != nil {
+			return fmt.Errorf("postman export error: %w", err)
+		}
+		for _, w := range warnings {
+			_ = rtfmt.Fprintf(os.Stderr, "warning: %s\n", nil, w)
+		}
+		_ = rtfmt.Fprintf(os.Stdout, "Exported %s to %s\n", nil, filePath, postmanOut)
+		return nil
+	}
+
 	var initialContent string
 	if filePath != "" {
 		data, err := os.ReadFile(filePath)
@@ -381,6 +500,35 @@ func run(a []string) error {
 	}
 
 	envSet, resolvedEnvFile := loadEnvironment(envFile, filePath, workspace)
+	if replayPath != "" {
+		now := time.Now()
+		since, err := parseReplayTime(replaySince, now)
+		if err != nil {
+			return cliExitErr{err: fmt.Errorf("replay: --replay-since: %w", err), code: 2}
+		}
+		until, err := parseReplayTime(replayUntil, now)
+		if err != nil {
+			return cliExitErr{err: fmt.Errorf("replay: --replay-until: %w", err), code: 2}
+		}
+		return replayHistory(
+			context.Background(),
+			os.Stdout,
+			httpclient.NewClient(nil),
+			replayPath,
+			replayOptions{
+				Since:  since,
+				Until:  until,
+				Env:    envName,
+				EnvSet: envSet,
+				HTTP: httpclient.Options{
+					Timeout:            timeout,
+					FollowRedirects:    follow,
+					InsecureSkipVerify: insecure,
+					ProxyURL:           proxyURL,
+				},
+			},
+		)
+	}
 	var envFallback string
 	if envName == "" && len(envSet) > 0 {
 		selected, notify := selectDefaultEnvironment(envSet)
@@ -410,6 +558,16 @@ func run(a []string) error {
 		}()
 	}
 
+	exports := telemetry.NewExports(tc)
+	client.SetTraceExports(exports)
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := exports.Shutdown(ctx); err != nil {
+			log.Printf("trace export shutdown: %v", err)
+		}
+	}()
+
 	httpOpts := httpclient.Options{
 		Timeout:            timeout,
 		FollowRedirects:    follow,
@@ -469,6 +627,13 @@ func run(a []string) error {
 		}
 	}
 
+	httpOpts.Accept = strings.TrimSpace(settings.DefaultAccept)
+	if name, err := httpclient.ParseRequestIDHeader(settings.RequestIDHeader); err != nil {
+		log.Printf("settings: %v", err)
+	} else {
+		httpOpts.RequestIDHeader = name
+	}
+
 	bindingMap, _, bindingErr := bindings.Load(config.Dir())
 	if bindingErr != nil {
 		log.Printf("bindings load error: %v", bindingErr)
@@ -529,8 +694,12 @@ func run(a []string) error {
 		CompareTargets:      compareTargets,
 		CompareBase:         compareBaseline,
 		Bindings:            bindingMap,
+		GlobalsPath:         config.GlobalsPath(),
+		WhatsNewPath:        config.WhatsNewPath(),
 	})
 
+	defer model.Cleanup()
+
 	program := tea.NewProgram(model, tea.WithAltScreen())
 	if _, err := program.Run(); err != nil {
 		return fmt.Errorf("error: %w", err)
@@ -707,6 +876,60 @@ func convertOpenAPISpec(
 	return svc.GenerateHTTPFile(ctx, specPath, outputPath, opts)
 }
 
+func convertPostmanCollection(
+	ctx context.Context,
+	collectionPath, outputPath, version string,
+	opts postman.WriterOptions,
+) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if outputPath == "" {
+		outputPath = defaultHTTPOutputPath(collectionPath)
+	}
+	if strings.TrimSpace(opts.HeaderComment) == "" {
+		opts.HeaderComment = fmt.Sprintf("Generated by resterm %s", version)
+	}
+	svc := postman.Service{
+		Parser:    postmanparser.NewLoader(),
+		Generator: postmangen.NewBuilder(),
+		Writer:    postmanwriter.NewFileWriter(),
+	}
+	return svc.GenerateHTTPFile(ctx, collectionPath, outputPath, opts)
+}
+
+// importPostmanEnvironment merges a Postman environment into the
+// resterm.env.json beside the generated .http file.
+func importPostmanEnvironment(envPath, httpPath string) (string, string, error) {
+	env, err := postmanparser.LoadEnvironment(envPath)
+	if err != nil {
+		return "", "", err
+	}
+	dst := filepath.Join(filepath.Dir(httpPath), "resterm.env.json")
+	name, err := postmanwriter.WriteEnvironment(env, dst)
+	if err != nil {
+		return "", "", err
+	}
+	return dst, name, nil
+}
+
+func exportPostmanCollection(filePath, outputPath string) ([]string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	doc := restparser.Parse(filePath, data)
+	col, warnings := postman.Export(doc, postman.ExportOptions{FoldersByTag: true})
+	out, err := postman.Marshal(col)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(outputPath, out, 0o644); err != nil {
+		return nil, fmt.Errorf("write collection: %w", err)
+	}
+	return warnings, nil
+}
+
 func readCurlCommand(src string) (string, error) {
 	src = strings.TrimSpace(src)
 	if src == "" {
@@ -89,6 +89,83 @@ func TestRunRejectsMissingFile(t *testing.T) {
 	}
 }
 
+func TestRunExportsPostmanCollection(t *testing.T) {
+	t.Setenv("RESTERM_CONFIG_DIR", t.TempDir())
+	dir := t.TempDir()
+	fp := filepath.Join(dir, "api.http")
+	src := "### Ping\n# @name ping\nGET https://example.com/ping\n\n" +
+		"### Call\n# @name call\n# @grpc demo.Svc/Call\nGRPC localhost:50051\n"
+	if err := os.WriteFile(fp, []byte(src), 0o644); err != nil {
+		t.Fatalf("write request file: %v", err)
+	}
+	outPath := filepath.Join(dir, "api.postman.json")
+
+	out, errOut, err := captureRunIO(t, func() error {
+		return run([]string{"--file", fp, "--to-postman", outPath})
+	})
+	if err != nil {
+		t.Fatalf("run --to-postman: %v", err)
+	}
+	if !strings.Contains(out, "Exported") {
+		t.Fatalf("expected export summary, got %q", out)
+	}
+	if !strings.Contains(errOut, `skipped gRPC request "call"`) {
+		t.Fatalf("expected grpc skip warning, got %q", errOut)
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read collection: %v", err)
+	}
+	if !strings.Contains(string(data), `"name": "ping"`) {
+		t.Fatalf("expected ping request in collection, got %s", data)
+	}
+}
+
+func TestRunImportsPostmanCollection(t *testing.T) {
+	t.Setenv("RESTERM_CONFIG_DIR", t.TempDir())
+	dir := t.TempDir()
+	colPath := filepath.Join(dir, "api.postman_collection.json")
+	col := `{"info": {"name": "api"}, "item": [` +
+		`{"name": "Ping", "request": {"method": "GET", "url": "{{host}}/ping"}}]}`
+	if err := os.WriteFile(colPath, []byte(col), 0o644); err != nil {
+		t.Fatalf("write collection: %v", err)
+	}
+	envPath := filepath.Join(dir, "dev.postman_environment.json")
+	env := `{"name": "dev", "values": [{"key": "host", "value": "http://localhost", "enabled": true}]}`
+	if err := os.WriteFile(envPath, []byte(env), 0o644); err != nil {
+		t.Fatalf("write environment: %v", err)
+	}
+	outPath := filepath.Join(dir, "api.http")
+
+	out, _, err := captureRunIO(t, func() error {
+		return run([]string{
+			"--from-postman", colPath,
+			"--postman-env", envPath,
+			"--http-out", outPath,
+		})
+	})
+	if err != nil {
+		t.Fatalf("run --from-postman: %v", err)
+	}
+	if !strings.Contains(out, "Generated "+outPath) {
+		t.Fatalf("expected generate summary, got %q", out)
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read generated file: %v", err)
+	}
+	if !strings.Contains(string(data), "# @name ping\nGET {{host}}/ping") {
+		t.Fatalf("expected ping request, got %s", data)
+	}
+	envData, err := os.ReadFile(filepath.Join(dir, "resterm.env.json"))
+	if err != nil {
+		t.Fatalf("read env file: %v", err)
+	}
+	if !strings.Contains(string(envData), `"host": "http://localhost"`) {
+		t.Fatalf("expected host in env file, got %s", envData)
+	}
+}
+
 func TestRunDispatchesHistorySubcommand(t *testing.T) {
 	out, errOut, err := captureRunIO(t, func() error {
 		return run([]string{"history", "-h"})
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/history"
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/settings"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+// replayOptions controls a --replay run. Env is only set when the user
+// passed --env; without it templates resolve from the recorded request,
+// its file and the process environment.
+type replayOptions struct {
+	Since  time.Time
+	Until  time.Time
+	Env    string
+	EnvSet vars.EnvironmentSet
+	HTTP   httpclient.Options
+}
+
+// replayHistory sends the HTTP requests recorded in a history export one
+// after another, oldest first, and prints one status line per entry.
+// Compare sweeps and streaming requests are skipped. It fails with exit
+// code 1 when any request could not be sent.
+func replayHistory(
+	ctx context.Context,
+	w io.Writer,
+	client *httpclient.Client,
+	path string,
+	opts replayOptions,
+) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("replay: read %s: %w", path, err)
+	}
+	var entries []history.Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("replay: parse %s: %w", path, err)
+	}
+	entries = filterReplayEntries(entries, opts.Since, opts.Until)
+
+	var envValues map[string]string
+	if opts.Env != "" {
+		envValues = vars.EnvValues(opts.EnvSet, opts.Env)
+		if envValues == nil {
+			return fmt.Errorf("replay: environment %q not found", opts.Env)
+		}
+	}
+
+	var sent, failed, skipped int
+	for i, e := range entries {
+		prefix := fmt.Sprintf("[%d/%d]", i+1, len(entries))
+		req, reason := replayRequest(e)
+		if req == nil {
+			skipped++
+			_, _ = fmt.Fprintf(w, "%s %s %s skipped (%s)\n", prefix, e.Method, e.URL, reason)
+			continue
+		}
+
+		resolver := replayResolver(e.FilePath, req, envValues)
+		httpOpts := opts.HTTP
+		if e.FilePath != "" {
+			httpOpts.BaseDir = filepath.Dir(e.FilePath)
+		}
+		merged := settings.Merge(settings.FromEnv(opts.EnvSet, opts.Env), req.Settings)
+		err := settings.ApplyHTTPSettings(&httpOpts, merged, resolver)
+		if err != nil {
+			failed++
+			_, _ = fmt.Fprintf(w, "%s %s %s error: %v\n", prefix, req.Method, req.URL, err)
+			continue
+		}
+		resp, err := client.Execute(ctx, req, resolver, httpOpts)
+		if err != nil {
+			failed++
+			_, _ = fmt.Fprintf(w, "%s %s %s error: %v\n", prefix, req.Method, req.URL, err)
+			continue
+		}
+		sent++
+		_, _ = fmt.Fprintf(
+			w,
+			"%s %s %s %s %s\n",
+			prefix,
+			req.Method,
+			resp.EffectiveURL,
+			resp.Status,
+			resp.Duration.Round(time.Millisecond),
+		)
+	}
+
+	_, err = fmt.Fprintf(
+		w,
+		"replayed %s: %d sent, %d failed, %d skipped\n",
+		plural(len(entries), "request"),
+		sent,
+		failed,
+		skipped,
+	)
+	if failed > 0 {
+		return cliExitErr{
+			err:  fmt.Errorf("replay: %s could not be sent", plural(failed, "request")),
+			code: 1,
+		}
+	}
+	return err
+}
+
+// filterReplayEntries keeps entries executed within [since, until] (zero
+// bounds are open) and orders them by execution time.
+func filterReplayEntries(entries []history.Entry, since, until time.Time) []history.Entry {
+	out := make([]history.Entry, 0, len(entries))
+	for _, e := range entries {
+		if !since.IsZero() && e.ExecutedAt.Before(since) {
+			continue
+		}
+		if !until.IsZero() && e.ExecutedAt.After(until) {
+			continue
+		}
+		out = append(out, e)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].ExecutedAt.Before(out[j].ExecutedAt)
+	})
+	return out
+}
+
+// replayRequest rebuilds the request from the entry's recorded text, with
+// the file and tag settings recorded alongside it folded into its own. A
+// nil request comes with the reason it cannot be replayed. Text carrying the
+// redaction mask is refused: the masked secrets would go out literally.
+func replayRequest(e history.Entry) (*restfile.Request, string) {
+	if e.Compare != nil {
+		return nil, "compare run"
+	}
+	if strings.TrimSpace(e.RequestText) == "" {
+		return nil, "no recorded request"
+	}
+	if strings.Contains(e.RequestText, history.RedactedMask) {
+		return nil, "recorded with redacted secrets"
+	}
+	name := e.FilePath
+	if name == "" {
+		name = "history.http"
+	}
+	doc := parser.Parse(name, []byte(e.RequestText))
+	if len(doc.Requests) == 0 {
+		return nil, "recorded request could not be parsed"
+	}
+	req := doc.Requests[0]
+	switch {
+	case req.GRPC != nil:
+		return nil, "grpc is not replayed"
+	case req.WebSocket != nil:
+		return nil, "websocket is not replayed"
+	case req.SSE != nil:
+		return nil, "sse is not replayed"
+	}
+	req.Settings = settings.Merge(doc.Settings, doc.SettingsForTags(req.Metadata.Tags), req.Settings)
+	return req, ""
+}
+
+// replayResolver resolves templates in a replayed request. Request @vars
+// from the recorded text win, then those of the matching request in the
+// source file and the file's constants, globals and @vars when it still
+// exists, then env (the --env values, if any) and finally the process
+// environment.
+func replayResolver(path string, req *restfile.Request, env map[string]string) *vars.Resolver {
+	providers := make([]vars.Provider, 0, 7)
+	if values := variableValues(req.Variables); len(values) > 0 {
+		providers = append(providers, vars.NewMapProvider("request", values))
+	}
+	if path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			doc := parser.Parse(path, data)
+			for _, src := range doc.Requests {
+				if src.Method != req.Method || src.URL != req.URL {
+					continue
+				}
+				if values := variableValues(src.Variables); len(values) > 0 {
+					providers = append(providers, vars.NewMapProvider("request", values))
+				}
+				break
+			}
+			consts := make(map[string]string, len(doc.Constants))
+			for _, c := range doc.Constants {
+				consts[c.Name] = c.Value
+			}
+			if len(consts) > 0 {
+				providers = append(providers, vars.NewMapProvider("const", consts))
+			}
+			if values := variableValues(doc.Globals); len(values) > 0 {
+				providers = append(providers, vars.NewMapProvider("document-global", values))
+			}
+			if values := variableValues(doc.Variables); len(values) > 0 {
+				providers = append(providers, vars.NewMapProvider("file", values))
+			}
+		}
+	}
+	if len(env) > 0 {
+		providers = append(providers, vars.NewMapProvider("environment", env))
+	}
+	providers = append(providers, vars.EnvProvider{})
+	res := vars.NewResolver(providers...)
+	res.AddRefResolver(vars.EnvRefResolver)
+	return res
+}
+
+func variableValues(vs []restfile.Variable) map[string]string {
+	values := make(map[string]string, len(vs))
+	for _, v := range vs {
+		values[v.Name] = v.Value
+	}
+	return values
+}
+
+// parseReplayTime accepts an RFC 3339 timestamp or a duration meaning
+// that long before now, so --replay-since 2h keeps the last two hours.
+func parseReplayTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if ts, err := time.Parse(time.RFC3339, value); err == nil {
+		return ts, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (use RFC 3339 or a duration like 2h)", value)
+}
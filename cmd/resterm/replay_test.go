@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/history"
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+func TestReplayHistorySendsEntriesInOrder(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []history.Entry{
+		{
+			ExecutedAt:  base.Add(2 * time.Minute),
+			Method:      "GET",
+			URL:         srv.URL + "/missing",
+			RequestText: "GET " + srv.URL + "/missing\n",
+		},
+		{
+			ExecutedAt:  base.Add(time.Minute),
+			Method:      "POST",
+			URL:         "{{host}}/users",
+			RequestText: "POST {{host}}/users\nContent-Type: application/json\n\n{}\n",
+		},
+		{
+			ExecutedAt: base.Add(3 * time.Minute),
+			Method:     "COMPARE",
+			Compare:    &history.CompareEntry{Baseline: "dev"},
+		},
+		{
+			ExecutedAt:  base.Add(-time.Hour),
+			Method:      "GET",
+			RequestText: "GET " + srv.URL + "/old\n",
+		},
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "history.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write history: %v", err)
+	}
+
+	var out bytes.Buffer
+	err = replayHistory(context.Background(), &out, httpclient.NewClient(nil), path, replayOptions{
+		Since:  base,
+		Env:    "dev",
+		EnvSet: vars.EnvironmentSet{"dev": {"host": srv.URL}},
+		HTTP:   httpclient.Options{Timeout: 5 * time.Second},
+	})
+	if err != nil {
+		t.Fatalf("replay: %v\n%s", err, out.String())
+	}
+
+	if strings.Join(seen, ",") != "POST /users,GET /missing" {
+		t.Fatalf("unexpected requests %v", seen)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected 4 output lines, got %q", out.String())
+	}
+	if !strings.HasPrefix(lines[0], "[1/3] POST "+srv.URL+"/users 200 OK") {
+		t.Fatalf("unexpected first line %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "404 Not Found") {
+		t.Fatalf("unexpected second line %q", lines[1])
+	}
+	if !strings.Contains(lines[2], "skipped (compare run)") {
+		t.Fatalf("unexpected third line %q", lines[2])
+	}
+	if lines[3] != "replayed 3 requests: 2 sent, 0 failed, 1 skipped" {
+		t.Fatalf("unexpected summary %q", lines[3])
+	}
+}
+
+func TestReplayHistoryFailsOnUnsentRequest(t *testing.T) {
+	entries := []history.Entry{{
+		Method:      "GET",
+		RequestText: "GET http://127.0.0.1:1/down\n",
+	}}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "history.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write history: %v", err)
+	}
+
+	var out bytes.Buffer
+	err = replayHistory(context.Background(), &out, httpclient.NewClient(nil), path, replayOptions{
+		HTTP: httpclient.Options{Timeout: time.Second},
+	})
+	if c := exitCode(err); c != 1 {
+		t.Fatalf("expected exit code 1, got %d (%v)", c, err)
+	}
+	if !strings.Contains(out.String(), "[1/1] GET http://127.0.0.1:1/down error:") {
+		t.Fatalf("unexpected output %q", out.String())
+	}
+}
+
+func TestReplayHistoryRefusesRedactedEntries(t *testing.T) {
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	entries := []history.Entry{{
+		Method:      "GET",
+		URL:         srv.URL + "/me",
+		RequestText: "GET " + srv.URL + "/me\nAuthorization: " + history.RedactedMask + "\n",
+	}}
+	path := writeReplayHistory(t, entries)
+
+	var out bytes.Buffer
+	err := replayHistory(context.Background(), &out, httpclient.NewClient(nil), path, replayOptions{
+		HTTP: httpclient.Options{Timeout: time.Second},
+	})
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if hits != 0 {
+		t.Fatalf("expected the redacted request not to be sent")
+	}
+	if !strings.Contains(out.String(), "skipped (recorded with redacted secrets)") {
+		t.Fatalf("unexpected output %q", out.String())
+	}
+}
+
+func TestReplayHistoryAppliesRecordedSettings(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	entries := []history.Entry{{
+		Method:      "GET",
+		RequestText: "# @setting http-insecure true\nGET " + srv.URL + "/ping\n",
+	}}
+	path := writeReplayHistory(t, entries)
+
+	var out bytes.Buffer
+	err := replayHistory(context.Background(), &out, httpclient.NewClient(nil), path, replayOptions{
+		HTTP: httpclient.Options{Timeout: 5 * time.Second},
+	})
+	if err != nil {
+		t.Fatalf("replay: %v\n%s", err, out.String())
+	}
+	if !strings.Contains(out.String(), "200 OK") {
+		t.Fatalf("expected the TLS setting to apply, got %q", out.String())
+	}
+}
+
+func writeReplayHistory(t *testing.T, entries []history.Entry) string {
+	t.Helper()
+	data, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "history.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write history: %v", err)
+	}
+	return path
+}
+
+func TestParseReplayTime(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	got, err := parseReplayTime("2h", now)
+	if err != nil || !got.Equal(now.Add(-2*time.Hour)) {
+		t.Fatalf("duration: got %v, %v", got, err)
+	}
+	got, err = parseReplayTime("2026-02-28T10:00:00Z", now)
+	if err != nil || got.Day() != 28 {
+		t.Fatalf("timestamp: got %v, %v", got, err)
+	}
+	if _, err := parseReplayTime("yesterday", now); err == nil {
+		t.Fatalf("expected error for invalid time")
+	}
+}
+
+func TestReplayHistoryResolvesTemplatesWithoutEnv(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = r.URL.String()
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "users.http")
+	src := "@base = " + srv.URL + "\n\n### user\n# @var id 7\nGET {{base}}/users/{{id}}?r={{$uuid}}\n"
+	if err := os.WriteFile(source, []byte(src), 0o644); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+	entries := []history.Entry{{
+		Method:      "GET",
+		URL:         "{{base}}/users/{{id}}?r={{$uuid}}",
+		FilePath:    source,
+		RequestText: "GET {{base}}/users/{{id}}?r={{$uuid}}\n",
+	}}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	path := filepath.Join(dir, "history.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write history: %v", err)
+	}
+
+	var out bytes.Buffer
+	err = replayHistory(context.Background(), &out, httpclient.NewClient(nil), path, replayOptions{
+		HTTP: httpclient.Options{Timeout: 5 * time.Second},
+	})
+	if err != nil {
+		t.Fatalf("replay: %v\n%s", err, out.String())
+	}
+	if !strings.HasPrefix(got, "/users/7?r=") || strings.Contains(got, "{{") ||
+		len(got) == len("/users/7?r=") {
+		t.Fatalf("expected templates to be filled in, got %q", got)
+	}
+}
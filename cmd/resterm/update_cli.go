@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/unkn0wn-root/resterm/internal/config"
 	"github.com/unkn0wn-root/resterm/internal/update"
 )
 
@@ -119,18 +120,20 @@ func (p *cliProgress) render(force bool) {
 }
 
 type cliUpdater struct {
-	cl  update.Client
-	ver string
-	out io.Writer
-	err io.Writer
+	cl        update.Client
+	ver       string
+	out       io.Writer
+	err       io.Writer
+	notesPath string
 }
 
 func newCLIUpdater(cl update.Client, ver string) cliUpdater {
 	return cliUpdater{
-		cl:  cl,
-		ver: strings.TrimSpace(ver),
-		out: os.Stdout,
-		err: os.Stderr,
+		cl:        cl,
+		ver:       strings.TrimSpace(ver),
+		out:       os.Stdout,
+		err:       os.Stderr,
+		notesPath: config.WhatsNewPath(),
 	}
 }
 
@@ -214,9 +217,22 @@ func (u cliUpdater) apply(ctx context.Context, res update.Result) (update.SwapSt
 	if _, werr := fmt.Fprintf(u.out, "resterm updated to %s\n", res.Info.Version); werr != nil {
 		log.Printf("print update notice failed: %v", werr)
 	}
+	u.saveWhatsNew(res)
 	return st, err
 }
 
+// saveWhatsNew keeps the release notes around so the next TUI launch
+// can show them. Failing to write them never fails the update itself.
+func (u cliUpdater) saveWhatsNew(res update.Result) {
+	if u.notesPath == "" || strings.TrimSpace(res.Info.Notes) == "" {
+		return
+	}
+	notes := config.WhatsNew{Version: res.Info.Version, Notes: res.Info.Notes}
+	if err := config.SaveWhatsNew(u.notesPath, notes); err != nil {
+		log.Printf("save whats-new failed: %v", err)
+	}
+}
+
 func resolveExecPath(path string) string {
 	clean := strings.TrimSpace(path)
 	if clean == "" {
@@ -259,7 +275,7 @@ func (u cliUpdater) printChangelog(res update.Result) {
 		log.Printf("print changelog header failed: %v", err)
 		return
 	}
-	for _, line := range formatChangelog(notes) {
+	for _, line := range update.FormatNotes(notes) {
 		if _, err := fmt.Fprintln(u.out, line); err != nil {
 			log.Printf("print changelog body failed: %v", err)
 			return
@@ -270,48 +286,6 @@ func (u cliUpdater) printChangelog(res update.Result) {
 	}
 }
 
-func formatChangelog(raw string) []string {
-	normalized := strings.ReplaceAll(raw, "\r\n", "\n")
-	lines := strings.Split(normalized, "\n")
-	out := make([]string, 0, len(lines))
-	for _, line := range lines {
-		trimmedRight := strings.TrimRight(line, " \t")
-		if strings.TrimSpace(trimmedRight) == "" {
-			out = append(out, "")
-			continue
-		}
-
-		leading := countLeadingSpaces(line)
-		token := strings.TrimSpace(trimmedRight)
-		switch {
-		case strings.HasPrefix(token, "- ") || strings.HasPrefix(token, "* "):
-			item := strings.TrimSpace(token[2:])
-			out = append(out, strings.Repeat(" ", leading)+"• "+item)
-		default:
-			out = append(out, trimmedRight)
-		}
-	}
-	return out
-}
-
-// Tabs count as 4 spaces for changelog indentation calculation
-// so markdown nested lists look reasonable in the terminal.
-func countLeadingSpaces(s string) int {
-	count := 0
-	for _, r := range s {
-		if r == ' ' || r == '\t' {
-			if r == '\t' {
-				count += 4
-			} else {
-				count++
-			}
-			continue
-		}
-		break
-	}
-	return count
-}
-
 func humanBytes(n int64) string {
 	const (
 		kb = 1024
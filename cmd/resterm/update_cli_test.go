@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/unkn0wn-root/resterm/internal/config"
 	"github.com/unkn0wn-root/resterm/internal/update"
 )
 
@@ -19,3 +22,26 @@ func TestCLIUpdaterCheckDev(t *testing.T) {
 		t.Fatalf("expected errUpdateDisabled, got %v", err)
 	}
 }
+
+func TestCLIUpdaterSaveWhatsNew(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "whats-new.json")
+	u := cliUpdater{notesPath: path}
+	u.saveWhatsNew(update.Result{Info: update.Info{Version: "v1.2.0", Notes: "- faster"}})
+
+	got, err := config.LoadWhatsNew(path)
+	if err != nil {
+		t.Fatalf("load whats-new: %v", err)
+	}
+	if got.Version != "v1.2.0" || got.Notes != "- faster" {
+		t.Fatalf("unexpected whats-new %+v", got)
+	}
+}
+
+func TestCLIUpdaterSaveWhatsNewSkipsEmptyNotes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "whats-new.json")
+	u := cliUpdater{notesPath: path}
+	u.saveWhatsNew(update.Result{Info: update.Info{Version: "v1.2.0"}})
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected no whats-new file, got err %v", err)
+	}
+}
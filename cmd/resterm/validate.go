@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+// validateFile parses path without starting the UI and prints every parse
+// error and warning as file:line. Only errors make it fail, so CI can gate
+// on malformed directives before anything is sent.
+func validateFile(w io.Writer, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+	doc := parser.Parse(path, data)
+
+	printDiagnostics(w, path, "error", doc.Errors)
+	printDiagnostics(w, path, "warning", doc.Warnings)
+
+	if n := len(doc.Errors); n > 0 {
+		return cliExitErr{
+			err:  fmt.Errorf("validate: %s has %s", path, plural(n, "parse error")),
+			code: 1,
+		}
+	}
+	_, err = fmt.Fprintf(
+		w,
+		"%s: ok (%s, %s)\n",
+		path,
+		plural(len(doc.Requests), "request"),
+		plural(len(doc.Warnings), "warning"),
+	)
+	return err
+}
+
+func printDiagnostics(w io.Writer, path, kind string, diags []restfile.ParseError) {
+	sorted := append([]restfile.ParseError(nil), diags...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Line < sorted[j].Line
+	})
+	for _, d := range sorted {
+		if d.Column > 0 {
+			_, _ = fmt.Fprintf(w, "%s:%d:%d: %s: %s\n", path, d.Line, d.Column, kind, d.Message)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s:%d: %s: %s\n", path, d.Line, kind, d.Message)
+	}
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
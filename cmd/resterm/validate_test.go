@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateFileReportsParseErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api.http")
+	src := "GET https://example.com/ok\n\n###\n\n" +
+		"# @assert jsonpath count == 5\n" +
+		"GET https://example.com/api\n"
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var out bytes.Buffer
+	err := validateFile(&out, path)
+	if err == nil {
+		t.Fatalf("expected validation to fail")
+	}
+	if c := exitCode(err); c != 1 {
+		t.Fatalf("expected exit code 1, got %d", c)
+	}
+	if !strings.Contains(err.Error(), "1 parse error") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := path + ":5: error: "
+	if !strings.HasPrefix(out.String(), want) || !strings.Contains(out.String(), "$") {
+		t.Fatalf("expected %q line, got %q", want, out.String())
+	}
+}
+
+func TestRunValidateCleanFile(t *testing.T) {
+	t.Setenv("RESTERM_CONFIG_DIR", t.TempDir())
+	path := filepath.Join(t.TempDir(), "api.http")
+	if err := os.WriteFile(path, []byte("GET https://example.com\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	stdout, _, err := captureRunIO(t, func() error {
+		return run([]string{"--file", path, "--validate"})
+	})
+	if err != nil {
+		t.Fatalf("expected clean file to validate, got %v", err)
+	}
+	if !strings.Contains(stdout, "ok (1 request, 0 warnings)") {
+		t.Fatalf("unexpected output %q", stdout)
+	}
+
+	if err := run([]string{"--validate"}); exitCode(err) != 2 {
+		t.Fatalf("expected usage error without --file, got %v", err)
+	}
+}
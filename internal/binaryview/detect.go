@@ -59,8 +59,27 @@ const (
 )
 
 func Analyze(body []byte, contentType string) Meta {
-	mimeType, charsetLabel := parseContentType(contentType)
+	return AnalyzeCharset(body, contentType, "")
+}
+
+// AnalyzeCharset is Analyze with the charset forced, for legacy APIs whose
+// Content-Type omits or misstates it. An empty charsetLabel falls back to the
+// Content-Type charset. Bodies in a non-UTF-8 charset are judged printable on
+// their decoded text, so Latin-1 or Shift-JIS is not mistaken for binary.
+func AnalyzeCharset(body []byte, contentType, charsetLabel string) Meta {
+	mimeType, declared := parseContentType(contentType)
+	label := strings.ToLower(strings.TrimSpace(charsetLabel))
+	if label == "" {
+		label = declared
+	}
 	printable := isLikelyPrintable(body)
+	if !printable && label != "" && !isUTF8Label(label) {
+		sample := trimPreview(body, printableSampleLimit)
+		if decoded, ok, _ := DecodeText(sample, label); ok {
+			printable = isLikelyPrintable([]byte(decoded))
+		}
+	}
+	charsetLabel = label
 	kind := decideKind(mimeType, printable)
 
 	preview := trimPreview(body, previewByteLimit)
@@ -94,6 +113,10 @@ func DecodeText(body []byte, charsetLabel string) (string, bool, string) {
 	return string(decoded), true, ""
 }
 
+func isUTF8Label(label string) bool {
+	return label == "utf-8" || label == "utf8"
+}
+
 // decideKind figures out if we should treat response as text or binary.
 // Priority: trust MIME type first, fall back to byte analysis if unknown.
 // This matters because some servers lie about Content-Type, so we double check
@@ -19,7 +19,7 @@ func FilenameHint(disposition, rawURL, mimeType string) string {
 		name = filenameFromURL(rawURL)
 	}
 
-	ext := extensionForMIME(mimeType)
+	ext := ExtensionForMIME(mimeType)
 
 	if name == "" {
 		name = "response"
@@ -82,7 +82,34 @@ func filenameFromURL(rawURL string) string {
 	return name
 }
 
-func extensionForMIME(mimeType string) string {
+// preferredExtensions pins the extension for common types. The system MIME
+// tables list several per type and mime.ExtensionsByType sorts them, so
+// text/html can come back as ".ehtml" and image/jpeg as ".jfif".
+var preferredExtensions = map[string]string{
+	"application/json":       ".json",
+	"application/xml":        ".xml",
+	"application/yaml":       ".yaml",
+	"application/pdf":        ".pdf",
+	"application/zip":        ".zip",
+	"application/gzip":       ".gz",
+	"application/javascript": ".js",
+	"text/html":              ".html",
+	"text/plain":             ".txt",
+	"text/csv":               ".csv",
+	"text/xml":               ".xml",
+	"text/yaml":              ".yaml",
+	"text/javascript":        ".js",
+	"image/jpeg":             ".jpg",
+	"image/png":              ".png",
+	"image/gif":              ".gif",
+	"image/webp":             ".webp",
+	"image/svg+xml":          ".svg",
+}
+
+// ExtensionForMIME returns the file extension (with the dot) for a
+// Content-Type value, or "" when the type is unknown. Structured syntax
+// suffixes such as application/problem+json map to their base format.
+func ExtensionForMIME(mimeType string) string {
 	mt := strings.TrimSpace(mimeType)
 	if mt == "" {
 		return ""
@@ -92,6 +119,19 @@ func extensionForMIME(mimeType string) string {
 	if mediaType, _, err := mime.ParseMediaType(mt); err == nil && mediaType != "" {
 		mt = mediaType
 	}
+	mt = strings.ToLower(mt)
+
+	if ext, ok := preferredExtensions[mt]; ok {
+		return ext
+	}
+	switch {
+	case strings.HasSuffix(mt, "+json"):
+		return ".json"
+	case strings.HasSuffix(mt, "+xml"):
+		return ".xml"
+	case strings.HasSuffix(mt, "+yaml"):
+		return ".yaml"
+	}
 
 	exts, err := mime.ExtensionsByType(mt)
 	if err != nil || len(exts) == 0 {
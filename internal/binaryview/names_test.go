@@ -62,3 +62,20 @@ func TestFilenameHintSanitize(t *testing.T) {
 		t.Fatalf("expected sanitized basename with fallback extension, got %q", name)
 	}
 }
+
+func TestExtensionForMIME(t *testing.T) {
+	cases := map[string]string{
+		"application/json; charset=utf-8": ".json",
+		"application/problem+json":        ".json",
+		"application/atom+xml":            ".xml",
+		"text/html":                       ".html",
+		"IMAGE/JPEG":                      ".jpg",
+		"application/x-unknown-thing":     "",
+		"":                                "",
+	}
+	for in, want := range cases {
+		if got := ExtensionForMIME(in); got != want {
+			t.Errorf("ExtensionForMIME(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
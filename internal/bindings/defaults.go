@@ -8,9 +8,11 @@ var (
 	ActionCycleFocusPrev          ActionID = "cycle_focus_prev"
 	ActionOpenEnvSelector         ActionID = "open_env_selector"
 	ActionShowGlobals             ActionID = "show_globals"
+	ActionRevealSecrets           ActionID = "reveal_secrets"
 	ActionClearGlobals            ActionID = "clear_globals"
 	ActionSaveFile                ActionID = "save_file"
 	ActionSaveLayout              ActionID = "save_layout"
+	ActionLayoutPresets           ActionID = "layout_presets"
 	ActionToggleResponseSplitVert ActionID = "toggle_response_split_vertical"
 	ActionToggleResponseSplitHorz ActionID = "toggle_response_split_horizontal"
 	ActionTogglePaneFollowLatest  ActionID = "toggle_pane_follow_latest"
@@ -46,13 +48,38 @@ var (
 	ActionSendRequest             ActionID = "send_request"
 	ActionCancelRun               ActionID = "cancel_run"
 	ActionCopyResponseTab         ActionID = "copy_response_tab"
+	ActionCopyResponseBody        ActionID = "copy_response_body"
+	ActionCopyRequestResponse     ActionID = "copy_request_response"
+	ActionCopyResolvedURL         ActionID = "copy_resolved_url"
 	ActionToggleHeaderPreview     ActionID = "toggle_header_preview"
 	ActionCycleRawView            ActionID = "cycle_raw_view"
 	ActionShowRawDump             ActionID = "show_raw_dump"
 	ActionScrollResponseTop       ActionID = "scroll_response_top"
 	ActionScrollResponseBottom    ActionID = "scroll_response_bottom"
+	ActionJumpResponseStatus      ActionID = "jump_response_status"
+	ActionJumpResponseHeaders     ActionID = "jump_response_headers"
+	ActionJumpResponseBody        ActionID = "jump_response_body"
+	ActionEditEnvironment         ActionID = "edit_environment"
+	ActionEditRequestHeaders      ActionID = "edit_request_headers"
+	ActionEditRequestBody         ActionID = "edit_request_body"
+	ActionFormatRequestBody       ActionID = "format_request_body"
 	ActionSaveResponseBody        ActionID = "save_response_body"
 	ActionOpenResponseExternally  ActionID = "open_response_externally"
+	ActionOpenResponseBrowser     ActionID = "open_response_browser"
+	ActionShowGRPCSchema          ActionID = "show_grpc_schema"
+	ActionDuplicateRequest        ActionID = "duplicate_request"
+	ActionCycleBodyFormat         ActionID = "cycle_body_format"
+	ActionPinBodyFormat           ActionID = "pin_body_format"
+	ActionShowVariableRefs        ActionID = "show_variable_refs"
+	ActionOpenRecentRequests      ActionID = "open_recent_requests"
+	ActionGotoRequest             ActionID = "goto_request"
+	ActionClearPersistedGlobals   ActionID = "clear_persisted_globals"
+	ActionSendVisibleRequests     ActionID = "send_visible_requests"
+	ActionSendVisibleUntilFail    ActionID = "send_visible_until_fail"
+	ActionClearExecTokens         ActionID = "clear_exec_tokens"
+	ActionJumpParseError          ActionID = "jump_parse_error"
+	ActionEscapeSelectionJSON     ActionID = "escape_selection_json"
+	ActionUnescapeSelectionJSON   ActionID = "unescape_selection_json"
 )
 
 type definition struct {
@@ -65,10 +92,16 @@ var definitions = []definition{
 	def(ActionCycleFocusNext, false, "tab"),
 	def(ActionCycleFocusPrev, false, "shift+tab"),
 	def(ActionOpenEnvSelector, false, "ctrl+e"),
+	def(ActionEditEnvironment, false, "g e"),
+	def(ActionEditRequestHeaders, false, "g a"),
+	def(ActionEditRequestBody, false, "g shift+a"),
+	def(ActionFormatRequestBody, false, "g shift+p"),
 	def(ActionShowGlobals, false, "ctrl+g"),
+	def(ActionRevealSecrets, false, "g shift+x"),
 	def(ActionClearGlobals, false, "ctrl+shift+g"),
 	def(ActionSaveFile, false, "ctrl+s"),
 	def(ActionSaveLayout, false, "g shift+l"),
+	def(ActionLayoutPresets, false, "g shift+w"),
 	def(ActionToggleResponseSplitVert, false, "ctrl+v"),
 	def(ActionToggleResponseSplitHorz, false, "ctrl+u"),
 	def(ActionTogglePaneFollowLatest, false, "ctrl+shift+v"),
@@ -104,13 +137,34 @@ var definitions = []definition{
 	def(ActionSendRequest, false, "ctrl+enter", "cmd+enter", "alt+enter", "ctrl+j", "ctrl+m"),
 	def(ActionCancelRun, false, "ctrl+c"),
 	def(ActionCopyResponseTab, false, "ctrl+shift+c", "g y"),
+	def(ActionCopyResponseBody, false, "g shift+y"),
+	def(ActionCopyRequestResponse, false, "g shift+b"),
+	def(ActionCopyResolvedURL, false, "g shift+u"),
 	def(ActionToggleHeaderPreview, false, "g shift+h"),
 	def(ActionCycleRawView, false, "g b"),
 	def(ActionShowRawDump, false, "g shift+d"),
 	def(ActionScrollResponseTop, false, "g g"),
 	def(ActionScrollResponseBottom, false, "shift+g"),
+	def(ActionJumpResponseStatus, false, "g 4"),
+	def(ActionJumpResponseHeaders, false, "g 5"),
+	def(ActionJumpResponseBody, false, "g 6"),
 	def(ActionSaveResponseBody, false, "g shift+s"),
 	def(ActionOpenResponseExternally, false, "g shift+e"),
+	def(ActionOpenResponseBrowser, false, "g o"),
+	def(ActionShowGRPCSchema, false, "g shift+m"),
+	def(ActionDuplicateRequest, false, "g d"),
+	def(ActionCycleBodyFormat, false, "g f"),
+	def(ActionPinBodyFormat, false, "g shift+f"),
+	def(ActionShowVariableRefs, false, "g u"),
+	def(ActionOpenRecentRequests, false, "g q"),
+	def(ActionGotoRequest, false, "g /"),
+	def(ActionClearPersistedGlobals, false, "g shift+g"),
+	def(ActionSendVisibleRequests, false, "g n"),
+	def(ActionSendVisibleUntilFail, false, "g shift+n"),
+	def(ActionClearExecTokens, false, "g x"),
+	def(ActionJumpParseError, false, "g shift+v"),
+	def(ActionEscapeSelectionJSON, false, "g shift+q"),
+	def(ActionUnescapeSelectionJSON, false, "g shift+c"),
 }
 
 var definitionLookup = func() map[ActionID]definition {
@@ -0,0 +1,29 @@
+package config
+
+import "strings"
+
+// ClipboardMode picks how copied text reaches the system clipboard.
+type ClipboardMode string
+
+const (
+	// ClipboardAuto uses the native clipboard and falls back to OSC52 when
+	// no clipboard tool is available, e.g. on a headless host over SSH.
+	ClipboardAuto ClipboardMode = "auto"
+	// ClipboardNative talks to the OS clipboard (pbcopy, xclip, wl-copy, ...).
+	ClipboardNative ClipboardMode = "native"
+	// ClipboardOSC52 asks the terminal to set the clipboard with an OSC52
+	// escape sequence, which also works over plain SSH.
+	ClipboardOSC52 ClipboardMode = "osc52"
+	// ClipboardOff keeps copies in the editor register only.
+	ClipboardOff ClipboardMode = "off"
+)
+
+// NormaliseClipboardMode maps unknown or empty values to ClipboardAuto.
+func NormaliseClipboardMode(in ClipboardMode) ClipboardMode {
+	switch mode := ClipboardMode(strings.ToLower(strings.TrimSpace(string(in)))); mode {
+	case ClipboardNative, ClipboardOSC52, ClipboardOff:
+		return mode
+	default:
+		return ClipboardAuto
+	}
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// PersistedGlobal is a global variable kept on disk between sessions.
+type PersistedGlobal struct {
+	Name      string    `json:"name"`
+	Value     string    `json:"value"`
+	Secret    bool      `json:"secret,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GlobalsFile maps environment keys to their saved globals, keyed by name.
+type GlobalsFile map[string]map[string]PersistedGlobal
+
+// LoadGlobals reads persisted globals. A missing file yields an empty set.
+func LoadGlobals(path string) (GlobalsFile, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return GlobalsFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read globals: %w", err)
+	}
+	globals := GlobalsFile{}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return globals, nil
+	}
+	if err := json.Unmarshal(data, &globals); err != nil {
+		return nil, fmt.Errorf("decode globals: %w", err)
+	}
+	return globals, nil
+}
+
+// SaveGlobals writes globals atomically. The file is readable only by the
+// owner since captured values are often tokens.
+func SaveGlobals(path string, globals GlobalsFile) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("ensure globals directory: %w", err)
+	}
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(globals); err != nil {
+		return fmt.Errorf("encode globals: %w", err)
+	}
+	if err := writeFileAtomic(path, buffer.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("write globals: %w", err)
+	}
+	return nil
+}
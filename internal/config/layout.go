@@ -25,6 +25,15 @@ type LayoutSettings struct {
 	ResponseOrientation LayoutResponseOrientation `json:"response_orientation" toml:"response_orientation"`
 }
 
+// LayoutPreset is a named layout the user can switch to. Besides pane
+// sizes and split orientation it remembers which panes were collapsed.
+type LayoutPreset struct {
+	Layout            LayoutSettings `json:"layout"             toml:"layout"`
+	SidebarCollapsed  bool           `json:"sidebar_collapsed"  toml:"sidebar_collapsed"`
+	EditorCollapsed   bool           `json:"editor_collapsed"   toml:"editor_collapsed"`
+	ResponseCollapsed bool           `json:"response_collapsed" toml:"response_collapsed"`
+}
+
 const (
 	LayoutSidebarWidthDefault  = 0.2
 	LayoutSidebarWidthMin      = 0.05
@@ -77,6 +86,31 @@ func NormaliseLayoutSettings(in LayoutSettings) LayoutSettings {
 	return layout
 }
 
+// NormaliseLayoutPresets normalises every preset layout and drops presets
+// with a blank name. A preset that would hide both the editor and the
+// response keeps the editor visible.
+func NormaliseLayoutPresets(in map[string]LayoutPreset) map[string]LayoutPreset {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make(map[string]LayoutPreset, len(in))
+	for name, preset := range in {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		preset.Layout = NormaliseLayoutSettings(preset.Layout)
+		if preset.EditorCollapsed && preset.ResponseCollapsed {
+			preset.EditorCollapsed = false
+		}
+		out[name] = preset
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
 func normaliseMainSplit(in LayoutMainSplit, def LayoutMainSplit) LayoutMainSplit {
 	switch strings.ToLower(strings.TrimSpace(string(in))) {
 	case string(LayoutMainSplitHorizontal):
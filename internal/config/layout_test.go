@@ -94,3 +94,30 @@ func TestNormaliseResponseOrientationHonoursExplicitVertical(t *testing.T) {
 		t.Fatalf("expected explicit vertical to be preserved, got %v", orientation)
 	}
 }
+
+func TestNormaliseLayoutPresets(t *testing.T) {
+	got := NormaliseLayoutPresets(map[string]LayoutPreset{
+		" review ": {
+			Layout:            LayoutSettings{EditorSplit: 0.9},
+			EditorCollapsed:   true,
+			ResponseCollapsed: true,
+		},
+		"  ": {},
+	})
+	if len(got) != 1 {
+		t.Fatalf("expected blank names dropped, got %+v", got)
+	}
+	preset, ok := got["review"]
+	if !ok {
+		t.Fatalf("expected trimmed name, got %+v", got)
+	}
+	if preset.Layout.EditorSplit != LayoutEditorSplitMax {
+		t.Fatalf("expected editor split clamped, got %v", preset.Layout.EditorSplit)
+	}
+	if preset.EditorCollapsed || !preset.ResponseCollapsed {
+		t.Fatalf("expected editor kept visible, got %+v", preset)
+	}
+	if NormaliseLayoutPresets(nil) != nil {
+		t.Fatalf("expected nil for no presets")
+	}
+}
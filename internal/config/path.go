@@ -30,6 +30,14 @@ func HistoryPath() string {
 	return filepath.Join(Dir(), "history.db")
 }
 
+func GlobalsPath() string {
+	return filepath.Join(Dir(), "globals.json")
+}
+
 func LegacyHistoryPath() string {
 	return filepath.Join(Dir(), "history.json")
 }
+
+func WhatsNewPath() string {
+	return filepath.Join(Dir(), "whats-new.json")
+}
@@ -18,8 +18,24 @@ const (
 )
 
 type Settings struct {
-	DefaultTheme string         `json:"default_theme" toml:"default_theme"`
-	Layout       LayoutSettings `json:"layout"        toml:"layout"`
+	DefaultTheme         string                  `json:"default_theme"               toml:"default_theme"`
+	Layout               LayoutSettings          `json:"layout"                      toml:"layout"`
+	LayoutPresets        map[string]LayoutPreset `json:"layout_presets,omitempty"    toml:"layout_presets,omitempty"`
+	FormatOnSave         bool                    `json:"format_on_save"              toml:"format_on_save"`
+	LastBrowseDir        string                  `json:"last_browse_dir"             toml:"last_browse_dir"`
+	AllowExecVars        bool                    `json:"allow_exec_vars"             toml:"allow_exec_vars"`
+	SandboxScripts       bool                    `json:"sandbox_scripts"             toml:"sandbox_scripts"`
+	DefaultAccept        string                  `json:"default_accept"              toml:"default_accept"`
+	RequestIDHeader      string                  `json:"request_id_header,omitempty" toml:"request_id_header,omitempty"`
+	HeaderDiff           bool                    `json:"header_diff"                 toml:"header_diff"`
+	HeaderDiffIgnore     []string                `json:"header_diff_ignore"          toml:"header_diff_ignore"`
+	HideStatusMeaning    bool                    `json:"hide_status_meaning"         toml:"hide_status_meaning"`
+	Clipboard            ClipboardMode           `json:"clipboard,omitempty"         toml:"clipboard,omitempty"`
+	PersistGlobals       bool                    `json:"persist_globals"             toml:"persist_globals"`
+	PersistSecretGlobals bool                    `json:"persist_secret_globals"      toml:"persist_secret_globals"`
+	FocusResponseOnSend  bool                    `json:"focus_response_on_send"      toml:"focus_response_on_send"`
+	JSONMaxDepth         int                     `json:"json_max_depth,omitempty"    toml:"json_max_depth,omitempty"`
+	LastSeenVersion      string                  `json:"last_seen_version,omitempty" toml:"last_seen_version,omitempty"`
 }
 
 type SettingsFormat string
@@ -60,6 +76,7 @@ func LoadSettings() (Settings, SettingsHandle, error) {
 			)
 		}
 		settings.Layout = NormaliseLayoutSettings(settings.Layout)
+		settings.LayoutPresets = NormaliseLayoutPresets(settings.LayoutPresets)
 		return settings, candidate, nil
 	}
 
@@ -96,6 +113,7 @@ func decodeSettings(data []byte, format SettingsFormat) (Settings, error) {
 
 func SaveSettings(settings Settings, handle SettingsHandle) error {
 	settings.Layout = NormaliseLayoutSettings(settings.Layout)
+	settings.LayoutPresets = NormaliseLayoutPresets(settings.LayoutPresets)
 	path := handle.Path
 	format := handle.Format
 	if path == "" {
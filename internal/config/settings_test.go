@@ -38,7 +38,7 @@ func TestSaveAndLoadSettingsTOML(t *testing.T) {
 	dir := t.TempDir()
 	t.Setenv("RESTERM_CONFIG_DIR", dir)
 
-	want := Settings{DefaultTheme: "oceanic"}
+	want := Settings{DefaultTheme: "oceanic", FormatOnSave: true}
 	if err := SaveSettings(want, SettingsHandle{}); err != nil {
 		t.Fatalf("SaveSettings failed: %v", err)
 	}
@@ -50,6 +50,9 @@ func TestSaveAndLoadSettingsTOML(t *testing.T) {
 	if got.DefaultTheme != want.DefaultTheme {
 		t.Fatalf("expected theme %q, got %q", want.DefaultTheme, got.DefaultTheme)
 	}
+	if !got.FormatOnSave {
+		t.Fatalf("expected format_on_save to round-trip")
+	}
 	if handle.Format != SettingsFormatTOML {
 		t.Fatalf("expected format %q after save, got %q", SettingsFormatTOML, handle.Format)
 	}
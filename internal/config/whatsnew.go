@@ -0,0 +1,61 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// WhatsNew holds the release notes of a version installed by --update so the
+// next interactive launch can show them once.
+type WhatsNew struct {
+	Version string `json:"version"`
+	Notes   string `json:"notes"`
+}
+
+// LoadWhatsNew reads pending release notes. A missing file yields a zero value.
+func LoadWhatsNew(path string) (WhatsNew, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return WhatsNew{}, nil
+	}
+	if err != nil {
+		return WhatsNew{}, fmt.Errorf("read whats-new: %w", err)
+	}
+	var notes WhatsNew
+	if len(bytes.TrimSpace(data)) == 0 {
+		return notes, nil
+	}
+	if err := json.Unmarshal(data, &notes); err != nil {
+		return WhatsNew{}, fmt.Errorf("decode whats-new: %w", err)
+	}
+	return notes, nil
+}
+
+func SaveWhatsNew(path string, notes WhatsNew) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("ensure whats-new directory: %w", err)
+	}
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(notes); err != nil {
+		return fmt.Errorf("encode whats-new: %w", err)
+	}
+	if err := writeFileAtomic(path, buffer.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write whats-new: %w", err)
+	}
+	return nil
+}
+
+// ClearWhatsNew removes pending release notes once they have been shown.
+func ClearWhatsNew(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove whats-new: %w", err)
+	}
+	return nil
+}
@@ -56,6 +56,14 @@ type Response struct {
 	StatusCode      codes.Code
 	StatusMessage   string
 	Duration        time.Duration
+	// HealthStatus is the serving status reported by a health check call.
+	HealthStatus string
+	// Text is the message in Protobuf text format, rendered with the
+	// resolved descriptor. Empty when it could not be produced.
+	Text string
+	// Messages is the number of messages received on a streaming call,
+	// including those that arrived before it was canceled.
+	Messages int
 }
 
 type StreamHook func(*stream.Session)
@@ -106,12 +114,44 @@ func (c *Client) Execute(
 	}
 	defer cancel()
 
+	conn, err := dialConn(target, grpcReq, options)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if closeErr := conn.Close(); closeErr != nil && err == nil {
+			err = errdef.Wrap(errdef.CodeHTTP, closeErr, "close grpc connection")
+		}
+	}()
+
+	methodDesc, err := c.resolveMethodDescriptor(ctx, conn, grpcReq, options)
+	if err != nil {
+		return nil, err
+	}
+
+	messageJSON, err := c.resolveMessage(grpcReq, options.BaseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if isStreaming(methodDesc) {
+		return c.executeStream(ctx, conn, req, grpcReq, methodDesc, messageJSON, hook)
+	}
+	return c.executeUnary(ctx, conn, req, grpcReq, methodDesc, messageJSON)
+}
+
+func dialConn(
+	target string,
+	grpcReq *restfile.GRPCRequest,
+	options Options,
+) (*grpc.ClientConn, error) {
 	usePlain := shouldUsePlaintext(grpcReq, options)
 	dialOpts := []grpc.DialOption{}
 	if usePlain {
 		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	} else {
-		creds, err := buildTransportCredentials(options)
+		creds, err := buildTransportCredentials(grpcReq, options)
 		if err != nil {
 			return nil, err
 		}
@@ -145,27 +185,7 @@ func (c *Client) Execute(
 	if err != nil {
 		return nil, errdef.Wrap(errdef.CodeHTTP, err, "dial grpc target")
 	}
-
-	defer func() {
-		if closeErr := conn.Close(); closeErr != nil && err == nil {
-			err = errdef.Wrap(errdef.CodeHTTP, closeErr, "close grpc connection")
-		}
-	}()
-
-	methodDesc, err := c.resolveMethodDescriptor(ctx, conn, grpcReq, options)
-	if err != nil {
-		return nil, err
-	}
-
-	messageJSON, err := c.resolveMessage(grpcReq, options.BaseDir)
-	if err != nil {
-		return nil, err
-	}
-
-	if isStreaming(methodDesc) {
-		return c.executeStream(ctx, conn, req, grpcReq, methodDesc, messageJSON, hook)
-	}
-	return c.executeUnary(ctx, conn, req, grpcReq, methodDesc, messageJSON)
+	return conn, nil
 }
 
 func (c *Client) executeUnary(
@@ -221,10 +241,16 @@ func (c *Client) executeUnary(
 	}
 	resp.Message = string(marshalled)
 	resp.Body = marshalled
+	if grpcReq.FullMethod == HealthCheckMethod {
+		resp.HealthStatus = healthStatus(outputMsg)
+	}
 
 	if wire, err := proto.Marshal(outputMsg); err == nil {
 		resp.Wire = wire
 	}
+	if text, err := marshalText(outputMsg); err == nil {
+		resp.Text = string(text)
+	}
 	if len(resp.Body) == 0 {
 		resp.Body = marshalled
 	}
@@ -241,6 +267,9 @@ func (c *Client) resolveMethodDescriptor(
 	if grpcReq.FullMethod == "" {
 		return nil, errdef.New(errdef.CodeHTTP, "grpc method not specified")
 	}
+	if grpcReq.FullMethod == HealthCheckMethod && grpcReq.DescriptorSet == "" {
+		return healthCheckDescriptor(), nil
+	}
 
 	if grpcReq.DescriptorSet != "" {
 		set, err := c.loadDescriptorSet(grpcReq.DescriptorSet, options.BaseDir)
@@ -261,7 +290,7 @@ func (c *Client) resolveMethodDescriptor(
 		)
 	}
 
-	fds, err := fetchDescriptorsViaReflection(ctx, conn, grpcReq.FullMethod)
+	fds, err := reflectDescriptors(ctx, conn, grpcReq, options)
 	if err != nil {
 		return nil, err
 	}
@@ -273,6 +302,36 @@ func (c *Client) resolveMethodDescriptor(
 	return findMethodInFiles(files, grpcReq)
 }
 
+// reflectDescriptors asks conn for the method's descriptors, or a separate
+// connection to the request's reflection target when one is set.
+func reflectDescriptors(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	grpcReq *restfile.GRPCRequest,
+	options Options,
+) (fds *descriptorpb.FileDescriptorSet, err error) {
+	target := strings.TrimSpace(grpcReq.ReflectionTarget)
+	if target == "" {
+		return fetchDescriptorsViaReflection(ctx, conn, grpcReq.FullMethod)
+	}
+
+	reflectReq := *grpcReq
+	if grpcReq.ReflectionPlaintextSet {
+		reflectReq.Plaintext = grpcReq.ReflectionPlaintext
+		reflectReq.PlaintextSet = true
+	}
+	reflectConn, err := dialConn(target, &reflectReq, options)
+	if err != nil {
+		return nil, errdef.Wrap(errdef.CodeHTTP, err, "dial grpc reflection target")
+	}
+	defer func() {
+		if closeErr := reflectConn.Close(); closeErr != nil && err == nil {
+			err = errdef.Wrap(errdef.CodeHTTP, closeErr, "close grpc reflection connection")
+		}
+	}()
+	return fetchDescriptorsViaReflection(ctx, reflectConn, grpcReq.FullMethod)
+}
+
 func (c *Client) loadDescriptorSet(
 	descriptorPath, baseDir string,
 ) (*descriptorpb.FileDescriptorSet, error) {
@@ -326,9 +385,22 @@ func (c *Client) resolveMessage(grpcReq *restfile.GRPCRequest, baseDir string) (
 	return string(data), nil
 }
 
-func buildTransportCredentials(opts Options) (credentials.TransportCredentials, error) {
+// buildTransportCredentials layers the request's @grpc-ca and
+// @grpc-server-name on top of the TLS settings shared with HTTP.
+func buildTransportCredentials(
+	grpcReq *restfile.GRPCRequest,
+	opts Options,
+) (credentials.TransportCredentials, error) {
+	roots := opts.RootCAs
+	serverName := ""
+	if grpcReq != nil {
+		if ca := strings.TrimSpace(grpcReq.RootCA); ca != "" {
+			roots = append(append([]string(nil), roots...), ca)
+		}
+		serverName = strings.TrimSpace(grpcReq.ServerName)
+	}
 	cfg, err := tlsconfig.Build(tlsconfig.Files{
-		RootCAs:    opts.RootCAs,
+		RootCAs:    roots,
 		ClientCert: opts.ClientCert,
 		ClientKey:  opts.ClientKey,
 		Insecure:   opts.Insecure,
@@ -337,6 +409,9 @@ func buildTransportCredentials(opts Options) (credentials.TransportCredentials,
 	if err != nil {
 		return nil, err
 	}
+	if serverName != "" {
+		cfg.ServerName = serverName
+	}
 	return credentials.NewTLS(cfg), nil
 }
 
@@ -440,6 +515,9 @@ func shouldUsePlaintext(grpcReq *restfile.GRPCRequest, options Options) bool {
 	if grpcReq != nil && grpcReq.PlaintextSet {
 		return grpcReq.Plaintext
 	}
+	if grpcReq != nil && (grpcReq.RootCA != "" || grpcReq.ServerName != "") {
+		return false
+	}
 	if options.DefaultPlaintextSet {
 		return options.DefaultPlaintext
 	}
@@ -3,8 +3,10 @@ package grpcclient
 import (
 	"context"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/unkn0wn-root/resterm/internal/k8s"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
@@ -49,6 +51,70 @@ func TestShouldUsePlaintextDisabledWhenTLSConfigured(t *testing.T) {
 	}
 }
 
+func TestShouldUsePlaintextDisabledByRequestCA(t *testing.T) {
+	opts := Options{DefaultPlaintext: true, DefaultPlaintextSet: true}
+	req := &restfile.GRPCRequest{RootCA: "ca.pem"}
+
+	if shouldUsePlaintext(req, opts) {
+		t.Fatalf("expected @grpc-ca to enable TLS")
+	}
+}
+
+func TestExecuteVerifiesPrivateCAWithServerName(t *testing.T) {
+	tmp := t.TempDir()
+	creds := writeTestServerCert(t, filepath.Join(tmp, "ca.pem"), "ledger.internal")
+	addr, stop := startTestServer(t, creds)
+	defer stop()
+
+	opts := Options{
+		BaseDir:             tmp,
+		DefaultPlaintext:    true,
+		DefaultPlaintextSet: true,
+		DialTimeout:         time.Second,
+	}
+	req := &restfile.Request{Settings: map[string]string{}}
+	grpcReq := baseStreamReq(addr, "StreamingOutputCall")
+	grpcReq.PlaintextSet = false
+	grpcReq.Plaintext = false
+	grpcReq.RootCA = "ca.pem"
+	grpcReq.ServerName = "ledger.internal"
+
+	if _, err := NewClient().Execute(context.Background(), req, grpcReq, opts, nil); err != nil {
+		t.Fatalf("execute with private ca: %v", err)
+	}
+
+	grpcReq.ServerName = ""
+	_, err := NewClient().Execute(context.Background(), req, grpcReq, opts, nil)
+	if err == nil {
+		t.Fatalf("expected verification to fail without matching server name")
+	}
+}
+
+func TestExecuteUsesReflectionTarget(t *testing.T) {
+	callAddr, stopCall := startServer(t, false)
+	defer stopCall()
+	reflectAddr, stopReflect := startTestServer(t)
+	defer stopReflect()
+
+	opts := Options{DialTimeout: time.Second}
+	req := &restfile.Request{Settings: map[string]string{}}
+	grpcReq := baseStreamReq(callAddr, "StreamingOutputCall")
+
+	_, err := NewClient().Execute(context.Background(), req, grpcReq, opts, nil)
+	if err == nil || !strings.Contains(err.Error(), "reflection") {
+		t.Fatalf("expected reflection failure on call target, got %v", err)
+	}
+
+	grpcReq.ReflectionTarget = reflectAddr
+	resp, err := NewClient().Execute(context.Background(), req, grpcReq, opts, nil)
+	if err != nil {
+		t.Fatalf("execute with reflection target: %v", err)
+	}
+	if resp.Messages != 2 {
+		t.Fatalf("expected 2 streamed messages from call target, got %d", resp.Messages)
+	}
+}
+
 func TestExecuteRejectsSSHAndK8s(t *testing.T) {
 	client := NewClient()
 	grpcReq := &restfile.GRPCRequest{Target: "127.0.0.1:1", Plaintext: true, PlaintextSet: true}
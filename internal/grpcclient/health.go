@@ -0,0 +1,32 @@
+package grpcclient
+
+import (
+	"strconv"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HealthCheckMethod is the standard grpc.health.v1 Check RPC. Its descriptor
+// is compiled in, so probing works against servers without reflection.
+const HealthCheckMethod = "/grpc.health.v1.Health/Check"
+
+func healthCheckDescriptor() protoreflect.MethodDescriptor {
+	return healthpb.File_grpc_health_v1_health_proto.
+		Services().ByName("Health").
+		Methods().ByName("Check")
+}
+
+// healthStatus returns the serving status name (SERVING, NOT_SERVING, ...)
+// from a HealthCheckResponse.
+func healthStatus(msg protoreflect.Message) string {
+	fd := msg.Descriptor().Fields().ByName("status")
+	if fd == nil || fd.Enum() == nil {
+		return ""
+	}
+	num := msg.Get(fd).Enum()
+	if ev := fd.Enum().Values().ByNumber(num); ev != nil {
+		return string(ev.Name())
+	}
+	return strconv.Itoa(int(num))
+}
@@ -0,0 +1,51 @@
+package grpcclient
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestExecuteHealthCheckWithoutReflection(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("billing", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(srv, hs)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	run := func(msg string) *Response {
+		t.Helper()
+		grpcReq := &restfile.GRPCRequest{
+			Target:        lis.Addr().String(),
+			FullMethod:    HealthCheckMethod,
+			Message:       msg,
+			Plaintext:     true,
+			PlaintextSet:  true,
+			UseReflection: true,
+		}
+		resp, err := NewClient().
+			Execute(context.Background(), &restfile.Request{}, grpcReq, Options{}, nil)
+		if err != nil {
+			t.Fatalf("execute: %v", err)
+		}
+		return resp
+	}
+
+	if got := run("").HealthStatus; got != "SERVING" {
+		t.Fatalf("expected overall SERVING, got %q", got)
+	}
+	if got := run(`{"service": "billing"}`).HealthStatus; got != "NOT_SERVING" {
+		t.Fatalf("expected billing NOT_SERVING, got %q", got)
+	}
+}
@@ -0,0 +1,167 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldSchema describes a single field of a protobuf message.
+type FieldSchema struct {
+	Name   string
+	Number int32
+	Type   string
+	Label  string
+	Oneof  string
+}
+
+// MessageSchema lists the fields of a protobuf message.
+type MessageSchema struct {
+	Name   string
+	Fields []FieldSchema
+}
+
+// MethodSchema describes the request/response shape of a gRPC method.
+// Messages holds the input, output and every message type reachable
+// from them, in discovery order.
+type MethodSchema struct {
+	FullMethod      string
+	Input           string
+	Output          string
+	ClientStreaming bool
+	ServerStreaming bool
+	Messages        []MessageSchema
+}
+
+// Describe resolves the method descriptor (descriptor set or reflection)
+// and returns its message schema without invoking the method.
+func (c *Client) Describe(
+	parent context.Context,
+	grpcReq *restfile.GRPCRequest,
+	options Options,
+) (schema *MethodSchema, err error) {
+	if grpcReq == nil {
+		return nil, errdef.New(errdef.CodeHTTP, "missing grpc metadata")
+	}
+
+	ctx := parent
+	cancel := func() {}
+	if options.DialTimeout > 0 {
+		ctx, cancel = context.WithTimeout(parent, options.DialTimeout)
+	}
+	defer cancel()
+
+	target := strings.TrimSpace(grpcReq.Target)
+	if target == "" && grpcReq.DescriptorSet == "" {
+		return nil, errdef.New(errdef.CodeHTTP, "grpc target not specified")
+	}
+	if target == "" {
+		// Descriptor sets never touch the network; any placeholder works.
+		target = "passthrough:///descriptor"
+	}
+
+	conn, err := dialConn(target, grpcReq, options)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := conn.Close(); closeErr != nil && err == nil {
+			err = errdef.Wrap(errdef.CodeHTTP, closeErr, "close grpc connection")
+		}
+	}()
+
+	methodDesc, err := c.resolveMethodDescriptor(ctx, conn, grpcReq, options)
+	if err != nil {
+		return nil, err
+	}
+	return DescribeMethod(methodDesc), nil
+}
+
+// DescribeMethod builds a MethodSchema from a resolved method descriptor.
+func DescribeMethod(md protoreflect.MethodDescriptor) *MethodSchema {
+	if md == nil {
+		return nil
+	}
+	schema := &MethodSchema{
+		FullMethod:      "/" + string(md.Parent().FullName()) + "/" + string(md.Name()),
+		Input:           string(md.Input().FullName()),
+		Output:          string(md.Output().FullName()),
+		ClientStreaming: md.IsStreamingClient(),
+		ServerStreaming: md.IsStreamingServer(),
+	}
+
+	seen := make(map[protoreflect.FullName]bool)
+	queue := []protoreflect.MessageDescriptor{md.Input(), md.Output()}
+	for len(queue) > 0 {
+		msg := queue[0]
+		queue = queue[1:]
+		if msg == nil || seen[msg.FullName()] {
+			continue
+		}
+		seen[msg.FullName()] = true
+
+		out := MessageSchema{Name: string(msg.FullName())}
+		fields := msg.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			out.Fields = append(out.Fields, describeField(fd))
+			if next := fieldMessage(fd); next != nil && !seen[next.FullName()] {
+				queue = append(queue, next)
+			}
+		}
+		schema.Messages = append(schema.Messages, out)
+	}
+	return schema
+}
+
+func describeField(fd protoreflect.FieldDescriptor) FieldSchema {
+	field := FieldSchema{
+		Name:   string(fd.Name()),
+		Number: int32(fd.Number()),
+		Type:   fieldType(fd),
+	}
+	switch {
+	case fd.IsMap():
+	case fd.IsList():
+		field.Label = "repeated"
+	case fd.Cardinality() == protoreflect.Required:
+		field.Label = "required"
+	case fd.HasOptionalKeyword():
+		field.Label = "optional"
+	}
+	if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		field.Oneof = string(oneof.Name())
+	}
+	return field
+}
+
+func fieldType(fd protoreflect.FieldDescriptor) string {
+	if fd.IsMap() {
+		return fmt.Sprintf("map<%s, %s>", fieldType(fd.MapKey()), fieldType(fd.MapValue()))
+	}
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().FullName())
+	case protoreflect.EnumKind:
+		return "enum " + string(fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+// fieldMessage returns the message type a field refers to, unwrapping maps.
+func fieldMessage(fd protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if fd.IsMap() {
+		fd = fd.MapValue()
+	}
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return fd.Message()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDescribeViaReflection(t *testing.T) {
+	addr, stop := startTestServer(t)
+	defer stop()
+
+	grpcReq := baseStreamReq(addr, "StreamingOutputCall")
+	opts := Options{DefaultPlaintext: true, DefaultPlaintextSet: true, DialTimeout: time.Second}
+
+	schema, err := NewClient().Describe(context.Background(), grpcReq, opts)
+	if err != nil {
+		t.Fatalf("describe: %v", err)
+	}
+	if schema.Input != "grpc.testing.StreamingOutputCallRequest" {
+		t.Fatalf("unexpected input %q", schema.Input)
+	}
+	if schema.ClientStreaming || !schema.ServerStreaming {
+		t.Fatalf("expected server streaming method, got %+v", schema)
+	}
+	if len(schema.Messages) < 3 {
+		t.Fatalf("expected nested messages to be collected, got %d", len(schema.Messages))
+	}
+
+	in := schema.Messages[0]
+	fields := make(map[string]FieldSchema, len(in.Fields))
+	for _, f := range in.Fields {
+		fields[f.Name] = f
+	}
+	params, ok := fields["response_parameters"]
+	if !ok || params.Label != "repeated" || params.Type != "grpc.testing.ResponseParameters" {
+		t.Fatalf("unexpected response_parameters field %+v", params)
+	}
+	kind, ok := fields["response_type"]
+	if !ok || kind.Type != "enum grpc.testing.PayloadType" {
+		t.Fatalf("unexpected response_type field %+v", kind)
+	}
+
+	found := false
+	for _, msg := range schema.Messages {
+		if msg.Name == "grpc.testing.Payload" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected Payload message in schema")
+	}
+}
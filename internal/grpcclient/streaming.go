@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/dynamicpb"
@@ -94,6 +95,8 @@ func (c *Client) executeStream(
 	}
 	resp.Message = string(body)
 	resp.Body = body
+	resp.Text = buildStreamText(out, methodDesc.Output())
+	resp.Messages = len(out)
 	ensureContentType(resp)
 
 	if streamErr != nil {
@@ -352,6 +355,29 @@ func buildStreamBody(msgs [][]byte) ([]byte, error) {
 	return json.MarshalIndent(raw, "", "  ")
 }
 
+// buildStreamText renders each received message in Protobuf text format,
+// separated by a comment naming its index. It returns "" if any message
+// cannot be converted so callers fall back to JSON.
+func buildStreamText(msgs [][]byte, outDesc protoreflect.MessageDescriptor) string {
+	var b strings.Builder
+	for i, payload := range msgs {
+		msg := dynamicpb.NewMessage(outDesc)
+		if err := protojson.Unmarshal(payload, msg); err != nil {
+			return ""
+		}
+		text, err := marshalText(msg)
+		if err != nil {
+			return ""
+		}
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("# message " + strconv.Itoa(i) + "\n")
+		b.Write(text)
+	}
+	return b.String()
+}
+
 func publishMsg(
 	session *stream.Session,
 	dir stream.Direction,
@@ -430,6 +456,10 @@ func marshalMsg(msg proto.Message) ([]byte, error) {
 	}.Marshal(msg)
 }
 
+func marshalText(msg proto.Message) ([]byte, error) {
+	return prototext.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(msg)
+}
+
 func newResponse(headerMD, trailerMD metadata.MD, dur time.Duration) *Response {
 	return &Response{
 		Headers:         copyMetadata(headerMD),
@@ -3,6 +3,7 @@ package grpcclient
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -43,6 +44,14 @@ func TestStreamServerOutput(t *testing.T) {
 	if len(out) != 2 {
 		t.Fatalf("expected 2 responses, got %d", len(out))
 	}
+	if resp.Messages != 2 {
+		t.Fatalf("expected 2 counted messages, got %d", resp.Messages)
+	}
+	for _, want := range []string{"# message 0", "# message 1", `"one"`, `"two"`} {
+		if !strings.Contains(resp.Text, want) {
+			t.Fatalf("expected %q in proto text, got:\n%s", want, resp.Text)
+		}
+	}
 }
 
 func TestStreamClientInput(t *testing.T) {
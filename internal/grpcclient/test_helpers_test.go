@@ -1,11 +1,22 @@
 package grpcclient
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"io"
+	"math/big"
 	"net"
+	"os"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	testgrpc "google.golang.org/grpc/interop/grpc_testing"
 	"google.golang.org/grpc/reflection"
 )
@@ -65,16 +76,29 @@ func (s *testSvc) FullDuplexCall(
 	}
 }
 
-func startTestServer(t *testing.T) (string, func()) {
+func startTestServer(t *testing.T, opts ...grpc.ServerOption) (string, func()) {
+	t.Helper()
+	return startServer(t, true, opts...)
+}
+
+// startServer runs the test service, registering reflection only when
+// withReflection is set.
+func startServer(
+	t *testing.T,
+	withReflection bool,
+	opts ...grpc.ServerOption,
+) (string, func()) {
 	t.Helper()
 
 	lis, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
 		t.Fatalf("listen: %v", err)
 	}
-	srv := grpc.NewServer()
+	srv := grpc.NewServer(opts...)
 	testgrpc.RegisterTestServiceServer(srv, &testSvc{})
-	reflection.Register(srv)
+	if withReflection {
+		reflection.Register(srv)
+	}
 
 	go func() {
 		_ = srv.Serve(lis)
@@ -86,3 +110,34 @@ func startTestServer(t *testing.T) (string, func()) {
 	}
 	return lis.Addr().String(), stop
 }
+
+// writeTestServerCert issues a self-signed certificate for dnsName, writes it
+// to caPath so clients can trust it, and returns server credentials using it.
+func writeTestServerCert(t *testing.T, caPath, dnsName string) grpc.ServerOption {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: dnsName},
+		DNSNames:              []string{dnsName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create cert: %v", err)
+	}
+	pemData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	if err := os.WriteFile(caPath, pemData, 0o644); err != nil {
+		t.Fatalf("write ca pem: %v", err)
+	}
+	cert := tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	return grpc.Creds(credentials.NewServerTLSFromCert(&cert))
+}
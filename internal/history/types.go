@@ -2,6 +2,10 @@ package history
 
 import "time"
 
+// RedactedMask replaces secrets and sensitive headers in recorded request
+// text. An entry containing it cannot be sent again as recorded.
+const RedactedMask = "•••"
+
 type Entry struct {
 	ID             string          `json:"id"`
 	ExecutedAt     time.Time       `json:"executedAt"`
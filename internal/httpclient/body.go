@@ -2,10 +2,13 @@ package httpclient
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"io"
 	"net/url"
+	"path/filepath"
 	"strings"
+	"unicode"
 
 	"github.com/unkn0wn-root/resterm/internal/errdef"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
@@ -27,6 +30,9 @@ func (c *Client) prepareBody(
 	}
 
 	lookup := newFileLookup(opts.BaseDir, opts)
+	if req.Body.Options.Base64 {
+		return c.prepareBase64Body(req, lookup)
+	}
 
 	switch {
 	case req.Body.FilePath != "":
@@ -36,8 +42,7 @@ func (c *Client) prepareBody(
 		}
 
 		if resolver != nil && req.Body.Options.ExpandTemplates {
-			text := string(data)
-			expanded, err := resolver.ExpandTemplates(text)
+			expanded, err := expandBodyTemplates(resolver, string(data))
 			if err != nil {
 				return bodyPlan{}, errdef.Wrap(errdef.CodeHTTP, err, "expand body file templates")
 			}
@@ -53,7 +58,7 @@ func (c *Client) prepareBody(
 		expanded := req.Body.Text
 		if resolver != nil {
 			var err error
-			expanded, err = resolver.ExpandTemplates(req.Body.Text)
+			expanded, err = expandBodyTemplates(resolver, req.Body.Text)
 			if err != nil {
 				return bodyPlan{}, errdef.Wrap(errdef.CodeHTTP, err, "expand body template")
 			}
@@ -68,6 +73,74 @@ func (c *Client) prepareBody(
 	}
 }
 
+// expandBodyTemplates renders #each/#if blocks and the regular {{var}}
+// templates in one pass, so loop items feed plain templates without their
+// own contents being expanded again.
+func expandBodyTemplates(resolver *vars.Resolver, text string) (string, error) {
+	return resolver.ExpandBlockTemplates(text)
+}
+
+// prepareBase64Body decodes a @body-base64 payload. Whitespace and line
+// breaks are ignored so long vectors can be wrapped, and both the standard
+// and URL-safe alphabets are accepted with or without padding.
+func (c *Client) prepareBase64Body(req *restfile.Request, lookup fileLookup) (bodyPlan, error) {
+	raw := req.Body.Text
+	if req.Body.FilePath != "" {
+		data, _, err := lookup.read(c, req.Body.FilePath, "body file")
+		if err != nil {
+			return bodyPlan{}, err
+		}
+		raw = string(data)
+	}
+	if strings.TrimSpace(raw) == "" {
+		return bodyPlan{}, nil
+	}
+	data, err := decodeBase64Body(raw)
+	if err != nil {
+		return bodyPlan{}, err
+	}
+	return bodyPlan{rd: bytes.NewReader(data)}, nil
+}
+
+// decodeBase64Body decodes a base64 request body as written in a request
+// file.
+func decodeBase64Body(raw string) ([]byte, error) {
+	compact := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, raw)
+	compact = strings.TrimRight(compact, "=")
+	data, err := base64.RawStdEncoding.DecodeString(compact)
+	if err != nil {
+		var urlErr error
+		if data, urlErr = base64.RawURLEncoding.DecodeString(compact); urlErr != nil {
+			return nil, errdef.Wrap(errdef.CodeHTTP, err, "decode base64 body")
+		}
+	}
+	return data, nil
+}
+
+// fileBodyTypes maps body file extensions to the Content-Type sent when the
+// request does not set one.
+var fileBodyTypes = map[string]string{
+	".json":       "application/json",
+	".xml":        "application/xml",
+	".csv":        "text/csv",
+	".txt":        "text/plain",
+	".html":       "text/html",
+	".htm":        "text/html",
+	".yaml":       "application/yaml",
+	".yml":        "application/yaml",
+	".form":       "application/x-www-form-urlencoded",
+	".urlencoded": "application/x-www-form-urlencoded",
+}
+
+func fileBodyContentType(path string) string {
+	return fileBodyTypes[strings.ToLower(filepath.Ext(strings.TrimSpace(path)))]
+}
+
 // GET requests put everything in query params, POST uses JSON body.
 // Variables need special handling since they must be valid JSON in both cases.
 func (c *Client) prepareGraphQLBody(
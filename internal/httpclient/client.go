@@ -24,26 +24,43 @@ type Options struct {
 	FollowRedirects    bool
 	InsecureSkipVerify bool
 	ProxyURL           string
+	Resolve            []ResolveOverride
+	DNSServer          string
+	LocalAddr          string
+	Accept             string
+	AcceptEncoding     string
+	RequestIDHeader    string
+	MaxHeaderBytes     int64
+	DisableKeepAlives  bool
 	RootCAs            []string
 	RootMode           tlsconfig.RootMode
 	ClientCert         string
 	ClientKey          string
 	HTTPVersion        httpver.Version
+	Compression        string
+	ExpectContinue     bool
+	ExpectTimeout      time.Duration
+	CaptureWire        bool
 	BaseDir            string
 	FallbackBaseDirs   []string
 	NoFallback         bool
 	Trace              bool
 	TraceBudget        *nettrace.Budget
+	TraceExport        *telemetry.Config
 	SSH                *ssh.Plan
 	K8s                *k8s.Plan
+	// UploadProgress, when set, is called from the transport while the
+	// request body is sent.
+	UploadProgress UploadProgressFunc
 }
 
 type Client struct {
 	fs          FileSystem
 	jar         http.CookieJar
 	httpFactory func(Options) (*http.Client, error)
-	wsDial      func(context.Context, string, *websocket.DialOptions) (*websocket.Conn, *http.Response, error)
+	wsDial      wsDialFunc
 	telemetry   telemetry.Instrumenter
+	exports     *telemetry.Exports
 }
 
 func (c *Client) resolveHTTPFactory() func(Options) (*http.Client, error) {
@@ -79,7 +96,11 @@ func NewClient(fs FileSystem) *Client {
 	}
 
 	jar, _ := cookiejar.New(nil)
-	c := &Client{fs: fs, jar: jar, telemetry: telemetry.Noop()}
+	c := &Client{
+		fs:        fs,
+		jar:       jar,
+		telemetry: telemetry.Noop(),
+	}
 	c.httpFactory = c.buildHTTPClient
 	c.wsDial = websocket.Dial
 	return c
@@ -99,6 +120,12 @@ func (c *Client) SetTelemetry(instr telemetry.Instrumenter) {
 	c.telemetry = instr
 }
 
+// SetTraceExports configures the cache used for requests that export spans to
+// their own collector. Passing nil turns per-request export off.
+func (c *Client) SetTraceExports(exports *telemetry.Exports) {
+	c.exports = exports
+}
+
 type Response struct {
 	Status         string
 	StatusCode     int
@@ -115,6 +142,35 @@ type Response struct {
 	Request        *restfile.Request
 	Timeline       *nettrace.Timeline
 	TraceReport    *nettrace.Report
+	Redirects      []Redirect
+	// RequestID is the value sent in the request-id-header, if one is set.
+	RequestID string
+	// Wire holds the response as read off the connection, chunked framing
+	// included, when the request ran with capture-wire.
+	Wire          []byte
+	WireTruncated bool
+	// ContentEncoding is set when the body arrived compressed because the
+	// accept-encoding setting turned off Go's transparent decompression.
+	// EncodedSize is the size as received; Body holds the decoded bytes
+	// when Decoded is true.
+	ContentEncoding string
+	EncodedSize     int64
+	Decoded         bool
+	// HeaderBytes is the received header size when it went over the
+	// max-response-headers HeaderLimit and Headers were truncated to fit.
+	HeaderBytes int64
+	HeaderLimit int64
+	// Insecure is set when the request ran with TLS verification off after
+	// all settings were applied.
+	Insecure bool
+}
+
+// Redirect is one followed hop: the URL that answered with a redirect, its
+// status code and the Location it pointed to.
+type Redirect struct {
+	URL        string
+	StatusCode int
+	Location   string
 }
 
 // Wraps the HTTP roundtrip with telemetry spans and network tracing.
@@ -144,7 +200,10 @@ func (c *Client) Execute(
 		traceReport *nettrace.Report
 	)
 
-	httpReq, requestSpan := c.startRequestSpan(req, httpReq, effectiveOpts)
+	httpReq, requestSpan, err := c.startRequestSpan(req, httpReq, effectiveOpts)
+	if err != nil {
+		return nil, errdef.Wrap(errdef.CodeHTTP, err, "trace export")
+	}
 
 	defer func() {
 		endRequestSpan(requestSpan, resp, err, timeline, traceReport)
@@ -155,6 +214,12 @@ func (c *Client) Execute(
 		httpReq = traceSess.bind(httpReq)
 	}
 
+	var wire *wireRecorder
+	if effectiveOpts.CaptureWire {
+		httpReq, wire = withWireRecorder(httpReq)
+	}
+	httpReq = withUploadProgress(httpReq, effectiveOpts.UploadProgress)
+
 	start := time.Now()
 	httpResp, err := client.Do(httpReq)
 	if err != nil {
@@ -167,16 +232,19 @@ func (c *Client) Execute(
 			timeline = traceSess.complete(buildTraceExtras(httpReq, nil, effectiveOpts, proxy))
 			traceReport = buildTraceReport(timeline, effectiveOpts.TraceBudget)
 		}
+		reqErr := errdef.Wrap(errdef.CodeHTTP, err, "perform request")
+		if isHeaderLimitErr(err) {
+			reqErr = headerLimitError(effectiveOpts.MaxHeaderBytes)
+		}
 		return &Response{
-				Request:     req,
-				Duration:    duration,
-				Timeline:    timeline,
-				TraceReport: traceReport,
-			}, errdef.Wrap(
-				errdef.CodeHTTP,
-				err,
-				"perform request",
-			)
+			Request:     req,
+			Duration:    duration,
+			Timeline:    timeline,
+			TraceReport: traceReport,
+		}, reqErr
+	}
+	if traceSess != nil {
+		traceSess.gotResponse()
 	}
 	if verErr := checkHTTPVersion(httpResp, effectiveOpts.HTTPVersion); verErr != nil {
 		duration := time.Since(start)
@@ -222,6 +290,20 @@ func (c *Client) Execute(
 	resp = respFromHTTP(httpReq, httpResp, req, body, duration)
 	resp.Timeline = timeline
 	resp.TraceReport = traceReport
+	resp.Insecure = effectiveOpts.InsecureSkipVerify
+	if effectiveOpts.RequestIDHeader != "" {
+		resp.RequestID = httpReq.Header.Get(effectiveOpts.RequestIDHeader)
+	}
+	if wire != nil {
+		resp.Wire, resp.WireTruncated = wire.bytes()
+	}
+	if effectiveOpts.AcceptEncoding != "" {
+		decodeResponse(resp, httpResp.Header.Get("Content-Encoding"))
+	}
+	if size := truncateHeaders(resp.Headers, effectiveOpts.MaxHeaderBytes); size > 0 {
+		resp.HeaderBytes = size
+		resp.HeaderLimit = effectiveOpts.MaxHeaderBytes
+	}
 
 	return resp, nil
 }
@@ -230,8 +312,15 @@ func (c *Client) startRequestSpan(
 	req *restfile.Request,
 	httpReq *http.Request,
 	opts Options,
-) (*http.Request, telemetry.RequestSpan) {
+) (*http.Request, telemetry.RequestSpan, error) {
 	instrumenter := c.telemetry
+	if opts.Trace && opts.TraceExport != nil && c.exports != nil {
+		inst, err := c.exports.For(*opts.TraceExport)
+		if err != nil {
+			return httpReq, nil, err
+		}
+		instrumenter = inst
+	}
 	if !opts.Trace || instrumenter == nil {
 		instrumenter = telemetry.Noop()
 	}
@@ -241,7 +330,7 @@ func (c *Client) startRequestSpan(
 		HTTPRequest: httpReq,
 		Budget:      cloneBudget(opts.TraceBudget),
 	})
-	return httpReq.WithContext(spanCtx), requestSpan
+	return httpReq.WithContext(spanCtx), requestSpan, nil
 }
 
 func cloneBudget(budget *nettrace.Budget) *nettrace.Budget {
@@ -0,0 +1,108 @@
+package httpclient
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+)
+
+const (
+	CompressionGzip    = "gzip"
+	CompressionDeflate = "deflate"
+)
+
+// ParseCompression normalises a compression setting value.
+// Empty, "none", "off" and "identity" disable compression.
+func ParseCompression(raw string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "", "none", "off", "identity", "false":
+		return "", true
+	case CompressionGzip, "x-gzip":
+		return CompressionGzip, true
+	case CompressionDeflate, "zlib":
+		return CompressionDeflate, true
+	default:
+		return "", false
+	}
+}
+
+// compressRequestBody encodes the request body with the configured algorithm
+// and sets Content-Encoding. Requests that already declare an encoding are
+// left untouched so hand-crafted payloads are sent as-is.
+func compressRequestBody(req *http.Request, body io.Reader, enc string) error {
+	if req == nil || body == nil || enc == "" {
+		return nil
+	}
+	if req.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch enc {
+	case CompressionGzip:
+		w = gzip.NewWriter(&buf)
+	case CompressionDeflate:
+		w = zlib.NewWriter(&buf)
+	default:
+		return errdef.New(errdef.CodeHTTP, "unsupported compression %q", enc)
+	}
+	if _, err := io.Copy(w, body); err != nil {
+		return errdef.Wrap(errdef.CodeHTTP, err, "compress request body")
+	}
+	if err := w.Close(); err != nil {
+		return errdef.Wrap(errdef.CodeHTTP, err, "compress request body")
+	}
+
+	data := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.ContentLength = int64(len(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	req.Header.Set("Content-Encoding", enc)
+	return nil
+}
+
+// decodeResponse records the body's Content-Encoding and size as received
+// and replaces the body with its decoded form for gzip and deflate. Other
+// encodings (br, zstd) and bodies that fail to decode are kept as is.
+func decodeResponse(resp *Response, encoding string) {
+	enc := strings.ToLower(strings.TrimSpace(encoding))
+	if resp == nil || enc == "" || enc == "identity" || len(resp.Body) == 0 {
+		return
+	}
+	resp.ContentEncoding = enc
+	resp.EncodedSize = int64(len(resp.Body))
+
+	var r io.ReadCloser
+	var err error
+	switch enc {
+	case CompressionGzip, "x-gzip":
+		r, err = gzip.NewReader(bytes.NewReader(resp.Body))
+	case CompressionDeflate:
+		// Servers disagree on whether deflate means zlib-wrapped or raw.
+		r, err = zlib.NewReader(bytes.NewReader(resp.Body))
+		if err != nil {
+			r, err = flate.NewReader(bytes.NewReader(resp.Body)), nil
+		}
+	default:
+		return
+	}
+	if err != nil {
+		return
+	}
+	defer func() { _ = r.Close() }()
+	decoded, err := io.ReadAll(r)
+	if err != nil {
+		return
+	}
+	resp.Body = decoded
+	resp.Decoded = true
+}
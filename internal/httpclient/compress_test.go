@@ -0,0 +1,141 @@
+package httpclient
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestExecuteCompressesRequestBody(t *testing.T) {
+	payload := `{"items":"` + strings.Repeat("x", 4096) + `"}`
+	cases := []struct {
+		setting string
+		enc     string
+		open    func(io.Reader) (io.ReadCloser, error)
+	}{
+		{
+			setting: "gzip",
+			enc:     "gzip",
+			open: func(r io.Reader) (io.ReadCloser, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		{setting: "deflate", enc: "deflate", open: zlib.NewReader},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.setting, func(t *testing.T) {
+			var gotEnc string
+			var gotLen int64
+			var gotBody string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotEnc = r.Header.Get("Content-Encoding")
+				gotLen = r.ContentLength
+				rd, err := tc.open(r.Body)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				defer func() { _ = rd.Close() }()
+				data, err := io.ReadAll(rd)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				gotBody = string(data)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer srv.Close()
+
+			req := &restfile.Request{
+				Method:   "POST",
+				URL:      srv.URL,
+				Body:     restfile.BodySource{Text: payload},
+				Settings: map[string]string{"compression": tc.setting},
+			}
+			resp, err := NewClient(nil).Execute(context.Background(), req, nil, Options{})
+			if err != nil {
+				t.Fatalf("execute: %v", err)
+			}
+			if resp.StatusCode != http.StatusNoContent {
+				t.Fatalf("unexpected status %d", resp.StatusCode)
+			}
+			if gotEnc != tc.enc {
+				t.Fatalf("expected Content-Encoding %q, got %q", tc.enc, gotEnc)
+			}
+			if gotLen <= 0 || gotLen >= int64(len(payload)) {
+				t.Fatalf("expected compressed content length, got %d", gotLen)
+			}
+			if gotBody != payload {
+				t.Fatalf("server received unexpected body %q", gotBody)
+			}
+		})
+	}
+}
+
+func TestExecuteDecodesWithAcceptEncoding(t *testing.T) {
+	payload := strings.Repeat("hello ", 512)
+	var gotAccept string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAccept = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		_, _ = io.WriteString(zw, payload)
+		_ = zw.Close()
+	}))
+	defer srv.Close()
+
+	req := &restfile.Request{
+		Method:   "GET",
+		URL:      srv.URL,
+		Settings: map[string]string{"accept-encoding": "gzip,br"},
+	}
+	resp, err := NewClient(nil).Execute(context.Background(), req, nil, Options{})
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if gotAccept != "gzip,br" {
+		t.Fatalf("expected Accept-Encoding gzip,br, got %q", gotAccept)
+	}
+	if resp.ContentEncoding != "gzip" || !resp.Decoded {
+		t.Fatalf("expected decoded gzip body, got %q decoded=%v", resp.ContentEncoding, resp.Decoded)
+	}
+	if string(resp.Body) != payload {
+		t.Fatalf("unexpected decoded body length %d", len(resp.Body))
+	}
+	if resp.EncodedSize <= 0 || resp.EncodedSize >= int64(len(payload)) {
+		t.Fatalf("expected compressed size below %d, got %d", len(payload), resp.EncodedSize)
+	}
+}
+
+func TestDecodeResponseKeepsUnknownEncoding(t *testing.T) {
+	resp := &Response{Body: []byte("opaque")}
+	decodeResponse(resp, "br")
+	if resp.ContentEncoding != "br" || resp.Decoded || string(resp.Body) != "opaque" {
+		t.Fatalf("expected br body to be kept, got %+v", resp)
+	}
+	if resp.EncodedSize != 6 {
+		t.Fatalf("expected encoded size 6, got %d", resp.EncodedSize)
+	}
+}
+
+func TestCompressRequestBodyKeepsExplicitEncoding(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("raw"))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set("Content-Encoding", "br")
+	if err := compressRequestBody(req, strings.NewReader("raw"), CompressionGzip); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	if req.Header.Get("Content-Encoding") != "br" || req.ContentLength != 3 {
+		t.Fatalf("expected request to be left untouched")
+	}
+}
@@ -0,0 +1,47 @@
+package httpclient
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+)
+
+const defaultDNSPort = "53"
+
+// ParseDNSServer normalizes a dns-server value to host:port, defaulting the
+// port to 53.
+func ParseDNSServer(raw string) (string, error) {
+	spec := strings.TrimSpace(raw)
+	if spec == "" {
+		return "", errdef.New(errdef.CodeHTTP, "dns-server is empty")
+	}
+	host, port, err := net.SplitHostPort(spec)
+	if err != nil {
+		host, port = strings.Trim(spec, "[]"), defaultDNSPort
+	}
+	if host == "" || strings.ContainsAny(host, " /") || port == "" {
+		return "", errdef.New(
+			errdef.CodeHTTP,
+			"invalid dns-server %q (use host or host:port)",
+			raw,
+		)
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// dnsResolver sends every lookup to server instead of the system resolver.
+// Resolve overrides are applied before dialing, so they never reach it.
+func dnsResolver(server string) *net.Resolver {
+	if server == "" {
+		return nil
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, server)
+		},
+	}
+}
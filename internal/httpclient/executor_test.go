@@ -13,23 +13,30 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"math/big"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/http/httptrace"
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
 	"github.com/unkn0wn-root/resterm/internal/httpver"
 	"github.com/unkn0wn-root/resterm/internal/k8s"
 	"github.com/unkn0wn-root/resterm/internal/nettrace"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
 	"github.com/unkn0wn-root/resterm/internal/ssh"
 	"github.com/unkn0wn-root/resterm/internal/stream"
+	"github.com/unkn0wn-root/resterm/internal/telemetry"
 	"github.com/unkn0wn-root/resterm/internal/tlsconfig"
 	"github.com/unkn0wn-root/resterm/internal/vars"
 )
@@ -202,6 +209,39 @@ func TestApplyAuthenticationBasic(t *testing.T) {
 	}
 }
 
+func TestExecuteRemovesHeadersAfterDefaults(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer srv.Close()
+
+	req := &restfile.Request{
+		Method:  "GET",
+		URL:     srv.URL,
+		Headers: http.Header{"X-Keep": {"1"}, "x-drop": {"2"}},
+		Metadata: restfile.RequestMetadata{
+			Auth: &restfile.AuthSpec{
+				Type:   "bearer",
+				Params: map[string]string{"token": "secret"},
+			},
+			RemoveHeaders: []string{"authorization", "ACCEPT", "X-Drop", "user-agent"},
+		},
+	}
+	opts := Options{Accept: "application/json"}
+	if _, err := NewClient(nil).Execute(context.Background(), req, vars.NewResolver(), opts); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	for _, name := range []string{"Authorization", "Accept", "X-Drop", "User-Agent"} {
+		if v := got.Get(name); v != "" {
+			t.Fatalf("expected %s to be removed, got %q", name, v)
+		}
+	}
+	if got.Get("X-Keep") != "1" {
+		t.Fatalf("expected X-Keep to be sent, got %v", got)
+	}
+}
+
 func TestPrepareGraphQLPostBody(t *testing.T) {
 	client := NewClient(nil)
 	req := &restfile.Request{Method: "POST", URL: "https://example.com/graphql"}
@@ -237,6 +277,35 @@ func TestPrepareGraphQLPostBody(t *testing.T) {
 	}
 }
 
+func TestPrepareBodyExpandsEachBlocks(t *testing.T) {
+	client := NewClient(nil)
+	req := &restfile.Request{Method: "POST", URL: "https://example.com/bulk"}
+	req.Body.Text = `[{{#each users}}{"id":{{id}},"org":"{{org}}"}` +
+		`{{#unless @last}},{{/unless}}{{/each}}]`
+	resolver := vars.NewResolver(vars.NewMapProvider("env", map[string]string{
+		"users": `[{"id":1},{"id":2}]`,
+		"org":   "acme",
+	}))
+	plan, err := client.prepareBody(req, resolver, Options{})
+	if err != nil {
+		t.Fatalf("prepare body: %v", err)
+	}
+	data, err := io.ReadAll(plan.rd)
+	if err != nil {
+		t.Fatalf("read payload: %v", err)
+	}
+	want := `[{"id":1,"org":"acme"},{"id":2,"org":"acme"}]`
+	if string(data) != want {
+		t.Fatalf("expected %s, got %s", want, data)
+	}
+
+	req.Body.Text = `{{#each users}}x`
+	if _, err := client.prepareBody(req, resolver, Options{}); err == nil ||
+		!strings.Contains(err.Error(), "unclosed {{#each users}}") {
+		t.Fatalf("expected unclosed block error, got %v", err)
+	}
+}
+
 func TestPrepareGraphQLGetQueryParameters(t *testing.T) {
 	client := NewClient(nil)
 	req := &restfile.Request{Method: "GET", URL: "https://example.com/graphql?existing=1"}
@@ -665,6 +734,44 @@ func TestExecuteEffectiveURLFallback(t *testing.T) {
 	}
 }
 
+func TestExecuteRecordsRedirectChain(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			http.Redirect(w, r, "/step", http.StatusFound)
+		case "/step":
+			http.Redirect(w, r, "/home", http.StatusMovedPermanently)
+		default:
+			_, _ = w.Write([]byte("ok"))
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(nil)
+	req := &restfile.Request{Method: http.MethodGet, URL: srv.URL + "/login"}
+	opts := Options{Timeout: 5 * time.Second, FollowRedirects: true}
+	resp, err := client.Execute(context.Background(), req, vars.NewResolver(), opts)
+	if err != nil {
+		t.Fatalf("execute request: %v", err)
+	}
+	want := []Redirect{
+		{URL: srv.URL + "/login", StatusCode: http.StatusFound, Location: "/step"},
+		{URL: srv.URL + "/step", StatusCode: http.StatusMovedPermanently, Location: "/home"},
+	}
+	if !reflect.DeepEqual(resp.Redirects, want) {
+		t.Fatalf("unexpected redirect chain %+v", resp.Redirects)
+	}
+
+	opts.FollowRedirects = false
+	resp, err = client.Execute(context.Background(), req, vars.NewResolver(), opts)
+	if err != nil {
+		t.Fatalf("execute request without redirects: %v", err)
+	}
+	if len(resp.Redirects) != 0 || resp.StatusCode != http.StatusFound {
+		t.Fatalf("expected unfollowed redirect, got %d with %+v", resp.StatusCode, resp.Redirects)
+	}
+}
+
 func TestCaptureReqMetaPrefersResponseRequest(t *testing.T) {
 	sent, err := http.NewRequest("POST", "https://old.example.com/items", strings.NewReader("body"))
 	if err != nil {
@@ -984,6 +1091,81 @@ func TestExecuteSSEMaxBytes(t *testing.T) {
 	}
 }
 
+func TestExecuteSSEWritesOutFile(t *testing.T) {
+	client := NewClient(nil)
+	client.httpFactory = func(Options) (*http.Client, error) {
+		return &http.Client{
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				resp := &http.Response{
+					Status:     "200 OK",
+					StatusCode: http.StatusOK,
+					Proto:      "HTTP/1.1",
+					Header:     make(http.Header),
+					Body: io.NopCloser(
+						strings.NewReader("event: tick\ndata: one\n\ndata: two"),
+					),
+					Request: req,
+				}
+				resp.Header.Set("Content-Type", "text/event-stream")
+				return resp, nil
+			}),
+		}, nil
+	}
+
+	dir := t.TempDir()
+	req := &restfile.Request{
+		Method: "GET",
+		URL:    "https://example.com/events",
+		SSE: &restfile.SSERequest{Options: restfile.SSEOptions{
+			Out: "{{dir}}/events.jsonl",
+		}},
+	}
+	resolver := vars.NewResolver(vars.NewMapProvider("env", map[string]string{"dir": "logs"}))
+	opts := Options{BaseDir: dir}
+	path := filepath.Join(dir, "logs", "events.jsonl")
+
+	readEvents := func(path string) []SSEEvent {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read out file: %v", err)
+		}
+		var events []SSEEvent
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			var evt SSEEvent
+			if err := json.Unmarshal([]byte(line), &evt); err != nil {
+				t.Fatalf("decode line %q: %v", line, err)
+			}
+			events = append(events, evt)
+		}
+		return events
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.ExecuteSSE(context.Background(), req, resolver, opts); err != nil {
+			t.Fatalf("execute sse %d: %v", i, err)
+		}
+	}
+	events := readEvents(path)
+	if len(events) != 4 {
+		t.Fatalf("expected appended events from both runs, got %d", len(events))
+	}
+	if events[0].Event != "tick" || events[0].Data != "one" || events[1].Data != "two" {
+		t.Fatalf("unexpected events %+v", events[:2])
+	}
+
+	req.SSE.Options.OutRotate = true
+	if _, err := client.ExecuteSSE(context.Background(), req, resolver, opts); err != nil {
+		t.Fatalf("execute sse rotate: %v", err)
+	}
+	if got := len(readEvents(path)); got != 2 {
+		t.Fatalf("expected fresh file after rotate, got %d events", got)
+	}
+	if got := len(readEvents(path + ".1")); got != 4 {
+		t.Fatalf("expected rotated file to keep previous events, got %d", got)
+	}
+}
+
 func TestStartSSEPublishesEvents(t *testing.T) {
 	client := NewClient(nil)
 	client.httpFactory = func(Options) (*http.Client, error) {
@@ -1083,3 +1265,479 @@ type roundTripFunc func(*http.Request) (*http.Response, error)
 func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
 	return fn(req)
 }
+
+func TestBuildHTTPRequestInfersFileBodyContentType(t *testing.T) {
+	client := &Client{fs: mapFS{
+		filepath.Join("ws", "payload.xml"): []byte("<a/>"),
+		filepath.Join("ws", "data.bin"):    []byte{0x01},
+	}}
+	opts := Options{BaseDir: "ws"}
+	build := func(path string, headers http.Header) string {
+		t.Helper()
+		req := &restfile.Request{
+			Method:  "POST",
+			URL:     "http://example.com",
+			Headers: headers,
+			Body:    restfile.BodySource{FilePath: path},
+		}
+		httpReq, _, _, err := client.BuildHTTPRequest(context.Background(), req, nil, opts)
+		if err != nil {
+			t.Fatalf("build request: %v", err)
+		}
+		return httpReq.Header.Get("Content-Type")
+	}
+
+	if got := build("payload.xml", nil); got != "application/xml" {
+		t.Fatalf("expected inferred xml content type, got %q", got)
+	}
+	explicit := http.Header{"Content-Type": {"text/xml; charset=utf-8"}}
+	if got := build("payload.xml", explicit); got != "text/xml; charset=utf-8" {
+		t.Fatalf("expected explicit content type to win, got %q", got)
+	}
+	if got := build("data.bin", nil); got != "" {
+		t.Fatalf("expected unknown extension to leave content type unset, got %q", got)
+	}
+}
+
+func TestBuildHTTPRequestDefaultAccept(t *testing.T) {
+	client := NewClient(nil)
+	build := func(opts Options, settings map[string]string, headers http.Header) string {
+		t.Helper()
+		req := &restfile.Request{
+			Method:   "GET",
+			URL:      "http://example.com",
+			Headers:  headers,
+			Settings: settings,
+		}
+		httpReq, _, _, err := client.BuildHTTPRequest(context.Background(), req, nil, opts)
+		if err != nil {
+			t.Fatalf("build request: %v", err)
+		}
+		return httpReq.Header.Get("Accept")
+	}
+
+	if got := build(Options{}, nil, nil); got != "" {
+		t.Fatalf("expected no Accept without a default, got %q", got)
+	}
+	opts := Options{Accept: "application/json"}
+	if got := build(opts, nil, nil); got != "application/json" {
+		t.Fatalf("expected default Accept, got %q", got)
+	}
+	perReq := map[string]string{"accept": "application/xml"}
+	if got := build(opts, perReq, nil); got != "application/xml" {
+		t.Fatalf("expected @setting accept to override default, got %q", got)
+	}
+	explicit := http.Header{"Accept": {"text/csv"}}
+	if got := build(opts, perReq, explicit); got != "text/csv" {
+		t.Fatalf("expected request header to win, got %q", got)
+	}
+}
+
+func TestExecuteTruncatesResponseHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Big", strings.Repeat("a", 4096))
+		w.Header().Set("X-Small", "kept")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := NewClient(nil)
+	req := &restfile.Request{Method: "GET", URL: srv.URL}
+	resp, err := client.Execute(
+		context.Background(),
+		req,
+		vars.NewResolver(),
+		Options{MaxHeaderBytes: 1024},
+	)
+	if err != nil {
+		t.Fatalf("expected oversized headers to be truncated, got %v", err)
+	}
+	if resp.HeaderLimit != 1024 || resp.HeaderBytes <= 4096 {
+		t.Fatalf("expected truncation to be reported, got %d/%d", resp.HeaderBytes, resp.HeaderLimit)
+	}
+	if got := resp.Headers.Get("X-Small"); got != "kept" {
+		t.Fatalf("expected small header kept, got %q", got)
+	}
+	var size int64
+	for name, values := range resp.Headers {
+		for _, value := range values {
+			size += headerLineSize(name, value)
+		}
+	}
+	if size > 1024 {
+		t.Fatalf("expected headers within 1KB, got %d bytes", size)
+	}
+	if got := resp.Headers.Get("X-Big"); got == "" || len(got) >= 4096 {
+		t.Fatalf("expected big header cut short, got %d bytes", len(got))
+	}
+
+	req.Settings = map[string]string{"max-response-headers": "64KB"}
+	resp, err = client.Execute(
+		context.Background(),
+		req,
+		vars.NewResolver(),
+		Options{MaxHeaderBytes: 1024},
+	)
+	if err != nil {
+		t.Fatalf("expected @setting to raise the limit, got %v", err)
+	}
+	if resp.HeaderBytes != 0 || len(resp.Headers.Get("X-Big")) != 4096 {
+		t.Fatalf("expected headers untouched under the raised limit")
+	}
+}
+
+func TestHeaderLimitErrorNamesTransportLimit(t *testing.T) {
+	msg := headerLimitError(1024).Error()
+	if !strings.Contains(msg, "exceeded the 10MB limit") ||
+		!strings.Contains(msg, "max-response-headers 40MB") {
+		t.Fatalf("expected transport limit and hint in error, got %q", msg)
+	}
+	msg = headerLimitError(32 << 20).Error()
+	if !strings.Contains(msg, "exceeded the 32MB limit") {
+		t.Fatalf("expected configured limit in error, got %q", msg)
+	}
+}
+
+func TestExecuteKeepAliveFalseClosesConnection(t *testing.T) {
+	var closed []bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		closed = append(closed, r.Close)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := NewClient(nil)
+	req := &restfile.Request{
+		Method:   "GET",
+		URL:      srv.URL,
+		Settings: map[string]string{"keep-alive": "false"},
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := client.Execute(
+			context.Background(),
+			req,
+			vars.NewResolver(),
+			Options{},
+		); err != nil {
+			t.Fatalf("execute %d: %v", i, err)
+		}
+	}
+	req.Settings = nil
+	if _, err := client.Execute(
+		context.Background(),
+		req,
+		vars.NewResolver(),
+		Options{},
+	); err != nil {
+		t.Fatalf("execute without setting: %v", err)
+	}
+
+	want := []bool{true, true, false}
+	if len(closed) != len(want) {
+		t.Fatalf("expected %d requests, got %d", len(want), len(closed))
+	}
+	for i := range want {
+		if closed[i] != want[i] {
+			t.Fatalf("request %d: expected Connection: close=%v, got %v", i, want[i], closed[i])
+		}
+	}
+}
+
+func TestExecuteInsecureSettingPerRequest(t *testing.T) {
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	client := NewClient(nil)
+	var resp *Response
+	run := func(settings map[string]string, opts Options) error {
+		req := &restfile.Request{Method: "GET", URL: srv.URL, Settings: settings}
+		var err error
+		resp, err = client.Execute(context.Background(), req, vars.NewResolver(), opts)
+		return err
+	}
+	if err := run(nil, Options{}); err == nil {
+		t.Fatalf("expected self-signed certificate to fail verification")
+	}
+	if err := run(map[string]string{"insecure": "true"}, Options{}); err != nil {
+		t.Fatalf("expected insecure true to skip verification, got %v", err)
+	}
+	if !resp.Insecure {
+		t.Fatalf("expected response to record insecure TLS")
+	}
+	forced := map[string]string{"insecure": "false"}
+	if err := run(forced, Options{InsecureSkipVerify: true}); err == nil {
+		t.Fatalf("expected insecure false to force verification over global insecure")
+	}
+}
+
+func TestParseHeaderLimit(t *testing.T) {
+	cases := map[string]int64{
+		"65536": 65536,
+		"64KB":  64 << 10,
+		"2 mib": 2 << 20,
+		"1.5MB": 3 << 19,
+	}
+	for raw, want := range cases {
+		got, err := ParseHeaderLimit(raw)
+		if err != nil || got != want {
+			t.Fatalf("ParseHeaderLimit(%q) = %d, %v; want %d", raw, got, err, want)
+		}
+	}
+	for _, raw := range []string{"", "0", "-1", "big", "1TB"} {
+		if _, err := ParseHeaderLimit(raw); err == nil {
+			t.Fatalf("expected error for %q", raw)
+		}
+	}
+}
+
+func TestExecuteEchoesRequestID(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("X-Request-Id"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := NewClient(nil)
+	opts := Options{RequestIDHeader: "X-Request-Id"}
+	req := &restfile.Request{Method: "GET", URL: srv.URL}
+	id := ApplyRequestID(req, opts.RequestIDHeader)
+	if id == "" {
+		t.Fatalf("expected a generated request id")
+	}
+	resp, err := client.Execute(context.Background(), req, nil, opts)
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if resp.RequestID != id || got[0] != id {
+		t.Fatalf(
+			"expected id %q sent and echoed, got sent=%q echoed=%q",
+			id,
+			got[0],
+			resp.RequestID,
+		)
+	}
+
+	fixed := &restfile.Request{Method: "GET", URL: srv.URL, Headers: http.Header{}}
+	fixed.Headers.Set("X-Request-Id", "abc")
+	if id := ApplyRequestID(fixed, opts.RequestIDHeader); id != "abc" {
+		t.Fatalf("expected existing header to win, got %q", id)
+	}
+	if id := ApplyRequestID(&restfile.Request{}, ""); id != "" {
+		t.Fatalf("expected no id when the header is off, got %q", id)
+	}
+}
+
+func TestExecuteSendsBase64Body(t *testing.T) {
+	want := []byte{0x08, 0x01, 0x12, 0x05, 'h', 'e', 'l', 'l', 'o', 0x00, 0xff}
+	var gotBody []byte
+	var gotType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotType = r.Header.Get("Content-Type")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	resolver := vars.NewResolver(vars.NewMapProvider("const", map[string]string{"x": "y"}))
+	req := &restfile.Request{
+		Method: "POST",
+		URL:    srv.URL,
+		Body: restfile.BodySource{
+			Text:    "CAESBWhl\nbGxvAP8=",
+			Options: restfile.BodyOptions{Base64: true},
+		},
+	}
+	if _, err := NewClient(nil).Execute(context.Background(), req, resolver, Options{}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if string(gotBody) != string(want) {
+		t.Fatalf("expected decoded bytes %v, got %v", want, gotBody)
+	}
+	if gotType != "application/octet-stream" {
+		t.Fatalf("expected octet-stream default, got %q", gotType)
+	}
+
+	req.Headers = http.Header{"Content-Type": {"application/x-protobuf"}}
+	req.Body.Text = "{{x}}"
+	if _, err := NewClient(nil).Execute(context.Background(), req, resolver, Options{}); err == nil ||
+		!strings.Contains(err.Error(), "decode base64 body") {
+		t.Fatalf("expected base64 decode error without template expansion, got %v", err)
+	}
+}
+
+func TestExecuteExpectContinueTracesOutcome(t *testing.T) {
+	var gotBody atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Expect") != "100-continue" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path == "/reject" {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		gotBody.Store(len(data) > 0)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	client := NewClient(nil)
+	run := func(path string) (*Response, string) {
+		t.Helper()
+		req := &restfile.Request{
+			Method:   http.MethodPut,
+			URL:      srv.URL + path,
+			Body:     restfile.BodySource{Text: strings.Repeat("x", 4096)},
+			Settings: map[string]string{"expect-continue": "5s"},
+		}
+		resp, err := client.Execute(context.Background(), req, vars.NewResolver(), Options{Trace: true})
+		if err != nil {
+			t.Fatalf("execute %s: %v", path, err)
+		}
+		for _, phase := range resp.Timeline.Phases {
+			if phase.Kind == nettrace.PhaseReqBody {
+				return resp, phase.Meta.Expect
+			}
+		}
+		return resp, ""
+	}
+
+	resp, outcome := run("/reject")
+	if resp.StatusCode != http.StatusRequestEntityTooLarge || outcome != nettrace.ExpectRejected {
+		t.Fatalf("expected early 413 traced as rejected, got %d %q", resp.StatusCode, outcome)
+	}
+	resp, outcome = run("/upload")
+	if resp.StatusCode != http.StatusCreated || !gotBody.Load() ||
+		outcome != nettrace.ExpectContinue {
+		t.Fatalf("expected body after 100 Continue, got %d %q", resp.StatusCode, outcome)
+	}
+}
+
+func TestParseExpectContinue(t *testing.T) {
+	if on, wait, err := ParseExpectContinue("true"); err != nil || !on || wait != 0 {
+		t.Fatalf("expected true to enable with default wait, got %v %v %v", on, wait, err)
+	}
+	if on, wait, err := ParseExpectContinue("3s"); err != nil || !on || wait != 3*time.Second {
+		t.Fatalf("expected duration to enable with wait, got %v %v %v", on, wait, err)
+	}
+	if on, _, err := ParseExpectContinue("false"); err != nil || on {
+		t.Fatalf("expected false to disable, got %v %v", on, err)
+	}
+	if _, _, err := ParseExpectContinue("soon"); err == nil {
+		t.Fatalf("expected invalid value to fail")
+	}
+}
+
+func TestExecuteCaptureWireKeepsChunkFraming(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		_, _ = io.WriteString(w, "hello ")
+		flusher.Flush()
+		_, _ = io.WriteString(w, "world")
+	})
+	plain := httptest.NewServer(handler)
+	defer plain.Close()
+	secure := httptest.NewTLSServer(handler)
+	defer secure.Close()
+
+	client := NewClient(nil)
+	run := func(url string, settings map[string]string) *Response {
+		t.Helper()
+		req := &restfile.Request{Method: http.MethodGet, URL: url, Settings: settings}
+		opts := Options{InsecureSkipVerify: true, Trace: true}
+		resp, err := client.Execute(context.Background(), req, vars.NewResolver(), opts)
+		if err != nil {
+			t.Fatalf("execute %s: %v", url, err)
+		}
+		if string(resp.Body) != "hello world" {
+			t.Fatalf("expected decoded body, got %q", resp.Body)
+		}
+		return resp
+	}
+
+	on := map[string]string{"capture-wire": "true"}
+	for _, url := range []string{plain.URL, secure.URL} {
+		resp := run(url, on)
+		wire := string(resp.Wire)
+		if !strings.HasPrefix(wire, "HTTP/1.1 200 OK\r\n") {
+			t.Fatalf("expected status line on the wire for %s, got %q", url, wire)
+		}
+		if !strings.Contains(wire, "\r\n\r\n6\r\nhello \r\n5\r\nworld\r\n0\r\n\r\n") {
+			t.Fatalf("expected chunk framing for %s, got %q", url, wire)
+		}
+	}
+	resp := run(secure.URL, on)
+	if resp.Timeline == nil || !hasPhase(resp.Timeline, nettrace.PhaseTLS) {
+		t.Fatalf("expected TLS phase to be traced with capture-wire")
+	}
+	if resp := run(plain.URL, nil); len(resp.Wire) != 0 {
+		t.Fatalf("expected no wire capture without the setting")
+	}
+}
+
+func hasPhase(tl *nettrace.Timeline, kind nettrace.PhaseKind) bool {
+	for _, phase := range tl.Phases {
+		if phase.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func TestExecuteTraceExportOnlyExportsOptedInRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	exporter := keepSpansExporter{tracetest.NewInMemoryExporter()}
+	exports := telemetry.NewExports(telemetry.Default(), telemetry.WithExporter(exporter))
+	client := NewClient(nil)
+	client.SetTraceExports(exports)
+
+	run := func(name string, export *telemetry.Config) {
+		t.Helper()
+		req := &restfile.Request{
+			Method:   http.MethodGet,
+			URL:      srv.URL,
+			Metadata: restfile.RequestMetadata{Name: name},
+		}
+		opts := Options{Trace: true, TraceExport: export}
+		if _, err := client.Execute(context.Background(), req, vars.NewResolver(), opts); err != nil {
+			t.Fatalf("execute %s: %v", name, err)
+		}
+	}
+	run("plain", nil)
+	run("exported", &telemetry.Config{Endpoint: "collector:4317", ServiceName: "checkout"})
+
+	if err := exports.Shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown: %v", err)
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || spans[0].Name != "exported" {
+		t.Fatalf("expected only the exported request span, got %+v", spans)
+	}
+	attrs := spans[0].Resource.Attributes()
+	found := false
+	for _, kv := range attrs {
+		if kv.Key == "service.name" && kv.Value.AsString() == "checkout" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected service name override, got %v", attrs)
+	}
+}
+
+// keepSpansExporter keeps exported spans readable after the provider shuts down.
+type keepSpansExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (keepSpansExporter) Shutdown(context.Context) error { return nil }
@@ -0,0 +1,41 @@
+package httpclient
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+)
+
+// ParseExpectContinue parses an expect-continue setting. A boolean toggles
+// the handshake with the default wait; a duration enables it and sets how
+// long to wait for the interim response before sending the body anyway.
+func ParseExpectContinue(raw string) (bool, time.Duration, error) {
+	value := strings.TrimSpace(raw)
+	if b, err := strconv.ParseBool(value); err == nil {
+		return b, 0, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return true, d, nil
+	}
+	return false, 0, errdef.New(
+		errdef.CodeHTTP,
+		"invalid expect-continue %q (use true, false or a wait such as 2s)",
+		raw,
+	)
+}
+
+// applyExpectContinue asks the server for a 100 Continue before the body is
+// written. Requests without a body or with their own Expect header are left
+// alone.
+func applyExpectContinue(req *http.Request, on bool) {
+	if !on || req == nil || req.Body == nil || req.Body == http.NoBody {
+		return
+	}
+	if req.Header.Get("Expect") != "" {
+		return
+	}
+	req.Header.Set("Expect", "100-continue")
+}
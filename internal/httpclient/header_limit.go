@@ -0,0 +1,129 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+	"github.com/unkn0wn-root/resterm/internal/util"
+)
+
+// defaultMaxResponseHeaderBytes mirrors net/http's transport default.
+const defaultMaxResponseHeaderBytes = 10 << 20
+
+// ParseHeaderLimit parses a max-response-headers value such as 65536, 64KB
+// or 2MiB into bytes.
+func ParseHeaderLimit(raw string) (int64, error) {
+	n, err := util.ParseByteSize(raw)
+	if err == nil && n <= 0 {
+		err = errors.New("limit must be positive")
+	}
+	if err != nil {
+		return 0, errdef.Wrap(
+			errdef.CodeHTTP,
+			err,
+			"invalid max-response-headers %q (use bytes or a size such as 64KB or 2MB)",
+			raw,
+		)
+	}
+	return n, nil
+}
+
+// transportHeaderLimit is the most the transport reads before failing. A
+// lower configured limit is applied by truncating the parsed headers, so
+// only headers beyond the transport default still fail the request.
+func transportHeaderLimit(limit int64) int64 {
+	return max(limit, defaultMaxResponseHeaderBytes)
+}
+
+// truncateHeaders trims h to at most limit bytes, counting each value as a
+// "Name: value\r\n" line. Smaller values are kept whole first so a single
+// oversized header does not push out the rest; the value that crosses the
+// limit is cut short and anything after it is dropped. It returns the size
+// before truncation, or zero when h already fit.
+func truncateHeaders(h http.Header, limit int64) int64 {
+	if limit <= 0 || len(h) == 0 {
+		return 0
+	}
+	type field struct {
+		name  string
+		value string
+	}
+	var (
+		fields []field
+		total  int64
+	)
+	for name, values := range h {
+		for _, value := range values {
+			fields = append(fields, field{name: name, value: value})
+			total += headerLineSize(name, value)
+		}
+	}
+	if total <= limit {
+		return 0
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		si := headerLineSize(fields[i].name, fields[i].value)
+		sj := headerLineSize(fields[j].name, fields[j].value)
+		if si != sj {
+			return si < sj
+		}
+		return fields[i].name < fields[j].name
+	})
+	clear(h)
+	room := limit
+	for _, f := range fields {
+		size := headerLineSize(f.name, f.value)
+		if size > room {
+			keep := room - headerLineSize(f.name, "")
+			if keep > 0 {
+				h[f.name] = append(h[f.name], f.value[:keep])
+			}
+			break
+		}
+		h[f.name] = append(h[f.name], f.value)
+		room -= size
+	}
+	return total
+}
+
+func headerLineSize(name, value string) int64 {
+	return int64(len(name) + len(value) + len(": \r\n"))
+}
+
+// isHeaderLimitErr reports whether err is net/http's oversized response
+// header failure (HTTP/1 or HTTP/2).
+func isHeaderLimitErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "server response headers exceeded") ||
+		strings.Contains(msg, "response header list larger than advertised limit")
+}
+
+// headerLimitError names the transport limit that was hit and the setting
+// that raises it.
+func headerLimitError(limit int64) error {
+	limit = transportHeaderLimit(limit)
+	return errdef.New(
+		errdef.CodeHTTP,
+		"response headers exceeded the %s limit; raise it with "+
+			"`# @setting max-response-headers %s`",
+		formatHeaderLimit(limit),
+		formatHeaderLimit(limit*4),
+	)
+}
+
+func formatHeaderLimit(n int64) string {
+	switch {
+	case n >= 1<<20 && n%(1<<20) == 0:
+		return fmt.Sprintf("%dMB", n>>20)
+	case n >= 1<<10 && n%(1<<10) == 0:
+		return fmt.Sprintf("%dKB", n>>10)
+	}
+	return fmt.Sprintf("%dB", n)
+}
@@ -0,0 +1,34 @@
+package httpclient
+
+import (
+	"net/http"
+	"strings"
+)
+
+// RemoveHeaders deletes every header in names from h. Matching ignores case,
+// so keys that were stored without canonicalisation are removed as well.
+// User-Agent is set to an empty value instead, since net/http adds its own
+// default when the key is missing.
+func RemoveHeaders(h http.Header, names []string) {
+	if h == nil || len(names) == 0 {
+		return
+	}
+	blankUA := false
+	for _, name := range names {
+		if strings.EqualFold(name, "User-Agent") {
+			blankUA = true
+			break
+		}
+	}
+	for key := range h {
+		for _, name := range names {
+			if strings.EqualFold(key, name) {
+				delete(h, key)
+				break
+			}
+		}
+	}
+	if blankUA {
+		h["User-Agent"] = []string{""}
+	}
+}
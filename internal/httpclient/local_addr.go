@@ -0,0 +1,52 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+)
+
+// ParseLocalAddress validates a local-address value. Only an IP is
+// accepted; the source port is always picked by the OS.
+func ParseLocalAddress(raw string) (string, error) {
+	spec := strings.Trim(strings.TrimSpace(raw), "[]")
+	addr, err := netip.ParseAddr(spec)
+	if err != nil || addr.Zone() != "" {
+		return "", errdef.New(
+			errdef.CodeHTTP,
+			"invalid local-address %q (use an IPv4 or IPv6 address)",
+			raw,
+		)
+	}
+	return addr.Unmap().String(), nil
+}
+
+// localAddrDialer binds outgoing TCP connections to ip and turns the
+// kernel's "cannot assign requested address" into an error that names the
+// setting.
+func localAddrDialer(
+	d *net.Dialer,
+	ip string,
+) func(context.Context, string, string) (net.Conn, error) {
+	if ip == "" {
+		return d.DialContext
+	}
+	d.LocalAddr = &net.TCPAddr{IP: net.ParseIP(ip)}
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		conn, err := d.DialContext(ctx, network, address)
+		if err != nil && errors.Is(err, syscall.EADDRNOTAVAIL) {
+			return nil, errdef.Wrap(
+				errdef.CodeHTTP,
+				err,
+				"local-address %s is not assigned to any interface on this host",
+				ip,
+			)
+		}
+		return conn, err
+	}
+}
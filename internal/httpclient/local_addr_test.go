@@ -0,0 +1,49 @@
+package httpclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestExecuteBindsLocalAddress(t *testing.T) {
+	var remote string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remote = r.RemoteAddr
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	req := &restfile.Request{Method: "GET", URL: srv.URL}
+	opts := Options{LocalAddr: "127.0.0.1"}
+	if _, err := NewClient(nil).Execute(context.Background(), req, nil, opts); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	host, _, err := net.SplitHostPort(remote)
+	if err != nil || host != "127.0.0.1" {
+		t.Fatalf("expected connection from 127.0.0.1, got %q", remote)
+	}
+
+	// 192.0.2.0/24 is reserved for documentation and never local.
+	opts.LocalAddr = "192.0.2.1"
+	_, err = NewClient(nil).Execute(context.Background(), req, nil, opts)
+	if err == nil || !strings.Contains(err.Error(), "local-address 192.0.2.1 is not assigned") {
+		t.Fatalf("expected unassignable local-address error, got %v", err)
+	}
+}
+
+func TestParseLocalAddress(t *testing.T) {
+	if got, err := ParseLocalAddress(" ::ffff:10.0.0.5 "); err != nil || got != "10.0.0.5" {
+		t.Fatalf("expected mapped ipv4 to unmap, got %q (%v)", got, err)
+	}
+	for _, bad := range []string{"", "example.com", "10.0.0.5:80", "fe80::1%eth0"} {
+		if _, err := ParseLocalAddress(bad); err == nil {
+			t.Fatalf("expected %q to be rejected", bad)
+		}
+	}
+}
@@ -163,20 +163,81 @@ func applyRequestSettings(opts Options, settings map[string]string) Options {
 		effective.ProxyURL = value
 	}
 
+	if value, ok := norm["accept"]; ok && value != "" {
+		effective.Accept = value
+	}
+	if value, ok := norm["accept-encoding"]; ok && value != "" {
+		effective.AcceptEncoding = value
+	}
+	if value, ok := norm["request-id-header"]; ok {
+		if name, err := ParseRequestIDHeader(value); err == nil {
+			effective.RequestIDHeader = name
+		}
+	}
+
+	if value, ok := norm["max-response-headers"]; ok {
+		if n, err := ParseHeaderLimit(value); err == nil {
+			effective.MaxHeaderBytes = n
+		}
+	}
+
 	if value, ok := norm["followredirects"]; ok {
 		if b, err := strconv.ParseBool(value); err == nil {
 			effective.FollowRedirects = b
 		}
 	}
 
+	if value, ok := norm["keep-alive"]; ok {
+		if b, err := strconv.ParseBool(value); err == nil {
+			effective.DisableKeepAlives = !b
+		}
+	}
+
 	if value, ok := norm["insecure"]; ok {
 		if b, err := strconv.ParseBool(value); err == nil {
 			effective.InsecureSkipVerify = b
 		}
 	}
+
+	if value, ok := norm["capture-wire"]; ok {
+		if b, err := strconv.ParseBool(value); err == nil {
+			effective.CaptureWire = b
+		}
+	}
 	if v := resolveHTTPVersion(opts, norm); v != httpver.Unknown {
 		effective.HTTPVersion = v
 	}
+	if value, ok := norm["compression"]; ok {
+		if enc, ok := ParseCompression(value); ok {
+			effective.Compression = enc
+		}
+	}
+	if value, ok := norm["expect-continue"]; ok {
+		if on, wait, err := ParseExpectContinue(value); err == nil {
+			effective.ExpectContinue = on
+			effective.ExpectTimeout = wait
+		}
+	}
+	for _, key := range []string{"resolve", "dns-override"} {
+		value, ok := norm[key]
+		if !ok {
+			continue
+		}
+		if overrides, ok := parseResolveList(value); ok {
+			effective.Resolve = overrides
+		}
+		break
+	}
+	if value, ok := norm["dns-server"]; ok {
+		if server, err := ParseDNSServer(value); err == nil {
+			effective.DNSServer = server
+		}
+	}
+	if value, ok := norm["local-address"]; ok {
+		if ip, err := ParseLocalAddress(value); err == nil {
+			effective.LocalAddr = ip
+		}
+	}
 
 	return effective
 }
@@ -111,7 +111,30 @@ func (c *Client) buildHTTPRequest(
 			httpReq.Header.Set("Content-Type", "application/json")
 		}
 	}
+	if req.Body.Options.Base64 && body != nil && httpReq.Header.Get("Content-Type") == "" {
+		httpReq.Header.Set("Content-Type", "application/octet-stream")
+	}
+	if req.Body.FilePath != "" && httpReq.Header.Get("Content-Type") == "" {
+		if ct := fileBodyContentType(req.Body.FilePath); ct != "" {
+			httpReq.Header.Set("Content-Type", ct)
+		}
+	}
+
+	if opts.Accept != "" && httpReq.Header.Get("Accept") == "" {
+		httpReq.Header.Set("Accept", opts.Accept)
+	}
+	if opts.AcceptEncoding != "" && httpReq.Header.Get("Accept-Encoding") == "" {
+		httpReq.Header.Set("Accept-Encoding", opts.AcceptEncoding)
+	}
+	if opts.DisableKeepAlives {
+		httpReq.Close = true
+	}
 
 	c.applyAuthentication(httpReq, resolver, req.Metadata.Auth)
+	RemoveHeaders(httpReq.Header, req.Metadata.RemoveHeaders)
+	if err := compressRequestBody(httpReq, body, opts.Compression); err != nil {
+		return nil, opts, err
+	}
+	applyExpectContinue(httpReq, opts.ExpectContinue)
 	return httpReq, opts, nil
 }
@@ -0,0 +1,44 @@
+package httpclient
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/http/httpguts"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+// ParseRequestIDHeader validates a request-id-header value. "off", "false"
+// and "none" disable the header so a request can opt out of a file or
+// environment default.
+func ParseRequestIDHeader(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	switch strings.ToLower(name) {
+	case "", "off", "false", "none":
+		return "", nil
+	}
+	if !httpguts.ValidHeaderFieldName(name) {
+		return "", errdef.New(errdef.CodeHTTP, "invalid request-id-header %q", raw)
+	}
+	return http.CanonicalHeaderKey(name), nil
+}
+
+// ApplyRequestID puts a fresh UUID into the header named by header unless
+// the request already sets it, and returns the value that will be sent.
+func ApplyRequestID(req *restfile.Request, header string) string {
+	if req == nil || header == "" {
+		return ""
+	}
+	if current := req.Headers.Get(header); current != "" {
+		return current
+	}
+	if req.Headers == nil {
+		req.Headers = make(http.Header)
+	}
+	id := uuid.NewString()
+	req.Headers.Set(header, id)
+	return id
+}
@@ -0,0 +1,100 @@
+package httpclient
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+)
+
+// ResolveOverride pins connections for Host to Addr, like curl's --resolve.
+// An empty Port matches any port and an Addr without a port keeps the
+// port the request dialed.
+type ResolveOverride struct {
+	Host string
+	Port string
+	Addr string
+}
+
+// ParseResolve parses one host[:port]=addr[:port] override.
+func ParseResolve(raw string) (ResolveOverride, error) {
+	spec := strings.TrimSpace(raw)
+	target, addr, ok := strings.Cut(spec, "=")
+	target = strings.TrimSpace(target)
+	addr = strings.TrimSpace(addr)
+	if !ok || target == "" || addr == "" {
+		return ResolveOverride{}, errdef.New(
+			errdef.CodeHTTP,
+			"invalid resolve %q (use host=addr or host:port=addr:port)",
+			raw,
+		)
+	}
+	host, port := splitResolveHost(target)
+	if host == "" {
+		return ResolveOverride{}, errdef.New(errdef.CodeHTTP, "invalid resolve host in %q", raw)
+	}
+	return ResolveOverride{
+		Host: strings.ToLower(host),
+		Port: port,
+		Addr: addr,
+	}, nil
+}
+
+func parseResolveList(raw string) ([]ResolveOverride, bool) {
+	specs := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t' || r == '\n'
+	})
+	if len(specs) == 0 {
+		return nil, false
+	}
+	out := make([]ResolveOverride, 0, len(specs))
+	for _, spec := range specs {
+		o, err := ParseResolve(spec)
+		if err != nil {
+			return nil, false
+		}
+		out = append(out, o)
+	}
+	return out, true
+}
+
+func splitResolveHost(target string) (string, string) {
+	if host, port, err := net.SplitHostPort(target); err == nil {
+		return host, port
+	}
+	return strings.Trim(target, "[]"), ""
+}
+
+func (o ResolveOverride) rewrite(addr string) (string, bool) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", false
+	}
+	if !strings.EqualFold(host, o.Host) || (o.Port != "" && o.Port != port) {
+		return "", false
+	}
+	if _, _, err := net.SplitHostPort(o.Addr); err == nil {
+		return o.Addr, true
+	}
+	return net.JoinHostPort(strings.Trim(o.Addr, "[]"), port), true
+}
+
+// resolveDialer wraps dial so matching addresses connect to their override.
+// TLS SNI and the Host header still come from the request URL.
+func resolveDialer(
+	dial func(context.Context, string, string) (net.Conn, error),
+	overrides []ResolveOverride,
+) func(context.Context, string, string) (net.Conn, error) {
+	if dial == nil || len(overrides) == 0 {
+		return dial
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		for _, o := range overrides {
+			if target, ok := o.rewrite(addr); ok {
+				return dial(ctx, network, target)
+			}
+		}
+		return dial(ctx, network, addr)
+	}
+}
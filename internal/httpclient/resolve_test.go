@@ -0,0 +1,143 @@
+package httpclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestExecuteResolveOverrideKeepsHost(t *testing.T) {
+	var gotHost string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHost = r.Host
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("parse server url: %v", err)
+	}
+	override, err := ParseResolve("api.resterm.test:8080=" + u.Host)
+	if err != nil {
+		t.Fatalf("parse resolve: %v", err)
+	}
+
+	req := &restfile.Request{Method: "GET", URL: "http://api.resterm.test:8080/ping"}
+	opts := Options{Resolve: []ResolveOverride{override}}
+	resp, err := NewClient(nil).Execute(context.Background(), req, nil, opts)
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("unexpected status %d", resp.StatusCode)
+	}
+	if gotHost != "api.resterm.test:8080" {
+		t.Fatalf("expected original host header, got %q", gotHost)
+	}
+}
+
+func TestResolveOverrideRewrite(t *testing.T) {
+	cases := []struct {
+		spec string
+		addr string
+		want string
+		ok   bool
+	}{
+		{spec: "api.test=127.0.0.1", addr: "api.test:443", want: "127.0.0.1:443", ok: true},
+		{spec: "API.test=127.0.0.1:8443", addr: "api.test:443", want: "127.0.0.1:8443", ok: true},
+		{spec: "api.test:80=127.0.0.1", addr: "api.test:443", ok: false},
+		{spec: "api.test=::1", addr: "api.test:443", want: "[::1]:443", ok: true},
+		{spec: "api.test=[::1]:9000", addr: "api.test:443", want: "[::1]:9000", ok: true},
+		{spec: "api.test=127.0.0.1", addr: "other.test:443", ok: false},
+	}
+	for _, tc := range cases {
+		o, err := ParseResolve(tc.spec)
+		if err != nil {
+			t.Fatalf("parse %q: %v", tc.spec, err)
+		}
+		got, ok := o.rewrite(tc.addr)
+		if ok != tc.ok || got != tc.want {
+			t.Fatalf("%q on %q: expected %q/%v, got %q/%v", tc.spec, tc.addr, tc.want, tc.ok, got, ok)
+		}
+	}
+}
+
+func TestDNSResolverQueriesServer(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("udp listen unavailable: %v", err)
+	}
+	defer func() { _ = pc.Close() }()
+
+	got := make(chan struct{}, 1)
+	go func() {
+		buf := make([]byte, 512)
+		if _, _, err := pc.ReadFrom(buf); err == nil {
+			got <- struct{}{}
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, _ = dnsResolver(pc.LocalAddr().String()).LookupHost(ctx, "api.resterm.test")
+
+	select {
+	case <-got:
+	case <-time.After(time.Second):
+		t.Fatalf("expected lookup to reach the configured dns server")
+	}
+}
+
+func TestExecuteResolveOverrideWinsOverDNSServer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("parse server url: %v", err)
+	}
+	override, err := ParseResolve("api.resterm.test=" + u.Host)
+	if err != nil {
+		t.Fatalf("parse resolve: %v", err)
+	}
+
+	// The dns server is unreachable; the request only succeeds if the
+	// override skips the lookup.
+	req := &restfile.Request{Method: "GET", URL: "http://api.resterm.test/ping"}
+	opts := Options{Resolve: []ResolveOverride{override}, DNSServer: "127.0.0.1:1"}
+	resp, err := NewClient(nil).Execute(context.Background(), req, nil, opts)
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("unexpected status %d", resp.StatusCode)
+	}
+}
+
+func TestParseDNSServer(t *testing.T) {
+	cases := map[string]string{
+		"8.8.8.8":         "8.8.8.8:53",
+		" 1.1.1.1:5353 ":  "1.1.1.1:5353",
+		"::1":             "[::1]:53",
+		"[2001:db8::1]":   "[2001:db8::1]:53",
+		"dns.example.com": "dns.example.com:53",
+	}
+	for in, want := range cases {
+		got, err := ParseDNSServer(in)
+		if err != nil || got != want {
+			t.Fatalf("%q: expected %q, got %q (%v)", in, want, got, err)
+		}
+	}
+	if _, err := ParseDNSServer(""); err == nil {
+		t.Fatalf("expected error for empty dns server")
+	}
+}
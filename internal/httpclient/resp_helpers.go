@@ -2,6 +2,7 @@ package httpclient
 
 import (
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/unkn0wn-root/resterm/internal/restfile"
@@ -64,5 +65,22 @@ func respFromHTTP(
 		Duration:       dur,
 		EffectiveURL:   effURL(sent, resp),
 		Request:        req,
+		Redirects:      redirectChain(resp),
 	}
 }
+
+// redirectChain walks the responses net/http links through Request.Response
+// while following redirects and returns them oldest first.
+func redirectChain(resp *http.Response) []Redirect {
+	var hops []Redirect
+	for prev := resp.Request; prev != nil && prev.Response != nil; prev = prev.Response.Request {
+		r := prev.Response
+		hop := Redirect{StatusCode: r.StatusCode, Location: r.Header.Get("Location")}
+		if r.Request != nil && r.Request.URL != nil {
+			hop.URL = r.Request.URL.String()
+		}
+		hops = append(hops, hop)
+	}
+	slices.Reverse(hops)
+	return hops
+}
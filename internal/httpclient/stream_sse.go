@@ -87,6 +87,9 @@ func (c *Client) StartSSE(
 	httpResp, err := client.Do(httpReq)
 	if err != nil {
 		cancel()
+		if isHeaderLimitErr(err) {
+			return nil, nil, headerLimitError(effectiveOpts.MaxHeaderBytes)
+		}
 		return nil, nil, errdef.Wrap(errdef.CodeHTTP, err, "perform sse request")
 	}
 	if verErr := checkHTTPVersion(httpResp, effectiveOpts.HTTPVersion); verErr != nil {
@@ -109,6 +112,19 @@ func (c *Client) StartSSE(
 		return nil, respFromHTTP(httpReq, httpResp, req, body, time.Since(start)), nil
 	}
 
+	var out *sseOut
+	if streamOpts.Out != "" {
+		path, err := sseOutPath(streamOpts.Out, resolver, effectiveOpts.BaseDir)
+		if err == nil {
+			out, err = openSSEOut(path, streamOpts.OutRotate)
+		}
+		if err != nil {
+			_ = httpResp.Body.Close()
+			cancel()
+			return nil, nil, err
+		}
+	}
+
 	meta := buildStreamMeta(req, httpReq, httpResp, effectiveOpts.BaseDir, metaDefaults{})
 
 	session := stream.NewSession(streamCtx, stream.KindSSE, stream.Config{})
@@ -119,7 +135,7 @@ func (c *Client) StartSSE(
 		defer func() {
 			_ = httpResp.Body.Close()
 		}()
-		runSSESession(session, httpResp.Body, streamOpts)
+		runSSESession(session, httpResp.Body, streamOpts, out)
 	}()
 
 	return &StreamHandle{Session: session, Meta: meta}, nil, nil
@@ -211,10 +227,18 @@ func CompleteSSE(handle *StreamHandle) (*Response, error) {
 
 // Idle timer watches for activity resets - each incoming byte triggers a reset.
 // The drain logic after Stop() handles the race where the timer fires just before we reset.
-func runSSESession(session *stream.Session, body io.ReadCloser, opts restfile.SSEOptions) {
+func runSSESession(
+	session *stream.Session,
+	body io.ReadCloser,
+	opts restfile.SSEOptions,
+	out *sseOut,
+) {
 	ctx := session.Context()
 	reader := bufio.NewReader(body)
 	summary := SSESummary{Reason: sseReasonEOF}
+	defer func() {
+		_ = out.close()
+	}()
 
 	var (
 		builder    sseEventBuilder
@@ -259,6 +283,10 @@ func runSSESession(session *stream.Session, body io.ReadCloser, opts restfile.SS
 		if trimmed == "" {
 			if evt, ok := builder.finalize(index); ok {
 				publishSSEEvent(session, evt)
+				if err := out.write(evt); err != nil {
+					session.Close(err)
+					return
+				}
 				index++
 				eventCount++
 				if opts.MaxEvents > 0 && eventCount >= opts.MaxEvents {
@@ -283,6 +311,10 @@ func runSSESession(session *stream.Session, body io.ReadCloser, opts restfile.SS
 		if errors.Is(err, io.EOF) {
 			if evt, ok := builder.finalize(index); ok {
 				publishSSEEvent(session, evt)
+				if err := out.write(evt); err != nil {
+					session.Close(err)
+					return
+				}
 				eventCount++
 			}
 			break
@@ -312,6 +344,9 @@ func runSSESession(session *stream.Session, body io.ReadCloser, opts restfile.SS
 	})
 
 	var closeErr error
+	if err := out.close(); err != nil {
+		closeErr = err
+	}
 	if ctx.Err() != nil && summary.Reason == sseReasonCanceled {
 		closeErr = ctx.Err()
 	}
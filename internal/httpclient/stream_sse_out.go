@@ -0,0 +1,81 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+// sseOut tees received events to disk as JSON lines. Writes are unbuffered,
+// one call per event, so a tail on the file never sees a partial line and
+// nothing is lost when the stream is cancelled.
+type sseOut struct {
+	f *os.File
+}
+
+func openSSEOut(path string, rotate bool) (*sseOut, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, errdef.Wrap(errdef.CodeFilesystem, err, "create sse out dir")
+	}
+	if rotate {
+		if info, err := os.Stat(path); err == nil && info.Size() > 0 {
+			if err := os.Rename(path, path+".1"); err != nil {
+				return nil, errdef.Wrap(errdef.CodeFilesystem, err, "rotate sse out file")
+			}
+		}
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, errdef.Wrap(errdef.CodeFilesystem, err, "open sse out file")
+	}
+	return &sseOut{f: f}, nil
+}
+
+func (o *sseOut) write(evt SSEEvent) error {
+	if o == nil || o.f == nil {
+		return nil
+	}
+	line, err := json.Marshal(evt)
+	if err != nil {
+		return errdef.Wrap(errdef.CodeHTTP, err, "encode sse event")
+	}
+	if _, err := o.f.Write(append(line, '\n')); err != nil {
+		return errdef.Wrap(errdef.CodeFilesystem, err, "write sse out file")
+	}
+	return nil
+}
+
+// close is safe to call more than once so error paths can rely on a defer.
+func (o *sseOut) close() error {
+	if o == nil || o.f == nil {
+		return nil
+	}
+	f := o.f
+	o.f = nil
+	if err := f.Close(); err != nil {
+		return errdef.Wrap(errdef.CodeFilesystem, err, "close sse out file")
+	}
+	return nil
+}
+
+func sseOutPath(out string, resolver *vars.Resolver, baseDir string) (string, error) {
+	path := strings.TrimSpace(out)
+	if resolver != nil {
+		expanded, err := resolver.ExpandTemplates(path)
+		if err != nil {
+			return "", errdef.Wrap(errdef.CodeHTTP, err, "expand sse out path")
+		}
+		path = strings.TrimSpace(expanded)
+	}
+	if path == "" {
+		return "", errdef.New(errdef.CodeHTTP, "sse out path is empty")
+	}
+	if !filepath.IsAbs(path) && baseDir != "" {
+		path = filepath.Join(baseDir, path)
+	}
+	return filepath.Clean(path), nil
+}
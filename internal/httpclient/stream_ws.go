@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"nhooyr.io/websocket"
@@ -182,6 +183,12 @@ func (c *Client) StartWebSocket(
 	if wsOpts.MaxMessageBytes > 0 {
 		conn.SetReadLimit(wsOpts.MaxMessageBytes)
 	}
+	if wsOpts.Reconnect {
+		runtime.redial = newWSRedial(wsOpts, func(ctx context.Context) (*websocket.Conn, error) {
+			return c.redialWebSocket(ctx, req, resolver, effective, dial)
+		})
+		runtime.swapped = make(chan struct{})
+	}
 
 	if wsOpts.IdleTimeout > 0 {
 		go runtime.idleWatch(wsOpts.IdleTimeout)
@@ -502,12 +509,25 @@ func ensureSessionAlive(session *stream.Session) error {
 }
 
 type wsRuntime struct {
+	mu      sync.RWMutex
 	conn    *websocket.Conn
 	session *stream.Session
 	writeCh chan wsOutbound
 	cancel  context.CancelFunc
 	pulse   chan struct{}
 	once    sync.Once
+	closing atomic.Bool
+
+	// Reconnect state; redial is nil unless the request opted in.
+	redial  *wsRedial
+	swapped chan struct{}
+	replay  []wsOutbound
+}
+
+func (rt *wsRuntime) currentConn() *websocket.Conn {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+	return rt.conn
 }
 
 func (rt *wsRuntime) readLoop() {
@@ -516,8 +536,11 @@ func (rt *wsRuntime) readLoop() {
 	defer rt.shutdown()
 
 	for {
-		msgType, data, err := rt.conn.Read(ctx)
+		msgType, data, err := rt.currentConn().Read(ctx)
 		if err != nil {
+			if rt.reconnect(ctx, err) {
+				continue
+			}
 			var ce websocket.CloseError
 			if errors.As(err, &ce) {
 				meta := map[string]string{
@@ -629,7 +652,14 @@ func (rt *wsRuntime) writeLoop() {
 			if !ok {
 				return
 			}
-			if err := rt.performWrite(msg); err != nil {
+			conn := rt.currentConn()
+			err := rt.performWrite(conn, msg)
+			if err != nil && msg.kind != wsOutboundClose {
+				if next := rt.awaitReconnect(ctx, conn, err); next != nil {
+					err = rt.performWrite(next, msg)
+				}
+			}
+			if err != nil {
 				if msg.result != nil {
 					msg.result <- err
 				}
@@ -646,7 +676,7 @@ func (rt *wsRuntime) writeLoop() {
 	}
 }
 
-func (rt *wsRuntime) performWrite(msg wsOutbound) error {
+func (rt *wsRuntime) performWrite(conn *websocket.Conn, msg wsOutbound) error {
 	session := rt.session
 	ctx := msg.ctx
 	if ctx == nil {
@@ -659,10 +689,11 @@ func (rt *wsRuntime) performWrite(msg wsOutbound) error {
 		if msg.msgType == websocket.MessageText {
 			opcode = wsOpcodeText
 		}
-		if err := rt.conn.Write(ctx, msg.msgType, msg.payload); err != nil {
+		if err := conn.Write(ctx, msg.msgType, msg.payload); err != nil {
 			return errdef.Wrap(errdef.CodeHTTP, err, "send websocket frame")
 		}
 		rt.touchActivity()
+		rt.rememberStep(msg)
 
 		payload := append([]byte(nil), msg.payload...)
 		metadata := cloneMetadata(msg.metadata)
@@ -685,7 +716,7 @@ func (rt *wsRuntime) performWrite(msg wsOutbound) error {
 		})
 		return nil
 	case wsOutboundPing:
-		if err := rt.conn.Ping(ctx); err != nil {
+		if err := conn.Ping(ctx); err != nil {
 			return errdef.Wrap(errdef.CodeHTTP, err, "send websocket ping")
 		}
 		rt.touchActivity()
@@ -714,7 +745,7 @@ func (rt *wsRuntime) performWrite(msg wsOutbound) error {
 				websocketControlMaxPayload,
 			)
 		}
-		if err := wsWriteControl(rt.conn, ctx, wsOpcodePong, payload); err != nil {
+		if err := wsWriteControl(conn, ctx, wsOpcodePong, payload); err != nil {
 			return errdef.Wrap(errdef.CodeHTTP, err, "send websocket pong")
 		}
 		rt.touchActivity()
@@ -737,7 +768,8 @@ func (rt *wsRuntime) performWrite(msg wsOutbound) error {
 		return nil
 	case wsOutboundClose:
 		session.MarkClosing()
-		if err := rt.conn.Close(msg.code, msg.reason); err != nil {
+		rt.closing.Store(true)
+		if err := conn.Close(msg.code, msg.reason); err != nil {
 			return errdef.Wrap(errdef.CodeHTTP, err, "close websocket")
 		}
 		rt.touchActivity()
@@ -775,7 +807,7 @@ func (rt *wsRuntime) shutdown() {
 		if rt.cancel != nil {
 			rt.cancel()
 		}
-		if err := rt.conn.Close(websocket.StatusNormalClosure, ""); err != nil &&
+		if err := rt.currentConn().Close(websocket.StatusNormalClosure, ""); err != nil &&
 			!errors.Is(err, net.ErrClosed) && !errors.Is(err, context.Canceled) {
 			if rt.session != nil {
 				rt.session.Close(errdef.Wrap(errdef.CodeHTTP, err, "close websocket connection"))
@@ -0,0 +1,240 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"nhooyr.io/websocket"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/stream"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+const (
+	wsMetaReconnect       = "resterm.ws.reconnect"
+	wsMetaReconnectReason = "resterm.ws.reconnect.reason"
+	wsMetaReplay          = "resterm.ws.replay"
+
+	defaultWSReconnectRetries = 5
+	defaultWSReconnectBackoff = time.Second
+	maxWSReconnectBackoff     = 30 * time.Second
+)
+
+type wsDialFunc func(
+	context.Context,
+	string,
+	*websocket.DialOptions,
+) (*websocket.Conn, *http.Response, error)
+
+type wsRedial struct {
+	dial       func(context.Context) (*websocket.Conn, error)
+	maxRetries int
+	backoff    time.Duration
+	readLimit  int64
+}
+
+func newWSRedial(
+	opts restfile.WebSocketOptions,
+	dial func(context.Context) (*websocket.Conn, error),
+) *wsRedial {
+	rd := &wsRedial{
+		dial:       dial,
+		maxRetries: opts.MaxRetries,
+		backoff:    opts.ReconnectBackoff,
+		readLimit:  opts.MaxMessageBytes,
+	}
+	if rd.maxRetries <= 0 {
+		rd.maxRetries = defaultWSReconnectRetries
+	}
+	if rd.backoff <= 0 {
+		rd.backoff = defaultWSReconnectBackoff
+	}
+	return rd
+}
+
+// delay doubles the base backoff per attempt, capped so long outages keep
+// probing at a sane interval.
+func (rd *wsRedial) delay(attempt int) time.Duration {
+	d := rd.backoff
+	for i := 1; i < attempt && d < maxWSReconnectBackoff; i++ {
+		d *= 2
+	}
+	if d > maxWSReconnectBackoff {
+		d = maxWSReconnectBackoff
+	}
+	return d
+}
+
+func (c *Client) redialWebSocket(
+	ctx context.Context,
+	req *restfile.Request,
+	resolver *vars.Resolver,
+	opts Options,
+	dial wsDialFunc,
+) (*websocket.Conn, error) {
+	wsOpts := req.WebSocket.Options
+	dialCtx, cancel := ctxWithTimeout(ctx, wsOpts.HandshakeTimeout)
+	defer cancel()
+
+	httpReq, effective, err := c.prepareHTTPRequestWithOpts(dialCtx, req, resolver, opts)
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.streamClient(effective)
+	if err != nil {
+		return nil, err
+	}
+	conn, resp, err := dial(dialCtx, httpReq.URL.String(), wsDialOptions(httpReq, wsOpts, client))
+	if err != nil {
+		if resp != nil && resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+		return nil, errdef.Wrap(errdef.CodeHTTP, err, "redial websocket")
+	}
+	return conn, nil
+}
+
+// reconnect re-dials after the connection dropped without the client asking
+// for it. It reports whether a fresh connection is in place.
+func (rt *wsRuntime) reconnect(ctx context.Context, cause error) bool {
+	if rt.redial == nil || ctx.Err() != nil || rt.closing.Load() {
+		return false
+	}
+	var ce websocket.CloseError
+	if errors.As(cause, &ce) && ce.Code == websocket.StatusNormalClosure {
+		return false
+	}
+	dropConn(rt.currentConn())
+
+	limit := rt.redial.maxRetries
+	for attempt := 1; attempt <= limit; attempt++ {
+		rt.publishReconnect(fmt.Sprintf("attempt %d/%d", attempt, limit), cause)
+		if err := waitForDuration(ctx, rt.redial.delay(attempt)); err != nil {
+			return false
+		}
+		if rt.closing.Load() {
+			return false
+		}
+		conn, err := rt.redial.dial(ctx)
+		if err != nil {
+			cause = err
+			continue
+		}
+		if rt.redial.readLimit > 0 {
+			conn.SetReadLimit(rt.redial.readLimit)
+		}
+		rt.swapConn(conn)
+		rt.touchActivity()
+		rt.publishReconnect("connected", nil)
+		rt.replaySteps(ctx, conn)
+		return true
+	}
+	rt.publishReconnect("failed", cause)
+	return false
+}
+
+func (rt *wsRuntime) swapConn(conn *websocket.Conn) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.conn = conn
+	close(rt.swapped)
+	rt.swapped = make(chan struct{})
+}
+
+// awaitReconnect blocks a failed write until the read loop has replaced the
+// connection, so queued sends survive a reconnect instead of ending the session.
+func (rt *wsRuntime) awaitReconnect(
+	ctx context.Context,
+	old *websocket.Conn,
+	err error,
+) *websocket.Conn {
+	if rt.redial == nil || rt.closing.Load() {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return nil
+	}
+	// Make sure the read loop notices the broken connection.
+	dropConn(old)
+	for {
+		rt.mu.RLock()
+		conn, swapped := rt.conn, rt.swapped
+		rt.mu.RUnlock()
+		if conn != old {
+			return conn
+		}
+		select {
+		case <-swapped:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// rememberStep keeps scripted sends so they can be replayed on a new
+// connection; interactive console messages are not replayed.
+func (rt *wsRuntime) rememberStep(msg wsOutbound) {
+	if rt.redial == nil || msg.metadata == nil {
+		return
+	}
+	if _, ok := msg.metadata[wsMetaStep]; !ok {
+		return
+	}
+	if _, ok := msg.metadata[wsMetaReplay]; ok {
+		return
+	}
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.replay = append(rt.replay, wsOutbound{
+		kind:     wsOutboundMessage,
+		msgType:  msg.msgType,
+		payload:  append([]byte(nil), msg.payload...),
+		metadata: cloneMetadata(msg.metadata),
+	})
+}
+
+func (rt *wsRuntime) replaySteps(ctx context.Context, conn *websocket.Conn) {
+	rt.mu.RLock()
+	steps := append([]wsOutbound(nil), rt.replay...)
+	rt.mu.RUnlock()
+	for _, msg := range steps {
+		msg.ctx = ctx
+		msg.metadata = cloneMetadata(msg.metadata)
+		msg.metadata[wsMetaReplay] = "true"
+		if err := rt.performWrite(conn, msg); err != nil {
+			return
+		}
+	}
+}
+
+// dropConn tears down a connection that is being replaced. Close may wait
+// for the peer's close frame, so it runs in the background.
+func dropConn(conn *websocket.Conn) {
+	if conn == nil {
+		return
+	}
+	go func() {
+		_ = conn.Close(websocket.StatusGoingAway, "reconnecting")
+	}()
+}
+
+func (rt *wsRuntime) publishReconnect(status string, cause error) {
+	meta := map[string]string{
+		wsMetaType:      "reconnect",
+		wsMetaReconnect: status,
+	}
+	if cause != nil {
+		meta[wsMetaReconnectReason] = cause.Error()
+	}
+	rt.session.Publish(&stream.Event{
+		Kind:      stream.KindWebSocket,
+		Direction: stream.DirNA,
+		Timestamp: time.Now(),
+		Metadata:  meta,
+	})
+}
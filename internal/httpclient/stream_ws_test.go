@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -490,3 +491,100 @@ loop:
 		t.Fatal("session did not terminate after close")
 	}
 }
+
+func TestStartWebSocketReconnectReplaysSteps(t *testing.T) {
+	received := make(chan string, 8)
+	var dials atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+		if err != nil {
+			t.Errorf("websocket accept failed: %v", err)
+			return
+		}
+		n := dials.Add(1)
+		_, data, err := conn.Read(r.Context())
+		if err != nil {
+			return
+		}
+		received <- fmt.Sprintf("%d:%s", n, data)
+		if n == 1 {
+			_ = conn.Close(websocket.StatusGoingAway, "restart")
+			return
+		}
+		<-r.Context().Done()
+		_ = conn.Close(websocket.StatusNormalClosure, "bye")
+	}))
+	defer srv.Close()
+
+	client := NewClient(nil)
+	req := &restfile.Request{
+		Method: http.MethodGet,
+		URL:    strings.Replace(srv.URL, "http", "ws", 1),
+		WebSocket: &restfile.WebSocketRequest{
+			Options: restfile.WebSocketOptions{
+				Reconnect:        true,
+				MaxRetries:       2,
+				ReconnectBackoff: 10 * time.Millisecond,
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	handle, fallback, err := client.StartWebSocket(ctx, req, nil, Options{})
+	if err != nil {
+		t.Fatalf("StartWebSocket returned error: %v", err)
+	}
+	if fallback != nil {
+		t.Fatalf("expected live session, received fallback response")
+	}
+	session := handle.Session
+	listener := session.Subscribe()
+	defer listener.Cancel()
+
+	if err := handle.Sender.SendText(
+		session.Context(),
+		"subscribe",
+		map[string]string{wsMetaType: "text", wsMetaStep: "1:send"},
+	); err != nil {
+		t.Fatalf("SendText failed: %v", err)
+	}
+
+	for _, want := range []string{"1:subscribe", "2:subscribe"} {
+		select {
+		case got := <-received:
+			if got != want {
+				t.Fatalf("expected server to receive %q, got %q", want, got)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for %q", want)
+		}
+	}
+
+	isConnected := func(evt *stream.Event) bool {
+		return evt != nil && evt.Metadata[wsMetaType] == "reconnect" &&
+			evt.Metadata[wsMetaReconnect] == "connected"
+	}
+	connected := false
+	for _, evt := range listener.Snapshot.Events {
+		connected = connected || isConnected(evt)
+	}
+	deadline := time.After(2 * time.Second)
+	for !connected {
+		select {
+		case evt := <-listener.C:
+			connected = isConnected(evt)
+		case <-deadline:
+			t.Fatal("expected reconnect event")
+		}
+	}
+
+	select {
+	case <-session.Done():
+		t.Fatalf("session ended after reconnect: %v", session.Err())
+	default:
+	}
+	session.Cancel()
+	<-session.Done()
+}
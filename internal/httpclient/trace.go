@@ -2,6 +2,7 @@ package httpclient
 
 import (
 	"crypto/tls"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httptrace"
@@ -20,6 +21,10 @@ type traceSession struct {
 	mu             sync.Mutex
 	conn           *nettrace.ConnDetails
 	tls            *nettrace.TLSDetails
+	expectWait     bool
+	expectGot      bool
+	expectRejected bool
+	bodyRead       bool
 }
 
 func newTraceSession() *traceSession {
@@ -34,6 +39,8 @@ func newTraceSession() *traceSession {
 		TLSHandshakeStart:    s.onTLSHandshakeStart,
 		TLSHandshakeDone:     s.onTLSHandshakeDone,
 		WroteHeaders:         s.onWroteHeaders,
+		Wait100Continue:      s.onWait100Continue,
+		Got100Continue:       s.onGot100Continue,
 		WroteRequest:         s.onWroteRequest,
 		GotFirstResponseByte: s.onGotFirstResponseByte,
 	}
@@ -46,7 +53,28 @@ func (s *traceSession) bind(req *http.Request) *http.Request {
 	}
 
 	ctx := httptrace.WithClientTrace(req.Context(), s.trace)
-	return req.WithContext(ctx)
+	req = req.WithContext(ctx)
+	if req.Header.Get("Expect") != "" && req.Body != nil && req.Body != http.NoBody {
+		req.Body = &traceBody{ReadCloser: req.Body, s: s}
+	}
+	return req
+}
+
+// traceBody notes whether the transport read the request body after it
+// started waiting for 100 Continue, which tells a timeout apart from a
+// rejection. Reads before the wait (length probes) do not count.
+type traceBody struct {
+	io.ReadCloser
+	s *traceSession
+}
+
+func (b *traceBody) Read(p []byte) (int, error) {
+	b.s.mu.Lock()
+	if b.s.expectWait {
+		b.s.bodyRead = true
+	}
+	b.s.mu.Unlock()
+	return b.ReadCloser.Read(p)
 }
 
 func (s *traceSession) onGetConn(hostPort string) {
@@ -167,8 +195,40 @@ func (s *traceSession) onWroteHeaders() {
 	}
 }
 
+func (s *traceSession) onWait100Continue() {
+	s.mu.Lock()
+	s.expectWait = true
+	s.mu.Unlock()
+}
+
+func (s *traceSession) onGot100Continue() {
+	s.mu.Lock()
+	s.expectGot = true
+	s.mu.Unlock()
+	s.collector.UpdateMeta(nettrace.PhaseReqBody, func(meta *nettrace.PhaseMeta) {
+		meta.Expect = nettrace.ExpectContinue
+	})
+}
+
 func (s *traceSession) onWroteRequest(info httptrace.WroteRequestInfo) {
 	now := time.Now()
+	s.mu.Lock()
+	closed := s.expectRejected
+	outcome := s.expectOutcomeLocked()
+	s.expectRejected = outcome == nettrace.ExpectRejected
+	s.mu.Unlock()
+	if closed {
+		// gotResponse already closed the body phase.
+		if info.Err != nil {
+			s.collector.Fail(info.Err)
+		}
+		return
+	}
+	if outcome != "" {
+		s.collector.UpdateMeta(nettrace.PhaseReqBody, func(meta *nettrace.PhaseMeta) {
+			meta.Expect = outcome
+		})
+	}
 	if s.reqBodyActive {
 		s.collector.End(nettrace.PhaseReqBody, now, info.Err)
 		s.reqBodyActive = false
@@ -177,12 +237,29 @@ func (s *traceSession) onWroteRequest(info httptrace.WroteRequestInfo) {
 		s.collector.Fail(info.Err)
 		return
 	}
+	if outcome == nettrace.ExpectRejected {
+		return
+	}
 	if !s.ttfbActive {
 		s.ttfbActive = true
 		s.collector.Begin(nettrace.PhaseTTFB, now)
 	}
 }
 
+// expectOutcomeLocked reports how a 100-continue wait ended without an
+// interim response: a body that was never read means the server rejected
+// the request, otherwise the wait timed out and the body went out anyway.
+func (s *traceSession) expectOutcomeLocked() string {
+	switch {
+	case !s.expectWait || s.expectGot:
+		return ""
+	case s.bodyRead:
+		return nettrace.ExpectTimeout
+	default:
+		return nettrace.ExpectRejected
+	}
+}
+
 func (s *traceSession) onGotFirstResponseByte() {
 	now := time.Now()
 	if s.ttfbActive {
@@ -195,6 +272,23 @@ func (s *traceSession) onGotFirstResponseByte() {
 	}
 }
 
+// gotResponse runs once the final response headers are in. When the server
+// rejected a 100-continue request the transport may still be unwinding the
+// write, so the body phase is closed here rather than left incomplete.
+func (s *traceSession) gotResponse() {
+	s.mu.Lock()
+	if s.expectRejected || s.expectOutcomeLocked() != nettrace.ExpectRejected {
+		s.mu.Unlock()
+		return
+	}
+	s.expectRejected = true
+	s.mu.Unlock()
+	s.collector.UpdateMeta(nettrace.PhaseReqBody, func(meta *nettrace.PhaseMeta) {
+		meta.Expect = nettrace.ExpectRejected
+	})
+	s.collector.End(nettrace.PhaseReqBody, time.Now(), nil)
+}
+
 func (s *traceSession) finishTransfer(err error) {
 	if !s.transferActive {
 		return
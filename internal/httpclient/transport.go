@@ -26,17 +26,36 @@ const (
 func (c *Client) buildHTTPClient(opts Options) (*http.Client, error) {
 	transport := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
+		DialContext: localAddrDialer(&net.Dialer{
 			Timeout:   defaultDialTimeout,
 			KeepAlive: defaultDialKeepAlive,
-		}).DialContext,
+			Resolver:  dnsResolver(opts.DNSServer),
+		}, opts.LocalAddr),
 		TLSHandshakeTimeout:   defaultTLSHandshakeTimeout,
 		MaxIdleConns:          defaultMaxIdleConns,
 		IdleConnTimeout:       defaultIdleConnTimeout,
 		ExpectContinueTimeout: defaultExpectContinueTimeout,
 		ForceAttemptHTTP2:     true,
 	}
-	if opts.HTTPVersion == httpver.V10 || opts.HTTPVersion == httpver.V11 {
+	if opts.DisableKeepAlives {
+		transport.DisableKeepAlives = true
+	}
+	if opts.ExpectTimeout > 0 {
+		transport.ExpectContinueTimeout = opts.ExpectTimeout
+	}
+	if opts.MaxHeaderBytes > 0 {
+		transport.MaxResponseHeaderBytes = transportHeaderLimit(opts.MaxHeaderBytes)
+	}
+	version := opts.HTTPVersion
+	if opts.CaptureWire {
+		if version == httpver.V2 {
+			return nil, errdef.New(errdef.CodeHTTP, "capture-wire requires HTTP/1.x")
+		}
+		if version == httpver.Unknown {
+			version = httpver.V11
+		}
+	}
+	if version == httpver.V10 || version == httpver.V11 {
 		transport.ForceAttemptHTTP2 = false
 		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
 	}
@@ -77,7 +96,7 @@ func (c *Client) buildHTTPClient(opts Options) (*http.Client, error) {
 	}
 
 	applyTunnel := func(kind string, dial tunnel.DialContextFunc) error {
-		if err := tunnel.ApplyHTTPTransport(transport, opts.HTTPVersion, dial); err != nil {
+		if err := tunnel.ApplyHTTPTransport(transport, version, dial); err != nil {
 			return errdef.Wrap(errdef.CodeHTTP, err, "enable http2 over %s", kind)
 		}
 		return nil
@@ -101,6 +120,11 @@ func (c *Client) buildHTTPClient(opts Options) (*http.Client, error) {
 		}
 	}
 
+	transport.DialContext = resolveDialer(transport.DialContext, opts.Resolve)
+	if opts.CaptureWire {
+		applyWireCapture(transport)
+	}
+
 	client := &http.Client{Transport: transport, Jar: c.jar}
 	if opts.Timeout > 0 {
 		client.Timeout = opts.Timeout
@@ -0,0 +1,66 @@
+package httpclient
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// UploadProgressFunc receives the number of request body bytes handed to
+// the transport so far and the body size, or -1 when Content-Length is
+// unknown.
+type UploadProgressFunc func(sent, total int64)
+
+const uploadProgressInterval = 100 * time.Millisecond
+
+// withUploadProgress wraps the request body so fn is called as it is read,
+// at most once per uploadProgressInterval plus once when the body is done.
+// Bodies replayed through GetBody on redirects count from zero again.
+func withUploadProgress(req *http.Request, fn UploadProgressFunc) *http.Request {
+	if fn == nil || req == nil || req.Body == nil || req.Body == http.NoBody {
+		return req
+	}
+	total := req.ContentLength
+	if total <= 0 {
+		total = -1
+	}
+	req.Body = &progressReader{rc: req.Body, fn: fn, total: total}
+	if getBody := req.GetBody; getBody != nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			body, err := getBody()
+			if err != nil || body == nil || body == http.NoBody {
+				return body, err
+			}
+			return &progressReader{rc: body, fn: fn, total: total}, nil
+		}
+	}
+	return req
+}
+
+type progressReader struct {
+	rc    io.ReadCloser
+	fn    UploadProgressFunc
+	total int64
+	sent  int64
+	last  time.Time
+	done  bool
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.rc.Read(p)
+	r.sent += int64(n)
+	switch {
+	case r.done:
+	case err == io.EOF:
+		r.done = true
+		r.fn(r.sent, r.total)
+	case n > 0 && time.Since(r.last) >= uploadProgressInterval:
+		r.last = time.Now()
+		r.fn(r.sent, r.total)
+	}
+	return n, err
+}
+
+func (r *progressReader) Close() error {
+	return r.rc.Close()
+}
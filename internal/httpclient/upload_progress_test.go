@@ -0,0 +1,62 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestExecuteReportsUploadProgress(t *testing.T) {
+	payload := strings.Repeat("x", 64*1024)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	var (
+		mu    sync.Mutex
+		calls [][2]int64
+	)
+	opts := Options{UploadProgress: func(sent, total int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, [2]int64{sent, total})
+	}}
+	req := &restfile.Request{
+		Method: "POST",
+		URL:    srv.URL,
+		Body:   restfile.BodySource{Text: payload},
+	}
+	if _, err := NewClient(nil).Execute(context.Background(), req, nil, opts); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(calls) == 0 {
+		t.Fatalf("expected upload progress callbacks")
+	}
+	want := [2]int64{int64(len(payload)), int64(len(payload))}
+	if last := calls[len(calls)-1]; last != want {
+		t.Fatalf("expected final progress %v, got %v", want, last)
+	}
+}
+
+func TestWithUploadProgressSkipsEmptyBody(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	called := false
+	got := withUploadProgress(req, func(int64, int64) { called = true })
+	if got.Body != http.NoBody {
+		t.Fatalf("expected body to stay untouched, got %T", got.Body)
+	}
+	if called {
+		t.Fatalf("expected no progress for an empty body")
+	}
+}
@@ -0,0 +1,157 @@
+package httpclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+)
+
+// maxWireBytes caps how much of a response capture-wire keeps. Anything past
+// the cap is dropped and the response is flagged as truncated.
+const maxWireBytes = 4 << 20
+
+type wireKey struct{}
+
+// wireRecorder collects the bytes read from the connection that carried a
+// response, before the transport strips chunked framing.
+type wireRecorder struct {
+	mu        sync.Mutex
+	plain     bool
+	buf       []byte
+	truncated bool
+}
+
+func withWireRecorder(req *http.Request) (*http.Request, *wireRecorder) {
+	rec := &wireRecorder{plain: req.URL != nil && req.URL.Scheme == "http"}
+	ctx := context.WithValue(req.Context(), wireKey{}, rec)
+	return req.WithContext(ctx), rec
+}
+
+func wireRecorderFrom(ctx context.Context) *wireRecorder {
+	rec, _ := ctx.Value(wireKey{}).(*wireRecorder)
+	return rec
+}
+
+// reset drops bytes from an earlier connection so a followed redirect only
+// leaves the final response behind.
+func (r *wireRecorder) reset() {
+	r.mu.Lock()
+	r.buf = r.buf[:0]
+	r.truncated = false
+	r.mu.Unlock()
+}
+
+func (r *wireRecorder) write(p []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	room := maxWireBytes - len(r.buf)
+	if len(p) > room {
+		p = p[:room]
+		r.truncated = true
+	}
+	r.buf = append(r.buf, p...)
+}
+
+func (r *wireRecorder) bytes() ([]byte, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]byte(nil), r.buf...), r.truncated
+}
+
+type wireConn struct {
+	net.Conn
+	rec *wireRecorder
+}
+
+func (c *wireConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if n > 0 {
+		c.rec.write(p[:n])
+	}
+	return n, err
+}
+
+// applyWireCapture pins the transport to fresh HTTP/1.x connections and
+// records what is read from them. TLS is terminated here rather than by the
+// transport so the recorder sees plaintext. HTTPS through a proxy is left
+// alone because the transport wraps the tunnel itself.
+func applyWireCapture(transport *http.Transport) {
+	transport.ForceAttemptHTTP2 = false
+	transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	transport.DisableKeepAlives = true
+
+	dial := transport.DialContext
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+	base := transport.TLSClientConfig
+
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		rec := wireRecorderFrom(ctx)
+		if rec == nil || !rec.plain {
+			return conn, nil
+		}
+		rec.reset()
+		return &wireConn{Conn: conn, rec: rec}, nil
+	}
+	transport.DialTLSContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		tlsConn, err := wireTLSHandshake(ctx, conn, base, addr)
+		if err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		rec := wireRecorderFrom(ctx)
+		if rec == nil {
+			return tlsConn, nil
+		}
+		rec.reset()
+		return &wireConn{Conn: tlsConn, rec: rec}, nil
+	}
+}
+
+func wireTLSHandshake(
+	ctx context.Context,
+	conn net.Conn,
+	base *tls.Config,
+	addr string,
+) (*tls.Conn, error) {
+	cfg := &tls.Config{}
+	if base != nil {
+		cfg = base.Clone()
+	}
+	if cfg.ServerName == "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			host = addr
+		}
+		cfg.ServerName = host
+	}
+	cfg.NextProtos = []string{"http/1.1"}
+
+	trace := httptrace.ContextClientTrace(ctx)
+	if trace != nil && trace.TLSHandshakeStart != nil {
+		trace.TLSHandshakeStart()
+	}
+	hctx, cancel := context.WithTimeout(ctx, defaultTLSHandshakeTimeout)
+	defer cancel()
+	tlsConn := tls.Client(conn, cfg)
+	err := tlsConn.HandshakeContext(hctx)
+	if trace != nil && trace.TLSHandshakeDone != nil {
+		trace.TLSHandshakeDone(tlsConn.ConnectionState(), err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tlsConn, nil
+}
@@ -22,8 +22,17 @@ type PhaseMeta struct {
 	Addr   string
 	Reused bool
 	Cached bool
+	// Expect records the 100-continue outcome on the request body phase:
+	// ExpectContinue, ExpectRejected or ExpectTimeout.
+	Expect string
 }
 
+const (
+	ExpectContinue = "continue"
+	ExpectRejected = "rejected"
+	ExpectTimeout  = "timeout"
+)
+
 type Phase struct {
 	Kind     PhaseKind
 	Start    time.Time
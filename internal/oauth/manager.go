@@ -158,6 +158,11 @@ func (m *Manager) obtainToken(
 			entry.token.RefreshToken,
 			opts,
 		); err == nil {
+			// Providers may omit refresh_token on refresh; keep the one we
+			// have so later refreshes still work.
+			if refreshed.RefreshToken == "" {
+				refreshed.RefreshToken = entry.token.RefreshToken
+			}
 			m.storeToken(key, cfg, refreshed)
 			return refreshed, nil
 		}
@@ -176,6 +181,21 @@ func (m *Manager) obtainToken(
 	return fetched, nil
 }
 
+// Refresh replaces a token the server rejected. The cached access token is
+// dropped only if it still matches stale, so concurrent 401s share a single
+// refresh. The stored refresh_token is used when present; otherwise the
+// grant runs again.
+func (m *Manager) Refresh(
+	ctx context.Context,
+	env string,
+	cfg Config,
+	stale string,
+	opts httpclient.Options,
+) (Token, error) {
+	m.expire(m.cacheKey(env, cfg), stale)
+	return m.Token(ctx, env, cfg, opts)
+}
+
 func (m *Manager) SetRequestFunc(
 	fn func(context.Context, *restfile.Request, httpclient.Options) (*httpclient.Response, error),
 ) {
@@ -206,6 +226,19 @@ func (m *Manager) cacheEntry(key string) *cacheEntry {
 	return m.cache[key]
 }
 
+func (m *Manager) expire(key, stale string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.cache[key]
+	if !ok || entry.token.AccessToken != stale {
+		return
+	}
+	m.cache[key] = &cacheEntry{
+		token: Token{RefreshToken: entry.token.RefreshToken},
+		cfg:   entry.cfg,
+	}
+}
+
 func (m *Manager) storeToken(key string, cfg Config, token Token) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -3,6 +3,7 @@ package oauth
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -493,3 +494,114 @@ func TestPrepareRedirectPreservesQuery(t *testing.T) {
 		t.Fatalf("unexpected host %q", redirect.Host)
 	}
 }
+
+func TestManagerRefreshRejectedToken(t *testing.T) {
+	mgr := NewManager(nil)
+	var grants []string
+
+	mgr.SetRequestFunc(
+		func(ctx context.Context, req *restfile.Request, opts httpclient.Options) (*httpclient.Response, error) {
+			values, err := url.ParseQuery(req.Body.Text)
+			if err != nil {
+				t.Fatalf("parse form: %v", err)
+			}
+			grant := values.Get("grant_type")
+			grants = append(grants, grant)
+			body := `{"access_token":"token-initial","expires_in":3600,"refresh_token":"refresh-1"}`
+			if grant == "refresh_token" {
+				if values.Get("refresh_token") != "refresh-1" {
+					t.Fatalf("unexpected refresh token %q", values.Get("refresh_token"))
+				}
+				body = fmt.Sprintf(`{"access_token":"token-%d","expires_in":3600}`, len(grants))
+			}
+			return &httpclient.Response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       []byte(body),
+				Headers:    http.Header{},
+			}, nil
+		},
+	)
+
+	cfg := Config{
+		TokenURL:     "https://auth.local/token",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		CacheKey:     "api",
+	}
+	ctx := context.Background()
+
+	if _, err := mgr.Token(ctx, "", cfg, httpclient.Options{}); err != nil {
+		t.Fatalf("token: %v", err)
+	}
+	token, err := mgr.Refresh(ctx, "", cfg, "token-initial", httpclient.Options{})
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if token.AccessToken != "token-2" {
+		t.Fatalf("expected refreshed token, got %q", token.AccessToken)
+	}
+
+	// A stale value that no longer matches the cache must not trigger another refresh.
+	token, err = mgr.Refresh(ctx, "", cfg, "token-initial", httpclient.Options{})
+	if err != nil {
+		t.Fatalf("stale refresh: %v", err)
+	}
+	if token.AccessToken != "token-2" {
+		t.Fatalf("expected cached token, got %q", token.AccessToken)
+	}
+
+	// The refresh response had no refresh_token, so the original must be kept.
+	token, err = mgr.Refresh(ctx, "", cfg, "token-2", httpclient.Options{})
+	if err != nil {
+		t.Fatalf("second refresh: %v", err)
+	}
+	if token.AccessToken != "token-3" {
+		t.Fatalf("expected second refreshed token, got %q", token.AccessToken)
+	}
+
+	want := []string{"client_credentials", "refresh_token", "refresh_token"}
+	if strings.Join(grants, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected grants sequence %v", grants)
+	}
+}
+
+func TestManagerRefreshWithoutRefreshTokenRerunsGrant(t *testing.T) {
+	mgr := NewManager(nil)
+	var grants []string
+
+	mgr.SetRequestFunc(
+		func(ctx context.Context, req *restfile.Request, opts httpclient.Options) (*httpclient.Response, error) {
+			values, err := url.ParseQuery(req.Body.Text)
+			if err != nil {
+				t.Fatalf("parse form: %v", err)
+			}
+			grants = append(grants, values.Get("grant_type"))
+			return &httpclient.Response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body: []byte(
+					fmt.Sprintf(`{"access_token":"token-%d","expires_in":3600}`, len(grants)),
+				),
+				Headers: http.Header{},
+			}, nil
+		},
+	)
+
+	cfg := Config{TokenURL: "https://auth.local/token", ClientID: "client", ClientSecret: "secret"}
+	ctx := context.Background()
+
+	if _, err := mgr.Token(ctx, "dev", cfg, httpclient.Options{}); err != nil {
+		t.Fatalf("token: %v", err)
+	}
+	token, err := mgr.Refresh(ctx, "dev", cfg, "token-1", httpclient.Options{})
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if token.AccessToken != "token-2" {
+		t.Fatalf("expected new token, got %q", token.AccessToken)
+	}
+	if len(grants) != 2 || grants[1] != "client_credentials" {
+		t.Fatalf("expected grant to run again, got %v", grants)
+	}
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/unkn0wn-root/resterm/internal/restfile"
@@ -45,6 +46,9 @@ func (b *documentBuilder) handleComment(line int, text string) {
 	if b.handlePatchDirective(line, key, rest) {
 		return
 	}
+	if b.handleTagSettingsDirective(line, key, rest) {
+		return
+	}
 	if b.handleFileSettingsDirective(key, rest) {
 		return
 	}
@@ -158,6 +162,38 @@ func (b *documentBuilder) handlePatchDirective(line int, key, rest string) bool
 	return true
 }
 
+// handleTagSettingsDirective records "@tag-settings <tag> key=value ...".
+// Requests tagged <tag> inherit those settings above file-level ones.
+func (b *documentBuilder) handleTagSettingsDirective(line int, key, rest string) bool {
+	if key != "tag-settings" {
+		return false
+	}
+	if b.inRequest {
+		b.addError(line, "@tag-settings must be declared outside a request")
+		return true
+	}
+	tag, opts := splitFirst(rest)
+	tag = restfile.TagKey(tag)
+	if tag == "" || strings.Contains(tag, "=") {
+		b.addError(line, "@tag-settings requires a tag before its settings")
+		return true
+	}
+	set := applySettingsTokens(nil, opts)
+	if len(set) == 0 {
+		b.addError(line, fmt.Sprintf("@tag-settings %s has no key=value settings", tag))
+		return true
+	}
+	if b.doc.TagSettings == nil {
+		b.doc.TagSettings = make(map[string]map[string]string)
+	}
+	merged := b.doc.TagSettings[tag]
+	for k, v := range set {
+		merged = setSetting(merged, k, v)
+	}
+	b.doc.TagSettings[tag] = merged
+	return true
+}
+
 func (b *documentBuilder) handleFileSettingsDirective(key, rest string) bool {
 	if b.inRequest {
 		return false
@@ -190,6 +226,10 @@ func (b *documentBuilder) handleRequestBuilderDirective(key, rest string) bool {
 	if key == "body" {
 		return b.request.handleBodyDirective(rest)
 	}
+	if key == "body-base64" {
+		b.request.bodyOptions.Base64 = true
+		return true
+	}
 	return false
 }
 
@@ -222,6 +262,11 @@ func (b *documentBuilder) handleRequestMetadataDirective(line int, key, rest str
 			}
 		}
 		return true
+	case "env":
+		if fields := strings.Fields(rest); len(fields) > 0 {
+			b.request.metadata.Env = trimQuotes(fields[0])
+		}
+		return true
 	case "no-log", "nolog":
 		b.request.metadata.NoLog = true
 		return true
@@ -246,10 +291,7 @@ func (b *documentBuilder) handleRequestMetadataDirective(line int, key, rest str
 	case "setting":
 		key, value := splitDirective(rest)
 		if key != "" {
-			if b.request.settings == nil {
-				b.request.settings = make(map[string]string)
-			}
-			b.request.settings[key] = value
+			b.request.settings = setSetting(b.request.settings, key, value)
 		}
 		return true
 	case "timeout":
@@ -272,6 +314,38 @@ func (b *documentBuilder) handleRequestMetadataDirective(line int, key, rest str
 		}
 		b.request.variables = append(b.request.variables, variable)
 		return true
+	case "path-param":
+		name, value := parseNameValue(rest)
+		if name == "" || value == "" {
+			b.addError(line, "@path-param expects a name and a value")
+			return true
+		}
+		if b.request.metadata.PathParams == nil {
+			b.request.metadata.PathParams = make(map[string]string)
+		}
+		b.request.metadata.PathParams[name] = value
+		return true
+	case "xmlns":
+		prefix, uri := parseNameValue(rest)
+		if prefix == "" || uri == "" {
+			b.addError(line, "@xmlns expects a prefix and a namespace URI")
+			return true
+		}
+		if b.request.metadata.XMLNS == nil {
+			b.request.metadata.XMLNS = make(map[string]string)
+		}
+		b.request.metadata.XMLNS[prefix] = uri
+		return true
+	case "remove-header":
+		names := strings.FieldsFunc(rest, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		if len(names) == 0 {
+			b.addError(line, "@remove-header expects a header name")
+			return true
+		}
+		b.request.metadata.RemoveHeaders = append(b.request.metadata.RemoveHeaders, names...)
+		return true
 	case "script":
 		if rest != "" {
 			kind, lang := parseScriptSpec(rest)
@@ -293,11 +367,12 @@ func (b *documentBuilder) handleRequestMetadataDirective(line int, key, rest str
 		}
 		return true
 	case "assert":
-		if spec, ok := b.parseAssertDirective(rest, line); ok {
-			b.request.metadata.Asserts = append(b.request.metadata.Asserts, spec)
-		} else {
-			b.addError(line, "@assert expression missing")
+		spec, err := b.parseAssertDirective(rest, line)
+		if err != nil {
+			b.addError(line, err.Error())
+			return true
 		}
+		b.request.metadata.Asserts = append(b.request.metadata.Asserts, spec)
 		return true
 	case "when", "skip-if":
 		negate := key == "skip-if"
@@ -334,6 +409,22 @@ func (b *documentBuilder) handleRequestMetadataDirective(line int, key, rest str
 			b.request.metadata.Trace = spec
 		}
 		return true
+	case "retry":
+		spec, err := parseRetrySpec(rest)
+		if err != nil {
+			b.addError(line, err.Error())
+			return true
+		}
+		b.request.metadata.Retry = spec
+		return true
+	case "webhook":
+		spec, err := parseWebhookSpec(rest)
+		if err != nil {
+			b.addError(line, err.Error())
+			return true
+		}
+		b.request.metadata.Webhook = spec
+		return true
 	case "compare":
 		if b.request.metadata.Compare != nil {
 			b.addError(line, "@compare directive already defined for this request")
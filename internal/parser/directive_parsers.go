@@ -3,13 +3,18 @@ package parser
 import (
 	"fmt"
 	"maps"
+	"net"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/antchfx/xpath"
+
 	"github.com/unkn0wn-root/resterm/internal/duration"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
 	"github.com/unkn0wn-root/resterm/internal/tracebudget"
+	"github.com/unkn0wn-root/resterm/internal/util"
 	"github.com/unkn0wn-root/resterm/internal/vars"
 )
 
@@ -251,6 +256,12 @@ func parseAuthSpec(rest string) *restfile.AuthSpec {
 			params["name"] = fields[2]
 			params["value"] = strings.Join(fields[3:], " ")
 		}
+	case "bearer-exec":
+		if len(fields) < 2 || fields[1] == "" {
+			return nil
+		}
+		params["command"] = fields[1]
+		maps.Copy(params, parseKeyValuePairs(fields[2:]))
 	case "oauth2":
 		if len(fields) < 2 {
 			return nil
@@ -325,6 +336,445 @@ func parseProfileSpec(rest string) *restfile.ProfileSpec {
 	return spec
 }
 
+func parseRetrySpec(rest string) (*restfile.RetrySpec, error) {
+	spec := &restfile.RetrySpec{Count: 3, Delay: time.Second, RespectRetryAfter: true}
+	fields := splitAuthFields(strings.TrimSpace(rest))
+	for _, field := range fields {
+		value := strings.TrimSpace(field)
+		if value == "" {
+			continue
+		}
+		idx := strings.Index(value, "=")
+		if idx == -1 {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("@retry invalid count %q", value)
+			}
+			spec.Count = n
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(value[:idx]))
+		val := strings.TrimSpace(value[idx+1:])
+		switch key {
+		case "count", "times", "max":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("@retry invalid count %q", val)
+			}
+			spec.Count = n
+		case "delay", "backoff":
+			dur, ok := duration.Parse(val)
+			if !ok || dur < 0 {
+				return nil, fmt.Errorf("@retry invalid delay %q", val)
+			}
+			spec.Delay = dur
+		case "on", "status":
+			codes, err := parseRetryCodes(val)
+			if err != nil {
+				return nil, err
+			}
+			spec.On = codes
+		case "respect-retry-after", "retry-after":
+			b, ok := parseBool(val)
+			if !ok {
+				return nil, fmt.Errorf("@retry invalid %s value %q", key, val)
+			}
+			spec.RespectRetryAfter = b
+		case "jitter":
+			b, ok := parseBool(val)
+			if !ok {
+				return nil, fmt.Errorf("@retry invalid jitter value %q", val)
+			}
+			spec.Jitter = b
+		default:
+			return nil, fmt.Errorf("@retry unknown option %q", key)
+		}
+	}
+	return spec, nil
+}
+
+func parseWebhookSpec(rest string) (*restfile.WebhookSpec, error) {
+	spec := &restfile.WebhookSpec{}
+	for _, field := range strings.Fields(rest) {
+		key, val, ok := strings.Cut(field, "=")
+		if !ok {
+			dur, ok := duration.Parse(field)
+			if !ok || dur <= 0 {
+				return nil, fmt.Errorf("@webhook invalid timeout %q", field)
+			}
+			spec.Timeout = dur
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "timeout":
+			dur, ok := duration.Parse(val)
+			if !ok || dur <= 0 {
+				return nil, fmt.Errorf("@webhook invalid timeout %q", val)
+			}
+			spec.Timeout = dur
+		case "listen", "addr":
+			if _, _, err := net.SplitHostPort(val); err != nil {
+				return nil, fmt.Errorf("@webhook invalid listen address %q", val)
+			}
+			spec.Listen = val
+		default:
+			return nil, fmt.Errorf("@webhook unknown option %q", key)
+		}
+	}
+	return spec, nil
+}
+
+func parseRetryCodes(raw string) ([]int, error) {
+	parts := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == '|' || r == ';'
+	})
+	codes := make([]int, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		code, err := strconv.Atoi(part)
+		if err != nil || code < 100 || code > 599 {
+			return nil, fmt.Errorf("@retry invalid status code %q", part)
+		}
+		codes = append(codes, code)
+	}
+	if len(codes) == 0 {
+		return nil, fmt.Errorf("@retry on= requires at least one status code")
+	}
+	return codes, nil
+}
+
+var jsonPathAssertOps = map[string]struct{}{
+	"==": {}, "!=": {}, "<": {}, "<=": {}, ">": {}, ">=": {},
+}
+
+var jsonPathAssertQuantifiers = map[string]struct{}{
+	"all": {}, "any": {}, "none": {},
+}
+
+// parseJSONPathAssert parses "<path> [all|any|none] [<op> <value>]"
+// following "@assert jsonpath". Without an operator the assertion checks
+// that the path exists. A quantifier applies the comparison to every value
+// matched by a [*] path and always needs an operator.
+func parseJSONPathAssert(rest string) (*restfile.JSONPathAssert, error) {
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return nil, fmt.Errorf("@assert jsonpath requires a path")
+	}
+	path, tail, _ := strings.Cut(rest, " ")
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("@assert jsonpath path must start with $: %q", path)
+	}
+	tail = strings.TrimSpace(tail)
+	op, value, _ := strings.Cut(tail, " ")
+	quant := ""
+	if _, ok := jsonPathAssertQuantifiers[strings.ToLower(op)]; ok {
+		quant = strings.ToLower(op)
+		op, value, _ = strings.Cut(strings.TrimSpace(value), " ")
+		if op == "" {
+			return nil, fmt.Errorf("@assert jsonpath %s requires an operator", quant)
+		}
+	} else if strings.Contains(path, "[*]") {
+		return nil, fmt.Errorf("@assert jsonpath %s needs all, any or none", path)
+	}
+	if tail == "" {
+		return &restfile.JSONPathAssert{Path: path}, nil
+	}
+	if _, ok := jsonPathAssertOps[op]; !ok {
+		return nil, fmt.Errorf("@assert jsonpath unknown operator %q", op)
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("@assert jsonpath %s requires a value", op)
+	}
+	if q := value[0]; (q == '"' || q == '\'') && (len(value) < 2 || value[len(value)-1] != q) {
+		return nil, fmt.Errorf("@assert jsonpath unterminated string %s", value)
+	}
+	return &restfile.JSONPathAssert{
+		Path:       path,
+		Quantifier: quant,
+		Op:         op,
+		Expected:   value,
+	}, nil
+}
+
+// cutStatusInAssert matches "status in <set>" (or "statusCode in") and
+// returns the set.
+func cutStatusInAssert(expr string) (string, bool) {
+	for _, kw := range []string{"status", "statusCode"} {
+		tail, ok := cutAssertKeyword(expr, kw)
+		if !ok {
+			continue
+		}
+		return cutAssertKeyword(tail, "in")
+	}
+	return "", false
+}
+
+// parseStatusAssert parses a comma-separated list of status codes and
+// inclusive ranges such as "200,201,204" or "200-299, 304".
+func parseStatusAssert(rest string) (*restfile.StatusAssert, error) {
+	parts := strings.Split(rest, ",")
+	spec := &restfile.StatusAssert{Ranges: make([]restfile.StatusRange, 0, len(parts))}
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(part, "-")
+		lo, err := parseAssertStatusCode(first)
+		if err != nil {
+			return nil, err
+		}
+		hi := lo
+		if isRange {
+			if hi, err = parseAssertStatusCode(last); err != nil {
+				return nil, err
+			}
+			if hi < lo {
+				return nil, fmt.Errorf("@assert status range %q is reversed", part)
+			}
+		}
+		spec.Ranges = append(spec.Ranges, restfile.StatusRange{Min: lo, Max: hi})
+	}
+	if len(spec.Ranges) == 0 {
+		return nil, fmt.Errorf("@assert status in requires at least one status code")
+	}
+	return spec, nil
+}
+
+func parseAssertStatusCode(raw string) (int, error) {
+	raw = strings.TrimSpace(raw)
+	code, err := strconv.Atoi(raw)
+	if err != nil || code < 100 || code > 599 {
+		return 0, fmt.Errorf("@assert invalid status code %q", raw)
+	}
+	return code, nil
+}
+
+// parseCookieAssert parses "<name> [== | != <value>]" following
+// "@assert cookie". Without an operator the cookie only has to be set. The
+// value may be quoted, so `!= ""` checks for a non-empty cookie.
+func parseCookieAssert(rest string) (*restfile.CookieAssert, error) {
+	name := rest
+	if i := strings.IndexAny(rest, " \t=!<>"); i >= 0 {
+		name = rest[:i]
+	}
+	if name == "" {
+		return nil, fmt.Errorf("@assert cookie requires a cookie name")
+	}
+	tail := strings.TrimSpace(rest[len(name):])
+	if tail == "" {
+		return &restfile.CookieAssert{Name: name}, nil
+	}
+	op, raw, err := cutAssertCompare("cookie "+name, tail)
+	if err != nil {
+		return nil, err
+	}
+	if op != "==" && op != "!=" {
+		return nil, fmt.Errorf("@assert cookie %s supports only == and !=", name)
+	}
+	value, err := unquoteAssertValue(raw)
+	if err != nil {
+		return nil, fmt.Errorf("@assert cookie %s %v", name, err)
+	}
+	return &restfile.CookieAssert{Name: name, Op: op, Expected: value}, nil
+}
+
+// parseXPathAssert parses "<expr> [<op> <value>]" following "@assert
+// xpath". The expression may contain spaces, so the comparison is the first
+// operator standing alone outside quotes, brackets and parentheses. The
+// expression is compiled here so syntax errors surface with the file.
+func parseXPathAssert(rest string) (*restfile.XPathAssert, error) {
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return nil, fmt.Errorf("@assert xpath requires an expression")
+	}
+	expr, op, value := splitXPathCompare(rest)
+	if _, err := xpath.Compile(expr); err != nil {
+		return nil, fmt.Errorf("@assert xpath invalid expression %q: %v", expr, err)
+	}
+	if op == "" {
+		return &restfile.XPathAssert{Expr: expr}, nil
+	}
+	if value == "" {
+		return nil, fmt.Errorf("@assert xpath %s requires a value", op)
+	}
+	if q := value[0]; (q == '"' || q == '\'') && (len(value) < 2 || value[len(value)-1] != q) {
+		return nil, fmt.Errorf("@assert xpath unterminated string %s", value)
+	}
+	return &restfile.XPathAssert{Expr: expr, Op: op, Expected: value}, nil
+}
+
+func splitXPathCompare(s string) (string, string, string) {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			continue
+		case c == '"' || c == '\'':
+			quote = c
+			continue
+		case c == '[' || c == '(':
+			depth++
+			continue
+		case c == ']' || c == ')':
+			depth--
+			continue
+		}
+		if depth != 0 || (c != ' ' && c != '\t') {
+			continue
+		}
+		field := strings.TrimLeft(s[i:], " \t")
+		op, _, _ := strings.Cut(field, " ")
+		if _, ok := jsonPathAssertOps[op]; !ok {
+			continue
+		}
+		value := strings.TrimSpace(field[len(op):])
+		return strings.TrimSpace(s[:i]), op, value
+	}
+	return s, "", ""
+}
+
+// unquoteAssertValue strips matching single or double quotes from raw;
+// double-quoted values accept Go escapes. Bare values are returned as is.
+func unquoteAssertValue(raw string) (string, error) {
+	q := raw[0]
+	if q != '"' && q != '\'' {
+		return raw, nil
+	}
+	if len(raw) < 2 || raw[len(raw)-1] != q {
+		return "", fmt.Errorf("unterminated string %s", raw)
+	}
+	if q == '"' {
+		if s, err := strconv.Unquote(raw); err == nil {
+			return s, nil
+		}
+	}
+	return raw[1 : len(raw)-1], nil
+}
+
+const (
+	assertHeaderCount  = "header-count"
+	assertBodySize     = "body-size"
+	assertResponseTime = "response-time"
+)
+
+// cutMetricAssert matches "header-count <op> <n>" or "body-size <op> <size>"
+// and returns the metric name and the comparison.
+func cutMetricAssert(expr string) (string, string, bool) {
+	for _, kw := range []string{assertHeaderCount, assertBodySize, assertResponseTime} {
+		if tail, ok := cutAssertKeyword(expr, kw); ok {
+			return kw, tail, true
+		}
+	}
+	return "", "", false
+}
+
+// parseMetricAssert parses "<op> <value>"; the space after the operator is
+// optional. body-size values accept size suffixes such as 10KB or 1.5MiB and
+// response-time values are durations such as 500ms.
+func parseMetricAssert(metric, rest string) (*restfile.MetricAssert, error) {
+	op, raw, err := cutAssertCompare(metric, rest)
+	if err != nil {
+		return nil, err
+	}
+	var value int64
+	switch metric {
+	case assertBodySize:
+		value, err = util.ParseByteSize(raw)
+	case assertResponseTime:
+		d, ok := duration.Parse(raw)
+		if !ok || d < 0 {
+			return nil, fmt.Errorf("@assert %s invalid duration %q", metric, raw)
+		}
+		value = int64(d)
+	default:
+		var n int
+		n, err = parsePositiveInt(raw)
+		value = int64(n)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("@assert %s invalid value %q: %v", metric, raw, err)
+	}
+	return &restfile.MetricAssert{Metric: metric, Op: op, Value: value}, nil
+}
+
+// cutAssertCompare splits "<op> <value>" for the named assertion; the space
+// after the operator is optional.
+func cutAssertCompare(name, rest string) (string, string, error) {
+	op := ""
+	for _, cand := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if strings.HasPrefix(rest, cand) {
+			op = cand
+			break
+		}
+	}
+	if op == "" {
+		return "", "", fmt.Errorf("@assert %s requires an operator (==, !=, <, <=, >, >=)", name)
+	}
+	raw := strings.TrimSpace(rest[len(op):])
+	if raw == "" {
+		return "", "", fmt.Errorf("@assert %s %s requires a value", name, op)
+	}
+	return op, raw, nil
+}
+
+var profileAssertStats = []string{"min", "max", "mean", "median", "stddev", "p50", "p90", "p95", "p99"}
+
+// cutProfileAssert matches "profile.<stat> <op> <duration>" and returns the
+// lower-cased stat name and the comparison.
+func cutProfileAssert(expr string) (string, string, bool) {
+	const prefix = "profile."
+	if len(expr) <= len(prefix) || !strings.EqualFold(expr[:len(prefix)], prefix) {
+		return "", "", false
+	}
+	tail := expr[len(prefix):]
+	end := strings.IndexAny(tail, " \t=!<>")
+	if end <= 0 {
+		return "", "", false
+	}
+	return strings.ToLower(tail[:end]), strings.TrimSpace(tail[end:]), true
+}
+
+// parseProfileAssert parses the comparison following "profile.<stat>". The
+// threshold is a Go duration such as 500ms or 1.5s.
+func parseProfileAssert(stat, rest string) (*restfile.ProfileAssert, error) {
+	name := "profile." + stat
+	if !slices.Contains(profileAssertStats, stat) {
+		return nil, fmt.Errorf(
+			"@assert %s is not a profile statistic (use %s)",
+			name,
+			strings.Join(profileAssertStats, ", "),
+		)
+	}
+	op, raw, err := cutAssertCompare(name, rest)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := duration.Parse(raw)
+	if !ok || value < 0 {
+		return nil, fmt.Errorf("@assert %s invalid duration %q", name, raw)
+	}
+	return &restfile.ProfileAssert{Stat: stat, Op: op, Value: value}, nil
+}
+
+// cutAssertKeyword reports whether expr starts with keyword followed by
+// whitespace and returns the remainder.
+func cutAssertKeyword(expr, keyword string) (string, bool) {
+	if len(expr) <= len(keyword) || !strings.EqualFold(expr[:len(keyword)], keyword) {
+		return "", false
+	}
+	if c := expr[len(keyword)]; c != ' ' && c != '\t' {
+		return "", false
+	}
+	return strings.TrimSpace(expr[len(keyword):]), true
+}
+
 func parseTraceSpec(rest string) *restfile.TraceSpec {
 	spec := &restfile.TraceSpec{Enabled: true}
 	trimmed := strings.TrimSpace(rest)
@@ -332,6 +782,9 @@ func parseTraceSpec(rest string) *restfile.TraceSpec {
 		return spec
 	}
 
+	var export restfile.TraceExport
+	exportOn := false
+
 	fields := splitAuthFields(trimmed)
 	for _, field := range fields {
 		value := strings.TrimSpace(field)
@@ -377,6 +830,16 @@ func parseTraceSpec(rest string) *restfile.TraceSpec {
 				if dur := parseDuration(val); dur > 0 {
 					spec.Budgets.Total = dur
 				}
+			case "export":
+				exportOn = strings.EqualFold(val, "otlp")
+			case "endpoint":
+				export.Endpoint = val
+			case "service":
+				export.Service = val
+			case "insecure":
+				if b, ok := parseBool(val); ok {
+					export.Insecure = b
+				}
 			case "tolerance", "allowance", "grace":
 				if dur := parseDuration(val); dur >= 0 {
 					spec.Budgets.Tolerance = dur
@@ -405,6 +868,9 @@ func parseTraceSpec(rest string) *restfile.TraceSpec {
 	if len(spec.Budgets.Phases) == 0 {
 		spec.Budgets.Phases = nil
 	}
+	if exportOn && export.Endpoint != "" {
+		spec.Export = &export
+	}
 	return spec
 }
 
@@ -27,6 +27,7 @@ type documentBuilder struct {
 	k8sDefs              []restfile.K8sProfile
 	patchDefs            []restfile.PatchProfile
 	fileUses             []restfile.UseSpec
+	group                string
 	inBlock              bool
 	workflow             *workflowBuilder
 	inScriptBlock        bool
@@ -160,9 +161,23 @@ func (b *documentBuilder) handleSeparator(lineNumber int, trimmed string) bool {
 	}
 	b.flushRequest(lineNumber - 1)
 	b.flushFileSettings()
+	if name, ok := groupSeparatorName(trimmed); ok {
+		b.group = name
+	}
 	return true
 }
 
+// groupSeparatorName matches "### Group: <name>". The group applies to
+// every request until the next group separator; an empty name ends it.
+func groupSeparatorName(trimmed string) (string, bool) {
+	rest := strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+	const prefix = "group:"
+	if len(rest) < len(prefix) || !strings.EqualFold(rest[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(rest[len(prefix):]), true
+}
+
 func (b *documentBuilder) handleCommentLine(lineNumber int, line, trimmed string) bool {
 	if commentText, ok := stripComment(trimmed); ok {
 		b.handleComment(lineNumber, commentText)
@@ -401,17 +416,57 @@ func captureExprMode(ex string) restfile.CaptureExprMode {
 	return restfile.CaptureExprModeRTS
 }
 
-func (b *documentBuilder) parseAssertDirective(rest string, line int) (restfile.AssertSpec, bool) {
+func (b *documentBuilder) parseAssertDirective(rest string, line int) (restfile.AssertSpec, error) {
 	expr, msg := splitAssert(rest)
 	expr = strings.TrimSpace(expr)
 	if expr == "" {
-		return restfile.AssertSpec{}, false
+		return restfile.AssertSpec{}, fmt.Errorf("@assert expression missing")
 	}
-	return restfile.AssertSpec{
+	spec := restfile.AssertSpec{
 		Expression: expr,
 		Message:    msg,
 		Line:       line,
-	}, true
+	}
+	// A leading "not" negates the built-in forms only; anything else is an
+	// RTS expression, where "not" is already the unary operator.
+	if tail, ok := cutAssertKeyword(expr, "not"); ok {
+		negated := spec
+		negated.Expression = tail
+		negated.Negate = true
+		matched, err := parseBuiltinAssert(&negated, tail)
+		if err != nil {
+			return restfile.AssertSpec{}, err
+		}
+		if matched {
+			return negated, nil
+		}
+	}
+	if _, err := parseBuiltinAssert(&spec, expr); err != nil {
+		return restfile.AssertSpec{}, err
+	}
+	return spec, nil
+}
+
+// parseBuiltinAssert fills spec when expr is one of the built-in assertion
+// forms and reports whether it was.
+func parseBuiltinAssert(spec *restfile.AssertSpec, expr string) (bool, error) {
+	var err error
+	if tail, ok := cutAssertKeyword(expr, "jsonpath"); ok {
+		spec.JSONPath, err = parseJSONPathAssert(tail)
+	} else if tail, ok := cutStatusInAssert(expr); ok {
+		spec.Status, err = parseStatusAssert(tail)
+	} else if tail, ok := cutAssertKeyword(expr, "cookie"); ok {
+		spec.Cookie, err = parseCookieAssert(tail)
+	} else if tail, ok := cutAssertKeyword(expr, "xpath"); ok {
+		spec.XPath, err = parseXPathAssert(tail)
+	} else if metric, tail, ok := cutMetricAssert(expr); ok {
+		spec.Metric, err = parseMetricAssert(metric, tail)
+	} else if stat, tail, ok := cutProfileAssert(expr); ok {
+		spec.Profile, err = parseProfileAssert(stat, tail)
+	} else {
+		return false, nil
+	}
+	return true, err
 }
 
 func (b *documentBuilder) handleScript(ln int, raw string) {
@@ -627,7 +682,7 @@ func (b *documentBuilder) ensureRequest(line int) {
 	b.inRequest = true
 	b.request = &requestBuilder{
 		startLine:         line,
-		metadata:          restfile.RequestMetadata{Tags: []string{}},
+		metadata:          restfile.RequestMetadata{Tags: []string{}, Group: b.group},
 		currentScriptKind: defaultScriptKind,
 		currentScriptLang: defaultScriptLang,
 		http:              httpbuilder.New(),
@@ -708,10 +763,7 @@ func (b *documentBuilder) handleFileSetting(rest string) {
 	if keyName == "" {
 		return
 	}
-	if b.fileSettings == nil {
-		b.fileSettings = make(map[string]string)
-	}
-	b.fileSettings[keyName] = value
+	b.fileSettings = setSetting(b.fileSettings, keyName, value)
 }
 
 func (b *documentBuilder) flushFileSettings() {
@@ -0,0 +1,189 @@
+package parser
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/parser/grpcbuilder"
+	"github.com/unkn0wn-root/resterm/internal/parser/httpbuilder"
+)
+
+type fmtPhase int
+
+const (
+	fmtOutside fmtPhase = iota
+	fmtHeaders
+	fmtBody
+)
+
+// Format canonicalizes a request file without changing what Parse produces:
+// directive comments get single spacing, HTTP header names are canonicalized
+// and sections are separated by exactly one blank line. Script blocks, block
+// comments and request bodies are copied verbatim, including their blank lines.
+func Format(src string) string {
+	newline := "\n"
+	if strings.Contains(src, "\r\n") {
+		newline = "\r\n"
+	}
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+	if n := len(lines); n > 0 && lines[n-1] == "" {
+		lines = lines[:n-1]
+	}
+
+	var (
+		out      = make([]string, 0, len(lines))
+		phase    = fmtOutside
+		grpc     bool
+		blanks   int
+		inBlock  bool
+		inScript bool
+	)
+	// Blank lines outside bodies carry no meaning, so runs collapse to one.
+	flushBlanks := func() {
+		if blanks > 0 && len(out) > 0 {
+			out = append(out, "")
+		}
+		blanks = 0
+	}
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inBlock:
+			out = append(out, line)
+			_, closed := parseBlockCommentLine(trimmed, false)
+			inBlock = !closed
+			continue
+		case inScript:
+			out = append(out, line)
+			inScript = !isSBEnd(trimmed)
+			continue
+		case isSBStart(trimmed):
+			flushBlanks()
+			out = append(out, line)
+			inScript = true
+			continue
+		case isBlockCommentStart(trimmed):
+			flushBlanks()
+			out = append(out, line)
+			_, closed := parseBlockCommentLine(trimmed, true)
+			inBlock = !closed
+			continue
+		case strings.HasPrefix(trimmed, "###"):
+			if len(out) > 0 && phase != fmtBody {
+				blanks = 1
+			}
+			flushBlanks()
+			out = append(out, line)
+			phase = fmtOutside
+			grpc = false
+			continue
+		}
+
+		if text, ok := stripComment(trimmed); ok {
+			flushBlanks()
+			out = append(out, formatDirectiveLine(line, trimmed, text))
+			continue
+		}
+		if trimmed == "" {
+			switch phase {
+			case fmtBody:
+				out = append(out, line)
+			case fmtHeaders:
+				out = append(out, "")
+				phase = fmtBody
+			default:
+				blanks++
+			}
+			continue
+		}
+		flushBlanks()
+
+		switch phase {
+		case fmtBody:
+			out = append(out, line)
+		case fmtHeaders:
+			if strings.HasPrefix(trimmed, ">") || variableLineRe.MatchString(trimmed) || grpc {
+				out = append(out, line)
+			} else {
+				out = append(out, formatHeaderLine(line))
+			}
+		default:
+			if strings.HasPrefix(trimmed, ">") || variableLineRe.MatchString(trimmed) {
+				out = append(out, line)
+				continue
+			}
+			if grpcbuilder.IsMethodLine(line) {
+				phase = fmtHeaders
+				grpc = true
+			} else if _, _, _, ok := httpbuilder.ParseMethodLine(line); ok {
+				phase = fmtHeaders
+			} else if _, ok := httpbuilder.ParseWebSocketURLLine(line); ok {
+				phase = fmtHeaders
+			}
+			out = append(out, line)
+		}
+	}
+	if phase == fmtBody {
+		for ; blanks > 0; blanks-- {
+			out = append(out, "")
+		}
+	}
+	if len(out) == 0 {
+		return ""
+	}
+	return strings.Join(out, newline) + newline
+}
+
+// formatDirectiveLine rewrites "#   @name   value" as "# @name value".
+// Plain comments and comment text that is not a directive are left alone.
+func formatDirectiveLine(line, trimmed, text string) string {
+	if !strings.HasPrefix(text, "@") || len(text) < 2 || text[1] == ' ' || text[1] == '\t' {
+		return line
+	}
+	prefix := "#"
+	switch {
+	case strings.HasPrefix(trimmed, "//"):
+		prefix = "//"
+	case strings.HasPrefix(trimmed, "--"):
+		prefix = "--"
+	}
+	fields := strings.Fields(text)
+	key := fields[0]
+	rest := strings.TrimSpace(text[len(key):])
+	if rest == "" {
+		return prefix + " " + key
+	}
+	return prefix + " " + key + " " + rest
+}
+
+func formatHeaderLine(line string) string {
+	idx := strings.Index(line, ":")
+	if idx <= 0 {
+		return line
+	}
+	name := strings.TrimSpace(line[:idx])
+	if !isHeaderToken(name) {
+		return line
+	}
+	value := strings.TrimSpace(line[idx+1:])
+	if value == "" {
+		return http.CanonicalHeaderKey(name) + ":"
+	}
+	return http.CanonicalHeaderKey(name) + ": " + value
+}
+
+func isHeaderToken(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestFormatCanonicalizesLayout(t *testing.T) {
+	src := "@host = example.com\n" +
+		"\n" +
+		"\n" +
+		"### First\n" +
+		"#   @name    First\n" +
+		"GET https://{{host}}\n" +
+		"content-type: application/json\n" +
+		"x-trace-id:abc\n" +
+		"### Second\n" +
+		"//@setting   timeout 5s\n" +
+		"# plain   comment\n" +
+		"POST https://example.com\n" +
+		"\n" +
+		"{\"a\": 1}\n" +
+		"\n" +
+		"\n" +
+		"### Third\n" +
+		"> {%\n" +
+		"   client.test(\"x\",   function() {})\n" +
+		"%}\n" +
+		"GET https://example.com\n"
+
+	want := "@host = example.com\n" +
+		"\n" +
+		"### First\n" +
+		"# @name First\n" +
+		"GET https://{{host}}\n" +
+		"Content-Type: application/json\n" +
+		"X-Trace-Id: abc\n" +
+		"\n" +
+		"### Second\n" +
+		"// @setting timeout 5s\n" +
+		"# plain   comment\n" +
+		"POST https://example.com\n" +
+		"\n" +
+		"{\"a\": 1}\n" +
+		"\n" +
+		"\n" +
+		"### Third\n" +
+		"> {%\n" +
+		"   client.test(\"x\",   function() {})\n" +
+		"%}\n" +
+		"GET https://example.com\n"
+
+	got := Format(src)
+	if got != want {
+		t.Fatalf("unexpected format output:\n%s\nwant:\n%s", got, want)
+	}
+	if again := Format(got); again != got {
+		t.Fatalf("expected format to be idempotent, got:\n%s", again)
+	}
+}
+
+func TestFormatKeepsGRPCMetadataCase(t *testing.T) {
+	src := "GRPC localhost:50051\n# @grpc svc.Greeter/Hello\nx-custom-md: v\n"
+	if got := Format(src); got != src {
+		t.Fatalf("expected grpc request untouched, got:\n%s", got)
+	}
+}
+
+func TestFormatPreservesExampleSemantics(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("..", "..", "_examples", "*.http"))
+	if err != nil {
+		t.Fatalf("glob examples: %v", err)
+	}
+	if len(paths) == 0 {
+		t.Skip("no example files")
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read %s: %v", path, err)
+		}
+		formatted := Format(string(data))
+		before := Parse(path, data)
+		after := Parse(path, []byte(formatted))
+		if len(before.Requests) != len(after.Requests) {
+			t.Fatalf("%s: request count changed %d -> %d",
+				path, len(before.Requests), len(after.Requests))
+		}
+		for i := range before.Requests {
+			if a, b := formatFingerprint(before.Requests[i]),
+				formatFingerprint(after.Requests[i]); !reflect.DeepEqual(a, b) {
+				t.Fatalf("%s: request %d changed:\n%+v\n%+v", path, i, a, b)
+			}
+		}
+		if len(before.Errors) != len(after.Errors) {
+			t.Fatalf("%s: parse errors changed: %v -> %v", path, before.Errors, after.Errors)
+		}
+	}
+}
+
+type formatPrint struct {
+	Method   string
+	URL      string
+	Headers  map[string][]string
+	Body     string
+	BodyFile string
+	Settings map[string]string
+	Name     string
+	Tags     []string
+	Scripts  []restfile.ScriptBlock
+	Asserts  []string
+	Captures []string
+	Vars     []string
+}
+
+func formatFingerprint(req *restfile.Request) formatPrint {
+	fp := formatPrint{
+		Method:   req.Method,
+		URL:      req.URL,
+		Headers:  req.Headers,
+		Body:     req.Body.Text,
+		BodyFile: req.Body.FilePath,
+		Settings: req.Settings,
+		Name:     req.Metadata.Name,
+		Tags:     req.Metadata.Tags,
+		Scripts:  req.Metadata.Scripts,
+	}
+	for _, a := range req.Metadata.Asserts {
+		fp.Asserts = append(fp.Asserts, a.Expression+"|"+a.Message)
+	}
+	for _, c := range req.Metadata.Captures {
+		fp.Captures = append(fp.Captures, c.Name+"="+c.Expression)
+	}
+	for _, v := range req.Variables {
+		fp.Vars = append(fp.Vars, v.Name+"="+v.Value)
+	}
+	return fp
+}
@@ -1,6 +1,7 @@
 package grpcbuilder
 
 import (
+	"encoding/json"
 	"strings"
 
 	"github.com/unkn0wn-root/resterm/internal/restfile"
@@ -10,8 +11,16 @@ type Builder struct {
 	request         *restfile.GRPCRequest
 	messageLines    []string
 	messageFromFile string
+	health          bool
+	healthService   string
 }
 
+const (
+	healthPackage = "grpc.health.v1"
+	healthService = "Health"
+	healthMethod  = "Check"
+)
+
 func New() *Builder {
 	return &Builder{}
 }
@@ -66,7 +75,12 @@ func (b *Builder) HandleDirective(key, rest string) bool {
 		return true
 	case "grpc-reflection":
 		req := b.EnsureRequest()
-		if rest == "" {
+		req.ReflectionExpr = ""
+		if strings.Contains(rest, "{{") {
+			// Resolved per environment at send time.
+			req.ReflectionExpr = rest
+			req.UseReflection = true
+		} else if rest == "" {
 			req.UseReflection = true
 		} else if strings.EqualFold(rest, "false") || strings.EqualFold(rest, "0") {
 			req.UseReflection = false
@@ -74,6 +88,11 @@ func (b *Builder) HandleDirective(key, rest string) bool {
 			req.UseReflection = true
 		}
 		return true
+	case "grpc-reflection-target":
+		req := b.EnsureRequest()
+		req.ReflectionTarget, req.ReflectionPlaintext, req.ReflectionPlaintextSet =
+			parseReflectionTarget(rest)
+		return true
 	case "grpc-plaintext":
 		req := b.EnsureRequest()
 		req.PlaintextSet = true
@@ -90,6 +109,12 @@ func (b *Builder) HandleDirective(key, rest string) bool {
 	case "grpc-authority":
 		b.EnsureRequest().Authority = rest
 		return true
+	case "grpc-ca":
+		b.EnsureRequest().RootCA = rest
+		return true
+	case "grpc-server-name":
+		b.EnsureRequest().ServerName = rest
+		return true
 	case "grpc-metadata":
 		req := b.EnsureRequest()
 		if rest != "" {
@@ -105,6 +130,21 @@ func (b *Builder) HandleDirective(key, rest string) bool {
 			}
 		}
 		return true
+	case "grpc-health":
+		req := b.EnsureRequest()
+		req.Package = healthPackage
+		req.Service = healthService
+		req.Method = healthMethod
+		req.FullMethod = "/" + healthPackage + "." + healthService + "/" + healthMethod
+		b.health = true
+		b.healthService = parseHealthService(rest)
+		return true
+	case "grpc-metadata-file":
+		req := b.EnsureRequest()
+		if path := strings.TrimSpace(rest); path != "" {
+			req.MetadataFiles = append(req.MetadataFiles, path)
+		}
+		return true
 	}
 	return false
 }
@@ -150,11 +190,17 @@ func (b *Builder) Finalize(
 		copy(meta, grpcCopy.Metadata)
 		grpcCopy.Metadata = meta
 	}
+	if len(grpcCopy.MetadataFiles) > 0 {
+		grpcCopy.MetadataFiles = append([]string(nil), grpcCopy.MetadataFiles...)
+	}
 	if b.messageFromFile != "" {
 		grpcCopy.MessageFile = b.messageFromFile
 		grpcCopy.Message = ""
 	} else if len(b.messageLines) > 0 {
 		grpcCopy.Message = strings.Join(b.messageLines, "\n")
+	} else if b.health && b.healthService != "" {
+		svc, _ := json.Marshal(b.healthService)
+		grpcCopy.Message = `{"service": ` + string(svc) + `}`
 	}
 
 	body := restfile.BodySource{}
@@ -166,6 +212,39 @@ func (b *Builder) Finalize(
 	return &grpcCopy, body, existingMime, true
 }
 
+// parseHealthService reads "service=name" (or a bare name) from
+// @grpc-health. Empty means the server's overall health.
+func parseHealthService(rest string) string {
+	for _, field := range strings.Fields(rest) {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			if strings.EqualFold(key, "service") {
+				return strings.Trim(value, `"'`)
+			}
+			continue
+		}
+		return strings.Trim(field, `"'`)
+	}
+	return ""
+}
+
+// parseReflectionTarget reads "host:port [plaintext=bool]" from
+// @grpc-reflection-target. Without plaintext= the call's setting is shared.
+func parseReflectionTarget(rest string) (target string, plaintext bool, set bool) {
+	for _, field := range strings.Fields(rest) {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			if strings.EqualFold(key, "plaintext") {
+				set = true
+				plaintext = !strings.EqualFold(value, "false") && value != "0"
+			}
+			continue
+		}
+		if target == "" {
+			target = field
+		}
+	}
+	return target, plaintext, set
+}
+
 func parseMethod(spec string) (pkg string, service string, method string) {
 	working := strings.TrimSpace(spec)
 	if working == "" {
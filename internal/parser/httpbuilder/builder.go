@@ -12,8 +12,12 @@ var methodRe = regexp.MustCompile(
 	`^(?i)(GET|POST|PUT|PATCH|DELETE|HEAD|OPTIONS|TRACE|CONNECT|WS|WSS)\b`,
 )
 
+// methodTemplateRe matches a templated method such as {{method}} followed by
+// the URL. The method is kept verbatim and expanded when the request is sent.
+var methodTemplateRe = regexp.MustCompile(`^\{\{[^{}]+\}\}\s`)
+
 func IsMethodLine(line string) bool {
-	return methodRe.MatchString(line)
+	return methodRe.MatchString(line) || methodTemplateRe.MatchString(line)
 }
 
 func ParseMethodLine(line string) (method string, url string, ver httpver.Version, ok bool) {
@@ -21,17 +25,23 @@ func ParseMethodLine(line string) (method string, url string, ver httpver.Versio
 		return "", "", httpver.Unknown, false
 	}
 
-	fields := strings.Fields(line)
-	if len(fields) < 2 {
-		return "", "", httpver.Unknown, false
+	var rest []string
+	if tpl := methodTemplateRe.FindString(line); tpl != "" {
+		method = strings.TrimSpace(tpl)
+		rest = strings.Fields(line[len(tpl):])
+	} else {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return "", "", httpver.Unknown, false
+		}
+		method = strings.ToUpper(fields[0])
+		if method == "WS" || method == "WSS" {
+			method = http.MethodGet
+		}
+		rest = fields[1:]
 	}
 
-	method = strings.ToUpper(fields[0])
-	if method == "WS" || method == "WSS" {
-		method = http.MethodGet
-	}
-
-	urlFields, ver := httpver.SplitToken(fields[1:])
+	urlFields, ver := httpver.SplitToken(rest)
 	if len(urlFields) == 0 {
 		return "", "", httpver.Unknown, false
 	}
@@ -70,7 +80,10 @@ func (b *Builder) HasMethod() bool {
 }
 
 func (b *Builder) SetMethodAndURL(method, url string) {
-	m := strings.ToUpper(strings.TrimSpace(method))
+	m := strings.TrimSpace(method)
+	if !strings.HasPrefix(m, "{{") {
+		m = strings.ToUpper(m)
+	}
 	if m == "WS" || m == "WSS" {
 		m = http.MethodGet
 	}
@@ -58,19 +58,7 @@ func (n *object) write(buf *strings.Builder, indent int) {
 		buf.WriteString("{}")
 		return
 	}
-	props := n.props
-	if len(props) > 1 {
-		sorted := make([]prop, len(props))
-		copy(sorted, props)
-		sort.SliceStable(sorted, func(i, j int) bool {
-			pi, pj := sorted[i], sorted[j]
-			if pi.key.name == pj.key.name {
-				return pi.key.kind < pj.key.kind
-			}
-			return pi.key.name < pj.key.name
-		})
-		props = sorted
-	}
+	props := n.sortedProps()
 	buf.WriteString("{\n")
 	for i, prop := range props {
 		writeIndent(buf, indent+1)
@@ -86,6 +74,23 @@ func (n *object) write(buf *strings.Builder, indent int) {
 	buf.WriteString("}")
 }
 
+func (n *object) sortedProps() []prop {
+	props := n.props
+	if len(props) > 1 {
+		sorted := make([]prop, len(props))
+		copy(sorted, props)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			pi, pj := sorted[i], sorted[j]
+			if pi.key.name == pj.key.name {
+				return pi.key.kind < pj.key.kind
+			}
+			return pi.key.name < pj.key.name
+		})
+		props = sorted
+	}
+	return props
+}
+
 func (k key) write(buf *strings.Builder) {
 	switch k.kind {
 	case keyIdentifier, keyNumber:
@@ -208,6 +213,161 @@ func FormatValue(src string) (string, error) {
 	return buf.String(), nil
 }
 
+// FormatValueWithPaths formats src exactly like FormatValue and also maps
+// each JSON path ($.a.b[0], $["odd key"]) to the zero-based output line
+// where that member starts.
+func FormatValueWithPaths(src string) (string, map[string]int, error) {
+	node, err := parseRelaxed(strings.TrimSpace(src))
+	if err != nil {
+		return "", nil, err
+	}
+	w := &pathWriter{lines: map[string]int{"$": 0}}
+	w.write(node, "$", 0)
+	return w.buf.String(), w.lines, nil
+}
+
+// FormatValueDepth formats src like FormatValue but folds objects and arrays
+// nested deeper than maxDepth levels into {…N keys} / […N items] summaries.
+// A maxDepth of zero or less disables folding.
+func FormatValueDepth(src string, maxDepth int) (string, error) {
+	node, err := parseRelaxed(strings.TrimSpace(src))
+	if err != nil {
+		return "", err
+	}
+	var buf strings.Builder
+	writeDepth(&buf, node, 0, maxDepth)
+	return buf.String(), nil
+}
+
+// writeDepth mirrors object.write and array.write, folding containers once
+// indent reaches maxDepth.
+func writeDepth(buf *strings.Builder, n node, indent, maxDepth int) {
+	fold := maxDepth > 0 && indent >= maxDepth
+	switch v := n.(type) {
+	case *object:
+		if len(v.props) == 0 {
+			v.write(buf, indent)
+			return
+		}
+		if fold {
+			buf.WriteString("{…" + countLabel(len(v.props), "key") + "}")
+			return
+		}
+		props := v.sortedProps()
+		buf.WriteString("{\n")
+		for i, prop := range props {
+			writeIndent(buf, indent+1)
+			prop.key.write(buf)
+			buf.WriteString(": ")
+			writeDepth(buf, prop.val, indent+1, maxDepth)
+			if i < len(props)-1 {
+				buf.WriteString(",")
+			}
+			buf.WriteByte('\n')
+		}
+		writeIndent(buf, indent)
+		buf.WriteString("}")
+	case *array:
+		if len(v.items) == 0 {
+			v.write(buf, indent)
+			return
+		}
+		if fold {
+			buf.WriteString("[…" + countLabel(len(v.items), "item") + "]")
+			return
+		}
+		buf.WriteString("[\n")
+		for i, item := range v.items {
+			writeIndent(buf, indent+1)
+			writeDepth(buf, item, indent+1, maxDepth)
+			if i < len(v.items)-1 {
+				buf.WriteString(",")
+			}
+			buf.WriteByte('\n')
+		}
+		writeIndent(buf, indent)
+		buf.WriteString("]")
+	default:
+		n.write(buf, indent)
+	}
+}
+
+func countLabel(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return strconv.Itoa(n) + " " + noun + "s"
+}
+
+// PathSegment renders an object key as a JSON path segment.
+func PathSegment(name string) string {
+	if isJSIdentifier(name) {
+		return "." + name
+	}
+	return "[" + strconv.Quote(name) + "]"
+}
+
+type pathWriter struct {
+	buf   strings.Builder
+	lines map[string]int
+	line  int
+	seen  int
+}
+
+func (w *pathWriter) currentLine() int {
+	s := w.buf.String()
+	w.line += strings.Count(s[w.seen:], "\n")
+	w.seen = len(s)
+	return w.line
+}
+
+// write mirrors object.write and array.write while recording line numbers.
+func (w *pathWriter) write(n node, path string, indent int) {
+	switch v := n.(type) {
+	case *object:
+		if len(v.props) == 0 {
+			v.write(&w.buf, indent)
+			return
+		}
+		props := v.sortedProps()
+		w.buf.WriteString("{\n")
+		for i, prop := range props {
+			writeIndent(&w.buf, indent+1)
+			child := path + PathSegment(prop.key.name)
+			w.lines[child] = w.currentLine()
+			prop.key.write(&w.buf)
+			w.buf.WriteString(": ")
+			w.write(prop.val, child, indent+1)
+			if i < len(props)-1 {
+				w.buf.WriteString(",")
+			}
+			w.buf.WriteByte('\n')
+		}
+		writeIndent(&w.buf, indent)
+		w.buf.WriteString("}")
+	case *array:
+		if len(v.items) == 0 {
+			v.write(&w.buf, indent)
+			return
+		}
+		w.buf.WriteString("[\n")
+		for i, item := range v.items {
+			writeIndent(&w.buf, indent+1)
+			child := path + "[" + strconv.Itoa(i) + "]"
+			w.lines[child] = w.currentLine()
+			w.write(item, child, indent+1)
+			if i < len(v.items)-1 {
+				w.buf.WriteString(",")
+			}
+			w.buf.WriteByte('\n')
+		}
+		writeIndent(&w.buf, indent)
+		w.buf.WriteString("]")
+	default:
+		n.write(&w.buf, indent)
+	}
+}
+
 func FormatInlineValue(src string, indent int) (string, bool) {
 	trimmed := strings.TrimSpace(src)
 	if !looksStructured(trimmed) {
@@ -702,7 +862,9 @@ func (l *lexer) scanNumber() token {
 		buf.WriteRune(l.next())
 	}
 
+	leadingZero := false
 	if l.peek() == '0' {
+		leadingZero = true
 		buf.WriteRune(l.next())
 		if p := l.peek(); p == 'x' || p == 'X' {
 			buf.WriteRune(l.next())
@@ -745,7 +907,7 @@ func (l *lexer) scanNumber() token {
 	if err != nil {
 		return token{typ: tokenError, pos: start, text: err.Error()}
 	}
-	if digits == 0 && l.peek() != '.' {
+	if digits == 0 && !leadingZero && l.peek() != '.' {
 		return token{typ: tokenError, pos: start, text: "invalid number"}
 	}
 
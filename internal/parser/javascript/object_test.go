@@ -107,3 +107,101 @@ func TestFormatValueAllowsNumericSeparators(t *testing.T) {
 		t.Fatalf("unexpected formatted output\nwant:\n%s\n\ngot:\n%s", want, got)
 	}
 }
+
+func TestFormatValueAcceptsZero(t *testing.T) {
+	got, err := FormatValue(`{"a":0,"b":[0,-0,0.5]}`)
+	if err != nil {
+		t.Fatalf("FormatValue returned error: %v", err)
+	}
+
+	want := `{
+  a: 0,
+  b: [
+    0,
+    -0,
+    0.5
+  ]
+}`
+
+	if got != want {
+		t.Fatalf("unexpected formatted output\nwant:\n%s\n\ngot:\n%s", want, got)
+	}
+}
+
+func TestScanNumberLeadingZero(t *testing.T) {
+	for _, src := range []string{"0", "-0", "+0", "0.5", "0e3", "0x1f", "0b10", "0o7"} {
+		tok := newLexer(src).nextToken()
+		if tok.typ != tokenNumber || tok.text != src {
+			t.Fatalf("scan %q: got %v %q", src, tok.typ, tok.text)
+		}
+	}
+	for _, src := range []string{"-", "0x", "0b", "0o", "0e"} {
+		if tok := newLexer(src).nextToken(); tok.typ != tokenError {
+			t.Fatalf("scan %q: expected error, got %v %q", src, tok.typ, tok.text)
+		}
+	}
+}
+
+func TestFormatValueWithPathsMapsLines(t *testing.T) {
+	input := `{"user":{"name":"ada","tags":["a","b"]},"odd key":1,"empty":{}}`
+
+	got, lines, err := FormatValueWithPaths(input)
+	if err != nil {
+		t.Fatalf("FormatValueWithPaths returned error: %v", err)
+	}
+	plain, err := FormatValue(input)
+	if err != nil {
+		t.Fatalf("FormatValue returned error: %v", err)
+	}
+	if got != plain {
+		t.Fatalf("expected identical output\nwant:\n%s\n\ngot:\n%s", plain, got)
+	}
+
+	want := map[string]int{
+		"$":              0,
+		"$.empty":        1,
+		`$["odd key"]`:   2,
+		"$.user":         3,
+		"$.user.name":    4,
+		"$.user.tags":    5,
+		"$.user.tags[0]": 6,
+		"$.user.tags[1]": 7,
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d paths, got %v", len(want), lines)
+	}
+	for path, line := range want {
+		if lines[path] != line {
+			t.Fatalf("expected %s on line %d, got %d (%v)", path, line, lines[path], lines)
+		}
+	}
+}
+
+func TestFormatValueDepthFoldsDeepContainers(t *testing.T) {
+	input := `{"a": {"b": {"c": 1, "d": 2}, "e": [1]}, "f": [], "g": 1}`
+
+	got, err := FormatValueDepth(input, 2)
+	if err != nil {
+		t.Fatalf("FormatValueDepth returned error: %v", err)
+	}
+	want := `{
+  a: {
+    b: {…2 keys},
+    e: […1 item]
+  },
+  f: [],
+  g: 1
+}`
+	if got != want {
+		t.Fatalf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+
+	full, err := FormatValueDepth(input, 0)
+	if err != nil {
+		t.Fatalf("FormatValueDepth returned error: %v", err)
+	}
+	plain, _ := FormatValue(input)
+	if full != plain {
+		t.Fatalf("expected depth 0 to match FormatValue, got:\n%s", full)
+	}
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -106,6 +107,26 @@ GET http://127.0.0.1:5001/games/1 HTTP/1.1
 	}
 }
 
+func TestParseTemplatedMethodLine(t *testing.T) {
+	src := `{{ method }} {{base}}/resource HTTP/1.1
+X-Test: 1
+
+{"ok": true}
+`
+
+	doc := Parse("method.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	req := doc.Requests[0]
+	if req.Method != "{{ method }}" || req.URL != "{{base}}/resource" {
+		t.Fatalf("unexpected method line %q %q", req.Method, req.URL)
+	}
+	if req.Headers.Get("X-Test") != "1" || req.Settings["http-version"] != "1.1" {
+		t.Fatalf("unexpected headers %v or settings %v", req.Headers, req.Settings)
+	}
+}
+
 func TestHTTPVersionSettingOverridesRequestLine(t *testing.T) {
 	src := `GET https://example.com HTTP/1.1
 # @setting http-version 2
@@ -152,6 +173,338 @@ GET https://example.com/api
 	}
 }
 
+func TestParseAssertJSONPathDirective(t *testing.T) {
+	src := `# @assert jsonpath $.count == 5
+# @assert jsonpath $.items[0].name != "bob" => "name differs"
+# @assert JSONPath $.id
+# @assert jsonpath count == 5
+# @assert jsonpath $.count ~ 5
+GET https://example.com/api
+`
+	doc := Parse("assert.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	asserts := doc.Requests[0].Metadata.Asserts
+	if len(asserts) != 3 {
+		t.Fatalf("expected 3 asserts, got %d", len(asserts))
+	}
+	first := asserts[0].JSONPath
+	if first == nil || first.Path != "$.count" || first.Op != "==" || first.Expected != "5" {
+		t.Fatalf("unexpected first jsonpath assert: %+v", first)
+	}
+	second := asserts[1]
+	if second.JSONPath == nil || second.JSONPath.Expected != `"bob"` {
+		t.Fatalf("unexpected second jsonpath assert: %+v", second.JSONPath)
+	}
+	if second.Message != "name differs" {
+		t.Fatalf("unexpected assert message: %q", second.Message)
+	}
+	if third := asserts[2].JSONPath; third == nil || third.Path != "$.id" || third.Op != "" {
+		t.Fatalf("expected existence assert, got %+v", third)
+	}
+	if !hasParseMessage(doc.Errors, "path must start with $") {
+		t.Fatalf("expected path error, got %+v", doc.Errors)
+	}
+	if !hasParseMessage(doc.Errors, `unknown operator "~"`) {
+		t.Fatalf("expected operator error, got %+v", doc.Errors)
+	}
+}
+
+func TestParseAssertJSONPathQuantifier(t *testing.T) {
+	src := `# @assert jsonpath $.items[*].status all == "active"
+# @assert jsonpath $.items[*].status ANY == pending
+# @assert jsonpath $.items[*].status none
+# @assert jsonpath $.items[*].status == "active"
+GET https://example.com/api
+`
+	doc := Parse("assert.http", []byte(src))
+	asserts := doc.Requests[0].Metadata.Asserts
+	if len(asserts) != 2 {
+		t.Fatalf("expected 2 asserts, got %d", len(asserts))
+	}
+	first := asserts[0].JSONPath
+	if first.Quantifier != "all" || first.Op != "==" || first.Expected != `"active"` {
+		t.Fatalf("unexpected quantified assert: %+v", first)
+	}
+	if second := asserts[1].JSONPath; second.Quantifier != "any" || second.Expected != "pending" {
+		t.Fatalf("unexpected any assert: %+v", second)
+	}
+	if !hasParseMessage(doc.Errors, "none requires an operator") {
+		t.Fatalf("expected missing operator error, got %+v", doc.Errors)
+	}
+	if !hasParseMessage(doc.Errors, "needs all, any or none") {
+		t.Fatalf("expected quantifier error, got %+v", doc.Errors)
+	}
+}
+
+func TestParseRequestGroups(t *testing.T) {
+	src := `GET https://example.com/health
+
+### Group: Auth
+POST https://example.com/login
+
+###
+POST https://example.com/refresh
+
+### group:   Users
+GET https://example.com/users
+
+### Group:
+GET https://example.com/version
+`
+	doc := Parse("groups.http", []byte(src))
+	if len(doc.Requests) != 5 {
+		t.Fatalf("expected 5 requests, got %d", len(doc.Requests))
+	}
+	want := []string{"", "Auth", "Auth", "Users", ""}
+	for i, w := range want {
+		if got := doc.Requests[i].Metadata.Group; got != w {
+			t.Fatalf("request %d: expected group %q, got %q", i, w, got)
+		}
+	}
+}
+
+func TestParseAssertStatusInDirective(t *testing.T) {
+	src := `# @assert status in 200,201, 204
+# @assert statusCode in 200-299,304 => "not ok"
+# @assert status == 200
+# @assert status in 299-200
+# @assert status in 2xx
+GET https://example.com/api
+`
+	doc := Parse("assert.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	asserts := doc.Requests[0].Metadata.Asserts
+	if len(asserts) != 3 {
+		t.Fatalf("expected 3 asserts, got %d", len(asserts))
+	}
+	first := asserts[0].Status
+	want := []restfile.StatusRange{{Min: 200, Max: 200}, {Min: 201, Max: 201}, {Min: 204, Max: 204}}
+	if first == nil || !reflect.DeepEqual(first.Ranges, want) {
+		t.Fatalf("unexpected status set: %+v", first)
+	}
+	second := asserts[1]
+	want = []restfile.StatusRange{{Min: 200, Max: 299}, {Min: 304, Max: 304}}
+	if second.Status == nil || !reflect.DeepEqual(second.Status.Ranges, want) {
+		t.Fatalf("unexpected status ranges: %+v", second.Status)
+	}
+	if second.Message != "not ok" {
+		t.Fatalf("unexpected assert message: %q", second.Message)
+	}
+	if asserts[2].Status != nil {
+		t.Fatalf("expected plain expression assert, got %+v", asserts[2].Status)
+	}
+	if !hasParseMessage(doc.Errors, `range "299-200" is reversed`) {
+		t.Fatalf("expected reversed range error, got %+v", doc.Errors)
+	}
+	if !hasParseMessage(doc.Errors, `invalid status code "2xx"`) {
+		t.Fatalf("expected invalid code error, got %+v", doc.Errors)
+	}
+}
+
+func TestParseAssertNotPrefix(t *testing.T) {
+	src := `# @assert not status in 500-599
+# @assert NOT jsonpath $.error
+# @assert not contains(response.text(), "error") => "no errors"
+# @assert nothing == 1
+GET https://example.com/api
+`
+	doc := Parse("assert.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	asserts := doc.Requests[0].Metadata.Asserts
+	if len(asserts) != 4 {
+		t.Fatalf("expected 4 asserts, got %d", len(asserts))
+	}
+	if !asserts[0].Negate || asserts[0].Status == nil ||
+		asserts[0].Expression != "status in 500-599" {
+		t.Fatalf("unexpected negated status assert: %+v", asserts[0])
+	}
+	if !asserts[1].Negate || asserts[1].JSONPath == nil || asserts[1].JSONPath.Path != "$.error" {
+		t.Fatalf("unexpected negated jsonpath assert: %+v", asserts[1])
+	}
+	if asserts[2].Negate || asserts[2].Expression != `not contains(response.text(), "error")` ||
+		asserts[2].Message != "no errors" {
+		t.Fatalf("expected RTS expression to keep its not operator: %+v", asserts[2])
+	}
+	if asserts[3].Negate || asserts[3].Expression != "nothing == 1" {
+		t.Fatalf("expected identifier starting with not to stay intact: %+v", asserts[3])
+	}
+}
+
+func TestParseAssertCookieDirective(t *testing.T) {
+	src := `# @assert cookie sessionid != ""
+# @assert cookie theme=='dark'
+# @assert cookie csrftoken
+# @assert cookie theme > 1
+# @assert cookie theme == "dark
+GET https://example.com/api
+`
+	doc := Parse("assert.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	asserts := doc.Requests[0].Metadata.Asserts
+	if len(asserts) != 3 {
+		t.Fatalf("expected 3 asserts, got %d", len(asserts))
+	}
+	want := []restfile.CookieAssert{
+		{Name: "sessionid", Op: "!=", Expected: ""},
+		{Name: "theme", Op: "==", Expected: "dark"},
+		{Name: "csrftoken"},
+	}
+	for i, w := range want {
+		if asserts[i].Cookie == nil || *asserts[i].Cookie != w {
+			t.Fatalf("assert %d: expected %+v, got %+v", i, w, asserts[i].Cookie)
+		}
+	}
+	if !hasParseMessage(doc.Errors, "@assert cookie theme supports only == and !=") {
+		t.Fatalf("expected operator error, got %+v", doc.Errors)
+	}
+	if !hasParseMessage(doc.Errors, `@assert cookie theme unterminated string "dark`) {
+		t.Fatalf("expected unterminated string error, got %+v", doc.Errors)
+	}
+}
+
+func TestParseAssertXPathDirective(t *testing.T) {
+	src := `# @xmlns s http://schemas.xmlsoap.org/soap/envelope/
+# @xmlns missing
+# @assert xpath //status/text() == "OK"
+# @assert xpath //item[@kind = 'a b'] != 'x y'
+# @assert xpath //s:Envelope
+# @assert xpath count(//item) >= 2
+# @assert xpath //status[ == 1
+GET https://example.com/api
+`
+	doc := Parse("assert.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	meta := doc.Requests[0].Metadata
+	if meta.XMLNS["s"] != "http://schemas.xmlsoap.org/soap/envelope/" || len(meta.XMLNS) != 1 {
+		t.Fatalf("unexpected namespaces: %+v", meta.XMLNS)
+	}
+	if len(meta.Asserts) != 4 {
+		t.Fatalf("expected 4 asserts, got %d", len(meta.Asserts))
+	}
+	want := []restfile.XPathAssert{
+		{Expr: "//status/text()", Op: "==", Expected: `"OK"`},
+		{Expr: "//item[@kind = 'a b']", Op: "!=", Expected: "'x y'"},
+		{Expr: "//s:Envelope"},
+		{Expr: "count(//item)", Op: ">=", Expected: "2"},
+	}
+	for i, w := range want {
+		if meta.Asserts[i].XPath == nil || *meta.Asserts[i].XPath != w {
+			t.Fatalf("assert %d: expected %+v, got %+v", i, w, meta.Asserts[i].XPath)
+		}
+	}
+	if !hasParseMessage(doc.Errors, "@xmlns expects a prefix and a namespace URI") {
+		t.Fatalf("expected xmlns error, got %+v", doc.Errors)
+	}
+	if len(doc.Errors) != 2 {
+		t.Fatalf("expected an invalid expression error, got %+v", doc.Errors)
+	}
+}
+
+func TestParseRemoveHeaderDirective(t *testing.T) {
+	src := `# @remove-header Authorization
+# @remove-header x-trace, Accept
+# @remove-header
+GET https://example.com/api
+`
+	doc := Parse("remove.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	got := strings.Join(doc.Requests[0].Metadata.RemoveHeaders, ",")
+	if got != "Authorization,x-trace,Accept" {
+		t.Fatalf("unexpected removed headers %q", got)
+	}
+	if !hasParseMessage(doc.Errors, "@remove-header expects a header name") {
+		t.Fatalf("expected remove-header error, got %+v", doc.Errors)
+	}
+}
+
+func TestParseAssertMetricDirectives(t *testing.T) {
+	src := `# @assert header-count > 5
+# @assert body-size <10KB
+# @assert not body-size >= 1.5mb
+# @assert body-size ~ 10
+# @assert header-count > many
+# @assert response-time < 500ms
+# @assert response-time <= soon
+GET https://example.com/api
+`
+	doc := Parse("assert.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	asserts := doc.Requests[0].Metadata.Asserts
+	if len(asserts) != 4 {
+		t.Fatalf("expected 4 asserts, got %d", len(asserts))
+	}
+	want := []restfile.MetricAssert{
+		{Metric: "header-count", Op: ">", Value: 5},
+		{Metric: "body-size", Op: "<", Value: 10 * 1024},
+		{Metric: "body-size", Op: ">=", Value: 1536 * 1024},
+		{Metric: "response-time", Op: "<", Value: int64(500 * time.Millisecond)},
+	}
+	for i, w := range want {
+		if asserts[i].Metric == nil || *asserts[i].Metric != w {
+			t.Fatalf("assert %d: expected %+v, got %+v", i, w, asserts[i].Metric)
+		}
+	}
+	if !asserts[2].Negate {
+		t.Fatalf("expected negated body-size assert")
+	}
+	if !hasParseMessage(doc.Errors, "@assert body-size requires an operator") {
+		t.Fatalf("expected missing operator error, got %+v", doc.Errors)
+	}
+	if !hasParseMessage(doc.Errors, `@assert header-count invalid value "many"`) {
+		t.Fatalf("expected invalid value error, got %+v", doc.Errors)
+	}
+	if !hasParseMessage(doc.Errors, `@assert response-time invalid duration "soon"`) {
+		t.Fatalf("expected invalid duration error, got %+v", doc.Errors)
+	}
+}
+
+func TestParseAssertProfileDirectives(t *testing.T) {
+	src := `# @profile count=20
+# @assert profile.p99 < 500ms
+# @assert Profile.mean<=1.5s
+# @assert profile.p42 < 1s
+# @assert profile.max < soon
+GET https://example.com/api
+`
+	doc := Parse("assert.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	asserts := doc.Requests[0].Metadata.Asserts
+	if len(asserts) != 2 {
+		t.Fatalf("expected 2 asserts, got %d", len(asserts))
+	}
+	want := []restfile.ProfileAssert{
+		{Stat: "p99", Op: "<", Value: 500 * time.Millisecond},
+		{Stat: "mean", Op: "<=", Value: 1500 * time.Millisecond},
+	}
+	for i, w := range want {
+		if asserts[i].Profile == nil || *asserts[i].Profile != w {
+			t.Fatalf("assert %d: expected %+v, got %+v", i, w, asserts[i].Profile)
+		}
+	}
+	if !hasParseMessage(doc.Errors, "@assert profile.p42 is not a profile statistic") {
+		t.Fatalf("expected unknown stat error, got %+v", doc.Errors)
+	}
+	if !hasParseMessage(doc.Errors, `@assert profile.max invalid duration "soon"`) {
+		t.Fatalf("expected invalid duration error, got %+v", doc.Errors)
+	}
+}
+
 func TestSplitAssertEscapes(t *testing.T) {
 	expr, msg := splitAssert(`contains(body, "a=>b") => "ok"`)
 	if expr != `contains(body, "a=>b")` {
@@ -353,6 +706,80 @@ GET https://example.com
 	}
 }
 
+func TestTagSettingsCaptured(t *testing.T) {
+	src := `# @setting timeout 10s
+# @tag-settings smoke timeout=2s http-insecure=true
+# @tag-settings Slow timeout=30s
+# @tag-settings
+# @tag-settings empty
+
+### First
+# @name first
+# @tag smoke slow
+# @tag-settings nested timeout=1s
+GET https://example.com
+`
+	doc := Parse("tag-settings.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	if doc.Settings["timeout"] != "10s" {
+		t.Fatalf("expected file-level timeout to stay, got %q", doc.Settings["timeout"])
+	}
+	if got := doc.TagSettings["smoke"]; got["timeout"] != "2s" || got["http-insecure"] != "true" {
+		t.Fatalf("unexpected smoke settings: %+v", got)
+	}
+	if _, ok := doc.TagSettings["nested"]; ok {
+		t.Fatalf("expected request-scoped @tag-settings to be rejected")
+	}
+
+	merged := doc.SettingsForTags(doc.Requests[0].Metadata.Tags)
+	if merged["timeout"] != "30s" || merged["http-insecure"] != "true" {
+		t.Fatalf("expected later tag to win, got %+v", merged)
+	}
+	if doc.SettingsForTags([]string{"other"}) != nil {
+		t.Fatalf("expected no settings for an unknown tag")
+	}
+
+	for _, msg := range []string{
+		"@tag-settings requires a tag",
+		"@tag-settings empty has no key=value settings",
+		"@tag-settings must be declared outside a request",
+	} {
+		if !hasParseMessage(doc.Errors, msg) {
+			t.Fatalf("expected %q error, got %+v", msg, doc.Errors)
+		}
+	}
+}
+
+func TestResolveSettingAccumulates(t *testing.T) {
+	src := `# @setting resolve api.example.com=127.0.0.1:8443
+
+### First
+# @name first
+# @setting resolve api.example.com=127.0.0.1:8443
+# @setting resolve auth.example.com=127.0.0.1
+# @setting timeout 1s
+# @setting timeout 2s
+GET https://api.example.com
+`
+	doc := Parse("resolve.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	req := doc.Requests[0]
+	want := "api.example.com=127.0.0.1:8443, auth.example.com=127.0.0.1"
+	if req.Settings["resolve"] != want {
+		t.Fatalf("expected accumulated resolve %q, got %q", want, req.Settings["resolve"])
+	}
+	if req.Settings["timeout"] != "2s" {
+		t.Fatalf("expected last timeout to win, got %q", req.Settings["timeout"])
+	}
+	if doc.Settings["resolve"] != "api.example.com=127.0.0.1:8443" {
+		t.Fatalf("unexpected file resolve %q", doc.Settings["resolve"])
+	}
+}
+
 func TestSettingsDirectiveRequestScopeOverride(t *testing.T) {
 	src := `# @settings timeout=9s followredirects=true
 
@@ -1014,6 +1441,27 @@ GET https://example.com
 	}
 }
 
+func TestParsePathParamDirective(t *testing.T) {
+	src := `# @name User
+# @path-param id 42
+# @path-param org={{orgId}}
+# @path-param missing
+GET https://example.com/orgs/{org}/users/{id}
+`
+
+	doc := Parse("paths.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	params := doc.Requests[0].Metadata.PathParams
+	if params["id"] != "42" || params["org"] != "{{orgId}}" || len(params) != 2 {
+		t.Fatalf("unexpected path params %#v", params)
+	}
+	if len(doc.Errors) != 1 || !strings.Contains(doc.Errors[0].Message, "@path-param") {
+		t.Fatalf("expected one @path-param error, got %#v", doc.Errors)
+	}
+}
+
 func TestShorthandBeforeMethodDefaultsToFileScope(t *testing.T) {
 	src := `### One
 @id abc
@@ -1445,6 +1893,22 @@ func TestParseOAuth2AuthSpecCacheOnly(t *testing.T) {
 	}
 }
 
+func TestParseBearerExecAuthSpec(t *testing.T) {
+	spec := parseAuthSpec(`bearer-exec "./token.sh --audience api" cache_key=svc timeout=5s`)
+	if spec == nil || spec.Type != "bearer-exec" {
+		t.Fatalf("expected bearer-exec spec, got %+v", spec)
+	}
+	if spec.Params["command"] != "./token.sh --audience api" {
+		t.Fatalf("unexpected command %q", spec.Params["command"])
+	}
+	if spec.Params["cache_key"] != "svc" || spec.Params["timeout"] != "5s" {
+		t.Fatalf("unexpected params %+v", spec.Params)
+	}
+	if parseAuthSpec("bearer-exec") != nil {
+		t.Fatalf("expected bearer-exec without command to be rejected")
+	}
+}
+
 func TestParseCompareDirective(t *testing.T) {
 	src := `# @name Compare
 # @compare dev stage prod base=stage
@@ -1834,6 +2298,77 @@ GET https://example.com/api
 	}
 }
 
+func TestParseRetryDirective(t *testing.T) {
+	src := `### Flaky
+# @retry 4 on=429,503 respect-retry-after=true jitter=true delay=200ms
+GET https://example.com/api
+
+### Bad
+# @retry on=abc
+GET https://example.com/bad
+`
+
+	doc := Parse("retry.http", []byte(src))
+	if len(doc.Requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doc.Requests))
+	}
+	spec := doc.Requests[0].Metadata.Retry
+	if spec == nil {
+		t.Fatalf("expected retry metadata to be parsed")
+	}
+	if spec.Count != 4 {
+		t.Fatalf("expected count=4, got %d", spec.Count)
+	}
+	if len(spec.On) != 2 || spec.On[0] != 429 || spec.On[1] != 503 {
+		t.Fatalf("unexpected retry statuses: %v", spec.On)
+	}
+	if !spec.RespectRetryAfter || !spec.Jitter {
+		t.Fatalf("expected retry-after and jitter enabled, got %+v", spec)
+	}
+	if spec.Delay != 200*time.Millisecond {
+		t.Fatalf("expected delay=200ms, got %s", spec.Delay)
+	}
+	if doc.Requests[1].Metadata.Retry != nil {
+		t.Fatalf("expected invalid retry to be rejected")
+	}
+	if !hasParseMessage(doc.Errors, "@retry invalid status code") {
+		t.Fatalf("expected retry parse error, got %+v", doc.Errors)
+	}
+}
+
+func TestParseWebhookDirective(t *testing.T) {
+	src := `### Async
+# @webhook timeout=2m listen=0.0.0.0:9090
+POST https://example.com/jobs
+
+### Default
+# @webhook
+POST https://example.com/jobs
+
+### Bad
+# @webhook wait=5s
+POST https://example.com/jobs
+`
+
+	doc := Parse("webhook.http", []byte(src))
+	if len(doc.Requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(doc.Requests))
+	}
+	spec := doc.Requests[0].Metadata.Webhook
+	if spec == nil || spec.Timeout != 2*time.Minute || spec.Listen != "0.0.0.0:9090" {
+		t.Fatalf("unexpected webhook spec %+v", spec)
+	}
+	if def := doc.Requests[1].Metadata.Webhook; def == nil || def.Timeout != 0 || def.Listen != "" {
+		t.Fatalf("expected default webhook spec, got %+v", def)
+	}
+	if doc.Requests[2].Metadata.Webhook != nil {
+		t.Fatalf("expected invalid webhook to be rejected")
+	}
+	if !hasParseMessage(doc.Errors, `@webhook unknown option "wait"`) {
+		t.Fatalf("expected webhook parse error, got %+v", doc.Errors)
+	}
+}
+
 func TestParseBodyExpandDirective(t *testing.T) {
 	src := `### ExpandBody
 # @body expand
@@ -1855,6 +2390,28 @@ POST https://example.com/api
 	}
 }
 
+func TestParseBodyBase64Directive(t *testing.T) {
+	src := `### Binary
+# @body-base64
+POST https://example.com/api
+
+CAESBWhlbGxv
+AP8=
+`
+
+	doc := Parse("body-base64.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	req := doc.Requests[0]
+	if !req.Body.Options.Base64 {
+		t.Fatalf("expected base64 body flag to be set")
+	}
+	if req.Body.Text != "CAESBWhlbGxv\nAP8=" {
+		t.Fatalf("unexpected body text %q", req.Body.Text)
+	}
+}
+
 func TestParseWorkflowDirectives(t *testing.T) {
 	src := `# @workflow provision-account on-failure=continue
 # @description Provision new account flow
@@ -2159,12 +2716,54 @@ func TestParseOptionTokensQuotedValues(t *testing.T) {
 	}
 }
 
+func TestParseGRPCReflectionTemplate(t *testing.T) {
+	src := `# @grpc pkg.Service/Get
+# @grpc-descriptor {{descriptor_path}}
+# @grpc-reflection {{use_reflection}}
+GRPC localhost:50051
+`
+	doc := Parse("grpc.http", []byte(src))
+	if len(doc.Requests) != 1 || doc.Requests[0].GRPC == nil {
+		t.Fatalf("expected one grpc request")
+	}
+	grpc := doc.Requests[0].GRPC
+	if grpc.DescriptorSet != "{{descriptor_path}}" {
+		t.Fatalf("expected descriptor template kept, got %q", grpc.DescriptorSet)
+	}
+	if grpc.ReflectionExpr != "{{use_reflection}}" || !grpc.UseReflection {
+		t.Fatalf("expected reflection template deferred, got %+v", grpc)
+	}
+}
+
+func TestParseGRPCReflectionTarget(t *testing.T) {
+	src := `# @grpc my.pkg.UserService/GetUser
+# @grpc-reflection-target {{admin}}:9090 plaintext=true
+GRPC users.internal:443
+`
+	doc := Parse("grpc.http", []byte(src))
+	if len(doc.Requests) != 1 || doc.Requests[0].GRPC == nil {
+		t.Fatalf("expected one grpc request")
+	}
+	grpc := doc.Requests[0].GRPC
+	if grpc.ReflectionTarget != "{{admin}}:9090" {
+		t.Fatalf("unexpected reflection target %q", grpc.ReflectionTarget)
+	}
+	if !grpc.ReflectionPlaintextSet || !grpc.ReflectionPlaintext {
+		t.Fatalf("expected plaintext override, got %+v", grpc)
+	}
+	if grpc.PlaintextSet {
+		t.Fatalf("expected call plaintext to stay unset")
+	}
+}
+
 func TestParseGRPCRequest(t *testing.T) {
 	src := `# @name GRPCSample
 # @grpc my.pkg.UserService/GetUser
 # @grpc-descriptor descriptors/user.pb
 # @grpc-plaintext false
 # @grpc-metadata authorization: Bearer 123
+# @grpc-ca ./certs/ca.pem
+# @grpc-server-name users.internal
 GRPC localhost:50051
 
 {
@@ -2193,6 +2792,9 @@ GRPC localhost:50051
 	if grpc.DescriptorSet != "descriptors/user.pb" {
 		t.Fatalf("unexpected descriptor: %s", grpc.DescriptorSet)
 	}
+	if grpc.RootCA != "./certs/ca.pem" || grpc.ServerName != "users.internal" {
+		t.Fatalf("unexpected tls overrides: ca=%q server=%q", grpc.RootCA, grpc.ServerName)
+	}
 	if grpc.Plaintext {
 		t.Fatalf("expected plaintext to be false")
 	}
@@ -2240,6 +2842,55 @@ GRPC localhost:50051
 	}
 }
 
+func TestParseGRPCMetadataFile(t *testing.T) {
+	src := `# @grpc my.pkg.UserService/GetUser
+# @grpc-metadata-file ./meta.txt
+# @grpc-metadata x-id: one
+GRPC localhost:50051
+{}`
+
+	doc := Parse("grpc.http", []byte(src))
+	if len(doc.Requests) != 1 || doc.Requests[0].GRPC == nil {
+		t.Fatalf("expected grpc request")
+	}
+	grpc := doc.Requests[0].GRPC
+	if !reflect.DeepEqual(grpc.MetadataFiles, []string{"./meta.txt"}) {
+		t.Fatalf("unexpected metadata files %#v", grpc.MetadataFiles)
+	}
+	if len(grpc.Metadata) != 1 {
+		t.Fatalf("expected inline metadata to be kept, got %#v", grpc.Metadata)
+	}
+}
+
+func TestParseGRPCHealthDirective(t *testing.T) {
+	src := `# @grpc-health service=billing
+GRPC localhost:50051
+
+###
+
+# @grpc-health
+GRPC localhost:50051
+`
+	doc := Parse("grpc.http", []byte(src))
+	if len(doc.Requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doc.Requests))
+	}
+	first := doc.Requests[0].GRPC
+	if first == nil || first.FullMethod != "/grpc.health.v1.Health/Check" {
+		t.Fatalf("expected health check method, got %#v", first)
+	}
+	if first.Message != `{"service": "billing"}` {
+		t.Fatalf("unexpected health request message %q", first.Message)
+	}
+	second := doc.Requests[1].GRPC
+	if second == nil || second.Service != "Health" || second.Method != "Check" {
+		t.Fatalf("expected health service and method, got %#v", second)
+	}
+	if strings.TrimSpace(second.Message) != "" {
+		t.Fatalf("expected empty message for overall health, got %q", second.Message)
+	}
+}
+
 func TestParseGRPCRequestDefaultsPlaintextToUnset(t *testing.T) {
 	src := `# @name DefaultPlaintext
 # @grpc my.pkg.UserService/GetUser
@@ -2288,6 +2939,47 @@ GET https://example.com/events
 	}
 }
 
+func TestParseSSEOutDirective(t *testing.T) {
+	src := `# @sse out=./logs/events.jsonl out-mode=rotate
+GET https://example.com/events
+`
+
+	doc := Parse("sse.http", []byte(src))
+	if len(doc.Requests) != 1 || doc.Requests[0].SSE == nil {
+		t.Fatalf("expected SSE request")
+	}
+	opts := doc.Requests[0].SSE.Options
+	if opts.Out != "./logs/events.jsonl" {
+		t.Fatalf("unexpected out path %q", opts.Out)
+	}
+	if !opts.OutRotate {
+		t.Fatalf("expected out-mode=rotate to be parsed")
+	}
+}
+
+func TestParseStreamBufferOptions(t *testing.T) {
+	src := `# @sse max-buffer=1000 drop=newest
+GET https://example.com/events
+
+###
+# @websocket max-buffer=50
+GET ws://example.com/socket
+`
+
+	doc := Parse("stream.http", []byte(src))
+	if len(doc.Requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doc.Requests))
+	}
+	sse := doc.Requests[0].SSE
+	if sse == nil || sse.Options.Buffer.Max != 1000 || !sse.Options.Buffer.DropNewest {
+		t.Fatalf("unexpected sse buffer options %+v", sse)
+	}
+	ws := doc.Requests[1].WebSocket
+	if ws == nil || ws.Options.Buffer.Max != 50 || ws.Options.Buffer.DropNewest {
+		t.Fatalf("unexpected websocket buffer options %+v", ws)
+	}
+}
+
 func TestParseWebSocketDirectives(t *testing.T) {
 	src := `# @name ws
 # @websocket timeout=12s idle=6s max-message-bytes=1mb subprotocols=chat,json compression=false
@@ -2346,6 +3038,30 @@ GET ws://example.com/socket
 	}
 }
 
+func TestParseWebSocketReconnectOptions(t *testing.T) {
+	src := `# @websocket reconnect=true max-retries=7 backoff=250ms
+GET ws://example.com/socket
+`
+
+	doc := Parse("ws.http", []byte(src))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(doc.Requests))
+	}
+	ws := doc.Requests[0].WebSocket
+	if ws == nil {
+		t.Fatalf("expected websocket metadata")
+	}
+	if !ws.Options.Reconnect {
+		t.Fatalf("expected reconnect to be enabled")
+	}
+	if ws.Options.MaxRetries != 7 {
+		t.Fatalf("unexpected max retries: %d", ws.Options.MaxRetries)
+	}
+	if ws.Options.ReconnectBackoff != 250*time.Millisecond {
+		t.Fatalf("unexpected backoff: %v", ws.Options.ReconnectBackoff)
+	}
+}
+
 func TestParseTraceDirectiveWithBudgets(t *testing.T) {
 	src := `# @trace dns<=50ms connect<=120ms total<=400ms tolerance=25ms
 GET https://example.com/api
@@ -2440,3 +3156,57 @@ GET https://example.com
 		t.Fatalf("expected empty alias, got %q", sp.Alias)
 	}
 }
+
+func TestParseRequestEnvDirective(t *testing.T) {
+	src := `# @name login
+# @env "staging"
+POST https://{{auth.host}}/token
+
+###
+
+GET https://{{host}}/me
+`
+	doc := Parse("env.http", []byte(src))
+	if len(doc.Requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doc.Requests))
+	}
+	if got := doc.Requests[0].Metadata.Env; got != "staging" {
+		t.Fatalf("expected @env staging, got %q", got)
+	}
+	if got := doc.Requests[1].Metadata.Env; got != "" {
+		t.Fatalf("expected no env on second request, got %q", got)
+	}
+}
+
+func TestParseTraceDirectiveExport(t *testing.T) {
+	src := `# @trace total<=1s export=otlp endpoint={{collector}} service="checkout api" insecure=true
+GET https://example.com/api
+
+###
+# @trace endpoint=otel:4317
+GET https://example.com/other
+`
+
+	doc := Parse("trace-export.http", []byte(src))
+	if len(doc.Requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doc.Requests))
+	}
+	export := doc.Requests[0].Metadata.Trace.Export
+	if export == nil {
+		t.Fatalf("expected trace export")
+	}
+	want := restfile.TraceExport{
+		Endpoint: "{{collector}}",
+		Service:  "checkout api",
+		Insecure: true,
+	}
+	if *export != want {
+		t.Fatalf("unexpected export %+v", *export)
+	}
+	if doc.Requests[0].Metadata.Trace.Budgets.Total != time.Second {
+		t.Fatalf("expected budget kept alongside export")
+	}
+	if doc.Requests[1].Metadata.Trace.Export != nil {
+		t.Fatalf("expected endpoint without export=otlp to be ignored")
+	}
+}
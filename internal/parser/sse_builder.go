@@ -5,6 +5,7 @@ import (
 
 	"github.com/unkn0wn-root/resterm/internal/duration"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/util"
 )
 
 type sseBuilder struct {
@@ -64,9 +65,38 @@ func (b *sseBuilder) applyOption(name, value string) {
 			b.options.MaxEvents = n
 		}
 	case "max-bytes", "limit-bytes":
-		if size, err := parseByteSize(value); err == nil {
+		if size, err := util.ParseByteSize(value); err == nil {
 			b.options.MaxBytes = size
 		}
+	case "out":
+		b.options.Out = strings.TrimSpace(value)
+	case "out-mode":
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "append":
+			b.options.OutRotate = false
+		case "rotate":
+			b.options.OutRotate = true
+		}
+	default:
+		applyStreamBufferOption(&b.options.Buffer, name, value)
+	}
+}
+
+// applyStreamBufferOption handles max-buffer and drop, shared by @sse and
+// @websocket.
+func applyStreamBufferOption(buf *restfile.StreamBuffer, name, value string) {
+	switch normKey(name) {
+	case "max-buffer":
+		if n, err := parsePositiveInt(value); err == nil {
+			buf.Max = n
+		}
+	case "drop":
+		switch normKey(value) {
+		case "oldest":
+			buf.DropNewest = false
+		case "newest":
+			buf.DropNewest = true
+		}
 	}
 }
 
@@ -282,11 +282,31 @@ func applySettingsTokens(dst map[string]string, raw string) map[string]string {
 		if k == "" {
 			continue
 		}
-		dst[k] = v
+		dst = setSetting(dst, k, v)
 	}
 	return dst
 }
 
+// repeatableSettings lists setting keys whose repeated directives
+// accumulate into a comma-separated list instead of overriding.
+var repeatableSettings = map[string]struct{}{
+	"resolve":      {},
+	"dns-override": {},
+}
+
+func setSetting(dst map[string]string, key, value string) map[string]string {
+	if dst == nil {
+		dst = make(map[string]string)
+	}
+	if _, ok := repeatableSettings[strings.ToLower(key)]; ok {
+		if prev := strings.TrimSpace(dst[key]); prev != "" && strings.TrimSpace(value) != "" {
+			value = prev + ", " + value
+		}
+	}
+	dst[key] = value
+	return dst
+}
+
 // Like splitAuthFields but handles backslash escapes.
 // A trailing backslash gets preserved if nothing follows it.
 func tokenizeOptionTokens(input string) []string {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/unkn0wn-root/resterm/internal/duration"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/util"
 )
 
 type wsBuilder struct {
@@ -24,6 +25,9 @@ const (
 	wsOptSub         = "subprotocol"
 	wsOptSubs        = "subprotocols"
 	wsOptCompression = "compression"
+	wsOptReconnect   = "reconnect"
+	wsOptMaxRetries  = "max-retries"
+	wsOptBackoff     = "backoff"
 	wsActSend        = "send"
 	wsActSendJSON    = "send-json"
 	wsActSendBase64  = "send-base64"
@@ -86,7 +90,7 @@ func (b *wsBuilder) applyOption(name, value string) {
 			b.opts.IdleTimeout = dur
 		}
 	case wsOptMaxMsg:
-		if size, err := parseByteSize(value); err == nil {
+		if size, err := util.ParseByteSize(value); err == nil {
 			b.opts.MaxMessageBytes = size
 		}
 	case wsOptSub, wsOptSubs:
@@ -98,6 +102,20 @@ func (b *wsBuilder) applyOption(name, value string) {
 			b.opts.Compression = val
 			b.opts.CompressionSet = true
 		}
+	case wsOptReconnect:
+		if val, err := strconv.ParseBool(value); err == nil {
+			b.opts.Reconnect = val
+		}
+	case wsOptMaxRetries:
+		if n, err := strconv.Atoi(value); err == nil && n >= 0 {
+			b.opts.MaxRetries = n
+		}
+	case wsOptBackoff:
+		if dur, ok := duration.Parse(value); ok && dur >= 0 {
+			b.opts.ReconnectBackoff = dur
+		}
+	default:
+		applyStreamBufferOption(&b.opts.Buffer, name, value)
 	}
 }
 
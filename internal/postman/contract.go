@@ -0,0 +1,33 @@
+package postman
+
+import (
+	"context"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+type Parser interface {
+	Parse(ctx context.Context, path string) (*Collection, error)
+}
+
+type Generator interface {
+	Generate(ctx context.Context, col *Collection) (*restfile.Document, error)
+}
+
+type DocumentWriter interface {
+	WriteDocument(
+		ctx context.Context,
+		doc *restfile.Document,
+		destination string,
+		opts WriterOptions,
+	) error
+}
+
+type Warner interface {
+	Warnings() []string
+}
+
+type WriterOptions struct {
+	OverwriteExisting bool
+	HeaderComment     string
+}
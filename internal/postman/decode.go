@@ -0,0 +1,126 @@
+package postman
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Description is a plain string in exports. Collections written by Postman
+// may also use the {"content": "...", "type": "text/markdown"} form.
+type Description string
+
+func (d *Description) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*d = Description(text)
+		return nil
+	}
+	var obj struct {
+		Content string `json:"content"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	*d = Description(obj.Content)
+	return nil
+}
+
+// UnmarshalJSON accepts the short form where a request is just its URL.
+func (r *Request) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err == nil {
+		*r = Request{Method: "GET", URL: URL{Raw: raw}}
+		return nil
+	}
+	type plain Request
+	var out plain
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*r = Request(out)
+	return nil
+}
+
+// UnmarshalJSON accepts URLs given as a bare string.
+func (u *URL) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err == nil {
+		*u = URL{Raw: raw}
+		return nil
+	}
+	type plain URL
+	var out plain
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*u = URL(out)
+	return nil
+}
+
+func (kv *KeyValue) UnmarshalJSON(data []byte) error {
+	type plain KeyValue
+	var out struct {
+		plain
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*kv = KeyValue(out.plain)
+	kv.Value = scalarString(out.Value)
+	return nil
+}
+
+func (v *Variable) UnmarshalJSON(data []byte) error {
+	type plain Variable
+	var out struct {
+		plain
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*v = Variable(out.plain)
+	v.Value = scalarString(out.Value)
+	return nil
+}
+
+// UnmarshalJSON accepts the multi-file form where src is an array; only the
+// first path is kept.
+func (p *FormParam) UnmarshalJSON(data []byte) error {
+	type plain FormParam
+	var out struct {
+		plain
+		Value json.RawMessage `json:"value"`
+		Src   json.RawMessage `json:"src"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*p = FormParam(out.plain)
+	p.Value = scalarString(out.Value)
+	var srcs []string
+	if err := json.Unmarshal(out.Src, &srcs); err == nil {
+		if len(srcs) > 0 {
+			p.Src = srcs[0]
+		}
+		return nil
+	}
+	p.Src = scalarString(out.Src)
+	return nil
+}
+
+// scalarString renders a JSON value as text. Strings are unquoted, null is
+// empty and anything else keeps its JSON spelling.
+func scalarString(raw json.RawMessage) string {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return ""
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	return strings.TrimSpace(string(raw))
+}
@@ -0,0 +1,483 @@
+package postman
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+const SchemaV21 = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+type Collection struct {
+	Info     Info       `json:"info"`
+	Item     []Item     `json:"item"`
+	Variable []Variable `json:"variable,omitempty"`
+	Auth     *Auth      `json:"auth,omitempty"`
+	Event    []Event    `json:"event,omitempty"`
+}
+
+type Info struct {
+	Name        string      `json:"name"`
+	Description Description `json:"description,omitempty"`
+	Schema      string      `json:"schema"`
+}
+
+// Item is either a request or, when Item is set, a folder.
+type Item struct {
+	Name        string      `json:"name"`
+	Description Description `json:"description,omitempty"`
+	Request     *Request    `json:"request,omitempty"`
+	Item        []Item      `json:"item,omitempty"`
+	Auth        *Auth       `json:"auth,omitempty"`
+	Event       []Event     `json:"event,omitempty"`
+}
+
+type Request struct {
+	Method      string      `json:"method"`
+	Header      []KeyValue  `json:"header"`
+	Body        *Body       `json:"body,omitempty"`
+	URL         URL         `json:"url"`
+	Auth        *Auth       `json:"auth,omitempty"`
+	Description Description `json:"description,omitempty"`
+}
+
+type URL struct {
+	Raw      string     `json:"raw"`
+	Protocol string     `json:"protocol,omitempty"`
+	Host     []string   `json:"host,omitempty"`
+	Port     string     `json:"port,omitempty"`
+	Path     []string   `json:"path,omitempty"`
+	Query    []KeyValue `json:"query,omitempty"`
+	Variable []KeyValue `json:"variable,omitempty"`
+}
+
+type KeyValue struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Type     string `json:"type,omitempty"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+type Body struct {
+	Mode       string       `json:"mode"`
+	Raw        string       `json:"raw,omitempty"`
+	URLEncoded []KeyValue   `json:"urlencoded,omitempty"`
+	FormData   []FormParam  `json:"formdata,omitempty"`
+	File       *BodyFile    `json:"file,omitempty"`
+	GraphQL    *BodyGraphQL `json:"graphql,omitempty"`
+	Options    *BodyOptions `json:"options,omitempty"`
+	Disabled   bool         `json:"disabled,omitempty"`
+}
+
+// FormParam is one multipart field. Type is "text" or "file"; file fields
+// carry the local path in Src.
+type FormParam struct {
+	Key      string `json:"key"`
+	Value    string `json:"value,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Src      string `json:"src,omitempty"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+type BodyFile struct {
+	Src string `json:"src"`
+}
+
+type BodyGraphQL struct {
+	Query     string `json:"query"`
+	Variables string `json:"variables,omitempty"`
+}
+
+type BodyOptions struct {
+	Raw struct {
+		Language string `json:"language"`
+	} `json:"raw"`
+}
+
+type Auth struct {
+	Type   string     `json:"type"`
+	Basic  []KeyValue `json:"basic,omitempty"`
+	Bearer []KeyValue `json:"bearer,omitempty"`
+	APIKey []KeyValue `json:"apikey,omitempty"`
+	OAuth2 []KeyValue `json:"oauth2,omitempty"`
+}
+
+type Variable struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Type     string `json:"type,omitempty"`
+	Disabled bool   `json:"disabled,omitempty"`
+	// Enabled is only set by environment files, which use it instead of
+	// Disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// Active reports whether the variable should be imported.
+func (v Variable) Active() bool {
+	if v.Enabled != nil {
+		return *v.Enabled
+	}
+	return !v.Disabled
+}
+
+// Event is a pre-request or test script attached to an item or collection.
+// Only the trigger is kept; scripts are not imported.
+type Event struct {
+	Listen   string `json:"listen"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+// Environment is a Postman environment export.
+type Environment struct {
+	Name   string     `json:"name"`
+	Values []Variable `json:"values"`
+}
+
+type ExportOptions struct {
+	// Name overrides the collection name derived from the document path.
+	Name string
+	// FoldersByTag groups requests into folders named after their first tag.
+	// Slashes in a tag ("users/admin") produce nested folders.
+	FoldersByTag bool
+}
+
+// Export converts the requests of doc into a Postman v2.1 collection.
+// Requests Postman cannot represent are skipped and reported as warnings.
+func Export(doc *restfile.Document, opts ExportOptions) (*Collection, []string) {
+	col := &Collection{Info: Info{Name: collectionName(doc, opts.Name), Schema: SchemaV21}}
+	if doc == nil {
+		return col, nil
+	}
+
+	col.Variable = exportVariables(doc)
+	root := &folder{}
+	var warnings []string
+	for i, req := range doc.Requests {
+		if req == nil {
+			continue
+		}
+		name := requestName(req, i)
+		switch {
+		case req.GRPC != nil:
+			warnings = append(warnings, fmt.Sprintf("skipped gRPC request %q", name))
+			continue
+		case req.WebSocket != nil:
+			warnings = append(warnings, fmt.Sprintf("skipped WebSocket request %q", name))
+			continue
+		}
+		item, warn := exportRequest(req, name)
+		warnings = append(warnings, warn...)
+		dst := root
+		if opts.FoldersByTag && len(req.Metadata.Tags) > 0 {
+			dst = root.child(req.Metadata.Tags[0])
+		}
+		dst.items = append(dst.items, item)
+	}
+	col.Item = root.flatten()
+	return col, warnings
+}
+
+// Marshal renders the collection as indented JSON.
+func Marshal(col *Collection) ([]byte, error) {
+	data, err := json.MarshalIndent(col, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+type folder struct {
+	name     string
+	items    []Item
+	children []*folder
+}
+
+func (f *folder) child(tag string) *folder {
+	cur := f
+	for _, part := range strings.Split(tag, "/") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		var next *folder
+		for _, c := range cur.children {
+			if c.name == part {
+				next = c
+				break
+			}
+		}
+		if next == nil {
+			next = &folder{name: part}
+			cur.children = append(cur.children, next)
+		}
+		cur = next
+	}
+	return cur
+}
+
+func (f *folder) flatten() []Item {
+	out := make([]Item, 0, len(f.children)+len(f.items))
+	for _, c := range f.children {
+		out = append(out, Item{Name: c.name, Item: c.flatten()})
+	}
+	return append(out, f.items...)
+}
+
+func collectionName(doc *restfile.Document, override string) string {
+	if name := strings.TrimSpace(override); name != "" {
+		return name
+	}
+	if doc != nil && doc.Path != "" {
+		base := filepath.Base(doc.Path)
+		return strings.TrimSuffix(base, filepath.Ext(base))
+	}
+	return "resterm"
+}
+
+func requestName(req *restfile.Request, idx int) string {
+	if name := strings.TrimSpace(req.Metadata.Name); name != "" {
+		return name
+	}
+	if url := strings.TrimSpace(req.URL); url != "" {
+		return strings.TrimSpace(req.Method + " " + url)
+	}
+	return fmt.Sprintf("request %d", idx+1)
+}
+
+func exportVariables(doc *restfile.Document) []Variable {
+	seen := make(map[string]int)
+	var out []Variable
+	add := func(name, value string, secret bool) {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return
+		}
+		v := Variable{Key: name, Value: value, Type: "string"}
+		if secret {
+			v.Type = "secret"
+		}
+		if idx, ok := seen[name]; ok {
+			out[idx] = v
+			return
+		}
+		seen[name] = len(out)
+		out = append(out, v)
+	}
+	for _, c := range doc.Constants {
+		add(c.Name, c.Value, false)
+	}
+	for _, v := range doc.Globals {
+		add(v.Name, v.Value, v.Secret)
+	}
+	for _, v := range doc.Variables {
+		add(v.Name, v.Value, v.Secret)
+	}
+	return out
+}
+
+func exportRequest(req *restfile.Request, name string) (Item, []string) {
+	var warnings []string
+	out := &Request{
+		Method: strings.ToUpper(strings.TrimSpace(req.Method)),
+		Header: exportHeaders(req),
+		URL:    exportURL(req.URL),
+	}
+	if out.Method == "" {
+		out.Method = "GET"
+	}
+	out.Body = exportBody(req)
+	if req.Metadata.Auth != nil {
+		auth, header, ok := exportAuth(req.Metadata.Auth)
+		switch {
+		case header != nil:
+			out.Header = append(out.Header, *header)
+		case ok:
+			out.Auth = auth
+		default:
+			warnings = append(
+				warnings,
+				fmt.Sprintf("request %q: unsupported auth %q", name, req.Metadata.Auth.Type),
+			)
+		}
+	}
+	if len(req.Variables) > 0 {
+		warnings = append(
+			warnings,
+			fmt.Sprintf("request %q: request-scoped variables are not exported", name),
+		)
+	}
+	return Item{Name: name, Description: Description(req.Metadata.Description), Request: out}, warnings
+}
+
+func exportHeaders(req *restfile.Request) []KeyValue {
+	names := make([]string, 0, len(req.Headers))
+	for name := range req.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]KeyValue, 0, len(names))
+	for _, name := range names {
+		for _, value := range req.Headers[name] {
+			out = append(out, KeyValue{Key: name, Value: value})
+		}
+	}
+	return out
+}
+
+// exportURL splits raw into Postman's URL parts without url.Parse, which
+// rejects {{template}} hosts.
+func exportURL(raw string) URL {
+	raw = strings.TrimSpace(raw)
+	out := URL{Raw: raw}
+	rest := raw
+	if scheme, after, ok := strings.Cut(rest, "://"); ok {
+		out.Protocol = scheme
+		rest = after
+	}
+	if idx := strings.IndexByte(rest, '#'); idx >= 0 {
+		rest = rest[:idx]
+	}
+	query := ""
+	if before, after, ok := strings.Cut(rest, "?"); ok {
+		rest = before
+		query = after
+	}
+	hostPart, pathPart, _ := strings.Cut(rest, "/")
+	if host, port, ok := strings.Cut(hostPart, ":"); ok && !strings.Contains(port, "}") {
+		hostPart = host
+		out.Port = port
+	}
+	if hostPart != "" {
+		out.Host = strings.Split(hostPart, ".")
+	}
+	if pathPart != "" {
+		out.Path = strings.Split(pathPart, "/")
+	}
+	for _, pair := range strings.Split(query, "&") {
+		if pair == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(pair, "=")
+		out.Query = append(out.Query, KeyValue{Key: key, Value: value})
+	}
+	return out
+}
+
+func exportBody(req *restfile.Request) *Body {
+	body := req.Body
+	switch {
+	case body.GraphQL != nil:
+		gql := body.GraphQL
+		return &Body{
+			Mode:    "graphql",
+			GraphQL: &BodyGraphQL{Query: gql.Query, Variables: gql.Variables},
+		}
+	case strings.TrimSpace(body.FilePath) != "":
+		return &Body{Mode: "file", File: &BodyFile{Src: body.FilePath}}
+	case body.Text != "":
+		out := &Body{Mode: "raw", Raw: strings.TrimRight(body.Text, "\r\n")}
+		if lang := rawLanguage(req); lang != "" {
+			out.Options = &BodyOptions{}
+			out.Options.Raw.Language = lang
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func rawLanguage(req *restfile.Request) string {
+	ct := strings.ToLower(req.Headers.Get("Content-Type"))
+	if ct == "" {
+		ct = strings.ToLower(req.Body.MimeType)
+	}
+	switch {
+	case strings.Contains(ct, "json"):
+		return "json"
+	case strings.Contains(ct, "xml"):
+		return "xml"
+	case strings.Contains(ct, "html"):
+		return "html"
+	case strings.Contains(ct, "javascript"):
+		return "javascript"
+	case ct != "":
+		return "text"
+	default:
+		return ""
+	}
+}
+
+// exportAuth maps an @auth spec onto Postman auth. Custom header auth has
+// no Postman equivalent and is returned as a plain header instead.
+func exportAuth(spec *restfile.AuthSpec) (*Auth, *KeyValue, bool) {
+	p := spec.Params
+	switch strings.ToLower(spec.Type) {
+	case "basic":
+		return &Auth{Type: "basic", Basic: []KeyValue{
+			{Key: "username", Value: p["username"], Type: "string"},
+			{Key: "password", Value: p["password"], Type: "string"},
+		}}, nil, true
+	case "bearer":
+		return &Auth{Type: "bearer", Bearer: []KeyValue{
+			{Key: "token", Value: p["token"], Type: "string"},
+		}}, nil, true
+	case "apikey", "api-key":
+		in := "header"
+		if strings.EqualFold(p["placement"], "query") {
+			in = "query"
+		}
+		key := p["name"]
+		if key == "" {
+			key = "X-API-Key"
+		}
+		return &Auth{Type: "apikey", APIKey: []KeyValue{
+			{Key: "key", Value: key, Type: "string"},
+			{Key: "value", Value: p["value"], Type: "string"},
+			{Key: "in", Value: in, Type: "string"},
+		}}, nil, true
+	case "header":
+		if p["header"] == "" {
+			return nil, nil, false
+		}
+		return nil, &KeyValue{Key: p["header"], Value: p["value"]}, true
+	case "oauth2":
+		return &Auth{Type: "oauth2", OAuth2: oauth2Params(p)}, nil, true
+	default:
+		return nil, nil, false
+	}
+}
+
+func oauth2Params(p map[string]string) []KeyValue {
+	grant := p["grant"]
+	switch grant {
+	case "client_credentials", "":
+		grant = "client_credentials"
+	case "password":
+		grant = "password_credentials"
+	}
+	fields := []struct{ key, param string }{
+		{"accessTokenUrl", "token_url"},
+		{"authUrl", "auth_url"},
+		{"redirect_uri", "redirect_uri"},
+		{"clientId", "client_id"},
+		{"clientSecret", "client_secret"},
+		{"scope", "scope"},
+		{"username", "username"},
+		{"password", "password"},
+		{"audience", "audience"},
+	}
+	out := []KeyValue{{Key: "grant_type", Value: grant, Type: "string"}}
+	for _, f := range fields {
+		if v := p[f.param]; v != "" {
+			out = append(out, KeyValue{Key: f.key, Value: v, Type: "string"})
+		}
+	}
+	if p["client_auth"] == "body" {
+		out = append(out, KeyValue{Key: "client_authentication", Value: "body", Type: "string"})
+	}
+	return out
+}
@@ -0,0 +1,117 @@
+package postman
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+)
+
+func TestExportConvertsRequests(t *testing.T) {
+	src := `@baseUrl = https://api.example.com
+
+### Create user
+# @name createUser
+# @tag users/admin
+# @auth bearer {{token}}
+POST {{baseUrl}}/users?notify=true
+Content-Type: application/json
+
+{"name":"ada"}
+
+### List users
+# @name listUsers
+# @tag users
+# @auth basic ada secret
+GET https://api.example.com:8443/users
+
+### Stream
+# @name chat
+# @websocket
+GET wss://api.example.com/ws
+`
+	doc := parser.Parse("api.http", []byte(src))
+	col, warnings := Export(doc, ExportOptions{FoldersByTag: true})
+
+	if col.Info.Name != "api" || col.Info.Schema != SchemaV21 {
+		t.Fatalf("unexpected info %+v", col.Info)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `skipped WebSocket request "chat"`) {
+		t.Fatalf("expected websocket warning, got %v", warnings)
+	}
+	if len(col.Variable) != 1 || col.Variable[0].Key != "baseUrl" {
+		t.Fatalf("expected baseUrl collection variable, got %+v", col.Variable)
+	}
+
+	if len(col.Item) != 1 || col.Item[0].Name != "users" {
+		t.Fatalf("expected users folder, got %+v", col.Item)
+	}
+	users := col.Item[0]
+	if len(users.Item) != 2 || users.Item[0].Name != "admin" {
+		t.Fatalf("expected nested admin folder first, got %+v", users.Item)
+	}
+	create := users.Item[0].Item[0]
+	if create.Name != "createUser" || create.Request == nil {
+		t.Fatalf("unexpected create item %+v", create)
+	}
+	req := create.Request
+	if req.Method != "POST" || req.URL.Raw != "{{baseUrl}}/users?notify=true" {
+		t.Fatalf("unexpected request %+v", req)
+	}
+	if len(req.URL.Host) != 1 || req.URL.Host[0] != "{{baseUrl}}" ||
+		len(req.URL.Path) != 1 || req.URL.Path[0] != "users" {
+		t.Fatalf("unexpected url parts %+v", req.URL)
+	}
+	if len(req.URL.Query) != 1 || req.URL.Query[0] != (KeyValue{Key: "notify", Value: "true"}) {
+		t.Fatalf("unexpected query %+v", req.URL.Query)
+	}
+	if req.Body == nil || req.Body.Mode != "raw" || req.Body.Raw != `{"name":"ada"}` ||
+		req.Body.Options == nil || req.Body.Options.Raw.Language != "json" {
+		t.Fatalf("unexpected body %+v", req.Body)
+	}
+	if req.Auth == nil || req.Auth.Type != "bearer" || req.Auth.Bearer[0].Value != "{{token}}" {
+		t.Fatalf("unexpected auth %+v", req.Auth)
+	}
+
+	list := users.Item[1].Request
+	if list.URL.Port != "8443" || list.URL.Protocol != "https" {
+		t.Fatalf("unexpected url %+v", list.URL)
+	}
+	if list.Auth == nil || list.Auth.Type != "basic" || list.Auth.Basic[1].Value != "secret" {
+		t.Fatalf("unexpected basic auth %+v", list.Auth)
+	}
+}
+
+func TestExportWithoutFoldersAndMarshal(t *testing.T) {
+	src := `### Ping
+# @tag health
+# @auth X-Token abc
+GET https://api.example.com/ping
+`
+	doc := parser.Parse("ping.http", []byte(src))
+	col, warnings := Export(doc, ExportOptions{Name: "Health"})
+	if len(warnings) != 0 {
+		t.Fatalf("unexpected warnings %v", warnings)
+	}
+	if len(col.Item) != 1 || col.Item[0].Request == nil {
+		t.Fatalf("expected flat request list, got %+v", col.Item)
+	}
+	req := col.Item[0].Request
+	if req.Auth != nil || len(req.Header) != 1 || req.Header[0].Key != "X-Token" {
+		t.Fatalf("expected header auth as plain header, got %+v", req)
+	}
+
+	data, err := Marshal(col)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	info := decoded["info"].(map[string]any)
+	if info["name"] != "Health" || info["schema"] != SchemaV21 {
+		t.Fatalf("unexpected info %v", info)
+	}
+}
@@ -0,0 +1,537 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/unkn0wn-root/resterm/internal/postman"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/urltpl"
+)
+
+const (
+	multipartBoundary = "resterm-boundary"
+
+	jsonContentType = "application/json"
+	formContentType = "application/x-www-form-urlencoded"
+)
+
+type Builder struct {
+	names    map[string]int
+	warnings []string
+}
+
+func NewBuilder() *Builder {
+	return &Builder{names: make(map[string]int)}
+}
+
+func (b *Builder) Warnings() []string {
+	return append([]string(nil), b.warnings...)
+}
+
+func (b *Builder) noteWarning(format string, args ...any) {
+	b.warnings = append(b.warnings, fmt.Sprintf(format, args...))
+}
+
+// Generate maps each request item to a restfile request. Folder names
+// become a slash-joined @tag and auth is inherited from the closest folder
+// or the collection, as Postman does.
+func (b *Builder) Generate(
+	ctx context.Context,
+	col *postman.Collection,
+) (*restfile.Document, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if col == nil {
+		return nil, errors.New("postman: collection is nil")
+	}
+	b.names = make(map[string]int)
+	b.warnings = nil
+
+	doc := &restfile.Document{Variables: b.variables(col.Variable)}
+	if hasScripts(col.Event) {
+		b.noteWarning("collection scripts are not imported")
+	}
+	if err := b.walk(ctx, doc, col.Item, nil, col.Auth); err != nil {
+		return nil, err
+	}
+	if len(doc.Requests) == 0 {
+		b.noteWarning("collection contains no requests")
+	}
+	return doc, nil
+}
+
+func (b *Builder) walk(
+	ctx context.Context,
+	doc *restfile.Document,
+	items []postman.Item,
+	folders []string,
+	auth *postman.Auth,
+) error {
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		itemAuth := inheritAuth(item.Auth, auth)
+		if item.Request == nil {
+			if item.Item == nil {
+				continue
+			}
+			if hasScripts(item.Event) {
+				b.noteWarning("folder %q: scripts are not imported", item.Name)
+			}
+			path := append(append([]string(nil), folders...), tagName(item.Name))
+			if err := b.walk(ctx, doc, item.Item, path, itemAuth); err != nil {
+				return err
+			}
+			continue
+		}
+		doc.Requests = append(doc.Requests, b.buildRequest(item, folders, itemAuth))
+	}
+	return nil
+}
+
+func (b *Builder) buildRequest(
+	item postman.Item,
+	folders []string,
+	auth *postman.Auth,
+) *restfile.Request {
+	src := item.Request
+	label := strings.TrimSpace(item.Name)
+	if label == "" {
+		label = strings.TrimSpace(src.URL.Raw)
+	}
+
+	req := &restfile.Request{
+		Method:  strings.ToUpper(strings.TrimSpace(src.Method)),
+		URL:     requestURL(src.URL),
+		Headers: make(http.Header),
+	}
+	if req.Method == "" {
+		req.Method = http.MethodGet
+	}
+	req.URL, req.Metadata.PathParams = b.pathParams(req.URL, src.URL.Variable, label)
+	req.Metadata.Name = b.uniqueName(requestName(item.Name, req))
+	req.Metadata.Description = string(item.Description)
+	if req.Metadata.Description == "" {
+		req.Metadata.Description = string(src.Description)
+	}
+	if tag := strings.Join(folders, "/"); tag != "" {
+		req.Metadata.Tags = []string{tag}
+	}
+	for _, h := range src.Header {
+		key := strings.TrimSpace(h.Key)
+		if key == "" || h.Disabled {
+			continue
+		}
+		req.Headers.Add(key, h.Value)
+	}
+	b.applyBody(req, src.Body, label)
+	b.applyAuth(req, inheritAuth(src.Auth, auth), label)
+	if hasScripts(item.Event) {
+		b.noteWarning("request %q: scripts are not imported", label)
+	}
+	return req
+}
+
+func (b *Builder) applyBody(req *restfile.Request, body *postman.Body, label string) {
+	if body == nil || body.Disabled {
+		return
+	}
+	switch strings.ToLower(body.Mode) {
+	case "", "none":
+	case "raw":
+		req.Body.Text = body.Raw
+		if ct := rawContentType(body); ct != "" && req.Headers.Get("Content-Type") == "" {
+			req.Headers.Set("Content-Type", ct)
+		}
+	case "urlencoded":
+		pairs := make([]string, 0, len(body.URLEncoded))
+		for _, p := range body.URLEncoded {
+			if p.Disabled || strings.TrimSpace(p.Key) == "" {
+				continue
+			}
+			pairs = append(pairs, formEscape(p.Key)+"="+formEscape(p.Value))
+		}
+		req.Body.Text = strings.Join(pairs, "&")
+		if req.Headers.Get("Content-Type") == "" {
+			req.Headers.Set("Content-Type", formContentType)
+		}
+	case "formdata":
+		req.Body.Text = multipartBody(body.FormData)
+		// A user-supplied multipart header would carry a boundary that no
+		// longer matches the generated body.
+		req.Headers.Set("Content-Type", "multipart/form-data; boundary="+multipartBoundary)
+	case "file":
+		if body.File == nil || strings.TrimSpace(body.File.Src) == "" {
+			b.noteWarning("request %q: file body has no source path", label)
+			return
+		}
+		req.Body.FilePath = body.File.Src
+	case "graphql":
+		text, err := graphQLBody(body.GraphQL)
+		if err != nil {
+			b.noteWarning("request %q: %v", label, err)
+		}
+		req.Body.Text = text
+		if req.Headers.Get("Content-Type") == "" {
+			req.Headers.Set("Content-Type", jsonContentType)
+		}
+	default:
+		b.noteWarning("request %q: unsupported body mode %q", label, body.Mode)
+	}
+}
+
+// applyAuth maps Postman auth onto @auth. Types resterm has no equivalent
+// for are reported and left off.
+func (b *Builder) applyAuth(req *restfile.Request, auth *postman.Auth, label string) {
+	if auth == nil {
+		return
+	}
+	switch strings.ToLower(auth.Type) {
+	case "", "noauth", "inherit":
+	case "basic":
+		p := params(auth.Basic)
+		req.Metadata.Auth = &restfile.AuthSpec{Type: "basic", Params: map[string]string{
+			"username": p["username"],
+			"password": p["password"],
+		}}
+	case "bearer":
+		p := params(auth.Bearer)
+		req.Metadata.Auth = &restfile.AuthSpec{Type: "bearer", Params: map[string]string{
+			"token": p["token"],
+		}}
+	case "apikey":
+		p := params(auth.APIKey)
+		place := "header"
+		if strings.EqualFold(p["in"], "query") {
+			place = "query"
+		}
+		req.Metadata.Auth = &restfile.AuthSpec{Type: "apikey", Params: map[string]string{
+			"placement": place,
+			"name":      p["key"],
+			"value":     p["value"],
+		}}
+	case "oauth2":
+		req.Metadata.Auth = &restfile.AuthSpec{Type: "oauth2", Params: oauth2Params(params(auth.OAuth2))}
+		if req.Metadata.Auth.Params["token_url"] == "" {
+			b.noteWarning("request %q: oauth2 auth has no access token URL", label)
+		}
+	default:
+		b.noteWarning("request %q: unsupported auth type %q", label, auth.Type)
+	}
+}
+
+func (b *Builder) variables(vars []postman.Variable) []restfile.Variable {
+	var out []restfile.Variable
+	for _, v := range vars {
+		name := strings.TrimSpace(v.Key)
+		if name == "" || !v.Active() {
+			continue
+		}
+		if strings.ContainsAny(name, " \t") {
+			b.noteWarning("variable %q: names with spaces are not supported", name)
+			continue
+		}
+		out = append(out, restfile.Variable{
+			Name:   name,
+			Value:  v.Value,
+			Scope:  restfile.ScopeFile,
+			Secret: v.Type == "secret",
+		})
+	}
+	return out
+}
+
+func (b *Builder) uniqueName(name string) string {
+	n := b.names[name]
+	b.names[name] = n + 1
+	if n == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s%d", name, n+1)
+}
+
+// inheritAuth resolves Postman's auth inheritance: a missing block or the
+// explicit "inherit" type falls back to the parent.
+func inheritAuth(own, parent *postman.Auth) *postman.Auth {
+	if own == nil || strings.EqualFold(own.Type, "inherit") {
+		return parent
+	}
+	return own
+}
+
+func hasScripts(events []postman.Event) bool {
+	for _, ev := range events {
+		if !ev.Disabled && (ev.Listen == "prerequest" || ev.Listen == "test") {
+			return true
+		}
+	}
+	return false
+}
+
+func params(kvs []postman.KeyValue) map[string]string {
+	out := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		out[kv.Key] = kv.Value
+	}
+	return out
+}
+
+func oauth2Params(p map[string]string) map[string]string {
+	fields := []struct{ key, param string }{
+		{"accessTokenUrl", "token_url"},
+		{"authUrl", "auth_url"},
+		{"redirect_uri", "redirect_uri"},
+		{"clientId", "client_id"},
+		{"clientSecret", "client_secret"},
+		{"scope", "scope"},
+		{"username", "username"},
+		{"password", "password"},
+		{"audience", "audience"},
+	}
+	out := make(map[string]string)
+	for _, f := range fields {
+		if v := p[f.key]; v != "" {
+			out[f.param] = v
+		}
+	}
+	switch p["grant_type"] {
+	case "password_credentials":
+		out["grant"] = "password"
+	case "authorization_code", "authorization_code_with_pkce":
+		out["grant"] = "authorization_code"
+	default:
+		out["grant"] = "client_credentials"
+	}
+	if p["client_authentication"] == "body" {
+		out["client_auth"] = "body"
+	}
+	return out
+}
+
+func requestURL(u postman.URL) string {
+	if raw := strings.TrimSpace(u.Raw); raw != "" {
+		return raw
+	}
+	var b strings.Builder
+	if u.Protocol != "" {
+		b.WriteString(u.Protocol)
+		b.WriteString("://")
+	}
+	b.WriteString(strings.Join(u.Host, "."))
+	if u.Port != "" {
+		b.WriteString(":")
+		b.WriteString(u.Port)
+	}
+	if len(u.Path) > 0 {
+		b.WriteString("/")
+		b.WriteString(strings.Join(u.Path, "/"))
+	}
+	sep := "?"
+	for _, q := range u.Query {
+		if q.Disabled {
+			continue
+		}
+		b.WriteString(sep)
+		b.WriteString(q.Key)
+		b.WriteString("=")
+		b.WriteString(q.Value)
+		sep = "&"
+	}
+	return b.String()
+}
+
+// pathParams rewrites Postman :name path segments to {name} placeholders
+// filled by @path-param. Segments without a usable value stay as they are
+// and are reported.
+func (b *Builder) pathParams(
+	raw string,
+	vars []postman.KeyValue,
+	label string,
+) (string, map[string]string) {
+	if !strings.Contains(raw, "/:") {
+		return raw, nil
+	}
+	values := make(map[string]string, len(vars))
+	for _, v := range vars {
+		if key := strings.TrimSpace(v.Key); key != "" && !v.Disabled {
+			values[key] = strings.TrimSpace(v.Value)
+		}
+	}
+	path, rest := raw, ""
+	if idx := strings.IndexAny(raw, "?#"); idx >= 0 {
+		path, rest = raw[:idx], raw[idx:]
+	}
+	segs := strings.Split(path, "/")
+	var out map[string]string
+	for i, seg := range segs {
+		name, ok := strings.CutPrefix(seg, ":")
+		if !ok || name == "" {
+			continue
+		}
+		value := values[name]
+		if value == "" || !urltpl.IsPathParamName(name) {
+			b.noteWarning(
+				"request %q: path variable :%s has no value and was left in the URL",
+				label,
+				name,
+			)
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string)
+		}
+		out[name] = value
+		segs[i] = "{" + name + "}"
+	}
+	return strings.Join(segs, "/") + rest, out
+}
+
+func rawContentType(body *postman.Body) string {
+	if body.Options == nil {
+		return ""
+	}
+	switch strings.ToLower(body.Options.Raw.Language) {
+	case "json":
+		return jsonContentType
+	case "xml":
+		return "application/xml"
+	case "html":
+		return "text/html"
+	case "javascript":
+		return "application/javascript"
+	default:
+		return ""
+	}
+}
+
+func multipartBody(fields []postman.FormParam) string {
+	var b strings.Builder
+	for _, f := range fields {
+		if f.Disabled || strings.TrimSpace(f.Key) == "" {
+			continue
+		}
+		file := strings.EqualFold(f.Type, "file")
+		b.WriteString("--" + multipartBoundary + "\n")
+		b.WriteString(`Content-Disposition: form-data; name="` + escapeQuotes(f.Key) + `"`)
+		if file {
+			b.WriteString(`; filename="` + escapeQuotes(filepath.Base(f.Src)) + `"`)
+		}
+		b.WriteString("\n\n")
+		if file {
+			b.WriteString("@" + f.Src)
+		} else {
+			b.WriteString(f.Value)
+		}
+		b.WriteString("\n")
+	}
+	if b.Len() == 0 {
+		return ""
+	}
+	b.WriteString("--" + multipartBoundary + "--\n")
+	return b.String()
+}
+
+func graphQLBody(gql *postman.BodyGraphQL) (string, error) {
+	if gql == nil {
+		return "", nil
+	}
+	payload := struct {
+		Query     string          `json:"query"`
+		Variables json.RawMessage `json:"variables,omitempty"`
+	}{Query: gql.Query}
+
+	var err error
+	if vars := strings.TrimSpace(gql.Variables); vars != "" {
+		if json.Valid([]byte(vars)) {
+			payload.Variables = json.RawMessage(vars)
+		} else {
+			err = errors.New("graphql variables are not valid JSON and were dropped")
+		}
+	}
+	data, mErr := json.MarshalIndent(payload, "", "  ")
+	if mErr != nil {
+		return "", mErr
+	}
+	return string(data), err
+}
+
+// requestName turns an item name like "Get user by id" into "getUserById",
+// falling back to the method and path when the item is unnamed.
+func requestName(name string, req *restfile.Request) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		words = append([]string{strings.ToLower(req.Method)}, urlWords(req.URL)...)
+	}
+	var b strings.Builder
+	for i, w := range words {
+		r := []rune(w)
+		if i == 0 {
+			r[0] = unicode.ToLower(r[0])
+		} else {
+			r[0] = unicode.ToUpper(r[0])
+		}
+		b.WriteString(string(r))
+	}
+	if b.Len() == 0 {
+		return "request"
+	}
+	return b.String()
+}
+
+func urlWords(raw string) []string {
+	if _, after, ok := strings.Cut(raw, "://"); ok {
+		raw = after
+	}
+	raw, _, _ = strings.Cut(raw, "?")
+	_, path, _ := strings.Cut(raw, "/")
+	return strings.FieldsFunc(path, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// tagName keeps a folder name usable inside the space-separated @tag list.
+func tagName(name string) string {
+	name = strings.Join(strings.Fields(strings.ReplaceAll(name, "/", " ")), "-")
+	if name == "" {
+		return "folder"
+	}
+	return name
+}
+
+// formEscape query-escapes s for a urlencoded body, as Postman does when it
+// sends the form. {{...}} template spans are kept so they still resolve.
+func formEscape(s string) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(s, "{{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(s[start+2:], "}}")
+		if end < 0 {
+			break
+		}
+		end += start + 4
+		b.WriteString(url.QueryEscape(s[:start]))
+		b.WriteString(s[start:end])
+		s = s[end:]
+	}
+	b.WriteString(url.QueryEscape(s))
+	return b.String()
+}
+
+func escapeQuotes(s string) string {
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
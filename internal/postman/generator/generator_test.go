@@ -0,0 +1,159 @@
+package generator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/postman/parser"
+)
+
+func TestGenerateNestedFoldersInheritAuth(t *testing.T) {
+	col, err := parser.Decode([]byte(`{
+		"info": {"name": "demo"},
+		"item": [{
+			"name": "Admin Area",
+			"auth": {"type": "apikey", "apikey": [
+				{"key": "key", "value": "X-Token"},
+				{"key": "value", "value": "{{key}}"},
+				{"key": "in", "value": "header"}
+			]},
+			"item": [{
+				"name": "users/v2",
+				"item": [
+					{"name": "List", "request": "https://example.com/users"},
+					{"name": "List", "request": {"method": "get", "url": "https://example.com/users?all=1"}}
+				]
+			}]
+		}]
+	}`))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	doc, err := NewBuilder().Generate(context.Background(), col)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if len(doc.Requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doc.Requests))
+	}
+	first, second := doc.Requests[0], doc.Requests[1]
+	if first.Metadata.Name != "list" || second.Metadata.Name != "list2" {
+		t.Fatalf("unexpected names %q, %q", first.Metadata.Name, second.Metadata.Name)
+	}
+	if got := strings.Join(first.Metadata.Tags, ","); got != "Admin-Area/users-v2" {
+		t.Fatalf("unexpected tag %q", got)
+	}
+	if second.Method != "GET" {
+		t.Fatalf("expected upper-cased method, got %q", second.Method)
+	}
+	auth := first.Metadata.Auth
+	if auth == nil || auth.Type != "apikey" || auth.Params["name"] != "X-Token" {
+		t.Fatalf("expected inherited apikey auth, got %#v", auth)
+	}
+}
+
+func TestGenerateFormDataAndGraphQL(t *testing.T) {
+	col, err := parser.Decode([]byte(`{
+		"info": {"name": "demo"},
+		"item": [
+			{"name": "Upload", "request": {"method": "POST", "url": "https://example.com/up",
+				"header": [{"key": "Content-Type", "value": "multipart/form-data"}],
+				"body": {"mode": "formdata", "formdata": [
+					{"key": "title", "value": "cat", "type": "text"},
+					{"key": "file", "type": "file", "src": ["/tmp/cat.png"]},
+					{"key": "off", "value": "x", "disabled": true}
+				]}}},
+			{"name": "Query", "request": {"method": "POST", "url": "https://example.com/graphql",
+				"body": {"mode": "graphql", "graphql": {
+					"query": "query { me { id } }",
+					"variables": "{\"id\": 1}"
+				}}}}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	b := NewBuilder()
+	doc, err := b.Generate(context.Background(), col)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	upload := doc.Requests[0]
+	if ct := upload.Headers.Get("Content-Type"); ct != "multipart/form-data; boundary=resterm-boundary" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+	body := upload.Body.Text
+	if !strings.Contains(body, `name="title"`) || !strings.Contains(body, "\ncat\n") {
+		t.Fatalf("expected text part in body, got %q", body)
+	}
+	if !strings.Contains(body, `filename="cat.png"`) || !strings.Contains(body, "@/tmp/cat.png") {
+		t.Fatalf("expected file part in body, got %q", body)
+	}
+	if strings.Contains(body, `name="off"`) {
+		t.Fatalf("expected disabled part to be skipped, got %q", body)
+	}
+
+	query := doc.Requests[1]
+	if !strings.Contains(query.Body.Text, `"query": "query { me { id } }"`) ||
+		!strings.Contains(query.Body.Text, `"id": 1`) {
+		t.Fatalf("unexpected graphql body %q", query.Body.Text)
+	}
+	if ct := query.Headers.Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("expected json content type, got %q", ct)
+	}
+	if len(b.Warnings()) != 0 {
+		t.Fatalf("unexpected warnings %v", b.Warnings())
+	}
+}
+
+func TestGenerateURLEncodedEscapesValues(t *testing.T) {
+	col, err := parser.Decode([]byte(`{
+		"info": {"name": "demo"},
+		"item": [{"name": "Login", "request": {"method": "POST", "url": "https://example.com/login",
+			"body": {"mode": "urlencoded", "urlencoded": [
+				{"key": "q", "value": "a&b=c+d 50%"},
+				{"key": "user name", "value": "{{user}}@x"}
+			]}}}]
+	}`))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	doc, err := NewBuilder().Generate(context.Background(), col)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	want := "q=a%26b%3Dc%2Bd+50%25&user+name={{user}}%40x"
+	if got := doc.Requests[0].Body.Text; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestGeneratePathVariablesBecomePathParams(t *testing.T) {
+	col, err := parser.Decode([]byte(`{
+		"info": {"name": "demo"},
+		"item": [{"name": "Get", "request": {"method": "GET", "url": {
+			"raw": "{{base}}/users/:id/posts/:post?full=:x",
+			"variable": [{"key": "id", "value": "{{userId}}"}, {"key": "post", "value": ""}]
+		}}}]
+	}`))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	b := NewBuilder()
+	doc, err := b.Generate(context.Background(), col)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	req := doc.Requests[0]
+	if req.URL != "{{base}}/users/{id}/posts/:post?full=:x" {
+		t.Fatalf("unexpected url %q", req.URL)
+	}
+	if req.Metadata.PathParams["id"] != "{{userId}}" || len(req.Metadata.PathParams) != 1 {
+		t.Fatalf("unexpected path params %v", req.Metadata.PathParams)
+	}
+	if w := b.Warnings(); len(w) != 1 || !strings.Contains(w[0], ":post") {
+		t.Fatalf("expected a warning for :post, got %v", w)
+	}
+}
@@ -0,0 +1,87 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/postman"
+)
+
+type Loader struct {
+	ws []string
+}
+
+func NewLoader() *Loader {
+	return &Loader{}
+}
+
+func (l *Loader) Warnings() []string {
+	return append([]string(nil), l.ws...)
+}
+
+func (l *Loader) noteWarn(msg string) {
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return
+	}
+	l.ws = append(l.ws, msg)
+}
+
+// Parse reads a Postman collection. Only the v2.1 schema is supported;
+// v2.0 files usually decode fine and are accepted with a warning.
+func (l *Loader) Parse(ctx context.Context, path string) (*postman.Collection, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	l.ws = nil
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read Postman collection: %w", err)
+	}
+	col, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := strings.TrimSpace(col.Info.Schema)
+	switch {
+	case schema == "":
+		l.noteWarn("collection has no schema; assuming Postman v2.1")
+	case !strings.Contains(schema, "/v2.1"):
+		l.noteWarn(fmt.Sprintf("collection schema %s is not v2.1; output may be incomplete", schema))
+	}
+	return col, nil
+}
+
+// Decode parses collection JSON.
+func Decode(data []byte) (*postman.Collection, error) {
+	var col postman.Collection
+	if err := json.Unmarshal(data, &col); err != nil {
+		return nil, fmt.Errorf("decode Postman collection: %w", err)
+	}
+	if col.Info.Name == "" && len(col.Item) == 0 {
+		return nil, errors.New("decode Postman collection: no info or items found")
+	}
+	return &col, nil
+}
+
+// LoadEnvironment reads a Postman environment export.
+func LoadEnvironment(path string) (*postman.Environment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read Postman environment: %w", err)
+	}
+	var env postman.Environment
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("decode Postman environment: %w", err)
+	}
+	return &env, nil
+}
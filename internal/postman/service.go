@@ -0,0 +1,93 @@
+package postman
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+const (
+	errParserNotConfigured    = "postman: parser not configured"
+	errGeneratorNotConfigured = "postman: generator not configured"
+	errWriterNotConfigured    = "postman: writer not configured"
+)
+
+// Service converts a Postman collection into a .http file.
+type Service struct {
+	Parser    Parser
+	Generator Generator
+	Writer    DocumentWriter
+}
+
+func (s *Service) GenerateHTTPFile(
+	ctx context.Context,
+	collectionPath, outputPath string,
+	opts WriterOptions,
+) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if s.Parser == nil {
+		return errors.New(errParserNotConfigured)
+	}
+	if s.Generator == nil {
+		return errors.New(errGeneratorNotConfigured)
+	}
+	if s.Writer == nil {
+		return errors.New(errWriterNotConfigured)
+	}
+
+	col, err := s.Parser.Parse(ctx, collectionPath)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	doc, err := s.Generator.Generate(ctx, col)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if warnProvider, ok := s.Parser.(Warner); ok {
+		opts.HeaderComment = appendWarnings(opts.HeaderComment, warnProvider.Warnings())
+	}
+	if warnProvider, ok := s.Generator.(Warner); ok {
+		opts.HeaderComment = appendWarnings(opts.HeaderComment, warnProvider.Warnings())
+	}
+
+	if err := s.Writer.WriteDocument(ctx, doc, outputPath, opts); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+func appendWarnings(base string, ws []string) string {
+	if len(ws) == 0 {
+		return base
+	}
+
+	var b strings.Builder
+	txt := strings.TrimSpace(base)
+	if txt != "" {
+		b.WriteString(txt)
+	}
+
+	for _, w := range ws {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("Warning: ")
+		b.WriteString(w)
+	}
+
+	return b.String()
+}
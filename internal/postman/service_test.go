@@ -0,0 +1,64 @@
+package postman_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/postman"
+	"github.com/unkn0wn-root/resterm/internal/postman/generator"
+	"github.com/unkn0wn-root/resterm/internal/postman/parser"
+	"github.com/unkn0wn-root/resterm/internal/postman/writer"
+)
+
+func TestServiceGenerateHTTPFile(t *testing.T) {
+	t.Parallel()
+
+	svc := &postman.Service{
+		Parser:    parser.NewLoader(),
+		Generator: generator.NewBuilder(),
+		Writer:    writer.NewFileWriter(),
+	}
+	src := filepath.Join("testdata", "petstore.postman_collection.json")
+	dest := filepath.Join(t.TempDir(), "petstore.http")
+
+	opts := postman.WriterOptions{
+		HeaderComment:     "Generated by resterm tests",
+		OverwriteExisting: true,
+	}
+	if err := svc.GenerateHTTPFile(context.Background(), src, dest, opts); err != nil {
+		t.Fatalf("GenerateHTTPFile: %v", err)
+	}
+
+	got, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatalf("read generated file: %v", err)
+	}
+	want, err := os.ReadFile(filepath.Join("testdata", "petstore.http"))
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+	if strings.TrimSpace(string(got)) != strings.TrimSpace(string(want)) {
+		t.Fatalf("generated file mismatch\nGot:\n%s\nWant:\n%s", string(got), string(want))
+	}
+
+	opts.OverwriteExisting = false
+	if err := svc.GenerateHTTPFile(context.Background(), src, dest, opts); err == nil {
+		t.Fatalf("expected overwrite error")
+	}
+}
+
+func TestServiceGenerateHTTPFileRequiresParser(t *testing.T) {
+	t.Parallel()
+
+	svc := &postman.Service{
+		Generator: generator.NewBuilder(),
+		Writer:    writer.NewFileWriter(),
+	}
+	err := svc.GenerateHTTPFile(context.Background(), "x.json", "x.http", postman.WriterOptions{})
+	if err == nil || !strings.Contains(err.Error(), "parser not configured") {
+		t.Fatalf("expected parser configuration error, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package writer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/postman"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/restwriter"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+const defaultEnvironmentName = "postman"
+
+type FileWriter struct{}
+
+func NewFileWriter() *FileWriter {
+	return &FileWriter{}
+}
+
+func (w *FileWriter) WriteDocument(
+	ctx context.Context,
+	doc *restfile.Document,
+	destination string,
+	opts postman.WriterOptions,
+) error {
+	return restwriter.WriteDocument(ctx, doc, destination, restwriter.Options{
+		OverwriteExisting: opts.OverwriteExisting,
+		HeaderComment:     opts.HeaderComment,
+	})
+}
+
+// WriteEnvironment merges env into the resterm environment file at
+// destination, replacing an environment of the same name. Other
+// environments keep their order and values, and the file is rewritten
+// atomically. It returns the environment name used.
+func WriteEnvironment(env *postman.Environment, destination string) (string, error) {
+	if env == nil {
+		return "", errors.New("postman: environment is nil")
+	}
+	name := strings.TrimSpace(env.Name)
+	if name == "" {
+		name = defaultEnvironmentName
+	}
+
+	if _, err := os.Stat(destination); errors.Is(err, os.ErrNotExist) {
+		if err := os.WriteFile(destination, []byte("{}\n"), 0o644); err != nil {
+			return "", fmt.Errorf("write environment: %w", err)
+		}
+	} else if err != nil {
+		return "", err
+	}
+
+	entries := make([]vars.EnvEntry, 0, len(env.Values))
+	for _, v := range env.Values {
+		key := strings.TrimSpace(v.Key)
+		if key == "" || !v.Active() {
+			continue
+		}
+		entries = append(entries, vars.EnvEntry{Key: key, Value: v.Value})
+	}
+	if err := vars.WriteEnvironmentEntries(destination, name, entries); err != nil {
+		return "", err
+	}
+	return name, nil
+}
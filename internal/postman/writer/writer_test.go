@@ -0,0 +1,75 @@
+package writer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/postman"
+)
+
+func TestWriteEnvironmentMergesExisting(t *testing.T) {
+	dst := filepath.Join(t.TempDir(), "resterm.env.json")
+	if err := os.WriteFile(dst, []byte(`{"dev": {"baseUrl": "http://localhost"}}`), 0o644); err != nil {
+		t.Fatalf("seed env file: %v", err)
+	}
+	off := false
+	env := &postman.Environment{
+		Name: "staging",
+		Values: []postman.Variable{
+			{Key: "baseUrl", Value: "https://staging.example.com"},
+			{Key: "token", Value: "abc", Enabled: &off},
+		},
+	}
+	name, err := WriteEnvironment(env, dst)
+	if err != nil {
+		t.Fatalf("WriteEnvironment: %v", err)
+	}
+	if name != "staging" {
+		t.Fatalf("unexpected env name %q", name)
+	}
+
+	data, err := os.ReadFile(dst)
+	if err != nil {
+		t.Fatalf("read env file: %v", err)
+	}
+	var got map[string]map[string]string
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("decode env file: %v", err)
+	}
+	if got["dev"]["baseUrl"] != "http://localhost" {
+		t.Fatalf("expected existing env to be kept, got %v", got)
+	}
+	if got["staging"]["baseUrl"] != "https://staging.example.com" {
+		t.Fatalf("expected staging env, got %v", got)
+	}
+	if _, ok := got["staging"]["token"]; ok {
+		t.Fatalf("expected disabled value to be skipped, got %v", got)
+	}
+}
+
+func TestWriteEnvironmentKeepsOtherEnvironments(t *testing.T) {
+	dst := filepath.Join(t.TempDir(), "resterm.env.json")
+	seed := "{\n  \"prod\": {\n    \"z\": \"1\",\n    \"a\": 2\n  },\n" +
+		"  \"$shared\": {\n    \"k\": \"v\"\n  },\n  \"version\": 3\n}\n"
+	if err := os.WriteFile(dst, []byte(seed), 0o644); err != nil {
+		t.Fatalf("seed env file: %v", err)
+	}
+	env := &postman.Environment{
+		Name:   "dev",
+		Values: []postman.Variable{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}},
+	}
+	if _, err := WriteEnvironment(env, dst); err != nil {
+		t.Fatalf("WriteEnvironment: %v", err)
+	}
+	data, err := os.ReadFile(dst)
+	if err != nil {
+		t.Fatalf("read env file: %v", err)
+	}
+	want := strings.TrimSuffix(seed, "\n}\n") + ",\n  \"dev\": {\n    \"b\": \"2\",\n    \"a\": \"1\"\n  }\n}\n"
+	if string(data) != want {
+		t.Fatalf("expected other environments untouched:\n%s\ngot:\n%s", want, data)
+	}
+}
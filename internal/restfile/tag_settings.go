@@ -0,0 +1,32 @@
+package restfile
+
+import "strings"
+
+// TagKey normalises a tag for TagSettings lookups; tags match
+// case-insensitively.
+func TagKey(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// SettingsForTags merges the @tag-settings of every tag in tags, in order,
+// so a later tag wins over an earlier one. It returns nil when no tag has
+// settings.
+func (d *Document) SettingsForTags(tags []string) map[string]string {
+	if d == nil || len(d.TagSettings) == 0 {
+		return nil
+	}
+	var out map[string]string
+	for _, tag := range tags {
+		set := d.TagSettings[TagKey(tag)]
+		if len(set) == 0 {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(set))
+		}
+		for k, v := range set {
+			out[k] = v
+		}
+	}
+	return out
+}
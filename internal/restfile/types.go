@@ -74,6 +74,9 @@ type BodySource struct {
 
 type BodyOptions struct {
 	ExpandTemplates bool
+	// Base64 decodes the body (inline or file) to raw bytes before sending;
+	// templates are never expanded in this mode.
+	Base64 bool
 }
 
 type GraphQLBody struct {
@@ -182,20 +185,33 @@ type GRPCRequest struct {
 	FullMethod         string
 	DescriptorSet      string
 	UseReflection      bool
+	ReflectionExpr     string
 	Plaintext          bool
 	PlaintextSet       bool
 	Authority          string
+	RootCA             string
+	ServerName         string
 	Message            string
 	MessageFile        string
 	MessageExpanded    string
 	MessageExpandedSet bool
 	Metadata           []MetadataPair
+	MetadataFiles      []string
+
+	// ReflectionTarget, when set, is dialed for reflection instead of
+	// Target. It shares TLS settings with the call unless
+	// ReflectionPlaintextSet overrides plaintext for it.
+	ReflectionTarget       string
+	ReflectionPlaintext    bool
+	ReflectionPlaintextSet bool
 }
 
 type RequestMetadata struct {
 	Name                  string
 	Description           string
 	Tags                  []string
+	Group                 string
+	Env                   string
 	NoLog                 bool
 	AllowSensitiveHeaders bool
 	Auth                  *AuthSpec
@@ -209,6 +225,15 @@ type RequestMetadata struct {
 	Profile               *ProfileSpec
 	Trace                 *TraceSpec
 	Compare               *CompareSpec
+	Retry                 *RetrySpec
+	PathParams            map[string]string
+	// XMLNS maps prefixes declared with @xmlns to namespace URIs for
+	// xpath assertions.
+	XMLNS map[string]string
+	// RemoveHeaders lists headers from @remove-header that are stripped
+	// after defaults, auth and scripts have been applied.
+	RemoveHeaders []string
+	Webhook       *WebhookSpec
 }
 
 type ProfileSpec struct {
@@ -217,9 +242,37 @@ type ProfileSpec struct {
 	Delay  time.Duration
 }
 
+// RetrySpec controls automatic re-sends of an HTTP request. An empty On list
+// retries transport errors and the default retryable status codes.
+type RetrySpec struct {
+	Count             int
+	Delay             time.Duration
+	On                []int
+	RespectRetryAfter bool
+	Jitter            bool
+}
+
+// WebhookSpec starts a temporary listener for the request. Its URL is
+// available as {{$webhook}} and the first callback it receives is shown as
+// a second response. An empty Listen uses a free loopback port.
+type WebhookSpec struct {
+	Listen  string
+	Timeout time.Duration
+}
+
 type TraceSpec struct {
 	Enabled bool
 	Budgets TraceBudget
+	Export  *TraceExport
+}
+
+// TraceExport sends the spans of one request to its own OTLP collector
+// instead of the globally configured one. Endpoint and Service may hold
+// templates that are expanded when the request is sent.
+type TraceExport struct {
+	Endpoint string
+	Service  string
+	Insecure bool
 }
 
 type TraceBudget struct {
@@ -262,6 +315,74 @@ type AssertSpec struct {
 	Expression string
 	Message    string
 	Line       int
+	JSONPath   *JSONPathAssert
+	Status     *StatusAssert
+	Metric     *MetricAssert
+	Profile    *ProfileAssert
+	Cookie     *CookieAssert
+	XPath      *XPathAssert
+	// Negate inverts the result; set by a leading "not" before a built-in
+	// form, which is stripped from Expression. RTS expressions keep theirs.
+	Negate bool
+}
+
+// StatusAssert passes when the response status code falls in any of
+// Ranges. A single code is a range with Min == Max.
+type StatusAssert struct {
+	Ranges []StatusRange
+}
+
+type StatusRange struct {
+	Min int
+	Max int
+}
+
+// JSONPathAssert compares the value at Path in a JSON response body against
+// Expected using Op. An empty Op only checks that the path exists. With a
+// Quantifier ("all", "any" or "none") Path may contain [*] and the
+// comparison runs over every matched value.
+type JSONPathAssert struct {
+	Path       string
+	Quantifier string
+	Op         string
+	Expected   string
+}
+
+// CookieAssert checks the cookie Name set by the response's Set-Cookie
+// headers. An empty Op only checks that the cookie is set; otherwise the
+// value is compared with Expected using == or !=.
+type CookieAssert struct {
+	Name     string
+	Op       string
+	Expected string
+}
+
+// XPathAssert evaluates Expr against an XML body. A node-set result uses
+// the text of its first node. An empty Op only checks that the expression
+// selects something (or, for scalar results, is not false or empty);
+// otherwise the value is compared with Expected using Op.
+type XPathAssert struct {
+	Expr     string
+	Op       string
+	Expected string
+}
+
+// MetricAssert compares a numeric property of the response, such as
+// header-count, body-size (in bytes) or response-time (in nanoseconds),
+// against Value using Op.
+type MetricAssert struct {
+	Metric string
+	Op     string
+	Value  int64
+}
+
+// ProfileAssert compares a latency statistic of a @profile run, such as
+// p99 or mean, against Value using Op. It is evaluated once when the run
+// finishes instead of after every iteration.
+type ProfileAssert struct {
+	Stat  string
+	Op    string
+	Value time.Duration
 }
 
 type ApplySpec struct {
@@ -305,6 +426,18 @@ type SSEOptions struct {
 	IdleTimeout  time.Duration
 	MaxEvents    int
 	MaxBytes     int64
+	// Out appends each received event as a JSON line; relative paths
+	// resolve against the request's base directory.
+	Out       string
+	OutRotate bool
+	Buffer    StreamBuffer
+}
+
+// StreamBuffer caps how many events the UI keeps for a live stream.
+// Zero Max keeps the UI default.
+type StreamBuffer struct {
+	Max        int
+	DropNewest bool
 }
 
 type WebSocketRequest struct {
@@ -319,6 +452,10 @@ type WebSocketOptions struct {
 	Subprotocols     []string
 	Compression      bool
 	CompressionSet   bool
+	Reconnect        bool
+	MaxRetries       int
+	ReconnectBackoff time.Duration
+	Buffer           StreamBuffer
 }
 
 type WebSocketStepType string
@@ -349,20 +486,21 @@ const (
 )
 
 type Document struct {
-	Path      string
-	Variables []Variable
-	Globals   []Variable
-	Constants []Constant
-	SSH       []SSHProfile
-	K8s       []K8sProfile
-	Patches   []PatchProfile
-	Settings  map[string]string
-	Uses      []UseSpec
-	Requests  []*Request
-	Workflows []Workflow
-	Errors    []ParseError
-	Warnings  []ParseDiagnostic
-	Raw       []byte
+	Path        string
+	Variables   []Variable
+	Globals     []Variable
+	Constants   []Constant
+	SSH         []SSHProfile
+	K8s         []K8sProfile
+	Patches     []PatchProfile
+	Settings    map[string]string
+	TagSettings map[string]map[string]string
+	Uses        []UseSpec
+	Requests    []*Request
+	Workflows   []Workflow
+	Errors      []ParseError
+	Warnings    []ParseDiagnostic
+	Raw         []byte
 }
 
 type WorkflowFailureMode string
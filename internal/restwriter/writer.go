@@ -152,10 +152,16 @@ func renderRequest(b *strings.Builder, req *restfile.Request) {
 
 	renderDescription(b, req.Metadata.Description)
 	renderTags(b, req.Metadata.Tags)
+	if env := strings.TrimSpace(req.Metadata.Env); env != "" {
+		b.WriteString("# @env ")
+		b.WriteString(env)
+		b.WriteString("\n")
+	}
 	renderLoggingDirectives(b, req.Metadata)
 	renderAuth(b, req.Metadata.Auth)
 	renderSettings(b, req.Settings)
 	renderRequestVariables(b, req.Variables)
+	renderPathParams(b, req.Metadata.PathParams)
 	renderCaptures(b, req.Metadata.Captures)
 
 	b.WriteString(reqLine(req))
@@ -208,6 +214,21 @@ func renderTags(b *strings.Builder, tags []string) {
 	b.WriteString("\n")
 }
 
+func renderPathParams(b *strings.Builder, params map[string]string) {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString("# @path-param ")
+		b.WriteString(name)
+		b.WriteString(" ")
+		b.WriteString(params[name])
+		b.WriteString("\n")
+	}
+}
+
 func renderLoggingDirectives(b *strings.Builder, meta restfile.RequestMetadata) {
 	if meta.NoLog {
 		b.WriteString("# @no-log\n")
@@ -246,6 +267,23 @@ func renderAuth(b *strings.Builder, auth *restfile.AuthSpec) {
 		b.WriteString(name)
 		b.WriteString(" ")
 		b.WriteString(val)
+	case "bearer-exec":
+		command := strings.TrimSpace(auth.Params["command"])
+		if command == "" {
+			return
+		}
+		b.WriteString("# @auth bearer-exec ")
+		quote := "\""
+		if strings.Contains(command, quote) {
+			quote = "'"
+		}
+		b.WriteString(quote + command + quote)
+		for _, key := range []string{"cache_key", "timeout"} {
+			if val := strings.TrimSpace(auth.Params[key]); val != "" {
+				b.WriteString(" ")
+				b.WriteString(formatAuthParam(key, val))
+			}
+		}
 	case "oauth2":
 		formatted := formatOAuthParams(auth.Params)
 		if len(formatted) == 0 {
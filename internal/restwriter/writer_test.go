@@ -29,3 +29,12 @@ func TestRenderSettings(t *testing.T) {
 		t.Fatalf("expected request setting in output: %q", out)
 	}
 }
+
+func TestRenderPathParams(t *testing.T) {
+	req := &restfile.Request{Method: "GET", URL: "https://example.com/users/{id}/{tab}"}
+	req.Metadata.PathParams = map[string]string{"tab": "posts", "id": "{{userId}}"}
+	out := Render(&restfile.Document{Requests: []*restfile.Request{req}}, Options{})
+	if !strings.Contains(out, "# @path-param id {{userId}}\n# @path-param tab posts\n") {
+		t.Fatalf("expected sorted path params in output: %q", out)
+	}
+}
@@ -4,14 +4,17 @@ func AssertExtra(r *Resp) map[string]Value {
 	o := newRespObj("response", r)
 	code := 0
 	status := ""
+	redirects := 0
 	if r != nil {
 		code = r.Code
 		status = r.Status
+		redirects = len(r.Redirects)
 	}
 	return map[string]Value{
 		"status":     Num(float64(code)),
 		"statusCode": Num(float64(code)),
 		"statusText": Str(status),
+		"redirects":  Num(float64(redirects)),
 		"header":     NativeNamed("header", o.headerFn),
 		"text":       NativeNamed("text", o.textFn),
 	}
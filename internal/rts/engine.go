@@ -26,6 +26,7 @@ type RT struct {
 	Uses        []Use
 	BaseDir     string
 	ReadFile    func(string) ([]byte, error)
+	UsePath     func(string) (string, error)
 	AllowRandom bool
 	Site        string
 	Extra       map[string]Value
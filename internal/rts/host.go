@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 )
 
 type mapObj struct {
@@ -52,11 +53,34 @@ func (o *mapObj) requireFn(ctx *Ctx, pos Pos, args []Value) (Value, error) {
 }
 
 type Resp struct {
-	Status string
-	Code   int
-	H      map[string][]string
-	Body   []byte
-	URL    string
+	Status    string
+	Code      int
+	H         map[string][]string
+	Body      []byte
+	URL       string
+	Redirects []Hop
+	// Duration is the total time the request took, as shown in the
+	// response summary.
+	Duration time.Duration
+}
+
+// Hop is one followed redirect, oldest first in Resp.Redirects.
+type Hop struct {
+	URL      string
+	Code     int
+	Location string
+}
+
+func hopList(hops []Hop) Value {
+	out := make([]Value, 0, len(hops))
+	for _, h := range hops {
+		out = append(out, Dict(map[string]Value{
+			"url":      Str(h.URL),
+			"status":   Num(float64(h.Code)),
+			"location": Str(h.Location),
+		}))
+	}
+	return List(out)
 }
 
 type respObj struct {
@@ -112,6 +136,11 @@ func (o *respObj) GetMember(name string) (Value, bool) {
 			return Str(""), true
 		}
 		return Str(o.r.URL), true
+	case "redirects":
+		if o.r == nil {
+			return List(nil), true
+		}
+		return hopList(o.r.Redirects), true
 	case "headers":
 		m := make(map[string]Value, len(o.h))
 		for k, v := range o.h {
@@ -1,6 +1,7 @@
 package rts
 
 import (
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -9,21 +10,29 @@ type jseg struct {
 	key string
 	idx int
 	isI bool
+	all bool
+}
+
+// JSONPathGet resolves a "$.a.b[0]" style path against decoded JSON. A [*]
+// segment is skipped, as it always was, rather than expanded: "$.items[*]"
+// yields the items array itself. Use JSONPathAll to expand wildcards.
+func JSONPathGet(v any, path string) (any, bool) {
+	return jsonPathGet(v, path)
 }
 
 func jsonPathGet(v any, path string) (any, bool) {
-	p := strings.TrimSpace(path)
+	p := trimPathRoot(path)
 	if p == "" {
 		return v, true
 	}
-	if strings.HasPrefix(p, "$") {
-		p = strings.TrimPrefix(p, "$")
-		p = strings.TrimPrefix(p, ".")
-	}
 
 	segs := splitPath(p)
 	cur := v
 	for _, s := range segs {
+		// Before wildcards were parsed, splitPath dropped [*] altogether.
+		if s.all {
+			continue
+		}
 		if s.isI {
 			arr, ok := cur.([]any)
 			if !ok {
@@ -52,6 +61,68 @@ func jsonPathGet(v any, path string) (any, bool) {
 	return cur, true
 }
 
+// JSONPathAll resolves path like JSONPathGet but expands each [*] over the
+// elements of an array (or the values of an object, in key order). Matches
+// are returned in document order; elements missing the rest of the path
+// are left out.
+func JSONPathAll(v any, path string) []any {
+	p := trimPathRoot(path)
+	if p == "" {
+		return []any{v}
+	}
+	return jsonPathAll(v, splitPath(p), nil)
+}
+
+func jsonPathAll(cur any, segs []jseg, out []any) []any {
+	for i, s := range segs {
+		switch {
+		case s.all:
+			switch t := cur.(type) {
+			case []any:
+				for _, el := range t {
+					out = jsonPathAll(el, segs[i+1:], out)
+				}
+			case map[string]any:
+				keys := make([]string, 0, len(t))
+				for k := range t {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				for _, k := range keys {
+					out = jsonPathAll(t[k], segs[i+1:], out)
+				}
+			}
+			return out
+		case s.isI:
+			arr, ok := cur.([]any)
+			if !ok || s.idx < 0 || s.idx >= len(arr) {
+				return out
+			}
+			cur = arr[s.idx]
+		default:
+			obj, ok := cur.(map[string]any)
+			if !ok {
+				return out
+			}
+			val, ok := obj[s.key]
+			if !ok {
+				return out
+			}
+			cur = val
+		}
+	}
+	return append(out, cur)
+}
+
+func trimPathRoot(path string) string {
+	p := strings.TrimSpace(path)
+	if strings.HasPrefix(p, "$") {
+		p = strings.TrimPrefix(p, "$")
+		p = strings.TrimPrefix(p, ".")
+	}
+	return p
+}
+
 func splitPath(p string) []jseg {
 	var out []jseg
 	buf := strings.Builder{}
@@ -99,6 +170,9 @@ func readSeg(p string, i int) (jseg, int, bool, bool) {
 		}
 		return jseg{key: key}, ni, true, false
 	}
+	if strings.HasPrefix(p[i:], "*]") {
+		return jseg{all: true}, i + 1, true, false
+	}
 	idx, ni, ok, stop := readIdx(p, i)
 	if stop {
 		return jseg{}, 0, false, true
@@ -0,0 +1,31 @@
+package rts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJSONPathGetSkipsWildcard(t *testing.T) {
+	data := map[string]any{
+		"items": []any{
+			map[string]any{"id": "a"},
+			map[string]any{"id": "b"},
+		},
+	}
+
+	got, ok := JSONPathGet(data, "$.items[*]")
+	if !ok || !reflect.DeepEqual(got, data["items"]) {
+		t.Fatalf("expected [*] to yield the array itself, got %v, %v", got, ok)
+	}
+	if got, ok := JSONPathGet(data, "$.items[*].id"); ok {
+		t.Fatalf("expected [*].id to miss on the array, got %v", got)
+	}
+	if got, ok := JSONPathGet(data, "$.items[*][1].id"); !ok || got != "b" {
+		t.Fatalf("expected index after [*] to apply to the array, got %v, %v", got, ok)
+	}
+
+	all := JSONPathAll(data, "$.items[*].id")
+	if want := []any{"a", "b"}; !reflect.DeepEqual(all, want) {
+		t.Fatalf("expected JSONPathAll to expand [*], got %v", all)
+	}
+}
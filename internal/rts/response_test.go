@@ -71,3 +71,30 @@ func TestAssertExtra(t *testing.T) {
 		t.Fatalf("expected text match, got %+v", v)
 	}
 }
+
+func TestResponseRedirects(t *testing.T) {
+	resp := &Resp{
+		Code: 200,
+		URL:  "https://example.com/home",
+		Redirects: []Hop{
+			{URL: "https://example.com/login", Code: 302, Location: "/home"},
+		},
+	}
+	rt := RT{Res: resp, Extra: AssertExtra(resp)}
+	v := evalRT2(t, rt, "redirects == 1")
+	if v.K != VBool || v.B != true {
+		t.Fatalf("expected redirects == 1, got %+v", v)
+	}
+	v = evalRT2(t, rt, "response.redirects[0].status")
+	if v.K != VNum || v.N != 302 {
+		t.Fatalf("expected first hop status 302, got %+v", v)
+	}
+	v = evalRT2(t, rt, "response.redirects[0].location")
+	if v.K != VStr || v.S != "/home" {
+		t.Fatalf("expected first hop location, got %+v", v)
+	}
+	v = evalRT2(t, rt, "len(response.redirects)")
+	if v.K != VNum || v.N != 1 {
+		t.Fatalf("expected one hop, got %+v", v)
+	}
+}
@@ -2,6 +2,8 @@ package rts
 
 import (
 	"encoding/json"
+	"errors"
+	"io/fs"
 	"path/filepath"
 	"strings"
 )
@@ -35,6 +37,9 @@ func jsonFile(ctx *Ctx, pos Pos, args []Value) (Value, error) {
 	}
 
 	data, err := ctx.ReadFile(path)
+	if errors.Is(err, fs.ErrPermission) {
+		return Null(), rtErr(ctx, pos, "file read failed: %v", err)
+	}
 	if err != nil {
 		return Null(), rtErr(ctx, pos, "file read failed")
 	}
@@ -16,6 +16,13 @@ func (e *Eng) resolveUses(cx *Ctx, rt RT, pre map[string]Value, pos Pos) ([]Use,
 		if !ok {
 			continue
 		}
+		if rt.UsePath != nil {
+			p, err := rt.UsePath(u.Path)
+			if err != nil {
+				return nil, rtErr(cx, pos, "use %s: %v", u.Path, err)
+			}
+			u.Path = p
+		}
 		al := u.Alias
 		if al == "" {
 			nm, mp, err := e.modHead(rt.BaseDir, u.Path)
@@ -3,6 +3,8 @@ package scripts
 import (
 	"net/http"
 	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
 )
 
 type ResponseKind string
@@ -24,6 +26,7 @@ type Response struct {
 	WireContentType string
 	// ContentType carries the best-known type for the Body payload (may be empty).
 	ContentType string
+	Redirects   []httpclient.Redirect
 }
 
 func (r *Response) Clone() *Response {
@@ -47,5 +50,6 @@ func (r *Response) Clone() *Response {
 	clone.Header = copyHeaders(r.Header)
 	clone.Body = append([]byte(nil), r.Body...)
 	clone.Wire = append([]byte(nil), r.Wire...)
+	clone.Redirects = append([]httpclient.Redirect(nil), r.Redirects...)
 	return &clone
 }
@@ -21,7 +21,8 @@ import (
 )
 
 type Runner struct {
-	fs httpclient.FileSystem
+	fs      httpclient.FileSystem
+	sandbox bool
 }
 
 func NewRunner(fs httpclient.FileSystem) *Runner {
@@ -31,6 +32,12 @@ func NewRunner(fs httpclient.FileSystem) *Runner {
 	return &Runner{fs: fs}
 }
 
+// SetSandbox toggles sandbox mode. Sandboxed scripts cannot write files
+// and can only load script files from the request file's directory.
+func (r *Runner) SetSandbox(on bool) {
+	r.sandbox = on
+}
+
 type GlobalValue struct {
 	Name   string
 	Value  string
@@ -235,6 +242,7 @@ func (r *Runner) executeTestScript(
 	streamInfo := input.Stream.Clone()
 	tester := newTestAPI(input.Response, input.Variables, input.Globals, streamInfo, input.Trace)
 	tester.vm = vm
+	tester.sandbox = r.sandbox
 	streamBinding := newStreamAPI(vm, streamInfo)
 
 	if err := bindCommon(vm); err != nil {
@@ -308,7 +316,13 @@ func (r *Runner) loadScript(block restfile.ScriptBlock, baseDir string) (string,
 	}
 
 	path := block.FilePath
-	if !filepath.IsAbs(path) && baseDir != "" {
+	if r.sandbox {
+		p, err := SandboxPath(baseDir, path)
+		if err != nil {
+			return "", err
+		}
+		path = p
+	} else if !filepath.IsAbs(path) && baseDir != "" {
 		path = filepath.Join(baseDir, path)
 	}
 
@@ -506,6 +520,7 @@ type testAPI struct {
 	stream    *StreamInfo
 	trace     *traceBinding
 	vm        *goja.Runtime
+	sandbox   bool
 }
 
 func newTestAPI(
@@ -784,6 +799,9 @@ func (api *testAPI) responseAPI() map[string]interface{} {
 			if trimmed == "" {
 				return false
 			}
+			if api.sandbox {
+				panic(api.vm.NewGoError(sandboxBlocked("response.saveBody cannot write files")))
+			}
 			src := api.response.Wire
 			if len(src) == 0 {
 				src = api.response.Body
@@ -9,9 +9,11 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
 	"github.com/unkn0wn-root/resterm/internal/nettrace"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
 )
@@ -612,3 +614,78 @@ func TestResponseAPIExposesBinaryHelpers(t *testing.T) {
 		t.Fatalf("saved body mismatch, got %v want %v", data, body)
 	}
 }
+
+func TestSandboxBlocksSaveBodyAndOutsideScriptFiles(t *testing.T) {
+	runner := NewRunner(nil)
+	runner.SetSandbox(true)
+	root := t.TempDir()
+	baseDir := filepath.Join(root, "shared")
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	src := []byte(`vars.set("x", "1");`)
+	outside := filepath.Join(root, "outside.js")
+	for _, path := range []string{outside, filepath.Join(baseDir, "pre.js")} {
+		if err := os.WriteFile(path, src, 0o644); err != nil {
+			t.Fatalf("write script: %v", err)
+		}
+	}
+
+	savePath := filepath.Join(baseDir, "body.bin")
+	script := fmt.Sprintf(`response.saveBody(%q);`, savePath)
+	_, _, err := runner.RunTests(
+		[]restfile.ScriptBlock{{Kind: "test", Body: script}},
+		TestInput{Response: &Response{Kind: ResponseKindHTTP, Body: []byte("x")}, BaseDir: baseDir},
+	)
+	if err == nil || !strings.Contains(err.Error(), "operation blocked in sandbox") {
+		t.Fatalf("expected sandbox error for saveBody, got %v", err)
+	}
+	if _, statErr := os.Stat(savePath); !errors.Is(statErr, os.ErrNotExist) {
+		t.Fatalf("expected no file written, stat err %v", statErr)
+	}
+
+	run := func(path string) error {
+		_, err := runner.RunPreRequest(
+			[]restfile.ScriptBlock{{Kind: "pre-request", FilePath: path}},
+			PreRequestInput{Request: &restfile.Request{}, BaseDir: baseDir},
+		)
+		return err
+	}
+	if err := run("pre.js"); err != nil {
+		t.Fatalf("expected script inside base dir to load, got %v", err)
+	}
+	for _, path := range []string{"../outside.js", outside} {
+		if err := run(path); !errors.Is(err, ErrSandboxBlocked) {
+			t.Fatalf("expected %s to be blocked, got %v", path, err)
+		}
+	}
+}
+
+func TestSandboxPathRejectsSymlinkEscape(t *testing.T) {
+	root := t.TempDir()
+	baseDir := filepath.Join(root, "shared")
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.Symlink(root, filepath.Join(baseDir, "link")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+	if _, err := SandboxPath(baseDir, "link/secret.json"); !errors.Is(err, ErrSandboxBlocked) {
+		t.Fatalf("expected symlink escape to be blocked, got %v", err)
+	}
+	if _, err := SandboxPath(baseDir, "data/users.json"); err != nil {
+		t.Fatalf("expected nested path to be allowed, got %v", err)
+	}
+	if _, err := SandboxPath("", "users.json"); !errors.Is(err, ErrSandboxBlocked) {
+		t.Fatalf("expected unsaved file to block access, got %v", err)
+	}
+}
+
+func TestResponseCloneCopiesRedirects(t *testing.T) {
+	resp := &Response{Redirects: []httpclient.Redirect{{URL: "http://a", StatusCode: 302}}}
+	clone := resp.Clone()
+	clone.Redirects[0].URL = "http://b"
+	if resp.Redirects[0].URL != "http://a" {
+		t.Fatalf("expected clone to own its redirects, got %q", resp.Redirects[0].URL)
+	}
+}
@@ -0,0 +1,84 @@
+package scripts
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// ErrSandboxBlocked is returned when a script asks for something the
+// sandbox does not allow. It also matches fs.ErrPermission so readers
+// that only know about permission errors still report it verbatim.
+var ErrSandboxBlocked = sandboxErr{}
+
+type sandboxErr struct{}
+
+func (sandboxErr) Error() string { return "operation blocked in sandbox" }
+
+func (sandboxErr) Is(target error) bool { return target == fs.ErrPermission }
+
+func sandboxBlocked(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrSandboxBlocked, fmt.Sprintf(format, args...))
+}
+
+// SandboxPath resolves path against baseDir and refuses anything that
+// lands outside of it. Symlinks are followed where they exist so a link
+// inside the directory cannot point the script somewhere else.
+func SandboxPath(baseDir, path string) (string, error) {
+	if strings.TrimSpace(baseDir) == "" {
+		return "", sandboxBlocked("file access requires a saved request file")
+	}
+	base, err := filepath.Abs(baseDir)
+	if err != nil {
+		return "", err
+	}
+	p := path
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(base, p)
+	}
+	p = filepath.Clean(p)
+	if !within(base, p) || !within(resolveLinks(base), resolveLinks(p)) {
+		return "", sandboxBlocked("%s is outside %s", path, base)
+	}
+	return p, nil
+}
+
+// SandboxReadFile wraps read so it only serves files under baseDir.
+func SandboxReadFile(
+	baseDir string,
+	read func(string) ([]byte, error),
+) func(string) ([]byte, error) {
+	return func(path string) ([]byte, error) {
+		p, err := SandboxPath(baseDir, path)
+		if err != nil {
+			return nil, err
+		}
+		return read(p)
+	}
+}
+
+func within(base, path string) bool {
+	rel, err := filepath.Rel(base, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolveLinks resolves the longest existing prefix of path so missing
+// files still compare against the real location of their directory.
+func resolveLinks(path string) string {
+	rest := ""
+	for p := path; ; {
+		if resolved, err := filepath.EvalSymlinks(p); err == nil {
+			return filepath.Join(resolved, rest)
+		}
+		parent := filepath.Dir(p)
+		if parent == p {
+			return path
+		}
+		rest = filepath.Join(filepath.Base(p), rest)
+		p = parent
+	}
+}
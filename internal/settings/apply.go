@@ -77,6 +77,60 @@ func ApplyHTTPSettings(
 		}
 		opts.HTTPVersion = v
 	}
+	if raw, ok := norm["compression"]; ok {
+		enc, valid := httpclient.ParseCompression(raw)
+		if !valid {
+			return errdef.New(
+				errdef.CodeHTTP,
+				"invalid compression %q (use gzip, deflate or none)",
+				raw,
+			)
+		}
+		opts.Compression = enc
+	}
+	if raw, ok := norm["expect-continue"]; ok {
+		on, wait, err := httpclient.ParseExpectContinue(raw)
+		if err != nil {
+			return err
+		}
+		opts.ExpectContinue = on
+		opts.ExpectTimeout = wait
+	}
+	if raw := firstSetting(norm, "resolve", "dns-override"); raw != "" {
+		overrides, err := parseResolveOverrides(raw, resolver)
+		if err != nil {
+			return err
+		}
+		opts.Resolve = overrides
+	}
+	if raw := firstSetting(norm, "dns-server"); raw != "" {
+		if resolver != nil {
+			expanded, err := resolver.ExpandTemplates(raw)
+			if err != nil {
+				return errdef.Wrap(errdef.CodeHTTP, err, "expand dns-server")
+			}
+			raw = expanded
+		}
+		server, err := httpclient.ParseDNSServer(raw)
+		if err != nil {
+			return err
+		}
+		opts.DNSServer = server
+	}
+	if raw := firstSetting(norm, "local-address"); raw != "" {
+		if resolver != nil {
+			expanded, err := resolver.ExpandTemplates(raw)
+			if err != nil {
+				return errdef.Wrap(errdef.CodeHTTP, err, "expand local-address")
+			}
+			raw = expanded
+		}
+		ip, err := httpclient.ParseLocalAddress(raw)
+		if err != nil {
+			return err
+		}
+		opts.LocalAddr = ip
+	}
 	if value, ok := norm["timeout"]; ok {
 		if dur, err := time.ParseDuration(value); err == nil {
 			opts.Timeout = dur
@@ -85,6 +139,26 @@ func ApplyHTTPSettings(
 	if value, ok := norm["proxy"]; ok && strings.TrimSpace(value) != "" {
 		opts.ProxyURL = value
 	}
+	if value, ok := norm["accept"]; ok && strings.TrimSpace(value) != "" {
+		opts.Accept = strings.TrimSpace(value)
+	}
+	if value, ok := norm["accept-encoding"]; ok && strings.TrimSpace(value) != "" {
+		opts.AcceptEncoding = strings.TrimSpace(value)
+	}
+	if value, ok := norm["request-id-header"]; ok {
+		name, err := httpclient.ParseRequestIDHeader(value)
+		if err != nil {
+			return err
+		}
+		opts.RequestIDHeader = name
+	}
+	if raw := firstSetting(norm, "max-response-headers"); raw != "" {
+		n, err := httpclient.ParseHeaderLimit(raw)
+		if err != nil {
+			return err
+		}
+		opts.MaxHeaderBytes = n
+	}
 	if value, ok := norm["followredirects"]; ok {
 		if b, err := strconv.ParseBool(value); err == nil {
 			opts.FollowRedirects = b
@@ -95,9 +169,42 @@ func ApplyHTTPSettings(
 			opts.InsecureSkipVerify = b
 		}
 	}
+	if value, ok := norm["keep-alive"]; ok {
+		if b, err := strconv.ParseBool(value); err == nil {
+			opts.DisableKeepAlives = !b
+		}
+	}
+	if value, ok := norm["capture-wire"]; ok {
+		if b, err := strconv.ParseBool(value); err == nil {
+			opts.CaptureWire = b
+		}
+	}
 	return nil
 }
 
+func parseResolveOverrides(
+	raw string,
+	resolver *vars.Resolver,
+) ([]httpclient.ResolveOverride, error) {
+	specs := splitList(raw)
+	out := make([]httpclient.ResolveOverride, 0, len(specs))
+	for _, spec := range specs {
+		if resolver != nil {
+			expanded, err := resolver.ExpandTemplates(spec)
+			if err != nil {
+				return nil, errdef.Wrap(errdef.CodeHTTP, err, "expand resolve")
+			}
+			spec = expanded
+		}
+		o, err := httpclient.ParseResolve(spec)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, o)
+	}
+	return out, nil
+}
+
 func applyTLSSettings(
 	cfg *tlsconfig.Files,
 	settings map[string]string,
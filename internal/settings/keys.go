@@ -6,7 +6,10 @@ import "strings"
 func IsHTTPKey(key string) bool {
 	k := strings.ToLower(strings.TrimSpace(key))
 	switch k {
-	case "timeout", "proxy", "followredirects", "insecure":
+	case "timeout", "proxy", "followredirects", "insecure", "compression",
+		"resolve", "dns-override", "dns-server", "local-address", "accept",
+		"accept-encoding", "request-id-header",
+		"max-response-headers", "keep-alive", "expect-continue", "capture-wire":
 		return true
 	default:
 		return strings.HasPrefix(k, "http-")
@@ -8,6 +8,11 @@ func TestIsHTTPKey(t *testing.T) {
 		"proxy",
 		"followredirects",
 		"insecure",
+		"compression",
+		"resolve",
+		"dns-override",
+		"dns-server",
+		"local-address",
 		"http-version",
 		"http-root-cas",
 		"HTTP-CLIENT-CERT",
@@ -102,3 +102,198 @@ func TestApplyAllHTTPAggregated(t *testing.T) {
 		t.Fatalf("unexpected client cert/key: %q / %q", httpOpts.ClientCert, httpOpts.ClientKey)
 	}
 }
+
+func TestApplyHTTPSettingsCompression(t *testing.T) {
+	httpOpts := httpclient.Options{}
+	if err := ApplyHTTPSettings(&httpOpts, map[string]string{"Compression": "GZIP"}, nil); err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.Compression != httpclient.CompressionGzip {
+		t.Fatalf("expected gzip compression, got %q", httpOpts.Compression)
+	}
+	if err := ApplyHTTPSettings(&httpOpts, map[string]string{"compression": "none"}, nil); err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.Compression != "" {
+		t.Fatalf("expected compression disabled, got %q", httpOpts.Compression)
+	}
+	err := ApplyHTTPSettings(&httpOpts, map[string]string{"compression": "brotli"}, nil)
+	if err == nil {
+		t.Fatalf("expected error for unsupported compression")
+	}
+}
+
+func TestApplyHTTPSettingsResolve(t *testing.T) {
+	httpOpts := httpclient.Options{}
+	err := ApplyHTTPSettings(
+		&httpOpts,
+		map[string]string{"resolve": "api.example.com=127.0.0.1:8443, web.test:443=::1"},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	want := []httpclient.ResolveOverride{
+		{Host: "api.example.com", Addr: "127.0.0.1:8443"},
+		{Host: "web.test", Port: "443", Addr: "::1"},
+	}
+	if len(httpOpts.Resolve) != len(want) {
+		t.Fatalf("expected %d overrides, got %+v", len(want), httpOpts.Resolve)
+	}
+	for i, o := range want {
+		if httpOpts.Resolve[i] != o {
+			t.Fatalf("override %d: expected %+v, got %+v", i, o, httpOpts.Resolve[i])
+		}
+	}
+	err = ApplyHTTPSettings(&httpOpts, map[string]string{"resolve": "api.example.com"}, nil)
+	if err == nil {
+		t.Fatalf("expected error for resolve without address")
+	}
+}
+
+func TestApplyHTTPSettingsAccept(t *testing.T) {
+	httpOpts := httpclient.Options{Accept: "application/json"}
+	err := ApplyHTTPSettings(&httpOpts, map[string]string{"Accept": " text/plain "}, nil)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.Accept != "text/plain" {
+		t.Fatalf("expected accept override, got %q", httpOpts.Accept)
+	}
+	if !IsHTTPKey("accept") {
+		t.Fatalf("expected accept to be an HTTP setting key")
+	}
+	err = ApplyHTTPSettings(&httpOpts, map[string]string{"accept-encoding": "gzip, br"}, nil)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.AcceptEncoding != "gzip, br" || !IsHTTPKey("accept-encoding") {
+		t.Fatalf("expected accept-encoding setting, got %q", httpOpts.AcceptEncoding)
+	}
+}
+
+func TestApplyHTTPSettingsRequestIDHeader(t *testing.T) {
+	if !IsHTTPKey("request-id-header") {
+		t.Fatalf("expected request-id-header to be an HTTP setting key")
+	}
+	httpOpts := httpclient.Options{}
+	err := ApplyHTTPSettings(
+		&httpOpts,
+		map[string]string{"request-id-header": "x-request-id"},
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.RequestIDHeader != "X-Request-Id" {
+		t.Fatalf("expected canonical header name, got %q", httpOpts.RequestIDHeader)
+	}
+	err = ApplyHTTPSettings(&httpOpts, map[string]string{"request-id-header": "off"}, nil)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.RequestIDHeader != "" {
+		t.Fatalf("expected off to clear the header, got %q", httpOpts.RequestIDHeader)
+	}
+	err = ApplyHTTPSettings(&httpOpts, map[string]string{"request-id-header": "bad header"}, nil)
+	if err == nil {
+		t.Fatalf("expected error for invalid header name")
+	}
+}
+
+func TestApplyHTTPSettingsDNSServer(t *testing.T) {
+	httpOpts := httpclient.Options{}
+	err := ApplyHTTPSettings(&httpOpts, map[string]string{"dns-server": "8.8.8.8"}, nil)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.DNSServer != "8.8.8.8:53" {
+		t.Fatalf("expected default dns port, got %q", httpOpts.DNSServer)
+	}
+	err = ApplyHTTPSettings(&httpOpts, map[string]string{"dns-server": "[::1]:5353"}, nil)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.DNSServer != "[::1]:5353" {
+		t.Fatalf("expected ipv6 dns server, got %q", httpOpts.DNSServer)
+	}
+	err = ApplyHTTPSettings(&httpOpts, map[string]string{"dns-server": "bad host:53"}, nil)
+	if err == nil {
+		t.Fatalf("expected error for invalid dns-server")
+	}
+}
+
+func TestApplyHTTPSettingsLocalAddress(t *testing.T) {
+	httpOpts := httpclient.Options{}
+	err := ApplyHTTPSettings(&httpOpts, map[string]string{"local-address": "10.0.0.5"}, nil)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.LocalAddr != "10.0.0.5" {
+		t.Fatalf("expected local address, got %q", httpOpts.LocalAddr)
+	}
+	err = ApplyHTTPSettings(&httpOpts, map[string]string{"local-address": "[fe80::1]"}, nil)
+	if err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.LocalAddr != "fe80::1" {
+		t.Fatalf("expected ipv6 local address, got %q", httpOpts.LocalAddr)
+	}
+	err = ApplyHTTPSettings(&httpOpts, map[string]string{"local-address": "10.0.0.5:8080"}, nil)
+	if err == nil {
+		t.Fatalf("expected error for local-address with a port")
+	}
+}
+
+func TestApplyHTTPSettingsKeepAlive(t *testing.T) {
+	httpOpts := httpclient.Options{}
+	if !IsHTTPKey("keep-alive") {
+		t.Fatalf("expected keep-alive to be an HTTP setting key")
+	}
+	if err := ApplyHTTPSettings(
+		&httpOpts,
+		map[string]string{"keep-alive": "false"},
+		nil,
+	); err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if !httpOpts.DisableKeepAlives {
+		t.Fatalf("expected keep-alive false to disable keep-alives")
+	}
+}
+
+func TestApplyHTTPSettingsMaxResponseHeaders(t *testing.T) {
+	httpOpts := httpclient.Options{}
+	settings := map[string]string{"max-response-headers": "256KB"}
+	if err := ApplyHTTPSettings(&httpOpts, settings, nil); err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if httpOpts.MaxHeaderBytes != 256<<10 {
+		t.Fatalf("expected 256KB limit, got %d", httpOpts.MaxHeaderBytes)
+	}
+	if !IsHTTPKey("max-response-headers") {
+		t.Fatalf("expected max-response-headers to be an HTTP setting key")
+	}
+	settings["max-response-headers"] = "lots"
+	if err := ApplyHTTPSettings(&httpOpts, settings, nil); err == nil {
+		t.Fatalf("expected error for invalid max-response-headers")
+	}
+}
+
+func TestApplyHTTPSettingsExpectContinue(t *testing.T) {
+	httpOpts := httpclient.Options{}
+	settings := map[string]string{"expect-continue": "2s"}
+	if err := ApplyHTTPSettings(&httpOpts, settings, nil); err != nil {
+		t.Fatalf("ApplyHTTPSettings returned error: %v", err)
+	}
+	if !httpOpts.ExpectContinue || httpOpts.ExpectTimeout != 2*time.Second {
+		t.Fatalf("expected expect-continue with 2s wait, got %+v", httpOpts)
+	}
+	if !IsHTTPKey("expect-continue") {
+		t.Fatalf("expected expect-continue to be an HTTP setting key")
+	}
+	settings["expect-continue"] = "maybe"
+	if err := ApplyHTTPSettings(&httpOpts, settings, nil); err == nil {
+		t.Fatalf("expected error for invalid expect-continue")
+	}
+}
@@ -105,31 +105,44 @@ func mergeProfile(base restfile.SSHProfile, override restfile.SSHProfile) restfi
 	return out
 }
 
+type profileField struct {
+	name   string
+	val    *string
+	secret bool
+}
+
+// expandProfile expands templates in every string field after file/global
+// profiles and inline overrides have been merged, so a shared profile can
+// pick up per-environment hosts and credentials. Errors name the field but
+// never its value, keeping expanded secrets out of status lines.
 func expandProfile(p restfile.SSHProfile, resolver *vars.Resolver) (restfile.SSHProfile, error) {
-	fields := []*string{
-		&p.Name,
-		&p.Host,
-		&p.User,
-		&p.Pass,
-		&p.Key,
-		&p.KeyPass,
-		&p.KnownHosts,
-		&p.PortStr,
-		&p.TimeoutStr,
-		&p.KeepAliveStr,
-		&p.RetriesStr,
+	fields := []profileField{
+		{name: "name", val: &p.Name},
+		{name: "host", val: &p.Host},
+		{name: "user", val: &p.User},
+		{name: "password", val: &p.Pass, secret: true},
+		{name: "key", val: &p.Key},
+		{name: "passphrase", val: &p.KeyPass, secret: true},
+		{name: "known_hosts", val: &p.KnownHosts},
+		{name: "port", val: &p.PortStr},
+		{name: "timeout", val: &p.TimeoutStr},
+		{name: "keepalive", val: &p.KeepAliveStr},
+		{name: "retries", val: &p.RetriesStr},
 	}
 
 	for _, field := range fields {
-		val := strings.TrimSpace(*field)
+		val := strings.TrimSpace(*field.val)
 		if val == "" {
 			continue
 		}
 		expanded, err := connprofile.ExpandValue(val, resolver)
 		if err != nil {
-			return restfile.SSHProfile{}, err
+			if field.secret {
+				return restfile.SSHProfile{}, fmt.Errorf("ssh %s: expand failed", field.name)
+			}
+			return restfile.SSHProfile{}, fmt.Errorf("ssh %s: %w", field.name, err)
 		}
-		*field = expanded
+		*field.val = expanded
 	}
 
 	return p, nil
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/vars"
 )
 
 func TestResolveUseMissingWithInline(t *testing.T) {
@@ -41,3 +42,84 @@ func TestResolveUseWithInlineOverrides(t *testing.T) {
 		t.Fatalf("expected inline host override, got %q", cfg.Host)
 	}
 }
+
+func TestResolveExpandsTemplatesInProfiles(t *testing.T) {
+	env := vars.NewEnvironmentProvider("staging", map[string]string{
+		"bastion":  "bastion.staging.internal",
+		"ssh_user": "deploy",
+		"ssh_port": "2222",
+		"ssh_pass": "s3cret",
+		"ssh_key":  "/keys/staging",
+		"ssh_kp":   "key-pass",
+	}, "")
+	resolver := vars.NewResolver(env)
+
+	fileProfiles := []restfile.SSHProfile{{
+		Scope:   restfile.SSHScopeFile,
+		Name:    "edge",
+		Host:    "{{bastion}}",
+		PortStr: "{{ssh_port}}",
+		User:    "{{ssh_user}}",
+		Pass:    "{{ssh_pass}}",
+	}}
+	globalProfiles := []restfile.SSHProfile{{
+		Scope:   restfile.SSHScopeGlobal,
+		Name:    "shared",
+		Host:    "{{bastion}}",
+		Key:     "{{ssh_key}}",
+		KeyPass: "{{ssh_kp}}",
+	}}
+
+	cfg, err := Resolve(&restfile.SSHSpec{Use: "edge"}, fileProfiles, globalProfiles, resolver, "")
+	if err != nil {
+		t.Fatalf("resolve file profile: %v", err)
+	}
+	if cfg.Host != "bastion.staging.internal" || cfg.User != "deploy" || cfg.Port != 2222 {
+		t.Fatalf("unexpected file profile expansion: %+v", cfg)
+	}
+	if cfg.Pass != "s3cret" {
+		t.Fatalf("expected password to expand")
+	}
+
+	cfg, err = Resolve(&restfile.SSHSpec{Use: "shared"}, fileProfiles, globalProfiles, resolver, "")
+	if err != nil {
+		t.Fatalf("resolve global profile: %v", err)
+	}
+	if cfg.Host != "bastion.staging.internal" || cfg.KeyPath != "/keys/staging" {
+		t.Fatalf("unexpected global profile expansion: %+v", cfg)
+	}
+	if cfg.KeyPass != "key-pass" {
+		t.Fatalf("expected passphrase to expand")
+	}
+}
+
+func TestResolveSecretExpansionErrorOmitsValue(t *testing.T) {
+	spec := &restfile.SSHSpec{
+		Inline: &restfile.SSHProfile{
+			Host: "jump",
+			Pass: "hunter2-{{missing_secret}}",
+		},
+	}
+	resolver := vars.NewResolver(vars.NewMapProvider("x", map[string]string{}))
+
+	_, err := Resolve(spec, nil, nil, resolver, "")
+	if err == nil {
+		t.Fatalf("expected error for undefined secret template")
+	}
+	if !strings.Contains(err.Error(), "ssh password") {
+		t.Fatalf("expected field name in error, got %v", err)
+	}
+	if strings.Contains(err.Error(), "hunter2") {
+		t.Fatalf("secret leaked into error: %v", err)
+	}
+}
+
+func TestResolveHostExpansionErrorNamesField(t *testing.T) {
+	spec := &restfile.SSHSpec{Inline: &restfile.SSHProfile{Host: "{{missing_host}}"}}
+	resolver := vars.NewResolver(vars.NewMapProvider("x", map[string]string{}))
+
+	_, err := Resolve(spec, nil, nil, resolver, "")
+	if err == nil || !strings.Contains(err.Error(), "ssh host") {
+		t.Fatalf("expected ssh host error, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Exports hands out instrumenters for requests that export their spans to
+// their own collector. Requests naming the same target share one provider
+// so spans are batched together and flushed on Shutdown.
+type Exports struct {
+	base Config
+	opts []Option
+
+	mu        sync.Mutex
+	providers map[string]Instrumenter
+}
+
+// NewExports returns an empty cache. Dial timeout and version of a
+// per-request target are taken from base. Headers of base often carry
+// collector credentials, so they are only sent to base's own endpoint.
+func NewExports(base Config, opts ...Option) *Exports {
+	return &Exports{base: base, opts: opts}
+}
+
+// For returns the instrumenter for target, creating it on first use. Only
+// Endpoint, ServiceName and Insecure are read from target.
+func (e *Exports) For(target Config) (Instrumenter, error) {
+	if e == nil {
+		return Noop(), nil
+	}
+	cfg := e.config(target)
+	if !cfg.Enabled() {
+		return nil, errors.New("trace export endpoint is required")
+	}
+
+	key := cfg.Endpoint + "\x00" + cfg.ServiceName + "\x00" + strconv.FormatBool(cfg.Insecure)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if inst, ok := e.providers[key]; ok {
+		return inst, nil
+	}
+	inst, err := New(cfg, e.opts...)
+	if err != nil {
+		return nil, err
+	}
+	if e.providers == nil {
+		e.providers = make(map[string]Instrumenter)
+	}
+	e.providers[key] = inst
+	return inst, nil
+}
+
+func (e *Exports) config(target Config) Config {
+	cfg := e.base
+	cfg.Endpoint = strings.TrimSpace(target.Endpoint)
+	if cfg.Endpoint != strings.TrimSpace(e.base.Endpoint) {
+		cfg.Headers = nil
+	}
+	cfg.Insecure = target.Insecure
+	if name := strings.TrimSpace(target.ServiceName); name != "" {
+		cfg.ServiceName = name
+	}
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = Default().ServiceName
+	}
+	return cfg
+}
+
+// Shutdown flushes and closes every provider created so far.
+func (e *Exports) Shutdown(ctx context.Context) error {
+	if e == nil {
+		return nil
+	}
+	e.mu.Lock()
+	providers := e.providers
+	e.providers = nil
+	e.mu.Unlock()
+
+	var errs []error
+	for _, inst := range providers {
+		if err := inst.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -140,3 +140,40 @@ func assertAttribute(t *testing.T, span sdktrace.ReadOnlySpan, key string, want
 	}
 	t.Fatalf("attribute %s not found", key)
 }
+
+func TestExportsReuseProviderPerTarget(t *testing.T) {
+	exports := NewExports(Default(), WithExporter(tracetest.NewInMemoryExporter()))
+	t.Cleanup(func() {
+		_ = exports.Shutdown(context.Background())
+	})
+
+	first, err := exports.For(Config{Endpoint: "otel:4317"})
+	if err != nil {
+		t.Fatalf("For: %v", err)
+	}
+	again, _ := exports.For(Config{Endpoint: " otel:4317 "})
+	other, _ := exports.For(Config{Endpoint: "otel:4317", ServiceName: "billing"})
+	if first != again {
+		t.Fatalf("expected the same provider for the same target")
+	}
+	if first == other {
+		t.Fatalf("expected a separate provider for a different service")
+	}
+	if _, err := exports.For(Config{Endpoint: "  "}); err == nil {
+		t.Fatalf("expected error for empty endpoint")
+	}
+}
+
+func TestExportsKeepGlobalHeadersOnGlobalEndpoint(t *testing.T) {
+	base := Default()
+	base.Endpoint = "collector:4317"
+	base.Headers = map[string]string{"authorization": "Bearer secret"}
+	exports := NewExports(base)
+
+	if got := exports.config(Config{Endpoint: "other:4317"}).Headers; len(got) != 0 {
+		t.Fatalf("expected no global headers for another endpoint, got %v", got)
+	}
+	if got := exports.config(Config{Endpoint: " collector:4317 "}).Headers; got["authorization"] == "" {
+		t.Fatalf("expected global headers for the global endpoint, got %v", got)
+	}
+}
@@ -288,28 +288,36 @@ func DefaultTheme() Theme {
 			RTSKeywordLiteral: lipgloss.Color("#6EF17E"),
 			RTSKeywordLogical: lipgloss.Color("#FF8B39"),
 			DirectiveColors: map[string]lipgloss.Color{
-				"name":              directiveAccent,
-				"description":       directiveAccent,
-				"desc":              directiveAccent,
-				"tag":               directiveAccent,
-				"auth":              directiveAccent,
-				"graphql":           directiveAccent,
-				"graphql-operation": directiveAccent,
-				"operation":         directiveAccent,
-				"variables":         directiveAccent,
-				"graphql-variables": directiveAccent,
-				"query":             directiveAccent,
-				"graphql-query":     directiveAccent,
-				"grpc":              directiveAccent,
-				"grpc-descriptor":   directiveAccent,
-				"grpc-reflection":   directiveAccent,
-				"grpc-plaintext":    directiveAccent,
-				"grpc-authority":    directiveAccent,
-				"grpc-metadata":     directiveAccent,
-				"setting":           directiveAccent,
-				"timeout":           directiveAccent,
-				"script":            directiveAccent,
-				"no-log":            directiveAccent,
+				"name":                   directiveAccent,
+				"description":            directiveAccent,
+				"desc":                   directiveAccent,
+				"tag":                    directiveAccent,
+				"auth":                   directiveAccent,
+				"graphql":                directiveAccent,
+				"graphql-operation":      directiveAccent,
+				"operation":              directiveAccent,
+				"variables":              directiveAccent,
+				"graphql-variables":      directiveAccent,
+				"query":                  directiveAccent,
+				"graphql-query":          directiveAccent,
+				"grpc":                   directiveAccent,
+				"grpc-descriptor":        directiveAccent,
+				"grpc-reflection":        directiveAccent,
+				"grpc-reflection-target": directiveAccent,
+				"grpc-plaintext":         directiveAccent,
+				"grpc-authority":         directiveAccent,
+				"grpc-ca":                directiveAccent,
+				"grpc-server-name":       directiveAccent,
+				"grpc-metadata":          directiveAccent,
+				"grpc-metadata-file":     directiveAccent,
+				"grpc-health":            directiveAccent,
+				"setting":                directiveAccent,
+				"timeout":                directiveAccent,
+				"path-param":             directiveAccent,
+				"remove-header":          directiveAccent,
+				"script":                 directiveAccent,
+				"no-log":                 directiveAccent,
+				"body-base64":            directiveAccent,
 			},
 		},
 		EditorHintBox: lipgloss.NewStyle().
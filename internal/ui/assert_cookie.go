@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/rts"
+	"github.com/unkn0wn-root/resterm/internal/scripts"
+)
+
+func cookieAssertResult(as restfile.AssertSpec, resp *rts.Resp) scripts.TestResult {
+	start := time.Now()
+	var h map[string][]string
+	if resp != nil {
+		h = resp.H
+	}
+	passed, detail := evalCookieAssert(as.Cookie, h)
+	return builtinAssertResult(as, start, passed, detail)
+}
+
+// evalCookieAssert looks up the cookie in the Set-Cookie headers; when a
+// name is set more than once the last header wins, as in a browser. A
+// missing cookie fails every comparison. The detail reports the actual
+// value, masked when the cookie name looks like a credential, and is meant
+// for failures.
+func evalCookieAssert(spec *restfile.CookieAssert, h map[string][]string) (bool, string) {
+	value, ok := setCookieValue(h, spec.Name)
+	if !ok {
+		return false, fmt.Sprintf("cookie %s not set", spec.Name)
+	}
+	actual := maskSecret(strconv.Quote(value), cookieLooksSecret(spec.Name))
+	switch spec.Op {
+	case "==":
+		return value == spec.Expected, "actual " + actual
+	case "!=":
+		return value != spec.Expected, "actual " + actual
+	}
+	return true, "actual " + actual
+}
+
+func setCookieValue(h map[string][]string, name string) (string, bool) {
+	value, found := "", false
+	for key, lines := range h {
+		if !strings.EqualFold(key, "Set-Cookie") {
+			continue
+		}
+		for _, line := range lines {
+			c, err := http.ParseSetCookie(line)
+			if err != nil || c.Name != name {
+				continue
+			}
+			value, found = c.Value, true
+		}
+	}
+	return value, found
+}
+
+var secretCookieHints = []string{"session", "sid", "token", "auth", "jwt", "secret", "csrf", "xsrf"}
+
+func cookieLooksSecret(name string) bool {
+	lower := strings.ToLower(name)
+	for _, hint := range secretCookieHints {
+		if strings.Contains(lower, hint) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,224 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/rts"
+)
+
+// evalJSONPathAssert checks a jsonpath assertion against a JSON body. The
+// returned detail describes the actual value and is meant for failures.
+func evalJSONPathAssert(spec *restfile.JSONPathAssert, body []byte) (bool, string) {
+	if strings.TrimSpace(string(body)) == "" {
+		return false, "response body empty"
+	}
+	var data any
+	if err := json.Unmarshal(body, &data); err != nil {
+		return false, "response body is not JSON"
+	}
+	if spec.Quantifier != "" {
+		return evalJSONPathQuantifier(spec, data)
+	}
+	actual, ok := rts.JSONPathGet(data, spec.Path)
+	if !ok {
+		return false, fmt.Sprintf("%s not found", spec.Path)
+	}
+	detail := "actual " + jsonAssertLiteral(actual)
+	if spec.Op == "" {
+		return true, detail
+	}
+	passed, note := matchJSONAssert(actual, spec.Op, parseAssertLiteral(spec.Expected))
+	return passed, detail + note
+}
+
+// evalJSONPathQuantifier applies the comparison to every value matched by
+// the path. "all" and "any" fail when nothing matches; "none" passes. The
+// detail counts matching values and shows the first one that decided the
+// outcome.
+func evalJSONPathQuantifier(spec *restfile.JSONPathAssert, data any) (bool, string) {
+	values := rts.JSONPathAll(data, spec.Path)
+	if len(values) == 0 {
+		return spec.Quantifier == "none", fmt.Sprintf("%s matched no values", spec.Path)
+	}
+	expected := parseAssertLiteral(spec.Expected)
+	matched := 0
+	firstHit, firstMiss := -1, -1
+	for i, v := range values {
+		if ok, _ := matchJSONAssert(v, spec.Op, expected); ok {
+			matched++
+			if firstHit < 0 {
+				firstHit = i
+			}
+		} else if firstMiss < 0 {
+			firstMiss = i
+		}
+	}
+	detail := fmt.Sprintf("%d of %d values matched", matched, len(values))
+	var passed bool
+	witness := -1
+	switch spec.Quantifier {
+	case "all":
+		passed = firstMiss < 0
+		witness = firstMiss
+	case "any":
+		passed = matched > 0
+	case "none":
+		passed = matched == 0
+		witness = firstHit
+	}
+	if witness >= 0 {
+		detail += fmt.Sprintf("; #%d is %s", witness+1, jsonAssertLiteral(values[witness]))
+	}
+	return passed, detail
+}
+
+// matchJSONAssert compares actual to expected with op. The note explains a
+// failure that is not a plain mismatch.
+func matchJSONAssert(actual any, op string, expected any) (bool, string) {
+	cmp, ok := compareJSONValues(actual, expected)
+	if !ok {
+		return false, ""
+	}
+	switch op {
+	case "==":
+		return cmp == 0, ""
+	case "!=":
+		return cmp != 0, ""
+	}
+	if !orderable(actual, expected) {
+		return false, " (not comparable)"
+	}
+	switch op {
+	case "<":
+		return cmp < 0, ""
+	case "<=":
+		return cmp <= 0, ""
+	case ">":
+		return cmp > 0, ""
+	case ">=":
+		return cmp >= 0, ""
+	}
+	return false, ""
+}
+
+// parseAssertLiteral turns the right-hand side into a JSON-like value:
+// numbers, quoted or bare strings, booleans and null.
+func parseAssertLiteral(raw string) any {
+	raw = strings.TrimSpace(raw)
+	switch raw {
+	case "null":
+		return nil
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if n := len(raw); n >= 2 && (raw[0] == '"' || raw[0] == '\'') && raw[n-1] == raw[0] {
+		if raw[0] == '"' {
+			if s, err := strconv.Unquote(raw); err == nil {
+				return s
+			}
+		}
+		return raw[1 : n-1]
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return f
+	}
+	return raw
+}
+
+// compareJSONValues compares with light coercion: numeric strings compare as
+// numbers, "true"/"false" as booleans, and anything else as its string form.
+// The bool result is false when the values cannot be compared at all.
+func compareJSONValues(actual, expected any) (int, bool) {
+	switch exp := expected.(type) {
+	case nil:
+		if actual == nil {
+			return 0, true
+		}
+		return 1, true
+	case float64:
+		if n, ok := jsonNumber(actual); ok {
+			return compareFloat(n, exp), true
+		}
+		return 1, true
+	case bool:
+		if b, ok := jsonBool(actual); ok && b == exp {
+			return 0, true
+		}
+		return 1, true
+	case string:
+		if actual == nil {
+			return 1, true
+		}
+		if n, ok := actual.(float64); ok {
+			if e, err := strconv.ParseFloat(exp, 64); err == nil {
+				return compareFloat(n, e), true
+			}
+		}
+		return strings.Compare(stringifyJSONValue(actual), exp), true
+	}
+	return 0, false
+}
+
+func orderable(actual, expected any) bool {
+	switch expected.(type) {
+	case float64:
+		_, ok := jsonNumber(actual)
+		return ok
+	case string:
+		switch actual.(type) {
+		case string, float64:
+			return true
+		}
+	}
+	return false
+}
+
+func jsonNumber(v any) (float64, bool) {
+	switch t := v.(type) {
+	case float64:
+		return t, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(t), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func jsonBool(v any) (bool, bool) {
+	switch t := v.(type) {
+	case bool:
+		return t, true
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(t))
+		return b, err == nil
+	}
+	return false, false
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func jsonAssertLiteral(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	const limit = 80
+	if len(data) > limit {
+		return string(data[:limit]) + "…"
+	}
+	return string(data)
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/rts"
+	"github.com/unkn0wn-root/resterm/internal/scripts"
+)
+
+func metricAssertResult(as restfile.AssertSpec, resp *rts.Resp) scripts.TestResult {
+	start := time.Now()
+	passed, detail := evalMetricAssert(as.Metric, resp)
+	return builtinAssertResult(as, start, passed, detail)
+}
+
+// evalMetricAssert compares a response metric against the spec. header-count
+// counts distinct header names; body-size is the body length in bytes;
+// response-time is the total request duration, so no @trace is needed. The
+// detail reports the actual value and is meant for failures.
+func evalMetricAssert(spec *restfile.MetricAssert, resp *rts.Resp) (bool, string) {
+	var actual int64
+	if resp != nil {
+		switch spec.Metric {
+		case "header-count":
+			actual = int64(len(resp.H))
+		case "body-size":
+			actual = int64(len(resp.Body))
+		case "response-time":
+			actual = int64(resp.Duration)
+		}
+	}
+	want := fmt.Sprintf("%d", spec.Value)
+	got := fmt.Sprintf("%d", actual)
+	switch spec.Metric {
+	case "body-size":
+		want = fmt.Sprintf("%d bytes", spec.Value)
+		got = fmt.Sprintf("%d bytes (%s)", actual, formatByteSize(actual))
+	case "response-time":
+		want = time.Duration(spec.Value).String()
+		got = time.Duration(actual).Round(time.Microsecond).String()
+	}
+	detail := fmt.Sprintf("expected %s %s %s, got %s", spec.Metric, spec.Op, want, got)
+	return compareMetric(actual, spec.Op, spec.Value), detail
+}
+
+func compareMetric(actual int64, op string, want int64) bool {
+	switch op {
+	case "==":
+		return actual == want
+	case "!=":
+		return actual != want
+	case "<":
+		return actual < want
+	case "<=":
+		return actual <= want
+	case ">":
+		return actual > want
+	case ">=":
+		return actual >= want
+	}
+	return false
+}
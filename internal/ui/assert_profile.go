@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/analysis"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/scripts"
+)
+
+// evalProfileAsserts checks the request's profile.* assertions against the
+// latency stats of a finished profile run. stats is nil when no iteration
+// succeeded, which fails every assertion. Measured values keep microsecond
+// precision so a near miss is not rounded onto the threshold.
+func evalProfileAsserts(
+	asserts []restfile.AssertSpec,
+	stats *analysis.LatencyStats,
+) []scripts.TestResult {
+	var results []scripts.TestResult
+	for _, as := range asserts {
+		if as.Profile == nil {
+			continue
+		}
+		start := time.Now()
+		passed, got := evalProfileAssert(as.Profile, stats)
+		detail := fmt.Sprintf(
+			"expected profile.%s %s %s, got %s",
+			as.Profile.Stat,
+			as.Profile.Op,
+			as.Profile.Value,
+			got,
+		)
+		res := builtinAssertResult(as, start, passed, detail)
+		if res.Passed {
+			// Passing runs still report the measurement for perf tracking.
+			res.Message = appendAssertDetail(res.Message, "got "+got)
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// evalProfileAssert returns the comparison result and the measured value.
+func evalProfileAssert(spec *restfile.ProfileAssert, stats *analysis.LatencyStats) (bool, string) {
+	if stats == nil || stats.Count == 0 {
+		return false, "no successful samples"
+	}
+	actual := profileStatValue(*stats, spec.Stat)
+	ok := compareMetric(int64(actual), spec.Op, int64(spec.Value))
+	return ok, actual.Round(time.Microsecond).String()
+}
+
+func profileStatValue(stats analysis.LatencyStats, stat string) time.Duration {
+	switch stat {
+	case "min":
+		return stats.Min
+	case "max":
+		return stats.Max
+	case "mean":
+		return stats.Mean
+	case "median":
+		return stats.Median
+	case "stddev":
+		return stats.StdDev
+	case "p50":
+		return percentileValue(stats, 50)
+	case "p90":
+		return percentileValue(stats, 90)
+	case "p95":
+		return percentileValue(stats, 95)
+	case "p99":
+		return percentileValue(stats, 99)
+	}
+	return 0
+}
+
+func countFailedTests(results []scripts.TestResult) int {
+	n := 0
+	for _, r := range results {
+		if !r.Passed {
+			n++
+		}
+	}
+	return n
+}
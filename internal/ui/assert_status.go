@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+// evalStatusAssert checks code against the allowed set. The detail names
+// the set and the actual code and is meant for failures.
+func evalStatusAssert(spec *restfile.StatusAssert, code int) (bool, string) {
+	parts := make([]string, 0, len(spec.Ranges))
+	passed := false
+	for _, r := range spec.Ranges {
+		if code >= r.Min && code <= r.Max {
+			passed = true
+		}
+		if r.Min == r.Max {
+			parts = append(parts, strconv.Itoa(r.Min))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", r.Min, r.Max))
+		}
+	}
+	actual := strconv.Itoa(code)
+	if code == 0 {
+		actual = "no status"
+	}
+	return passed, fmt.Sprintf("expected status in %s, got %s", strings.Join(parts, ", "), actual)
+}
@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/antchfx/xmlquery"
+	"github.com/antchfx/xpath"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/rts"
+	"github.com/unkn0wn-root/resterm/internal/scripts"
+)
+
+func xpathAssertResult(
+	as restfile.AssertSpec,
+	resp *rts.Resp,
+	ns map[string]string,
+) scripts.TestResult {
+	start := time.Now()
+	var body []byte
+	if resp != nil {
+		body = resp.Body
+	}
+	passed, detail := evalXPathAssert(as.XPath, body, ns)
+	return builtinAssertResult(as, start, passed, detail)
+}
+
+// evalXPathAssert evaluates the expression against an XML body, resolving
+// prefixes through ns (from @xmlns). Node-set results compare the text of
+// the first node; numbers, strings and booleans compare as they are. The
+// detail reports the evaluated value and is meant for failures.
+func evalXPathAssert(
+	spec *restfile.XPathAssert,
+	body []byte,
+	ns map[string]string,
+) (bool, string) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return false, "response body empty"
+	}
+	doc, err := xmlquery.Parse(bytes.NewReader(body))
+	if err != nil {
+		return false, "response body is not XML"
+	}
+	expr, err := xpath.CompileWithNS(spec.Expr, ns)
+	if err != nil {
+		return false, fmt.Sprintf("invalid xpath: %v", err)
+	}
+
+	var actual any
+	node := false
+	switch v := expr.Evaluate(xmlquery.CreateXPathNavigator(doc)).(type) {
+	case *xpath.NodeIterator:
+		if !v.MoveNext() {
+			return false, fmt.Sprintf("%s selected no nodes", spec.Expr)
+		}
+		actual, node = v.Current().Value(), true
+	case float64, string, bool:
+		actual = v
+	default:
+		return false, fmt.Sprintf("%s returned %T", spec.Expr, v)
+	}
+
+	detail := "actual " + jsonAssertLiteral(actual)
+	if spec.Op == "" {
+		switch v := actual.(type) {
+		case bool:
+			return v, detail
+		case string:
+			return node || v != "", detail
+		}
+		return true, detail
+	}
+	passed, note := matchJSONAssert(actual, spec.Op, parseAssertLiteral(spec.Expected))
+	return passed, detail + note
+}
@@ -30,6 +30,7 @@ const (
 type captureResult struct {
 	requestVars map[string]restfile.Variable
 	fileVars    map[string]restfile.Variable
+	globals     []string
 }
 
 type captureRun struct {
@@ -163,6 +164,9 @@ func (m *Model) applyCaptures(in captureRun) error {
 		case restfile.CaptureScopeGlobal:
 			if m.globals != nil {
 				m.globals.set(envKey, c.Name, value, c.Secret)
+				if in.out != nil {
+					in.out.globals = append(in.out.globals, c.Name)
+				}
 			}
 		}
 	}
@@ -497,6 +501,11 @@ func (c *captureContext) lookupResponse(path string) (string, error) {
 			return strconv.Itoa(c.response.Code), nil
 		}
 		return "", nil
+	case "redirects":
+		if c.response != nil {
+			return strconv.Itoa(len(c.response.Redirects)), nil
+		}
+		return "", nil
 	}
 	if strings.HasPrefix(lp, captureHeadersPrefix) {
 		key := path[len(captureHeadersPrefix):]
@@ -823,6 +832,8 @@ func upsertVariable(
 			vars[i].Value = value
 			vars[i].Scope = scope
 			vars[i].Secret = secret
+			// The value no longer comes from the file.
+			vars[i].Line = 0
 			return
 		}
 	}
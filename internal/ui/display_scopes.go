@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+type displayVar struct {
+	value  string
+	secret bool
+}
+
+// displayScope is one layer of variables as the display resolver sees it.
+// Secret entries are kept so the request details can list them masked;
+// the resolver itself never receives them.
+type displayScope struct {
+	label string
+	vars  map[string]displayVar
+}
+
+func (s *displayScope) set(name, value string, secret bool) {
+	if s.vars == nil {
+		s.vars = make(map[string]displayVar)
+	}
+	s.vars[name] = displayVar{value: value, secret: secret}
+}
+
+func (s displayScope) public() map[string]string {
+	out := make(map[string]string, len(s.vars))
+	for name, v := range s.vars {
+		if !v.secret {
+			out[name] = v.value
+		}
+	}
+	return out
+}
+
+// masked returns every variable, with secret values replaced by the mask.
+func (s displayScope) masked() map[string]string {
+	out := make(map[string]string, len(s.vars))
+	for name, v := range s.vars {
+		out[name] = maskSecret(v.value, v.secret)
+	}
+	return out
+}
+
+// displayScopes lists the variable scopes in lookup order: the first scope
+// holding a name wins and shadows the rest.
+func (m *Model) displayScopes(
+	doc *restfile.Document,
+	req *restfile.Request,
+	resolvedEnv string,
+	extras ...map[string]string,
+) []displayScope {
+	var scopes []displayScope
+	add := func(s displayScope) {
+		if len(s.vars) > 0 {
+			scopes = append(scopes, s)
+		}
+	}
+
+	if doc != nil {
+		s := displayScope{label: "const"}
+		for _, c := range doc.Constants {
+			s.set(c.Name, c.Value, false)
+		}
+		add(s)
+	}
+
+	for _, extra := range extras {
+		s := displayScope{label: "script"}
+		for name, value := range extra {
+			s.set(name, value, false)
+		}
+		add(s)
+	}
+
+	if req != nil {
+		s := displayScope{label: "request"}
+		for _, v := range req.Variables {
+			s.set(v.Name, v.Value, v.Secret)
+		}
+		add(s)
+	}
+
+	if m.globals != nil {
+		s := displayScope{label: "global"}
+		for key, entry := range m.globals.snapshot(resolvedEnv) {
+			name := entry.Name
+			if strings.TrimSpace(name) == "" {
+				name = key
+			}
+			s.set(name, entry.Value, entry.Secret)
+		}
+		add(s)
+	}
+
+	if doc != nil {
+		s := displayScope{label: "document-global"}
+		for _, v := range doc.Globals {
+			s.set(v.Name, v.Value, v.Secret)
+		}
+		add(s)
+	}
+
+	file := displayScope{label: "file"}
+	if doc != nil {
+		for _, v := range doc.Variables {
+			file.set(v.Name, v.Value, v.Secret)
+		}
+	}
+	if m.fileVars != nil {
+		for key, entry := range m.fileVars.snapshot(resolvedEnv, m.documentRuntimePath(doc)) {
+			name := strings.TrimSpace(entry.Name)
+			if name == "" {
+				name = key
+			}
+			// A runtime secret must not replace a declared value: public()
+			// drops secrets, so the name would vanish from the resolver.
+			if _, ok := file.vars[name]; ok && entry.Secret {
+				continue
+			}
+			file.set(name, entry.Value, entry.Secret)
+		}
+	}
+	add(file)
+
+	env := displayScope{label: "environment"}
+	for name, value := range vars.EnvValues(m.cfg.EnvironmentSet, resolvedEnv) {
+		env.set(name, value, false)
+	}
+	add(env)
+
+	return scopes
+}
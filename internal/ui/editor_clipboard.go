@@ -1,35 +1,118 @@
 package ui
 
 import (
+	"errors"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/atotto/clipboard"
+	"github.com/aymanbagabas/go-osc52/v2"
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
 )
 
 const (
 	pasteSourceClipboard     = "clipboard"
+	pasteSourceRegister      = "register"
 	pasteSourceRegisterEmpty = "register-empty"
 	pasteSourceRegisterError = "register-error"
 )
 
+var errClipboardNoRead = errors.New("clipboard backend cannot be read")
+
+// writeClipboard sends text to the configured backend. OSC52 output is
+// returned rather than written: it has to reach the terminal through the
+// renderer, so the caller hands it to the model. Auto tries the native
+// clipboard first and falls back to OSC52 when no clipboard tool is
+// available, which is the usual case on a headless host over SSH.
+func (e *requestEditor) writeClipboard(text string) (string, error) {
+	switch config.NormaliseClipboardMode(e.clipboardMode) {
+	case config.ClipboardOff:
+		return "", nil
+	case config.ClipboardOSC52:
+		return osc52Sequence(text), nil
+	case config.ClipboardNative:
+		return "", clipboard.WriteAll(text)
+	default:
+		if err := clipboard.WriteAll(text); err != nil {
+			return osc52Sequence(text), nil
+		}
+		return "", nil
+	}
+}
+
+// readClipboard reads the native clipboard. OSC52 reads need a terminal
+// reply most terminals refuse, so the osc52 and off backends paste from the
+// editor register instead.
+func (e *requestEditor) readClipboard() (string, error) {
+	if !e.readsClipboard() {
+		return "", errClipboardNoRead
+	}
+	return clipboard.ReadAll()
+}
+
+// readsClipboard reports whether pastes come from the system clipboard
+// rather than the editor register.
+func (e *requestEditor) readsClipboard() bool {
+	switch config.NormaliseClipboardMode(e.clipboardMode) {
+	case config.ClipboardOSC52, config.ClipboardOff:
+		return false
+	default:
+		return true
+	}
+}
+
+// osc52Hold keeps a clipboard sequence in the frame long enough for the
+// renderer to flush it at least once.
+const osc52Hold = 200 * time.Millisecond
+
+// emitOSC52 prints seq with the next frames. Writing it to stdout directly
+// would race the renderer and corrupt the screen.
+func (m *Model) emitOSC52(seq string) tea.Cmd {
+	m.osc52 = seq
+	return tea.Tick(osc52Hold, func(time.Time) tea.Msg {
+		return osc52DoneMsg{seq: seq}
+	})
+}
+
+func osc52Sequence(text string) string {
+	seq := osc52.New(text)
+	switch {
+	case os.Getenv("TMUX") != "":
+		seq = seq.Tmux()
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		seq = seq.Screen()
+	}
+	return seq.String()
+}
+
+// writeClipboardWithFallback copies text to the register and the clipboard
+// backend. It returns the status to show and any OSC52 sequence the model
+// still has to print.
 func (e *requestEditor) writeClipboardWithFallback(
 	text string,
 	success string,
-) statusMsg {
+) (statusMsg, string) {
 	trimmed := normalizeClipboardText(text)
 	e.registerText = trimmed
 	if trimmed == "" {
-		return statusMsg{text: success, level: statusInfo}
+		return statusMsg{text: success, level: statusInfo}, ""
 	}
 
-	if err := clipboard.WriteAll(trimmed); err != nil {
+	seq, err := e.writeClipboard(trimmed)
+	if err != nil {
 		return statusMsg{
 			level: statusWarn,
 			text:  "Clipboard unavailable; saved in editor register",
-		}
+		}, ""
+	}
+	if success != "" &&
+		config.NormaliseClipboardMode(e.clipboardMode) == config.ClipboardOff {
+		success += " to editor register (clipboard off)"
 	}
-	return statusMsg{text: success, level: statusInfo}
+	return statusMsg{text: success, level: statusInfo}, seq
 }
 
 func (e *requestEditor) resolvePasteBuffer() (
@@ -38,7 +121,7 @@ func (e *requestEditor) resolvePasteBuffer() (
 	bool,
 	*statusMsg,
 ) {
-	text, err := clipboard.ReadAll()
+	text, err := e.readClipboard()
 	if err == nil {
 		if text != "" {
 			normalized := normalizeClipboardText(text)
@@ -56,12 +139,20 @@ func (e *requestEditor) resolvePasteBuffer() (
 		return "", "", false, &msg
 	}
 
+	source := pasteSourceRegisterError
+	if errors.Is(err, errClipboardNoRead) {
+		source = pasteSourceRegister
+	}
 	if e.registerText != "" {
 		normalized := normalizeClipboardText(e.registerText)
 		e.registerText = normalized
-		return normalized, pasteSourceRegisterError, true, nil
+		return normalized, source, true, nil
 	}
 
+	if source == pasteSourceRegister {
+		msg := statusMsg{text: "Editor register empty", level: statusWarn}
+		return "", "", false, &msg
+	}
 	msg := statusMsg{text: "Clipboard unavailable", level: statusWarn}
 	return "", "", false, &msg
 }
@@ -80,8 +171,8 @@ func (e *requestEditor) copyToClipboard(text string, success string) tea.Cmd {
 	}
 
 	return func() tea.Msg {
-		status := e.writeClipboardWithFallback(trimmed, success)
-		return editorEvent{status: &status}
+		status, seq := e.writeClipboardWithFallback(trimmed, success)
+		return editorEvent{status: &status, osc52: seq}
 	}
 }
 
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+)
+
+func TestClipboardOSC52ReturnsEscapeSequence(t *testing.T) {
+	t.Setenv("TMUX", "")
+	t.Setenv("TERM", "xterm-256color")
+
+	editor := newTestEditor("abc")
+	editor.clipboardMode = config.ClipboardOSC52
+	status, seq := editor.writeClipboardWithFallback("hello", "Copied")
+	if status.text != "Copied" || status.level != statusInfo {
+		t.Fatalf("expected copy to succeed, got %+v", status)
+	}
+	want := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte("hello")) + "\x07"
+	if seq != want {
+		t.Fatalf("expected OSC52 sequence %q, got %q", want, seq)
+	}
+
+	text, source, ok, _ := editor.resolvePasteBuffer()
+	if !ok || text != "hello" || source != pasteSourceRegister {
+		t.Fatalf("expected paste from register, got %q %q %v", text, source, ok)
+	}
+}
+
+func TestClipboardOffKeepsRegisterOnly(t *testing.T) {
+	editor := newTestEditor("abc")
+	editor.clipboardMode = config.ClipboardOff
+	status, seq := editor.writeClipboardWithFallback("line\r\n", "Copied")
+	if status.text != "Copied to editor register (clipboard off)" {
+		t.Fatalf("expected status to mention the register, got %+v", status)
+	}
+	if seq != "" {
+		t.Fatalf("expected nothing sent to the terminal, got %q", seq)
+	}
+
+	editor, cmd := editor.PasteClipboard(true)
+	evt := editorEventFromCmd(t, cmd)
+	if evt.status == nil || evt.status.text != "Pasted from editor register" {
+		t.Fatalf("expected register paste status, got %+v", evt.status)
+	}
+	if got := editor.Value(); !strings.Contains(got, "line\n") {
+		t.Fatalf("expected register text pasted, got %q", got)
+	}
+}
+
+func TestClipboardOffCtrlVPastesRegister(t *testing.T) {
+	editor := newTestEditor("")
+	editor.clipboardMode = config.ClipboardOff
+	editor.registerText = "from-register"
+	editor.Focus()
+
+	editor, _ = editor.Update(tea.KeyMsg{Type: tea.KeyCtrlV})
+	if got := editor.Value(); got != "from-register" {
+		t.Fatalf("expected ctrl+v to paste the register, got %q", got)
+	}
+}
+
+func TestEmitOSC52PrintsWithFrameUntilDone(t *testing.T) {
+	model := New(Config{})
+	model.ready = true
+	model.width = 80
+	model.height = 24
+	model.frameWidth = 80
+	model.frameHeight = 24
+	model.applyLayout()
+
+	seq := "\x1b]52;c;aGk=\x07"
+	if cmd := model.emitOSC52(seq); cmd == nil {
+		t.Fatalf("expected a hold command")
+	}
+	if view := model.View(); !strings.HasPrefix(view, seq) {
+		t.Fatalf("expected OSC52 sequence at the start of the frame")
+	}
+
+	updated, _ := model.Update(osc52DoneMsg{seq: seq})
+	model = updated.(Model)
+	if view := model.View(); strings.Contains(view, seq) {
+		t.Fatalf("expected OSC52 sequence cleared after the hold")
+	}
+}
@@ -20,41 +20,51 @@ const (
 )
 
 var directiveValueModes = map[string]metadataValueMode{
-	"name":                  metadataValueModeToken,
-	"description":           metadataValueModeRest,
-	"desc":                  metadataValueModeRest,
-	"tag":                   metadataValueModeRest,
-	"auth":                  metadataValueModeToken,
-	"graphql":               metadataValueModeToken,
-	"graphql-operation":     metadataValueModeToken,
-	"operation":             metadataValueModeToken,
-	"variables":             metadataValueModeRest,
-	"graphql-variables":     metadataValueModeRest,
-	"query":                 metadataValueModeRest,
-	"graphql-query":         metadataValueModeRest,
-	"grpc":                  metadataValueModeRest,
-	"grpc-descriptor":       metadataValueModeRest,
-	"grpc-reflection":       metadataValueModeToken,
-	"grpc-plaintext":        metadataValueModeToken,
-	"grpc-authority":        metadataValueModeRest,
-	"grpc-metadata":         metadataValueModeRest,
-	"script":                metadataValueModeToken,
-	"patch":                 metadataValueModeRest,
-	"use":                   metadataValueModeRest,
-	"apply":                 metadataValueModeRest,
-	"when":                  metadataValueModeRest,
-	"skip-if":               metadataValueModeRest,
-	"assert":                metadataValueModeRest,
-	"for-each":              metadataValueModeRest,
-	"switch":                metadataValueModeRest,
-	"case":                  metadataValueModeRest,
-	"default":               metadataValueModeRest,
-	"if":                    metadataValueModeRest,
-	"elif":                  metadataValueModeRest,
-	"else":                  metadataValueModeRest,
-	"no-log":                metadataValueModeNone,
-	"log-sensitive-headers": metadataValueModeToken,
-	"log-secret-headers":    metadataValueModeToken,
+	"name":                   metadataValueModeToken,
+	"description":            metadataValueModeRest,
+	"desc":                   metadataValueModeRest,
+	"tag":                    metadataValueModeRest,
+	"env":                    metadataValueModeToken,
+	"auth":                   metadataValueModeToken,
+	"graphql":                metadataValueModeToken,
+	"graphql-operation":      metadataValueModeToken,
+	"operation":              metadataValueModeToken,
+	"variables":              metadataValueModeRest,
+	"graphql-variables":      metadataValueModeRest,
+	"query":                  metadataValueModeRest,
+	"graphql-query":          metadataValueModeRest,
+	"grpc":                   metadataValueModeRest,
+	"grpc-descriptor":        metadataValueModeRest,
+	"grpc-reflection":        metadataValueModeToken,
+	"grpc-reflection-target": metadataValueModeRest,
+	"grpc-plaintext":         metadataValueModeToken,
+	"grpc-authority":         metadataValueModeRest,
+	"grpc-ca":                metadataValueModeRest,
+	"grpc-server-name":       metadataValueModeRest,
+	"grpc-metadata":          metadataValueModeRest,
+	"grpc-metadata-file":     metadataValueModeRest,
+	"grpc-health":            metadataValueModeRest,
+	"path-param":             metadataValueModeRest,
+	"remove-header":          metadataValueModeRest,
+	"script":                 metadataValueModeToken,
+	"patch":                  metadataValueModeRest,
+	"tag-settings":           metadataValueModeRest,
+	"use":                    metadataValueModeRest,
+	"apply":                  metadataValueModeRest,
+	"when":                   metadataValueModeRest,
+	"skip-if":                metadataValueModeRest,
+	"assert":                 metadataValueModeRest,
+	"for-each":               metadataValueModeRest,
+	"switch":                 metadataValueModeRest,
+	"case":                   metadataValueModeRest,
+	"default":                metadataValueModeRest,
+	"if":                     metadataValueModeRest,
+	"elif":                   metadataValueModeRest,
+	"else":                   metadataValueModeRest,
+	"no-log":                 metadataValueModeNone,
+	"body-base64":            metadataValueModeNone,
+	"log-sensitive-headers":  metadataValueModeToken,
+	"log-secret-headers":     metadataValueModeToken,
 }
 
 var httpRequestMethods = map[string]struct{}{
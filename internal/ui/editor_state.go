@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -8,6 +10,7 @@ import (
 	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/unkn0wn-root/resterm/internal/config"
 	"github.com/unkn0wn-root/resterm/internal/ui/hint"
 	"github.com/unkn0wn-root/resterm/internal/ui/textarea"
 )
@@ -67,6 +70,9 @@ func (s selectionState) Caret() cursorPosition {
 type editorEvent struct {
 	dirty  bool
 	status *statusMsg
+	// osc52 is a clipboard escape sequence for the model to print through
+	// the renderer.
+	osc52 string
 }
 
 func toEditorEventCmd(evt editorEvent) tea.Cmd {
@@ -95,6 +101,7 @@ type requestEditor struct {
 	redoStack            []editorSnapshot
 	undoCoalescing       bool
 	registerText         string
+	clipboardMode        config.ClipboardMode
 	metadataHints        metadataHintState
 	metadataHintsEnabled bool
 	hintManager          hint.Manager
@@ -619,6 +626,18 @@ func (e requestEditor) Update(msg tea.Msg) (requestEditor, tea.Cmd) {
 				cmds = append(cmds, toEditorEventCmd(editorEvent{dirty: true}))
 			}
 		}
+		if !e.readsClipboard() {
+			// The textarea would paste the system clipboard; honour the
+			// clipboard setting and paste the editor register instead.
+			if e.registerText != "" {
+				e.pushUndoSnapshot()
+				e.InsertString(e.registerText)
+				cmds = append(cmds, toEditorEventCmd(editorEvent{dirty: true}))
+			} else {
+				cmds = append(cmds, statusCmd(statusWarn, "Editor register empty"))
+			}
+			handled = true
+		}
 	case "backspace", "ctrl+h", "delete":
 		if e.hasSelection() {
 			if _, removed := (&e).removeSelection(); removed {
@@ -747,11 +766,14 @@ func (e requestEditor) Update(msg tea.Msg) (requestEditor, tea.Cmd) {
 		} else if insertsText(keyMsg) && e.hasSelection() {
 			if removedText, removed := (&e).removeSelection(); removed {
 				if removedText != "" {
-					status := (&e).writeClipboardWithFallback(removedText, "")
+					status, osc52 := (&e).writeClipboardWithFallback(removedText, "")
 
-					if status.level == statusWarn {
+					switch {
+					case status.level == statusWarn:
 						warnCmd := toEditorEventCmd(editorEvent{status: &status})
 						cmds = append(cmds, warnCmd)
+					case osc52 != "":
+						cmds = append(cmds, toEditorEventCmd(editorEvent{osc52: osc52}))
 					}
 				}
 
@@ -872,11 +894,11 @@ func (e requestEditor) DeleteSelection() (requestEditor, tea.Cmd) {
 	}
 
 	if _, removed := (&e).removeSelection(); removed {
-		status := (&e).writeClipboardWithFallback(text, "Deleted selection")
+		status, osc52 := (&e).writeClipboardWithFallback(text, "Deleted selection")
 		if status.level == statusInfo && status.text == "Deleted selection" {
 			status.text = "Selection deleted"
 		}
-		return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+		return e, toEditorEventCmd(editorEvent{dirty: true, status: &status, osc52: osc52})
 	}
 	return e, nil
 }
@@ -978,8 +1000,8 @@ func (e requestEditor) DeleteMotion(
 		summary = "Deleted lines"
 	}
 
-	status := editorPtr.writeClipboardWithFallback(removed, summary)
-	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+	status, osc52 := editorPtr.writeClipboardWithFallback(removed, summary)
+	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status, osc52: osc52})
 }
 
 func (e *requestEditor) changeLines(startLine, endLine int) (string, bool) {
@@ -1069,8 +1091,8 @@ func (e requestEditor) ChangeMotion(
 		summary = "Changed lines"
 	}
 
-	status := editorPtr.writeClipboardWithFallback(removed, summary)
-	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+	status, osc52 := editorPtr.writeClipboardWithFallback(removed, summary)
+	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status, osc52: osc52})
 }
 
 func classifyMotion(keys []string, action string) (deleteMotionSpec, error) {
@@ -1221,8 +1243,8 @@ func (e requestEditor) DeleteCurrentLine() (requestEditor, tea.Cmd) {
 	editorPtr := &e
 	editorPtr.moveCursorTo(target, 0)
 	e.applySelectionHighlight()
-	status := editorPtr.writeClipboardWithFallback(clip, "Deleted line")
-	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+	status, osc52 := editorPtr.writeClipboardWithFallback(clip, "Deleted line")
+	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status, osc52: osc52})
 }
 
 func (e requestEditor) DeleteToLineEnd() (requestEditor, tea.Cmd) {
@@ -1262,8 +1284,8 @@ func (e requestEditor) DeleteToLineEnd() (requestEditor, tea.Cmd) {
 	editorPtr := &e
 	editorPtr.moveCursorTo(cursor.Line, cursor.Column)
 	e.applySelectionHighlight()
-	status := (&e).writeClipboardWithFallback(segment, "Deleted to end of line")
-	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+	status, osc52 := (&e).writeClipboardWithFallback(segment, "Deleted to end of line")
+	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status, osc52: osc52})
 }
 
 func (e requestEditor) DeleteCharAtCursor() (requestEditor, tea.Cmd) {
@@ -1272,13 +1294,12 @@ func (e requestEditor) DeleteCharAtCursor() (requestEditor, tea.Cmd) {
 		if !ok {
 			return e, statusCmd(statusWarn, "Nothing to delete")
 		}
-		status := statusMsg{}
+		status := statusMsg{text: "Deleted selection", level: statusInfo}
+		osc52 := ""
 		if removed != "" {
-			status = (&e).writeClipboardWithFallback(removed, "Deleted selection")
-		} else {
-			status = statusMsg{text: "Deleted selection", level: statusInfo}
+			status, osc52 = (&e).writeClipboardWithFallback(removed, "Deleted selection")
 		}
-		return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+		return e, toEditorEventCmd(editorEvent{dirty: true, status: &status, osc52: osc52})
 	}
 
 	runes := []rune(e.Value())
@@ -1300,8 +1321,8 @@ func (e requestEditor) DeleteCharAtCursor() (requestEditor, tea.Cmd) {
 	editorPtr.moveCursorTo(cursor.Line, cursor.Column)
 	e.applySelectionHighlight()
 	deletedChar := string([]rune{removed})
-	status := (&e).writeClipboardWithFallback(deletedChar, "Deleted character")
-	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+	status, osc52 := (&e).writeClipboardWithFallback(deletedChar, "Deleted character")
+	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status, osc52: osc52})
 }
 
 func (e requestEditor) ChangeCurrentLine() (requestEditor, tea.Cmd) {
@@ -1314,8 +1335,8 @@ func (e requestEditor) ChangeCurrentLine() (requestEditor, tea.Cmd) {
 	if !ok {
 		return e, statusCmd(statusWarn, "Nothing to change")
 	}
-	status := editorPtr.writeClipboardWithFallback(removed, "Changed line")
-	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+	status, osc52 := editorPtr.writeClipboardWithFallback(removed, "Changed line")
+	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status, osc52: osc52})
 }
 
 func (e requestEditor) PasteClipboard(after bool) (requestEditor, tea.Cmd) {
@@ -1398,7 +1419,7 @@ func (e requestEditor) PasteClipboard(after bool) (requestEditor, tea.Cmd) {
 		text:  "Pasted",
 	}
 	switch source {
-	case pasteSourceRegisterEmpty:
+	case pasteSourceRegister, pasteSourceRegisterEmpty:
 		status.text = "Pasted from editor register"
 	case pasteSourceRegisterError:
 		status = statusMsg{
@@ -1878,6 +1899,92 @@ func (e *requestEditor) removeSelection() (string, bool) {
 	return removed, true
 }
 
+// replaceSelection swaps the selected text for text as one undo step and
+// leaves the cursor at the start of the replacement.
+func (e *requestEditor) replaceSelection(text string) bool {
+	startOffset, endOffset, ok := e.selectionOffsets()
+	if !ok {
+		return false
+	}
+	runes := []rune(e.Value())
+	if startOffset < 0 {
+		startOffset = 0
+	}
+	if endOffset > len(runes) {
+		endOffset = len(runes)
+	}
+	if startOffset >= endOffset {
+		return false
+	}
+
+	prevView := e.ViewStart()
+	e.pushUndoSnapshot()
+
+	updated := append([]rune{}, runes[:startOffset]...)
+	updated = append(updated, []rune(text)...)
+	updated = append(updated, runes[endOffset:]...)
+	e.SetValue(string(updated))
+	e.SetViewStart(prevView)
+	e.clearSelection()
+	line, col := e.positionForOffset(startOffset)
+	e.moveCursorTo(line, col)
+	e.applySelectionHighlight()
+	return true
+}
+
+// EscapeSelectionJSON replaces the selection with a JSON string literal
+// holding it, quotes included, so a document can be embedded as a field.
+func (e requestEditor) EscapeSelectionJSON() (requestEditor, tea.Cmd) {
+	text := e.selectedText()
+	if text == "" {
+		return e, statusCmd(statusWarn, "No selection to escape")
+	}
+	if !(&e).replaceSelection(jsonStringLiteral(text)) {
+		return e, nil
+	}
+	status := statusMsg{level: statusInfo, text: "Selection escaped as JSON string"}
+	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+}
+
+// UnescapeSelectionJSON reverses EscapeSelectionJSON. Surrounding quotes
+// are optional, so the contents of a string can be selected on their own.
+func (e requestEditor) UnescapeSelectionJSON() (requestEditor, tea.Cmd) {
+	text := e.selectedText()
+	if text == "" {
+		return e, statusCmd(statusWarn, "No selection to unescape")
+	}
+	plain, err := unquoteJSONString(text)
+	if err != nil {
+		return e, statusCmd(statusWarn, "Selection is not a valid JSON string")
+	}
+	if !(&e).replaceSelection(plain) {
+		return e, nil
+	}
+	status := statusMsg{level: statusInfo, text: "Selection unescaped from JSON string"}
+	return e, toEditorEventCmd(editorEvent{dirty: true, status: &status})
+}
+
+func jsonStringLiteral(text string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	// Encoding a string cannot fail.
+	_ = enc.Encode(text)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func unquoteJSONString(text string) (string, error) {
+	trimmed := strings.TrimSpace(text)
+	if len(trimmed) < 2 || !strings.HasPrefix(trimmed, `"`) || !strings.HasSuffix(trimmed, `"`) {
+		trimmed = `"` + text + `"`
+	}
+	var out string
+	if err := json.Unmarshal([]byte(trimmed), &out); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
 func nextRuneOffset(runes []rune, offset int) int {
 	if offset < 0 {
 		return 0
@@ -2347,6 +2454,49 @@ func (e *requestEditor) ExitSearchMode() tea.Cmd {
 	return statusCmd(statusInfo, "Search cleared")
 }
 
+// PreviewSearch highlights matches for a query that is still being typed and
+// moves to the first one at or after origin. It stays off the status line;
+// ApplySearch reports once the query is confirmed.
+func (e requestEditor) PreviewSearch(
+	query string,
+	isRegex bool,
+	origin int,
+) (requestEditor, tea.Cmd) {
+	pe := &e
+	trimmed := strings.TrimSpace(query)
+	pe.search = editorSearch{query: trimmed, isRegex: isRegex, index: -1}
+	var matches []searchMatch
+	if trimmed != "" {
+		matches, _ = pe.buildSearchMatches(trimmed, isRegex)
+	}
+	if len(matches) == 0 {
+		return e, pe.jumpToOffset(origin)
+	}
+	pe.search.matches = matches
+	pe.clearSelection()
+	index, _ := firstMatchIndex(matches, origin)
+	return e, pe.jumpToSearchIndex(index)
+}
+
+// RestoreSearch puts back the search that was in place before a preview and
+// returns the caret to origin.
+func (e requestEditor) RestoreSearch(
+	prev editorSearch,
+	origin int,
+) (requestEditor, tea.Cmd) {
+	pe := &e
+	pe.search = prev
+	return e, pe.jumpToOffset(origin)
+}
+
+func (e *requestEditor) jumpToOffset(offset int) tea.Cmd {
+	line, col := e.PositionForOffset(e.clampOffset(offset))
+	return e.executeMotion(func() {
+		e.moveCursorTo(line, col)
+		e.applySelectionHighlight()
+	})
+}
+
 func stripSelectionMovement(msg tea.KeyMsg) (tea.KeyMsg, bool) {
 	switch msg.Type {
 	case tea.KeyShiftLeft:
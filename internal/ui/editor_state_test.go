@@ -357,6 +357,59 @@ func TestRequestEditorDeleteSelectionRequiresSelection(t *testing.T) {
 	}
 }
 
+func TestRequestEditorEscapeSelectionJSONRoundTrip(t *testing.T) {
+	src := "{\"note\": \"a\\b\"}\nend"
+	editor := newTestEditor(src)
+	editorPtr := &editor
+	editorPtr.moveCursorTo(0, 0)
+	editorPtr.startSelection(editor.caretPosition(), selectionManual)
+	editorPtr.selection.Update(cursorPosition{Line: 1, Column: 0, Offset: 16})
+	editorPtr.applySelectionHighlight()
+
+	escaped, cmd := editor.EscapeSelectionJSON()
+	if evt := editorEventFromCmd(t, cmd); !evt.dirty {
+		t.Fatalf("expected escape to mark editor dirty")
+	}
+	want := `"{\"note\": \"a\\b\"}\n"end`
+	if got := escaped.Value(); got != want {
+		t.Fatalf("unexpected escaped text:\n got %q\nwant %q", got, want)
+	}
+
+	ptr := &escaped
+	ptr.moveCursorTo(0, 0)
+	ptr.startSelection(escaped.caretPosition(), selectionManual)
+	ptr.selection.Update(cursorPosition{Line: 0, Column: 24, Offset: 24})
+	ptr.applySelectionHighlight()
+	unescaped, cmd := escaped.UnescapeSelectionJSON()
+	_ = editorEventFromCmd(t, cmd)
+	if got := unescaped.Value(); got != src {
+		t.Fatalf("expected round trip to restore %q, got %q", src, got)
+	}
+
+	undone, _ := unescaped.UndoLastChange()
+	if got := undone.Value(); got != want {
+		t.Fatalf("expected undo to restore escaped text, got %q", got)
+	}
+}
+
+func TestRequestEditorUnescapeSelectionJSONRejectsInvalid(t *testing.T) {
+	editor := newTestEditor(`bad \q`)
+	editorPtr := &editor
+	editorPtr.moveCursorTo(0, 0)
+	editorPtr.startSelection(editor.caretPosition(), selectionManual)
+	editorPtr.selection.Update(cursorPosition{Line: 0, Column: 6, Offset: 6})
+	editorPtr.applySelectionHighlight()
+
+	updated, cmd := editor.UnescapeSelectionJSON()
+	evt := editorEventFromCmd(t, cmd)
+	if evt.status == nil || evt.status.text != "Selection is not a valid JSON string" {
+		t.Fatalf("expected invalid string warning, got %+v", evt.status)
+	}
+	if got := updated.Value(); got != `bad \q` {
+		t.Fatalf("expected text unchanged, got %q", got)
+	}
+}
+
 func TestRequestEditorVisualYankIncludesCaretRune(t *testing.T) {
 	editor := newTestEditor("Align")
 	editorPtr := &editor
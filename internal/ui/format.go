@@ -20,6 +20,17 @@ func prettifyBody(body []byte, contentType string) string {
 }
 
 func prettifyBodyCtx(ctx context.Context, body []byte, contentType string) string {
+	return prettifyBodyDepthCtx(ctx, body, contentType, 0)
+}
+
+// prettifyBodyDepthCtx is prettifyBodyCtx with JSON containers nested deeper
+// than maxDepth folded into summaries (json_max_depth). Zero disables folding.
+func prettifyBodyDepthCtx(
+	ctx context.Context,
+	body []byte,
+	contentType string,
+	maxDepth int,
+) string {
 	ct := strings.ToLower(contentType)
 	source := string(body)
 	lexer := ""
@@ -30,7 +41,7 @@ func prettifyBodyCtx(ctx context.Context, body []byte, contentType string) strin
 
 	switch {
 	case strings.Contains(ct, "json"):
-		if formatted, ok := renderJSONAsJSCtx(ctx, body); ok {
+		if formatted, ok := renderJSONAsJSCtx(ctx, body, maxDepth); ok {
 			source = formatted
 			lexer = "javascript"
 		} else {
@@ -64,11 +75,11 @@ func prettifyBodyCtx(ctx context.Context, body []byte, contentType string) strin
 	return source
 }
 
-func renderJSONAsJSCtx(ctx context.Context, body []byte) (string, bool) {
+func renderJSONAsJSCtx(ctx context.Context, body []byte, maxDepth int) (string, bool) {
 	if ctxDone(ctx) {
 		return "", false
 	}
-	if formatted, err := js.FormatValue(string(body)); err == nil {
+	if formatted, err := js.FormatValueDepth(string(body), maxDepth); err == nil {
 		return formatted, true
 	}
 	if ctxDone(ctx) {
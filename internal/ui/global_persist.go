@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+)
+
+// loadPersistedGlobals seeds the in-memory store from the globals file when
+// persist_globals is enabled.
+func (m *Model) loadPersistedGlobals() error {
+	if m.globalsPath == "" || m.globals == nil {
+		return nil
+	}
+	file, err := config.LoadGlobals(m.globalsPath)
+	if err != nil {
+		return err
+	}
+	for envKey, entries := range file {
+		for _, entry := range entries {
+			if entry.Secret && !m.cfg.Settings.PersistSecretGlobals {
+				continue
+			}
+			m.globals.restore(envKey, globalValue{
+				Name:      entry.Name,
+				Value:     entry.Value,
+				Secret:    entry.Secret,
+				UpdatedAt: entry.UpdatedAt,
+			})
+		}
+	}
+	return nil
+}
+
+// changedGlobals names the globals a request set or deleted. It rides on
+// responseMsg so the file is written from Update, not the request goroutine.
+type changedGlobals struct {
+	env   string
+	names []string
+}
+
+func (c *changedGlobals) add(names ...string) {
+	c.names = append(c.names, names...)
+}
+
+// globalsFileMu serializes reads and writes of the globals file.
+var globalsFileMu sync.Mutex
+
+// persistGlobals writes the current value of each changed global to disk,
+// dropping names that were deleted. Secrets are refused unless
+// persist_secret_globals is set. The write runs in the returned command.
+func (m *Model) persistGlobals(changed changedGlobals) tea.Cmd {
+	if m.globalsPath == "" || m.globals == nil || len(changed.names) == 0 {
+		return nil
+	}
+	path := m.globalsPath
+	allowSecrets := m.cfg.Settings.PersistSecretGlobals
+	envKey := normalizeEnvKey(changed.env)
+	current := m.globals.snapshot(changed.env)
+	names := append([]string(nil), changed.names...)
+	return func() tea.Msg {
+		globalsFileMu.Lock()
+		defer globalsFileMu.Unlock()
+
+		file, err := config.LoadGlobals(path)
+		if err != nil {
+			return globalsPersistedMsg{err: err}
+		}
+		entries := file[envKey]
+		if entries == nil {
+			entries = make(map[string]config.PersistedGlobal)
+		}
+		var refused []string
+		for _, name := range names {
+			key := normalizeNameKey(name)
+			value, ok := current[key]
+			switch {
+			case !ok:
+				delete(entries, key)
+			case value.Secret && !allowSecrets:
+				delete(entries, key)
+				refused = append(refused, value.Name)
+			default:
+				entries[key] = config.PersistedGlobal{
+					Name:      value.Name,
+					Value:     value.Value,
+					Secret:    value.Secret,
+					UpdatedAt: value.UpdatedAt,
+				}
+			}
+		}
+		if len(entries) == 0 {
+			delete(file, envKey)
+		} else {
+			file[envKey] = entries
+		}
+		if err := config.SaveGlobals(path, file); err != nil {
+			return globalsPersistedMsg{err: err}
+		}
+		return globalsPersistedMsg{refused: refused}
+	}
+}
+
+func (m *Model) handleGlobalsPersisted(msg globalsPersistedMsg) {
+	switch {
+	case msg.err != nil:
+		m.setStatusMessage(statusMsg{level: statusWarn, text: msg.err.Error()})
+	case len(msg.refused) > 0:
+		m.setStatusMessage(statusMsg{
+			level: statusWarn,
+			text: fmt.Sprintf(
+				"Secret globals not saved to disk: %s (set persist_secret_globals = true to allow)",
+				strings.Join(msg.refused, ", "),
+			),
+		})
+	}
+}
+
+func (m *Model) clearPersistedGlobals() tea.Cmd {
+	if m.globalsPath == "" {
+		m.setStatusMessage(statusMsg{
+			level: statusWarn,
+			text:  "Persisted globals are off (set persist_globals = true)",
+		})
+		return nil
+	}
+	globalsFileMu.Lock()
+	defer globalsFileMu.Unlock()
+
+	file, err := config.LoadGlobals(m.globalsPath)
+	if err != nil {
+		m.setStatusMessage(statusMsg{level: statusError, text: err.Error()})
+		return nil
+	}
+	env := m.cfg.EnvironmentName
+	label := env
+	if strings.TrimSpace(label) == "" {
+		label = "default"
+	}
+	envKey := normalizeEnvKey(env)
+	if _, ok := file[envKey]; !ok {
+		m.setStatusMessage(statusMsg{
+			level: statusInfo,
+			text:  fmt.Sprintf("No persisted globals for %s", label),
+		})
+		return nil
+	}
+	delete(file, envKey)
+	if err := config.SaveGlobals(m.globalsPath, file); err != nil {
+		m.setStatusMessage(statusMsg{level: statusError, text: err.Error()})
+		return nil
+	}
+	m.setStatusMessage(statusMsg{
+		level: statusInfo,
+		text:  fmt.Sprintf("Cleared persisted globals for %s", label),
+	})
+	return nil
+}
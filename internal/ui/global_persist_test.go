@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/scripts"
+)
+
+func TestPersistGlobalsSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "globals.json")
+	cfg := Config{
+		EnvironmentName: "dev",
+		GlobalsPath:     path,
+		Settings:        config.Settings{PersistGlobals: true},
+	}
+	model := New(cfg)
+	names := model.applyGlobalMutations(map[string]scripts.GlobalValue{
+		"token":    {Name: "token", Value: "abc"},
+		"password": {Name: "password", Value: "hunter2", Secret: true},
+	}, "dev")
+	persist := model.persistGlobals(changedGlobals{env: "dev", names: names})
+	if persist == nil {
+		t.Fatalf("expected a persist command")
+	}
+	msg, ok := persist().(globalsPersistedMsg)
+	if !ok {
+		t.Fatalf("expected globalsPersistedMsg")
+	}
+	model.handleGlobalsPersisted(msg)
+	if !strings.Contains(model.statusMessage.text, "password") {
+		t.Fatalf("expected warning about skipped secret, got %q", model.statusMessage.text)
+	}
+
+	file, err := config.LoadGlobals(path)
+	if err != nil {
+		t.Fatalf("LoadGlobals: %v", err)
+	}
+	if got := file["dev"]["token"].Value; got != "abc" {
+		t.Fatalf("expected token on disk, got %q", got)
+	}
+	if _, ok := file["dev"]["password"]; ok {
+		t.Fatalf("expected secret global to be refused")
+	}
+
+	restarted := New(cfg)
+	snap := restarted.globalsSnapshot()
+	if snap["token"].Value != "abc" {
+		t.Fatalf("expected token restored after restart, got %+v", snap)
+	}
+
+	restarted.clearPersistedGlobals()
+	file, err = config.LoadGlobals(path)
+	if err != nil {
+		t.Fatalf("LoadGlobals: %v", err)
+	}
+	if len(file["dev"]) != 0 {
+		t.Fatalf("expected persisted globals cleared, got %+v", file)
+	}
+	if restarted.globalsSnapshot()["token"].Value != "abc" {
+		t.Fatalf("expected in-memory globals to remain after clearing disk")
+	}
+}
+
+func TestPersistSecretGlobalsWithFlag(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "globals.json")
+	model := New(Config{
+		EnvironmentName: "dev",
+		GlobalsPath:     path,
+		Settings:        config.Settings{PersistGlobals: true, PersistSecretGlobals: true},
+	})
+	names := model.applyGlobalMutations(map[string]scripts.GlobalValue{
+		"password": {Name: "password", Value: "hunter2", Secret: true},
+	}, "dev")
+	msg := model.persistGlobals(changedGlobals{env: "dev", names: names})().(globalsPersistedMsg)
+	if msg.err != nil || len(msg.refused) != 0 {
+		t.Fatalf("unexpected persist result %+v", msg)
+	}
+	file, err := config.LoadGlobals(path)
+	if err != nil {
+		t.Fatalf("LoadGlobals: %v", err)
+	}
+	if got := file["dev"]["password"]; got.Value != "hunter2" || !got.Secret {
+		t.Fatalf("expected secret persisted with flag, got %+v", got)
+	}
+}
+
+func TestExecuteRequestLeavesGlobalsPersistenceToUpdate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"token":"abc"}`))
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "globals.json")
+	model := New(Config{
+		EnvironmentName: "dev",
+		GlobalsPath:     path,
+		Settings:        config.Settings{PersistGlobals: true},
+	})
+	content := "# @capture global token {{response.json.token}}\nGET " + srv.URL + "\n"
+	doc := parser.Parse("globals.http", []byte(content))
+	msg, ok := model.executeRequest(doc, doc.Requests[0], model.cfg.HTTPOptions, "", nil)().(responseMsg)
+	if !ok || msg.err != nil {
+		t.Fatalf("expected response, got %+v", msg)
+	}
+	if len(msg.globals.names) != 1 || msg.globals.names[0] != "token" {
+		t.Fatalf("expected changed global on the response, got %+v", msg.globals)
+	}
+	if file, _ := config.LoadGlobals(path); len(file) != 0 {
+		t.Fatalf("expected nothing written before Update, got %+v", file)
+	}
+
+	_, cmd := model.Update(msg)
+	for _, m := range runCmdTree(cmd) {
+		if persisted, ok := m.(globalsPersistedMsg); ok && persisted.err != nil {
+			t.Fatalf("persist: %v", persisted.err)
+		}
+	}
+	file, err := config.LoadGlobals(path)
+	if err != nil {
+		t.Fatalf("LoadGlobals: %v", err)
+	}
+	if got := file["dev"]["token"].Value; got != "abc" {
+		t.Fatalf("expected token on disk after Update, got %q", got)
+	}
+}
@@ -55,6 +55,19 @@ func (s *globalStore) set(env, name, value string, secret bool) {
 	}
 }
 
+// restore adds a value loaded from disk, keeping its original timestamp.
+func (s *globalStore) restore(env string, value globalValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	envKey := normalizeEnvKey(env)
+	if s.values[envKey] == nil {
+		s.values[envKey] = make(map[string]globalValue)
+	}
+	value.Name = strings.TrimSpace(value.Name)
+	s.values[envKey][normalizeNameKey(value.Name)] = value
+}
+
 func (s *globalStore) delete(env, name string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
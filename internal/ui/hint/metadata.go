@@ -15,9 +15,13 @@ var MetaCatalog = []Hint{
 	{Label: "@auth", Summary: "Configure authentication (basic, bearer, etc.)"},
 	{Label: "@setting", Summary: "Set options (transport/TLS/etc.)"},
 	{Label: "@settings", Summary: "Set multiple options on one line"},
+	{Label: "@tag-settings", Summary: "Set options for every request with a tag"},
 	{Label: "@timeout", Summary: "Override the request timeout"},
 	{Label: "@body", Summary: "Control body processing (e.g. template expansion)"},
+	{Label: "@body-base64", Summary: "Decode the base64 body to raw bytes before sending"},
 	{Label: "@var", Summary: "Declare a request-scoped variable"},
+	{Label: "@path-param", Summary: "Fill a {name} placeholder in the URL path"},
+	{Label: "@remove-header", Summary: "Strip a header added by defaults or auth"},
 	{Label: "@request", Summary: "Define a request-scoped variable"},
 	{Label: "@request-secret", Summary: "Define a secret request variable"},
 	{Label: "@file", Summary: "Define a file-scoped variable"},
@@ -41,6 +45,8 @@ var MetaCatalog = []Hint{
 	{Label: "@assert", Summary: "Evaluate a RestermScript assertion"},
 	{Label: "@trace", Summary: "Enable HTTP tracing and latency budgets"},
 	{Label: "@profile", Summary: "Run the request repeatedly with profiling"},
+	{Label: "@retry", Summary: "Retry on transport errors or selected status codes"},
+	{Label: "@webhook", Summary: "Wait for a callback on a local {{$webhook}} URL"},
 	{Label: "@compare", Summary: "Run the request across multiple environments"},
 	{Label: "@ssh", Summary: "Send request via SSH jump host"},
 	{Label: "@k8s", Summary: "Send request via Kubernetes port-forward"},
@@ -68,12 +74,23 @@ var MetaCatalog = []Hint{
 	{Label: "@grpc", Summary: "Configure the gRPC method (supports streaming)"},
 	{Label: "@grpc-descriptor", Summary: "Load a gRPC descriptor set"},
 	{Label: "@grpc-reflection", Summary: "Toggle gRPC reflection"},
+	{
+		Label:   "@grpc-reflection-target",
+		Summary: "Resolve gRPC descriptors from another host:port (plaintext= optional)",
+	},
 	{Label: "@grpc-plaintext", Summary: "Force plaintext gRPC transport"},
 	{Label: "@grpc-authority", Summary: "Set gRPC authority override"},
+	{Label: "@grpc-ca", Summary: "Trust a CA bundle for gRPC TLS"},
+	{Label: "@grpc-server-name", Summary: "Override gRPC TLS server name"},
 	{
 		Label:   "@grpc-metadata",
 		Summary: "Attach gRPC metadata (Repeatable. Reserved keys rejected - use @timeout)",
 	},
+	{
+		Label:   "@grpc-metadata-file",
+		Summary: "Load gRPC metadata from a file of key: value lines",
+	},
+	{Label: "@grpc-health", Summary: "Call grpc.health.v1 Check (service=name optional)"},
 	{Label: "@sse", Summary: "Enable Server-Sent Events streaming"},
 	{Label: "@websocket", Summary: "Enable WebSocket streaming"},
 	{Label: "@ws", Summary: "Add a WebSocket scripted step (send/ping/wait/close)"},
@@ -152,6 +169,42 @@ var metaSub = map[string][]Hint{
 			CursorBack: len("250ms"),
 		},
 	},
+	"retry": {
+		{
+			Label:      "count=",
+			Summary:    "Maximum number of retries",
+			Insert:     "count=3",
+			CursorBack: len("3"),
+		},
+		{
+			Label:      "on=",
+			Summary:    "Status codes that trigger a retry",
+			Insert:     "on=429,503",
+			CursorBack: len("429,503"),
+		},
+		{
+			Label:      "delay=",
+			Summary:    "Base backoff, doubled per attempt",
+			Insert:     "delay=1s",
+			CursorBack: len("1s"),
+		},
+		{Label: "respect-retry-after=true", Summary: "Wait for the Retry-After header"},
+		{Label: "jitter=true", Summary: "Add random jitter to each wait"},
+	},
+	"webhook": {
+		{
+			Label:      "timeout=",
+			Summary:    "How long to wait for the callback",
+			Insert:     "timeout=30s",
+			CursorBack: len("30s"),
+		},
+		{
+			Label:      "listen=",
+			Summary:    "Listener address (default 127.0.0.1:0)",
+			Insert:     "listen=127.0.0.1:0",
+			CursorBack: len("127.0.0.1:0"),
+		},
+	},
 	"script":  scriptHints,
 	"if":      workflowRunHints,
 	"elif":    workflowRunHints,
@@ -265,6 +318,24 @@ var metaSub = map[string][]Hint{
 			Insert:     "compression=true",
 			CursorBack: len("true"),
 		},
+		{
+			Label:      "reconnect=",
+			Summary:    "Re-dial when the connection drops",
+			Insert:     "reconnect=true",
+			CursorBack: len("true"),
+		},
+		{
+			Label:      "max-retries=",
+			Summary:    "Reconnect attempts before giving up",
+			Insert:     "max-retries=5",
+			CursorBack: len("5"),
+		},
+		{
+			Label:      "backoff=",
+			Summary:    "Initial reconnect delay (doubles per attempt)",
+			Insert:     "backoff=1s",
+			CursorBack: len("1s"),
+		},
 	},
 	"ws": {
 		{Label: "send", Summary: "Send a text frame"},
@@ -81,5 +81,7 @@ func (m *Model) applyThemeToLists() {
 		m.theme.ListItemDescription,
 	)
 	applyListTheme(m.theme, &m.envList, false, 0)
+	applyListTheme(m.theme, &m.recentList, false, 0)
+	applyListTheme(m.theme, &m.gotoList, false, 0)
 	applyListTheme(m.theme, &m.themeList, true, 3)
 }
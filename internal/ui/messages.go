@@ -1,12 +1,15 @@
 package ui
 
 import (
+	"time"
+
 	"github.com/unkn0wn-root/resterm/internal/grpcclient"
 	"github.com/unkn0wn-root/resterm/internal/httpclient"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
 	"github.com/unkn0wn-root/resterm/internal/scripts"
 	"github.com/unkn0wn-root/resterm/internal/stream"
 	"github.com/unkn0wn-root/resterm/internal/update"
+	"github.com/unkn0wn-root/resterm/internal/webhook"
 )
 
 type statusPulseMsg struct {
@@ -40,9 +43,38 @@ type responseMsg struct {
 	scriptErr   error
 	executed    *restfile.Request
 	requestText string
+	sourceText  string
 	environment string
+	docPath     string
 	skipped     bool
 	skipReason  string
+	// webhook is the @webhook listener, handed over so the wait starts
+	// once the response has been shown.
+	webhook *pendingWebhook
+	// globals lists the globals the request changed, persisted from Update.
+	globals changedGlobals
+}
+
+// globalsPersistedMsg reports the outcome of writing globals to disk.
+type globalsPersistedMsg struct {
+	err     error
+	refused []string
+}
+
+// osc52DoneMsg drops a clipboard sequence once the renderer has sent it.
+type osc52DoneMsg struct {
+	seq string
+}
+
+// webhookMsg carries the outcome of waiting for a @webhook callback.
+type webhookMsg struct {
+	wait        *webhookWait
+	callback    *webhook.Callback
+	err         error
+	url         string
+	started     time.Time
+	timeout     time.Duration
+	environment string
 }
 
 type statusMsg struct {
@@ -74,6 +106,14 @@ type streamReadyMsg struct {
 	sessionID string
 }
 
+// uploadProgressMsg carries the id of the send it belongs to so a late
+// update from an earlier request cannot overwrite the current status.
+type uploadProgressMsg struct {
+	id    uint64
+	sent  int64
+	total int64
+}
+
 type wsConsoleResultMsg struct {
 	err     error
 	status  string
@@ -81,6 +121,12 @@ type wsConsoleResultMsg struct {
 	payload string
 }
 
+type bodyFormattedMsg struct {
+	snapshot *responseSnapshot
+	format   bodyFormat
+	text     string
+}
+
 type rawDumpLoadedMsg struct {
 	snapshot *responseSnapshot
 	mode     rawViewMode
@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/ui/navigator"
+)
+
+// visibleNavigatorRequests returns the request rows currently shown in the
+// navigator, in display order, paired with the document they belong to.
+func (m *Model) visibleNavigatorRequests() []workflowStepRuntime {
+	if m.navigator == nil {
+		return nil
+	}
+	var out []workflowStepRuntime
+	for _, row := range m.navigator.Rows() {
+		if step, ok := m.navigatorRequestStep(row.Node); ok {
+			out = append(out, step)
+		}
+	}
+	return out
+}
+
+// navigatorRequestStep turns a request node into a batch step.
+func (m *Model) navigatorRequestStep(n *navigator.Node[any]) (workflowStepRuntime, bool) {
+	if n == nil || n.Kind != navigator.KindRequest {
+		return workflowStepRuntime{}, false
+	}
+	req, ok := n.Payload.Data.(*restfile.Request)
+	if !ok || req == nil {
+		return workflowStepRuntime{}, false
+	}
+	doc := m.loadDocFor(n.Payload.FilePath)
+	if doc == nil {
+		return workflowStepRuntime{}, false
+	}
+	step := restfile.WorkflowStep{
+		Kind: restfile.WorkflowStepKindRequest,
+		Name: requestBaseTitle(req),
+		Line: req.LineRange.Start,
+	}
+	return workflowStepRuntime{step: step, request: req, doc: doc}, true
+}
+
+// sendVisibleRequests runs every request visible in the navigator one after
+// another. Unlike workflows there is no ordering or data passing between
+// steps; the rollup lands in the Stats tab when the batch finishes.
+func (m *Model) sendVisibleRequests(stopOnFailure bool) tea.Cmd {
+	if m.workflowRun != nil {
+		m.setStatusMessage(statusMsg{text: "Another run is already active", level: statusWarn})
+		return nil
+	}
+	steps := m.visibleNavigatorRequests()
+	if len(steps) == 0 {
+		m.setStatusMessage(statusMsg{text: "No visible requests to send", level: statusWarn})
+		return nil
+	}
+	return m.startBatchRun(fmt.Sprintf("%d visible requests", len(steps)), steps, stopOnFailure)
+}
+
+// sendNavigatorGroup runs every request in the selected navigator group,
+// collapsed or not, as a batch that continues past failures.
+func (m *Model) sendNavigatorGroup() tea.Cmd {
+	if m.navigator == nil {
+		return nil
+	}
+	n := m.navigator.Selected()
+	if n == nil || n.Kind != navigator.KindGroup {
+		return nil
+	}
+	if m.workflowRun != nil {
+		return statusCmd(statusWarn, "Another run is already active")
+	}
+	var steps []workflowStepRuntime
+	for _, c := range n.Children {
+		if step, ok := m.navigatorRequestStep(c); ok {
+			steps = append(steps, step)
+		}
+	}
+	if len(steps) == 0 {
+		return statusCmd(statusWarn, "Group has no requests to send")
+	}
+	return m.startBatchRun("Group "+n.Title, steps, false)
+}
+
+func (m *Model) startBatchRun(
+	name string,
+	steps []workflowStepRuntime,
+	stopOnFailure bool,
+) tea.Cmd {
+	onFailure := restfile.WorkflowOnFailureContinue
+	if stopOnFailure {
+		onFailure = restfile.WorkflowOnFailureStop
+	}
+	workflowSteps := make([]restfile.WorkflowStep, 0, len(steps))
+	for i := range steps {
+		steps[i].step.OnFailure = onFailure
+		workflowSteps = append(workflowSteps, steps[i].step)
+	}
+	workflow := restfile.Workflow{
+		Name:             name,
+		DefaultOnFailure: onFailure,
+		Steps:            workflowSteps,
+	}
+	m.workflowRun = &workflowState{
+		doc:      steps[0].doc,
+		options:  m.cfg.HTTPOptions,
+		workflow: workflow,
+		steps:    steps,
+		vars:     make(map[string]string),
+		origin:   workflowOriginBatch,
+		start:    time.Now(),
+	}
+	m.statusPulseBase = ""
+	m.statusPulseFrame = -1
+
+	return m.executeWorkflowStep()
+}
@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/scripts"
+	"github.com/unkn0wn-root/resterm/internal/ui/navigator"
+)
+
+func newBatchTestModel(t *testing.T) (Model, *restfile.Document, *restfile.Document) {
+	t.Helper()
+	dir := t.TempDir()
+	docA := &restfile.Document{
+		Path: filepath.Join(dir, "a.http"),
+		Requests: []*restfile.Request{
+			{
+				Method:   "GET",
+				URL:      "https://example.com/a",
+				Metadata: restfile.RequestMetadata{Name: "A"},
+			},
+		},
+	}
+	docB := &restfile.Document{
+		Path:     filepath.Join(dir, "b.http"),
+		Requests: []*restfile.Request{{Method: "POST", URL: "https://example.com/b"}},
+	}
+	model := New(Config{})
+	model.ready = true
+	var files []*navigator.Node[any]
+	for _, doc := range []*restfile.Document{docA, docB} {
+		if err := os.WriteFile(doc.Path, nil, 0o644); err != nil {
+			t.Fatalf("write %s: %v", doc.Path, err)
+		}
+		model.cacheDoc(doc.Path, doc)
+		files = append(files, &navigator.Node[any]{
+			ID:       "file:" + doc.Path,
+			Kind:     navigator.KindFile,
+			Expanded: true,
+			Children: model.buildRequestNodes(doc, doc.Path),
+			Payload:  navigator.Payload[any]{FilePath: doc.Path},
+		})
+	}
+	model.navigator = navigator.New(files)
+	return model, docA, docB
+}
+
+func TestSendVisibleRequestsRunsAcrossFiles(t *testing.T) {
+	model, docA, docB := newBatchTestModel(t)
+
+	if cmd := model.sendVisibleRequests(false); cmd == nil {
+		t.Fatalf("expected batch start command")
+	}
+	st := model.workflowRun
+	if st == nil || len(st.steps) != 2 || st.doc != docA {
+		t.Fatalf("expected two-step batch starting in first file, got %+v", st)
+	}
+
+	model.handleWorkflowResponse(responseMsg{
+		response: &httpclient.Response{Status: "500 Internal Server Error", StatusCode: 500},
+		executed: st.current,
+	})
+	if model.workflowRun == nil {
+		t.Fatalf("expected batch to continue past failure")
+	}
+	if model.workflowRun.doc != docB {
+		t.Fatalf("expected second step to run against its own document")
+	}
+
+	model.handleWorkflowResponse(responseMsg{
+		response: &httpclient.Response{Status: "200 OK", StatusCode: 200},
+		executed: model.workflowRun.current,
+	})
+	if model.workflowRun != nil {
+		t.Fatalf("expected batch to finish")
+	}
+	if !strings.HasPrefix(model.statusMessage.text, "Batch ") ||
+		!strings.Contains(model.statusMessage.text, "1 failure") {
+		t.Fatalf("expected batch rollup in status, got %q", model.statusMessage.text)
+	}
+}
+
+func TestSendVisibleRequestsRecordsFailedRuns(t *testing.T) {
+	model, docA, docB := newBatchTestModel(t)
+	model.sendVisibleRequests(false)
+
+	model.handleWorkflowResponse(responseMsg{
+		response: &httpclient.Response{Status: "200 OK", StatusCode: 200},
+		tests:    []scripts.TestResult{{Name: "status", Passed: false}},
+		executed: model.workflowRun.current,
+	})
+	model.handleWorkflowResponse(responseMsg{
+		response: &httpclient.Response{Status: "200 OK", StatusCode: 200},
+		tests:    []scripts.TestResult{{Name: "status", Passed: true}},
+		executed: model.workflowRun.current,
+	})
+	if !model.navFailed[navRunKey(docA.Path, docA.Requests[0])] {
+		t.Fatalf("expected failed batch request to be marked, got %v", model.navFailed)
+	}
+	if model.navFailed[navRunKey(docB.Path, docB.Requests[0])] {
+		t.Fatalf("expected passing batch request to stay unmarked")
+	}
+	if n := model.navigator.Find("file:" + docA.Path); n == nil || !n.Children[0].Failed {
+		t.Fatalf("expected navigator row to show the failure")
+	}
+}
+
+func TestSendVisibleRequestsHonoursFiltersAndStopOnFailure(t *testing.T) {
+	model, _, _ := newBatchTestModel(t)
+	model.navigator.ToggleMethodFilter("GET")
+	model.sendVisibleRequests(false)
+	if st := model.workflowRun; st == nil || len(st.steps) != 1 {
+		t.Fatalf("expected only the filtered request in the batch, got %+v", st)
+	}
+
+	model, _, _ = newBatchTestModel(t)
+	model.sendVisibleRequests(true)
+	st := model.workflowRun
+	model.handleWorkflowResponse(responseMsg{
+		response: &httpclient.Response{Status: "500 Internal Server Error", StatusCode: 500},
+		executed: st.current,
+	})
+	if model.workflowRun != nil {
+		t.Fatalf("expected batch to stop after first failure")
+	}
+	if len(st.results) != 1 {
+		t.Fatalf("expected second request not to run, got %d results", len(st.results))
+	}
+}
+
+func TestSendNavigatorGroupRunsCollapsedGroup(t *testing.T) {
+	dir := t.TempDir()
+	doc := &restfile.Document{
+		Path: filepath.Join(dir, "auth.http"),
+		Requests: []*restfile.Request{
+			{
+				Method:   "POST",
+				URL:      "https://example.com/login",
+				Metadata: restfile.RequestMetadata{Name: "Login", Group: "Auth"},
+			},
+			{Method: "GET", URL: "https://example.com/health"},
+			{
+				Method:   "POST",
+				URL:      "https://example.com/logout",
+				Metadata: restfile.RequestMetadata{Name: "Logout", Group: "Auth"},
+			},
+		},
+	}
+	if err := os.WriteFile(doc.Path, nil, 0o644); err != nil {
+		t.Fatalf("write %s: %v", doc.Path, err)
+	}
+	model := New(Config{})
+	model.ready = true
+	model.cacheDoc(doc.Path, doc)
+	children := model.buildRequestNodes(doc, doc.Path)
+	if len(children) != 2 || children[0].Kind != navigator.KindGroup ||
+		children[0].Count != 2 || children[1].Kind != navigator.KindRequest {
+		t.Fatalf("expected an Auth group followed by the ungrouped request, got %+v", children)
+	}
+	group := children[0]
+	group.Expanded = false
+	model.navigator = navigator.New([]*navigator.Node[any]{{
+		ID:       "file:" + doc.Path,
+		Kind:     navigator.KindFile,
+		Expanded: true,
+		Children: children,
+		Payload:  navigator.Payload[any]{FilePath: doc.Path},
+	}})
+	if !model.navigator.SelectByID(group.ID) {
+		t.Fatalf("expected group row to be selectable")
+	}
+
+	if cmd := model.sendNavigatorGroup(); cmd == nil {
+		t.Fatalf("expected group run to start")
+	}
+	st := model.workflowRun
+	if st == nil || len(st.steps) != 2 || st.workflow.Name != "Group Auth" {
+		t.Fatalf("expected a two-step run for the group, got %+v", st)
+	}
+	if st.steps[0].request != doc.Requests[0] || st.steps[1].request != doc.Requests[2] {
+		t.Fatalf("expected group requests in file order")
+	}
+}
@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"encoding/json"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+// bodyBadgeDelay debounces badge updates so fast cursor movement or typing
+// does not reparse the buffer on every key.
+const bodyBadgeDelay = 200 * time.Millisecond
+
+type bodyBadgeMsg struct {
+	seq int
+}
+
+// scheduleBodyBadge queues a recompute of the status bar body badge when the
+// cursor line or buffer changed since the last request.
+func (m *Model) scheduleBodyBadge() tea.Cmd {
+	if m.focus != focusEditor {
+		return nil
+	}
+	line := currentCursorLine(m.editor)
+	src := m.editor.Value()
+	if line == m.bodyBadgeLine && src == m.bodyBadgeSrc {
+		return nil
+	}
+	m.bodyBadgeLine = line
+	m.bodyBadgeSrc = src
+	m.bodyBadgeSeq++
+	seq := m.bodyBadgeSeq
+	return tea.Tick(bodyBadgeDelay, func(time.Time) tea.Msg {
+		return bodyBadgeMsg{seq: seq}
+	})
+}
+
+func (m *Model) handleBodyBadge(msg bodyBadgeMsg) {
+	if msg.seq != m.bodyBadgeSeq {
+		return
+	}
+	src := m.bodyBadgeSrc
+	doc := parser.Parse(m.currentFile, []byte(src))
+	req, _ := m.requestAtCursor(doc, src, m.bodyBadgeLine)
+	m.bodyBadge = requestBodyBadge(req, filepath.Dir(m.currentFile))
+}
+
+// requestBodyBadge summarizes the request body as "<type> · <size>".
+// It returns an empty string when the request has no body.
+func requestBodyBadge(req *restfile.Request, baseDir string) string {
+	if req == nil || req.GRPC != nil {
+		return ""
+	}
+	body := req.Body
+	var (
+		size   int64
+		sample []byte
+	)
+	switch {
+	case body.GraphQL != nil:
+		size = int64(len(body.GraphQL.Query) + len(body.GraphQL.Variables))
+		if size == 0 {
+			return ""
+		}
+		return "GraphQL · " + formatByteSize(size)
+	case body.FilePath != "":
+		path := body.FilePath
+		if !filepath.IsAbs(path) && baseDir != "" {
+			path = filepath.Join(baseDir, path)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return bodyTypeLabel(body.MimeType, req, nil, body.FilePath) + " · missing file"
+		}
+		size = info.Size()
+	case body.Text != "":
+		sample = []byte(body.Text)
+		size = int64(len(sample))
+	default:
+		return ""
+	}
+	return bodyTypeLabel(body.MimeType, req, sample, body.FilePath) + " · " + formatByteSize(size)
+}
+
+func bodyTypeLabel(mimeType string, req *restfile.Request, sample []byte, path string) string {
+	ct := strings.TrimSpace(mimeType)
+	if ct == "" && req != nil && req.Headers != nil {
+		ct = req.Headers.Get("Content-Type")
+	}
+	if ct == "" && path != "" {
+		ct = mime.TypeByExtension(filepath.Ext(path))
+	}
+	if ct != "" {
+		if parsed, _, err := mime.ParseMediaType(ct); err == nil {
+			ct = parsed
+		}
+	}
+	ct = strings.ToLower(ct)
+	switch {
+	case strings.Contains(ct, "json"):
+		return "JSON"
+	case strings.Contains(ct, "xml"):
+		return "XML"
+	case strings.Contains(ct, "x-www-form-urlencoded"):
+		return "Form"
+	case strings.HasPrefix(ct, "multipart/"):
+		return "Multipart"
+	case strings.Contains(ct, "graphql"):
+		return "GraphQL"
+	case strings.HasPrefix(ct, "text/"):
+		return "Text"
+	case ct != "":
+		return ct
+	}
+	trimmed := strings.TrimSpace(string(sample))
+	switch {
+	case trimmed == "":
+		return "Body"
+	case json.Valid([]byte(trimmed)):
+		return "JSON"
+	case strings.HasPrefix(trimmed, "<"):
+		return "XML"
+	default:
+		return "Text"
+	}
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestRequestBodyBadge(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "payload.xml"), make([]byte, 2048), 0o644); err != nil {
+		t.Fatalf("write payload: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		req  *restfile.Request
+		want string
+	}{
+		{name: "nil", req: nil, want: ""},
+		{name: "no body", req: &restfile.Request{Method: "GET"}, want: ""},
+		{
+			name: "sniffed json",
+			req:  &restfile.Request{Body: restfile.BodySource{Text: `{"a":1}`}},
+			want: "JSON · 7 B",
+		},
+		{
+			name: "content type header",
+			req: &restfile.Request{
+				Headers: http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+				Body:    restfile.BodySource{Text: "a=1&b=2"},
+			},
+			want: "Form · 7 B",
+		},
+		{
+			name: "file body",
+			req:  &restfile.Request{Body: restfile.BodySource{FilePath: "payload.xml"}},
+			want: "XML · 2 KiB",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := requestBodyBadge(tc.req, dir); got != tc.want {
+				t.Fatalf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBodyBadgeFollowsCursorAfterDebounce(t *testing.T) {
+	model := New(Config{})
+	m := &model
+	m.ready = true
+	m.width = 160
+	_ = m.setFocus(focusEditor)
+	m.editor.SetValue("### one\nPOST https://example.com/a\n\n{\"ok\":true}\n")
+	m.editor.moveCursorTo(1, 0)
+
+	cmd := m.scheduleBodyBadge()
+	if cmd == nil {
+		t.Fatalf("expected debounced badge command")
+	}
+	if again := m.scheduleBodyBadge(); again != nil {
+		t.Fatalf("expected no reschedule without cursor or content change")
+	}
+	msg, ok := cmd().(bodyBadgeMsg)
+	if !ok {
+		t.Fatalf("expected bodyBadgeMsg")
+	}
+	m.handleBodyBadge(msg)
+	if m.bodyBadge != "JSON · 11 B" {
+		t.Fatalf("unexpected badge %q", m.bodyBadge)
+	}
+	if !strings.Contains(m.renderStatusBar(), "JSON · 11 B") {
+		t.Fatalf("expected badge in status bar")
+	}
+
+	stale := msg
+	m.editor.moveCursorTo(0, 0)
+	if m.scheduleBodyBadge() == nil {
+		t.Fatalf("expected reschedule after cursor move")
+	}
+	m.bodyBadge = ""
+	m.handleBodyBadge(stale)
+	if m.bodyBadge != "" {
+		t.Fatalf("expected stale badge message to be ignored")
+	}
+}
@@ -0,0 +1,260 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	bubbletextarea "github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+)
+
+// bodyEditor holds the JSON body panel. It edits the inline body of the
+// request at the cursor and refuses to write back until the text parses.
+type bodyEditor struct {
+	on     bool
+	title  string
+	src    string
+	start  int
+	end    int
+	cursor int
+	orig   string
+	input  bubbletextarea.Model
+	err    string
+}
+
+var bodyTemplatePattern = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+
+func (m *Model) openBodyEditor() tea.Cmd {
+	src := m.editor.Value()
+	doc := parser.Parse(m.currentFile, []byte(src))
+	req, _ := requestAtLine(doc, currentCursorLine(m.editor))
+	if req == nil {
+		return statusCmd(statusWarn, "No request at cursor")
+	}
+	if req.Body.FilePath != "" {
+		return statusCmd(statusWarn, "Body is loaded from a file; edit the file instead")
+	}
+	body := strings.TrimSpace(req.Body.Text)
+	if body == "" {
+		return statusCmd(statusWarn, "Request has no inline body")
+	}
+	if !looksLikeJSONContainer([]byte(body)) &&
+		!strings.Contains(strings.ToLower(req.Body.MimeType), "json") {
+		return statusCmd(statusWarn, "Request body is not JSON")
+	}
+	lines := strings.Split(src, "\n")
+	start, end, ok := scanRequestBody(lines, req.LineRange.Start, req.LineRange.End, body)
+	if !ok {
+		return statusCmd(statusWarn, "Could not locate the body in the editor")
+	}
+
+	text := strings.Join(lines[start:end], "\n")
+	input := bubbletextarea.New()
+	input.ShowLineNumbers = true
+	input.Prompt = ""
+	input.CharLimit = 0
+	input.MaxHeight = 0
+	input.SetWidth(bodyEditorWidth(m.width) - 8)
+	input.SetHeight(max(m.height-16, 5))
+	input.SetValue(text)
+	input.Focus()
+
+	m.showHelp = false
+	m.showEnvSelector = false
+	m.showThemeSelector = false
+	m.bodyEdit = bodyEditor{
+		on:     true,
+		title:  requestBaseTitle(req),
+		src:    src,
+		start:  start,
+		end:    end,
+		cursor: m.editor.Line(),
+		orig:   text,
+		input:  input,
+	}
+	m.validateBodyEditor()
+	return nil
+}
+
+func (m *Model) closeBodyEditor() {
+	m.bodyEdit.input.Blur()
+	m.bodyEdit = bodyEditor{}
+}
+
+// scanRequestBody finds the lines holding body inside the request spanning
+// the 1-based lines first..last. The body starts after the first blank line
+// following the request line and stops before response scripts or handlers.
+// The match is confirmed against the parsed body text so comments or
+// directives mixed into the region never get rewritten.
+func scanRequestBody(lines []string, first, last int, body string) (int, int, bool) {
+	first = max(first-1, 0)
+	last = min(last, len(lines))
+	i := first
+	for i < last && !isRequestTextLine(lines[i]) {
+		i++
+	}
+	for i < last && strings.TrimSpace(lines[i]) != "" {
+		i++
+	}
+	for i < last && strings.TrimSpace(lines[i]) == "" {
+		i++
+	}
+	start := i
+	end := start
+	for end < last {
+		trimmed := strings.TrimSpace(lines[end])
+		if strings.HasPrefix(trimmed, ">") || strings.HasPrefix(trimmed, "<>") ||
+			strings.HasPrefix(trimmed, "###") {
+			break
+		}
+		end++
+	}
+	for end > start && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	if end <= start || strings.TrimSpace(strings.Join(lines[start:end], "\n")) != body {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// validateJSONBody reports the first syntax error in text as a message with
+// a 1-based line and column. Template placeholders are masked with a number
+// of the same width so offsets still point into the original text.
+func validateJSONBody(text string) string {
+	masked := bodyTemplatePattern.ReplaceAllStringFunc(text, func(s string) string {
+		return "0" + strings.Repeat(" ", len(s)-1)
+	})
+	var v any
+	err := json.Unmarshal([]byte(masked), &v)
+	if err == nil {
+		return ""
+	}
+	var syn *json.SyntaxError
+	if !errors.As(err, &syn) {
+		return err.Error()
+	}
+	offset := min(int(syn.Offset), len(masked))
+	line := strings.Count(masked[:offset], "\n") + 1
+	col := offset - strings.LastIndex(masked[:offset], "\n") - 1
+	return fmt.Sprintf("line %d, column %d: %s", line, max(col, 1), syn.Error())
+}
+
+func (m *Model) validateBodyEditor() {
+	ed := &m.bodyEdit
+	prev := ed.err
+	ed.err = validateJSONBody(ed.input.Value())
+	switch {
+	case ed.err != "":
+		m.setStatusMessage(statusMsg{level: statusWarn, text: "Invalid JSON: " + ed.err})
+	case prev != "":
+		m.setStatusMessage(statusMsg{level: statusInfo, text: "JSON body is valid"})
+	}
+}
+
+func (m *Model) handleBodyEditorKey(msg tea.KeyMsg) tea.Cmd {
+	ed := &m.bodyEdit
+	switch msg.String() {
+	case "esc":
+		dirty := ed.input.Value() != ed.orig
+		m.closeBodyEditor()
+		if dirty {
+			return statusCmd(statusInfo, "Discarded body changes")
+		}
+		return nil
+	case "ctrl+s":
+		return m.applyBodyEditor()
+	}
+	before := ed.input.Value()
+	var cmd tea.Cmd
+	ed.input, cmd = ed.input.Update(msg)
+	if ed.input.Value() != before {
+		m.validateBodyEditor()
+	}
+	return cmd
+}
+
+// applyBodyEditor writes the edited body back into the editor buffer as
+// one undo step.
+func (m *Model) applyBodyEditor() tea.Cmd {
+	ed := &m.bodyEdit
+	text := ed.input.Value()
+	if text == ed.orig {
+		m.closeBodyEditor()
+		return statusCmd(statusInfo, "No body changes to apply")
+	}
+	if ed.err != "" {
+		return statusCmd(statusWarn, "Fix the JSON before saving: "+ed.err)
+	}
+	if m.editor.Value() != ed.src {
+		ed.err = "The editor changed since the panel opened; reopen it"
+		return nil
+	}
+
+	lines := strings.Split(ed.src, "\n")
+	updated := make([]string, 0, len(lines))
+	updated = append(updated, lines[:ed.start]...)
+	updated = append(updated, strings.Split(strings.TrimRight(text, "\n"), "\n")...)
+	updated = append(updated, lines[ed.end:]...)
+
+	view := m.editor.ViewStart()
+	m.editor.pushUndoSnapshot()
+	m.editor.SetValue(strings.Join(updated, "\n"))
+	m.editor.SetViewStart(view)
+	m.editor.clearSelection()
+	m.editor.moveCursorTo(ed.cursor, 0)
+	m.dirty = true
+
+	m.doc = parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	m.syncRequestList(m.doc)
+
+	title := ed.title
+	m.closeBodyEditor()
+	return statusCmd(statusInfo, fmt.Sprintf("Updated body for %s", title))
+}
+
+func bodyEditorWidth(total int) int {
+	return max(minInt(total-10, 100), 40)
+}
+
+func (m Model) renderBodyEditorModal() string {
+	ed := m.bodyEdit
+	width := bodyEditorWidth(m.width)
+
+	title := fmt.Sprintf("JSON body: %s", ed.title)
+	if ed.input.Value() != ed.orig {
+		title += " *"
+	}
+	hint := func(key string) string { return m.theme.CommandBarHint.Render(key) }
+	info := fmt.Sprintf("%s Apply    %s Close", hint("Ctrl+S"), hint("Esc"))
+
+	status := m.theme.Success.Render("Valid JSON")
+	if ed.err != "" {
+		status = m.theme.Error.Render(truncateToWidth(ed.err, width-8))
+	}
+	lines := []string{
+		m.theme.HeaderTitle.
+			Width(width - 4).
+			Align(lipgloss.Center).
+			Render(truncateToWidth(title, width-4)),
+		"",
+		lipgloss.NewStyle().Padding(0, 2).Render(ed.input.View()),
+		"",
+		lipgloss.NewStyle().Padding(0, 2).Render(status),
+		"",
+		m.theme.HeaderValue.Padding(0, 2).Render(info),
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	box := m.theme.BrowserBorder.Width(width).Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("#1A1823")),
+	)
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestValidateJSONBodyReportsLineAndColumn(t *testing.T) {
+	if msg := validateJSONBody("{\n  \"id\": {{id}},\n  \"name\": \"{{name}}\"\n}"); msg != "" {
+		t.Fatalf("expected templates to validate, got %q", msg)
+	}
+	msg := validateJSONBody("{\n  \"a\": 1,\n  \"b\" 2\n}")
+	if !strings.HasPrefix(msg, "line 3, column 7:") {
+		t.Fatalf("expected error at line 3 column 7, got %q", msg)
+	}
+}
+
+func TestBodyEditorWritesBackOnlyValidJSON(t *testing.T) {
+	src := strings.Join([]string{
+		"### Create",
+		"POST https://example.com/users",
+		"Content-Type: application/json",
+		"",
+		"{",
+		"  \"name\": \"ana\"",
+		"}",
+		"",
+		"> {% client.test(\"ok\", () => {}) %}",
+		"",
+		"### Next",
+		"GET https://example.com/next",
+	}, "\n")
+	model := New(Config{InitialContent: src})
+	model.editor.SetValue(src)
+	model.editor.moveCursorTo(1, 0)
+
+	if cmd := model.openBodyEditor(); cmd != nil {
+		t.Fatalf("expected body editor to open, got %+v", statusFromCmd(t, cmd))
+	}
+	if got := model.bodyEdit.input.Value(); got != "{\n  \"name\": \"ana\"\n}" {
+		t.Fatalf("expected body region only, got %q", got)
+	}
+
+	model.bodyEdit.input.SetValue("{\n  \"name\": \"ana\",\n}")
+	model.handleBodyEditorKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" ")})
+	if model.bodyEdit.err == "" || !strings.Contains(model.statusMessage.text, "Invalid JSON") {
+		t.Fatalf("expected validation error, got %q", model.statusMessage.text)
+	}
+	model.handleBodyEditorKey(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if !model.bodyEdit.on || model.editor.Value() != src {
+		t.Fatalf("expected invalid body to be refused")
+	}
+
+	model.bodyEdit.input.SetValue("{\n  \"name\": \"bob\"\n}")
+	model.handleBodyEditorKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" ")})
+	model.handleBodyEditorKey(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if model.bodyEdit.on {
+		t.Fatalf("expected body editor to close after apply")
+	}
+	got := model.editor.Value()
+	if !strings.Contains(got, "\"name\": \"bob\"") || strings.Contains(got, "ana") {
+		t.Fatalf("expected body replaced, got:\n%s", got)
+	}
+	if !strings.Contains(got, "> {% client.test") || !strings.Contains(got, "### Next") {
+		t.Fatalf("expected script and next request kept, got:\n%s", got)
+	}
+}
+
+func TestOpenBodyEditorRejectsNonJSON(t *testing.T) {
+	src := "POST https://example.com\nContent-Type: text/plain\n\nhello"
+	model := New(Config{InitialContent: src})
+	model.editor.SetValue(src)
+	model.editor.moveCursorTo(0, 0)
+	if cmd := model.openBodyEditor(); cmd == nil || model.bodyEdit.on {
+		t.Fatalf("expected non-JSON body to be rejected")
+	}
+}
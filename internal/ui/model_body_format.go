@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+)
+
+const bodyFormatIndent = "  "
+
+// toggleBodyFormat pretty-prints a single-line JSON body of the request at
+// the cursor and minifies a multi-line one. The rewrite is one undo step.
+func (m *Model) toggleBodyFormat() tea.Cmd {
+	src := m.editor.Value()
+	doc := parser.Parse(m.currentFile, []byte(src))
+	req, _ := requestAtLine(doc, currentCursorLine(m.editor))
+	if req == nil {
+		return statusCmd(statusWarn, "No request at cursor")
+	}
+	if req.Body.FilePath != "" {
+		return statusCmd(statusWarn, "Body is loaded from a file; format the file instead")
+	}
+	body := strings.TrimSpace(req.Body.Text)
+	if body == "" {
+		return statusCmd(statusWarn, "Request has no inline body")
+	}
+	lines := strings.Split(src, "\n")
+	start, end, ok := scanRequestBody(lines, req.LineRange.Start, req.LineRange.End, body)
+	if !ok {
+		return statusCmd(statusWarn, "Could not locate the body in the editor")
+	}
+	text := strings.Join(lines[start:end], "\n")
+	if errMsg := validateJSONBody(text); errMsg != "" {
+		return statusCmd(statusWarn, "Body is not JSON: "+errMsg)
+	}
+
+	pretty := !strings.Contains(strings.TrimSpace(text), "\n")
+	formatted := formatJSONBody(text, pretty)
+	if formatted == text {
+		return statusCmd(statusInfo, "Body is already formatted")
+	}
+
+	updated := make([]string, 0, len(lines))
+	updated = append(updated, lines[:start]...)
+	updated = append(updated, strings.Split(formatted, "\n")...)
+	updated = append(updated, lines[end:]...)
+
+	cursor := m.editor.Line()
+	if cursor >= end {
+		cursor += strings.Count(formatted, "\n") - strings.Count(text, "\n")
+	} else if cursor >= start {
+		cursor = start
+	}
+	view := m.editor.ViewStart()
+	m.editor.pushUndoSnapshot()
+	m.editor.SetValue(strings.Join(updated, "\n"))
+	m.editor.SetViewStart(view)
+	m.editor.clearSelection()
+	m.editor.moveCursorTo(cursor, 0)
+	m.dirty = true
+
+	m.doc = parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	m.syncRequestList(m.doc)
+
+	verb := "Minified"
+	if pretty {
+		verb = "Pretty-printed"
+	}
+	return statusCmd(statusInfo, fmt.Sprintf("%s body for %s", verb, requestBaseTitle(req)))
+}
+
+// formatJSONBody re-lays out text, which must already be valid JSON once
+// templates are masked. Strings and {{templates}} are copied verbatim so
+// placeholders survive in both key and value positions.
+func formatJSONBody(text string, pretty bool) string {
+	var b strings.Builder
+	depth := 0
+	newline := func() {
+		if pretty {
+			b.WriteString("\n")
+			b.WriteString(strings.Repeat(bodyFormatIndent, depth))
+		}
+	}
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"':
+			j := jsonStringEnd(text, i)
+			b.WriteString(text[i:j])
+			i = j
+		case strings.HasPrefix(text[i:], "{{"):
+			j := strings.Index(text[i:], "}}")
+			if j < 0 {
+				b.WriteString(text[i:])
+				return b.String()
+			}
+			b.WriteString(text[i : i+j+2])
+			i += j + 2
+		case c == '{' || c == '[':
+			b.WriteByte(c)
+			i++
+			if next := skipJSONSpace(text, i); next < len(text) && isJSONClose(text[next], c) {
+				b.WriteByte(text[next])
+				i = next + 1
+				continue
+			}
+			depth++
+			newline()
+		case c == '}' || c == ']':
+			depth = max(depth-1, 0)
+			newline()
+			b.WriteByte(c)
+			i++
+		case c == ',':
+			b.WriteByte(c)
+			newline()
+			i++
+		case c == ':':
+			b.WriteByte(c)
+			if pretty {
+				b.WriteByte(' ')
+			}
+			i++
+		default:
+			j := i
+			for j < len(text) && !strings.ContainsRune(" \t\r\n,:{}[]\"", rune(text[j])) &&
+				!strings.HasPrefix(text[j:], "{{") {
+				j++
+			}
+			b.WriteString(text[i:j])
+			i = j
+		}
+	}
+	return b.String()
+}
+
+func jsonStringEnd(text string, start int) int {
+	for i := start + 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return len(text)
+}
+
+func skipJSONSpace(text string, i int) int {
+	for i < len(text) && strings.ContainsRune(" \t\r\n", rune(text[i])) {
+		i++
+	}
+	return i
+}
+
+func isJSONClose(c, open byte) bool {
+	return (open == '{' && c == '}') || (open == '[' && c == ']')
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatJSONBodyKeepsTemplatesAndStrings(t *testing.T) {
+	compact := `{"id":{{id}},"note":"a, b: [c]","tags":[],"meta":{"{{key}}":"x \"y\""},"n":[1,2]}`
+	pretty := strings.Join([]string{
+		`{`,
+		`  "id": {{id}},`,
+		`  "note": "a, b: [c]",`,
+		`  "tags": [],`,
+		`  "meta": {`,
+		`    "{{key}}": "x \"y\""`,
+		`  },`,
+		`  "n": [`,
+		`    1,`,
+		`    2`,
+		`  ]`,
+		`}`,
+	}, "\n")
+	if got := formatJSONBody(compact, true); got != pretty {
+		t.Fatalf("unexpected pretty output:\n%s", got)
+	}
+	if got := formatJSONBody(pretty, false); got != compact {
+		t.Fatalf("unexpected minified output:\n%s", got)
+	}
+}
+
+func TestToggleBodyFormatRewritesOnlyTheBody(t *testing.T) {
+	doc := "### create\nPOST https://example.com/items\nContent-Type: application/json\n\n" +
+		"{\"name\":\"{{name}}\",\"qty\":2}\n\n> {% tests.assert(true) %}\n"
+	model := newTestModelWithDoc(doc)
+	model.editor.moveCursorTo(1, 0)
+
+	_ = model.toggleBodyFormat()
+	want := "### create\nPOST https://example.com/items\nContent-Type: application/json\n\n" +
+		"{\n  \"name\": \"{{name}}\",\n  \"qty\": 2\n}\n\n> {% tests.assert(true) %}\n"
+	if got := model.editor.Value(); got != want {
+		t.Fatalf("unexpected pretty buffer:\n%s", got)
+	}
+	if !model.dirty {
+		t.Fatalf("expected editor marked dirty")
+	}
+
+	_ = model.toggleBodyFormat()
+	if got := model.editor.Value(); got != doc {
+		t.Fatalf("expected minify to restore the original, got:\n%s", got)
+	}
+	model.editor, _ = model.editor.UndoLastChange()
+	if got := model.editor.Value(); got != want {
+		t.Fatalf("expected undo to restore the pretty body, got:\n%s", got)
+	}
+}
+
+func TestToggleBodyFormatRejectsInvalidJSON(t *testing.T) {
+	doc := "POST https://example.com\n\n{\"a\": }\n"
+	model := newTestModelWithDoc(doc)
+	msg := model.toggleBodyFormat()()
+	evt, ok := msg.(editorEvent)
+	if !ok || evt.status == nil || !strings.HasPrefix(evt.status.text, "Body is not JSON") {
+		t.Fatalf("expected not-JSON status, got %#v", msg)
+	}
+	if model.editor.Value() != doc {
+		t.Fatalf("expected buffer untouched")
+	}
+}
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/settings"
+)
+
+const bodyFileWatchSetting = "body-file-watch"
+
+// bodyFileWatch ties a watched body file to the request that sends it.
+// The body itself is always read from disk at send time; the watch only
+// decides whether a change on disk re-sends the request.
+type bodyFileWatch struct {
+	file string
+	key  string
+}
+
+func bodyFileWatchEnabled(values map[string]string) bool {
+	on, err := strconv.ParseBool(strings.TrimSpace(values[bodyFileWatchSetting]))
+	return err == nil && on
+}
+
+// bodyFilePath resolves the body file the same way the HTTP client does for
+// its first candidate: relative paths start at the request's base dir.
+func (m *Model) bodyFilePath(req *restfile.Request) string {
+	path := strings.TrimSpace(req.Body.FilePath)
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	if base := m.sessionBaseDir(req); base != "" {
+		return filepath.Join(base, path)
+	}
+	return path
+}
+
+// syncBodyFileWatch starts or stops watching the body file of a request that
+// was just sent. req carries the settings merged at send time.
+func (m *Model) syncBodyFileWatch(req *restfile.Request) {
+	if req == nil || m.fileWatcher == nil {
+		return
+	}
+	key := requestKey(req)
+	path := ""
+	if bodyFileWatchEnabled(req.Settings) {
+		path = m.bodyFilePath(req)
+	}
+	for p, w := range m.bodyWatches {
+		if w.key == key && w.file == m.currentFile && !samePath(p, path) {
+			m.forgetBodyFileWatch(p)
+		}
+	}
+	if path == "" {
+		return
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	if m.bodyWatches == nil {
+		m.bodyWatches = make(map[string]bodyFileWatch)
+	}
+	m.fileWatcher.Track(path, data)
+	m.bodyWatches[path] = bodyFileWatch{file: m.currentFile, key: key}
+}
+
+func (m *Model) forgetBodyFileWatch(path string) {
+	if m.fileWatcher != nil && !samePath(path, m.currentFile) {
+		m.fileWatcher.Forget(path)
+	}
+	delete(m.bodyWatches, path)
+}
+
+func (m *Model) clearBodyFileWatches() {
+	for path := range m.bodyWatches {
+		m.forgetBodyFileWatch(path)
+	}
+}
+
+func (m *Model) bodyWatchFor(path string) (string, bodyFileWatch, bool) {
+	for p, w := range m.bodyWatches {
+		if samePath(p, path) {
+			return p, w, true
+		}
+	}
+	return "", bodyFileWatch{}, false
+}
+
+// handleBodyFileChange re-sends the request whose watched body file changed.
+// The watch is dropped once the request is gone or no longer opts in.
+func (m *Model) handleBodyFileChange(path string) (tea.Cmd, bool) {
+	tracked, w, ok := m.bodyWatchFor(path)
+	if !ok {
+		return nil, false
+	}
+	if w.file != m.currentFile || m.doc == nil {
+		m.forgetBodyFileWatch(tracked)
+		return nil, true
+	}
+	var req *restfile.Request
+	for _, candidate := range m.doc.Requests {
+		if requestKey(candidate) == w.key {
+			req = candidate
+			break
+		}
+	}
+	if req == nil {
+		m.forgetBodyFileWatch(tracked)
+		return nil, true
+	}
+	merged := settings.Merge(
+		settings.FromEnv(m.cfg.EnvironmentSet, m.cfg.EnvironmentName),
+		m.doc.Settings,
+		m.doc.SettingsForTags(req.Metadata.Tags),
+		req.Settings,
+	)
+	if !bodyFileWatchEnabled(merged) {
+		m.forgetBodyFileWatch(tracked)
+		return nil, true
+	}
+	if m.sending {
+		return nil, true
+	}
+	m.moveCursorToLine(req.LineRange.Start)
+	status := statusCmd(
+		statusInfo,
+		fmt.Sprintf("%s changed; re-sending", filepath.Base(tracked)),
+	)
+	return batchCommands(status, m.sendActiveRequest()), true
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/theme"
+	"github.com/unkn0wn-root/resterm/internal/watcher"
+)
+
+func newBodyWatchModel(t *testing.T, content string) (*Model, string) {
+	t.Helper()
+	tmp := t.TempDir()
+	path := filepath.Join(tmp, "api.http")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write request file: %v", err)
+	}
+	body := filepath.Join(tmp, "body.json")
+	if err := os.WriteFile(body, []byte(`{"a":1}`), 0o644); err != nil {
+		t.Fatalf("write body file: %v", err)
+	}
+	th := theme.DefaultTheme()
+	model := New(Config{WorkspaceRoot: tmp, Theme: &th, FilePath: path, InitialContent: content})
+	m := &model
+	m.doc = parser.Parse(path, []byte(content))
+	return m, body
+}
+
+func TestBodyFileWatchResendsOnChange(t *testing.T) {
+	content := "# @name create\n" +
+		"# @setting body-file-watch true\n" +
+		"POST https://example.com/items\n" +
+		"Content-Type: application/json\n\n" +
+		"< ./body.json\n"
+	m, body := newBodyWatchModel(t, content)
+	if len(m.doc.Requests) != 1 {
+		t.Fatalf("expected one request, got %d", len(m.doc.Requests))
+	}
+	m.syncBodyFileWatch(m.doc.Requests[0])
+	if _, _, ok := m.bodyWatchFor(body); !ok {
+		t.Fatalf("expected %s to be watched, got %#v", body, m.bodyWatches)
+	}
+
+	cmd, ok := m.handleBodyFileChange(body)
+	if !ok {
+		t.Fatalf("expected body change to be handled")
+	}
+	if cmd == nil || !m.sending {
+		t.Fatalf("expected request to be re-sent")
+	}
+	if m.showFileChangeModal {
+		t.Fatalf("expected no file change modal for a body file")
+	}
+}
+
+func TestBodyFileWatchDroppedWhenSettingRemoved(t *testing.T) {
+	content := "# @name create\n" +
+		"POST https://example.com/items\n\n" +
+		"< ./body.json\n"
+	m, body := newBodyWatchModel(t, content)
+	req := m.doc.Requests[0]
+
+	m.syncBodyFileWatch(req)
+	if len(m.bodyWatches) != 0 {
+		t.Fatalf("expected no watch without the setting, got %#v", m.bodyWatches)
+	}
+
+	sent := *req
+	sent.Settings = map[string]string{bodyFileWatchSetting: "true"}
+	m.syncBodyFileWatch(&sent)
+	if len(m.bodyWatches) != 1 {
+		t.Fatalf("expected watch from merged settings, got %#v", m.bodyWatches)
+	}
+
+	cmd, ok := m.handleBodyFileChange(body)
+	if !ok || cmd != nil || m.sending {
+		t.Fatalf("expected no re-send once the document drops the setting")
+	}
+	if len(m.bodyWatches) != 0 {
+		t.Fatalf("expected watch to be forgotten, got %#v", m.bodyWatches)
+	}
+}
+
+func TestBodyFileWatchLeavesRequestFileEvents(t *testing.T) {
+	m, _ := newBodyWatchModel(t, "GET https://example.com\n")
+	if _, ok := m.handleBodyFileChange(m.currentFile); ok {
+		t.Fatalf("expected request file change to be left to the file watcher")
+	}
+	m.handleFileChangeEvent(fileChangedMsg{path: m.currentFile, kind: watcher.EventChanged})
+	if !m.showFileChangeModal {
+		t.Fatalf("expected file change modal for the request file")
+	}
+}
@@ -0,0 +1,174 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+)
+
+var captureScopes = []string{
+	"request",
+	"file",
+	"global",
+	"request-secret",
+	"file-secret",
+	"global-secret",
+}
+
+// capturePrompt turns the Tree tab selection into an @capture directive for
+// the request under the editor cursor. The user picks a scope and a name.
+type capturePrompt struct {
+	on    bool
+	expr  string
+	scope int
+	input textinput.Model
+	err   string
+}
+
+// jsonTreeCaptureExpr maps a tree node ID such as $.items[0].id onto the
+// equivalent response.json capture expression.
+func jsonTreeCaptureExpr(id string) string {
+	return "response.json" + strings.TrimPrefix(id, "$")
+}
+
+func (m *Model) openCapturePrompt() tea.Cmd {
+	_, view := m.currentJSONTree()
+	if view == nil {
+		return nil
+	}
+	node := view.nav.Selected()
+	if node == nil || node.ID == "$" {
+		return statusCmd(statusWarn, "Select a value below the root to capture")
+	}
+	name := node.Title
+	if node.Payload.Data.index || !isCaptureName(name) {
+		name = "value"
+	}
+	input := textinput.New()
+	input.Prompt = ""
+	input.CharLimit = 0
+	input.SetValue(name)
+	input.CursorEnd()
+
+	m.capPrompt = capturePrompt{
+		on:    true,
+		expr:  jsonTreeCaptureExpr(node.ID),
+		input: input,
+	}
+	return m.capPrompt.input.Focus()
+}
+
+func (m *Model) closeCapturePrompt() {
+	m.capPrompt.input.Blur()
+	m.capPrompt = capturePrompt{}
+}
+
+func isCaptureName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, " \t=")
+}
+
+func (m *Model) handleCapturePromptKey(msg tea.KeyMsg) tea.Cmd {
+	p := &m.capPrompt
+	switch msg.String() {
+	case "esc":
+		m.closeCapturePrompt()
+		return nil
+	case "tab":
+		p.scope = (p.scope + 1) % len(captureScopes)
+		return nil
+	case "shift+tab":
+		p.scope = (p.scope + len(captureScopes) - 1) % len(captureScopes)
+		return nil
+	case "enter":
+		return m.applyCapturePrompt()
+	}
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	p.err = ""
+	return cmd
+}
+
+// applyCapturePrompt inserts the directive just above the request line so
+// it joins the request's other directives, as one undo step.
+func (m *Model) applyCapturePrompt() tea.Cmd {
+	p := &m.capPrompt
+	name := strings.TrimSpace(p.input.Value())
+	if !isCaptureName(name) {
+		p.err = "Name must be non-empty without spaces or '='"
+		return nil
+	}
+	src := m.editor.Value()
+	doc := parser.Parse(m.currentFile, []byte(src))
+	req, _ := requestAtLine(doc, currentCursorLine(m.editor))
+	if req == nil {
+		p.err = "Move the editor cursor onto the request to add the capture to"
+		return nil
+	}
+	lines := strings.Split(src, "\n")
+	start, _, _, ok := scanRequestHeaders(lines, req)
+	if !ok {
+		p.err = "Could not find the request line in the editor"
+		return nil
+	}
+	at := start - 1
+	scope := captureScopes[p.scope]
+	directive := fmt.Sprintf("# @capture %s %s = %s", scope, name, p.expr)
+
+	updated := make([]string, 0, len(lines)+1)
+	updated = append(updated, lines[:at]...)
+	updated = append(updated, directive)
+	updated = append(updated, lines[at:]...)
+
+	cursor := m.editor.Line()
+	if cursor >= at {
+		cursor++
+	}
+	view := m.editor.ViewStart()
+	m.editor.pushUndoSnapshot()
+	m.editor.SetValue(strings.Join(updated, "\n"))
+	m.editor.SetViewStart(view)
+	m.editor.clearSelection()
+	m.editor.moveCursorTo(cursor, 0)
+	m.dirty = true
+
+	m.doc = parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	m.syncRequestList(m.doc)
+
+	m.closeCapturePrompt()
+	return statusCmd(statusInfo, fmt.Sprintf("Added @capture %s %s", scope, name))
+}
+
+func (m Model) renderCapturePromptModal() string {
+	p := m.capPrompt
+	width := max(minInt(m.width-10, 80), 40)
+	inner := width - 8
+
+	scope := m.theme.NavigatorTitleSelected.Render(" " + captureScopes[p.scope] + " ")
+	hint := func(key string) string { return m.theme.CommandBarHint.Render(key) }
+	info := fmt.Sprintf("%s Scope    %s Insert    %s Cancel", hint("Tab"), hint("Enter"), hint("Esc"))
+
+	lines := []string{
+		m.theme.HeaderTitle.Width(width - 4).Align(lipgloss.Center).Render("Add @capture"),
+		"",
+		m.theme.HeaderValue.Padding(0, 2).Render(truncateToWidth(p.expr, inner)),
+		"",
+		lipgloss.NewStyle().Padding(0, 2).Render("Scope: " + scope),
+		lipgloss.NewStyle().Padding(0, 2).Render("Name:  " + p.input.View()),
+	}
+	if p.err != "" {
+		lines = append(lines, "", m.theme.Error.Padding(0, 2).Render(truncateToWidth(p.err, inner)))
+	}
+	lines = append(lines, "", m.theme.HeaderValue.Padding(0, 2).Render(info))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	box := m.theme.BrowserBorder.Width(width).Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("#1A1823")),
+	)
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+)
+
+func TestJSONTreeCaptureExpr(t *testing.T) {
+	cases := map[string]string{
+		`$.items[0].id`:   "response.json.items[0].id",
+		`$["odd key"].id`: `response.json["odd key"].id`,
+	}
+	for id, want := range cases {
+		if got := jsonTreeCaptureExpr(id); got != want {
+			t.Fatalf("jsonTreeCaptureExpr(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestCapturePromptInsertsDirectiveForSelectedValue(t *testing.T) {
+	model := newTestModelWithDoc(sampleRequestDoc)
+	model.ready = true
+	model.width = 120
+	model.height = 40
+	if cmd := model.applyLayout(); cmd != nil {
+		collectMsgs(cmd)
+	}
+	model.editor.moveCursorTo(2, 0)
+	resp := &httpclient.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+		Headers:    http.Header{"Content-Type": {"application/json"}},
+		Body:       []byte(`{"data": {"token": "abc"}}`),
+	}
+	drainResponseCommands(t, model, model.consumeHTTPResponse(resp, nil, nil, ""))
+	model.setFocus(focusResponse)
+	pane := model.pane(responsePanePrimary)
+	pane.setActiveTab(responseTabTree)
+	model.syncResponsePane(responsePanePrimary)
+
+	model.handleKey(keyMsgFor("down"))
+	model.handleKey(keyMsgFor("down"))
+	model.handleKey(keyMsgFor("a"))
+	if !model.capPrompt.on {
+		t.Fatalf("expected capture prompt to open")
+	}
+	if got := model.capPrompt.input.Value(); got != "token" {
+		t.Fatalf("expected name to default to key, got %q", got)
+	}
+
+	update := func(msg tea.KeyMsg) {
+		t.Helper()
+		updated, _ := model.Update(msg)
+		next := updated.(Model)
+		model = &next
+	}
+	update(tea.KeyMsg{Type: tea.KeyTab})
+	update(keyMsgFor("2"))
+	update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if model.capPrompt.on {
+		t.Fatalf("expected prompt closed, err %q", model.capPrompt.err)
+	}
+	want := "### example\n# @name getExample\n" +
+		"# @capture file token2 = response.json.data.token\n" +
+		"GET https://example.com\n"
+	if got := model.editor.Value(); got != want {
+		t.Fatalf("unexpected editor content:\n%s", got)
+	}
+	if !model.dirty {
+		t.Fatalf("expected editor marked dirty")
+	}
+	if line := model.editor.Line(); line != 3 {
+		t.Fatalf("expected cursor to follow request line, got %d", line)
+	}
+	reqs := model.doc.Requests
+	if len(reqs) != 1 || len(reqs[0].Metadata.Captures) != 1 {
+		t.Fatalf("expected parsed capture on request, got %+v", reqs)
+	}
+}
@@ -28,6 +28,8 @@ const (
 	wsMetaStep          = "resterm.ws.step"
 	wsMetaCloseCode     = "resterm.ws.close.code"
 	wsMetaCloseReason   = "resterm.ws.close.reason"
+	wsMetaReconnect     = "resterm.ws.reconnect"
+	wsMetaReconnectWhy  = "resterm.ws.reconnect.reason"
 )
 
 const websocketConsoleSendTimeout = 5 * time.Second
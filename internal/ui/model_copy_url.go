@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+// copyResolvedURL copies the URL of the request under the cursor with its
+// templates and @path-param placeholders filled in, the same way a send
+// would (pre-request scripts are not run). Secret values are masked unless
+// the request opts in with @log-sensitive-headers.
+func (m *Model) copyResolvedURL() tea.Cmd {
+	content := m.editor.Value()
+	doc := parser.Parse(m.currentFile, []byte(content))
+	req, _ := m.requestAtCursor(doc, content, currentCursorLine(m.editor))
+	if req == nil || strings.TrimSpace(req.URL) == "" {
+		return statusCmd(statusWarn, "No request at cursor")
+	}
+
+	clone := cloneRequest(req)
+	env := vars.SelectEnv(m.cfg.EnvironmentSet, requestEnv(clone), m.cfg.EnvironmentName)
+	resolver := m.buildResolver(
+		context.Background(),
+		doc,
+		clone,
+		env,
+		m.sessionBaseDir(clone),
+		nil,
+	)
+	if err := applyPathParams(clone, resolver); err != nil {
+		return statusCmd(statusWarn, fmt.Sprintf("Could not resolve URL: %v", err))
+	}
+	if err := m.applyBaseURL(clone, resolver, env); err != nil {
+		return statusCmd(statusWarn, fmt.Sprintf("Could not resolve URL: %v", err))
+	}
+	url, err := resolver.ExpandTemplates(strings.TrimSpace(clone.URL))
+	if err != nil {
+		return statusCmd(statusWarn, fmt.Sprintf("Could not resolve URL: %v", err))
+	}
+
+	success := "Copied resolved URL"
+	if !req.Metadata.AllowSensitiveHeaders {
+		masked := redactHistoryText(url, m.secretValuesForEnvironment(env, req), false)
+		if masked != url {
+			url = masked
+			success += " (secrets masked)"
+		}
+	}
+	return (&m.editor).copyToClipboard(url, success)
+}
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+)
+
+func TestCopyResolvedURLMasksSecrets(t *testing.T) {
+	content := "@host = api.example.com\n" +
+		"@file-secret token = s3cr3t\n\n" +
+		"# @name get-user\n" +
+		"# @path-param id 42\n" +
+		"GET https://{{host}}/users/{id}?key={{token}}&v=1\n"
+	model := newTestModelWithDoc(content)
+	model.editor.clipboardMode = config.ClipboardOff
+	model.moveCursorToLine(6)
+
+	evt := editorEventFromCmd(t, model.copyResolvedURL())
+	if evt.status == nil || evt.status.text != "Copied resolved URL (secrets masked) to editor register (clipboard off)" {
+		t.Fatalf("unexpected status %+v", evt.status)
+	}
+	want := "https://api.example.com/users/42?key=•••&v=1"
+	if got := model.editor.registerText; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestCopyResolvedURLWithoutRequest(t *testing.T) {
+	model := newTestModelWithDoc("# just a comment\n")
+	evt := editorEventFromCmd(t, model.copyResolvedURL())
+	if evt.status == nil || evt.status.text != "No request at cursor" {
+		t.Fatalf("expected no-request status, got %+v", evt.status)
+	}
+}
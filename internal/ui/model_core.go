@@ -3,6 +3,7 @@ package ui
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -55,9 +56,12 @@ const (
 	responseTabPretty responseTab = iota
 	responseTabRaw
 	responseTabHeaders
+	responseTabTree
+	responseTabProto
 	responseTabStream
 	responseTabStats
 	responseTabTimeline
+	responseTabSent
 	responseTabCompare
 	responseTabDiff
 	responseTabHistory
@@ -164,6 +168,8 @@ type Config struct {
 	CompareTargets      []string
 	CompareBase         string
 	Bindings            *bindings.Map
+	GlobalsPath         string
+	WhatsNewPath        string
 }
 
 type operatorState struct {
@@ -200,6 +206,7 @@ type Model struct {
 	navigatorCompact         bool
 	pendingCrossFileID       string
 	docCache                 map[string]navDocCache
+	navFailed                map[string]bool
 	editor                   requestEditor
 	responsePanes            [2]responsePaneState
 	responseSplit            bool
@@ -223,11 +230,21 @@ type Model struct {
 	historyBlockKey          bool
 	envList                  list.Model
 	themeList                list.Model
+	recentList               list.Model
+	recentReqs               []recentRequest
+	recentPending            string
+	gotoList                 list.Model
+	gotoInput                textinput.Model
+	gotoEntries              []recentRequest
+	gotoIndex                map[string]gotoIndexEntry
+	gotoPending              string
 
 	responseLatest         *responseSnapshot
 	responsePrevious       *responseSnapshot
 	responsePending        *responseSnapshot
 	responseTokens         map[string]*responseSnapshot
+	responseSent           string
+	lastRespHeaders        map[string]http.Header
 	responseLastFocused    responsePaneID
 	focus                  paneFocus
 	compareSnapshots       map[string]*responseSnapshot
@@ -236,9 +253,17 @@ type Model struct {
 	compareFocusedEnv      string
 	showEnvSelector        bool
 	showThemeSelector      bool
+	showRecentRequests     bool
+	showGotoRequest        bool
 	showHelp               bool
 	helpJustOpened         bool
 	showNewFileModal       bool
+	envEdit                envEditor
+	hdrEdit                headerEditor
+	bodyEdit               bodyEditor
+	capPrompt              capturePrompt
+	presets                layoutPresetPicker
+	bodyWatches            map[string]bodyFileWatch
 	showLayoutSaveModal    bool
 	showOpenModal          bool
 	showErrorModal         bool
@@ -253,6 +278,11 @@ type Model struct {
 	requestDetailTitle     string
 	requestDetailFields    []requestDetailField
 	requestDetailViewport  *viewport.Model
+	showInfoModal          bool
+	infoModalTitle         string
+	infoModalContent       string
+	infoModalViewport      *viewport.Model
+	whatsNewVersion        string
 	helpViewport           *viewport.Model
 	suppressNextErrorModal bool
 
@@ -262,12 +292,17 @@ type Model struct {
 	searchJustOpened   bool
 	searchTarget       searchTarget
 	searchResponsePane responsePaneID
+	searchOrigin       int
+	searchPrevious     editorSearch
+	searchPreviewed    bool
 
 	statusMessage    statusMsg
 	statusPulseBase  string
 	statusPulseFrame int
 	statusPulseSeq   int
 	statusPulseOn    bool
+	uploadProgress   string
+	uploadProgressID uint64
 	tabSpinIdx       int
 	tabSpinSeq       int
 	tabSpinOn        bool
@@ -284,6 +319,9 @@ type Model struct {
 	testResults     []scripts.TestResult
 	scriptError     error
 	globals         *globalStore
+	globalsPath     string
+	execVars        *vars.ExecRunner
+	execTokens      *execTokenCache
 	fileVars        *fileStore
 	oauth           *oauth.Manager
 	updateClient    update.Client
@@ -301,6 +339,7 @@ type Model struct {
 	responseLoadingFrame int
 	responseRenderCancel context.CancelFunc
 	respTasks            *respTasks
+	tempFiles            *tempFiles
 
 	activeThemeKey      string
 	settingsHandle      config.SettingsHandle
@@ -342,6 +381,7 @@ type Model struct {
 	dirty                  bool
 	sending                bool
 	sendCancel             context.CancelFunc
+	webhookWait            *webhookWait
 	suppressEditorKey      bool
 	editorInsertMode       bool
 	editorWriteKeyMap      textarea.KeyMap
@@ -351,23 +391,38 @@ type Model struct {
 	newFileError           string
 	newFileFromSave        bool
 	openPathInput          textinput.Model
+	openBrowser            *navigator.Model[any]
+	openBrowserRoot        string
+	openBrowserFocus       bool
 	openPathError          string
 	responseSaveInput      textinput.Model
 	responseSaveError      string
 	showResponseSaveModal  bool
 	responseSaveJustOpened bool
+	responseSavePretty     bool
+	responseSaveFilter     textinput.Model
+	responseSaveOnFilter   bool
 	lastResponseSaveDir    string
 
 	fileStale            bool
 	fileMissing          bool
 	pendingReloadConfirm bool
 
+	// osc52 is a clipboard escape sequence riding on the next frames.
+	osc52 string
+
 	doc                *restfile.Document
 	currentFile        string
 	currentRequest     *restfile.Request
 	lastCursorLine     int
 	lastCursorFile     string
 	lastCursorDoc      *restfile.Document
+	bodyBadge          string
+	bodyBadgeSeq       int
+	bodyBadgeLine      int
+	bodyBadgeSrc       string
+	revealSecrets      bool
+	revealSecretsSeq   int
 	profileRun         *profileState
 	workflowRun        *workflowState
 	compareRun         *compareState
@@ -380,6 +435,7 @@ type Model struct {
 
 	streamMgr          *stream.Manager
 	streamMsgChan      chan tea.Msg
+	uploadProgressChan chan uploadProgressMsg
 	streamBatchWindow  time.Duration
 	streamMaxEvents    int
 	liveSessions       map[string]*liveSession
@@ -469,6 +525,7 @@ func New(cfg Config) Model {
 	}
 
 	editor := newRequestEditor()
+	editor.clipboardMode = cfg.Settings.Clipboard
 	editor.SetRuneStyler(selectEditorRuneStyler(cfg.FilePath, th.EditorMetadata))
 	editor.Placeholder = "Write HTTP requests here..."
 	editor.SetValue(cfg.InitialContent)
@@ -497,6 +554,12 @@ func New(cfg Config) Model {
 	responseSaveInput.Prompt = ""
 	responseSaveInput.SetCursor(0)
 
+	responseSaveFilter := textinput.New()
+	responseSaveFilter.Placeholder = "$.data (optional)"
+	responseSaveFilter.CharLimit = 0
+	responseSaveFilter.Prompt = ""
+	responseSaveFilter.SetCursor(0)
+
 	searchInput := textinput.New()
 	searchInput.Placeholder = "pattern"
 	searchInput.CharLimit = 0
@@ -563,6 +626,27 @@ func New(cfg Config) Model {
 	envList.SetFilteringEnabled(false)
 	envList.DisableQuitKeybindings()
 
+	recentList := list.New(nil, listDelegateForTheme(th, false, 0), 0, 0)
+	recentList.Title = "Recent requests"
+	recentList.SetShowStatusBar(false)
+	recentList.SetShowHelp(false)
+	recentList.SetFilteringEnabled(false)
+	recentList.DisableQuitKeybindings()
+
+	gotoList := list.New(nil, listDelegateForTheme(th, false, 0), 0, 0)
+	gotoList.Title = "Go to request"
+	gotoList.SetShowStatusBar(false)
+	gotoList.SetShowHelp(false)
+	gotoList.SetFilteringEnabled(false)
+	gotoList.DisableQuitKeybindings()
+
+	gotoInput := textinput.New()
+	gotoInput.Placeholder = "request name or file"
+	gotoInput.CharLimit = 0
+	gotoInput.Prompt = "> "
+	gotoInput.SetCursor(0)
+	gotoInput.Blur()
+
 	themeItems := makeThemeItems(cfg.ThemeCatalog, activeTheme)
 	themeDelegate := listDelegateForTheme(th, true, 3)
 	themeList := list.New(themeItems, themeDelegate, 0, 0)
@@ -591,6 +675,8 @@ func New(cfg Config) Model {
 
 	detailViewport := viewport.New(0, 0)
 	detailViewport.SetContent("")
+	infoViewport := viewport.New(0, 0)
+	infoViewport.SetContent("")
 
 	helpViewport := viewport.New(0, 0)
 	helpViewport.SetContent("")
@@ -607,6 +693,15 @@ func New(cfg Config) Model {
 	k8sGlobals := newK8sStore()
 	patchGlobals := newPatchStore()
 
+	var (
+		execVars   *vars.ExecRunner
+		execTokens *execTokenCache
+	)
+	if cfg.Settings.AllowExecVars {
+		execVars = vars.NewExecRunner(vars.DefaultExecTimeout)
+		execTokens = newExecTokenCache()
+	}
+
 	updateVersion := strings.TrimSpace(cfg.Version)
 	updateCmd := strings.TrimSpace(cfg.UpdateCmd)
 	if updateCmd == "" {
@@ -642,8 +737,12 @@ func New(cfg Config) Model {
 		historyFilterInput:     historyFilter,
 		envList:                envList,
 		themeList:              themeList,
+		recentList:             recentList,
+		gotoList:               gotoList,
+		gotoInput:              gotoInput,
 		historyPreviewViewport: &previewViewport,
 		requestDetailViewport:  &detailViewport,
+		infoModalViewport:      &infoViewport,
 		helpViewport:           &helpViewport,
 		activeThemeKey:         activeTheme,
 		settingsHandle:         cfg.SettingsHandle,
@@ -676,6 +775,9 @@ func New(cfg Config) Model {
 		scriptRunner:             scripts.NewRunner(nil),
 		rtsEng:                   rts.NewEng(),
 		globals:                  newGlobalStore(),
+		execVars:                 execVars,
+		execTokens:               execTokens,
+		tempFiles:                &tempFiles{},
 		fileVars:                 newFileStore(),
 		oauth:                    oauth.NewManager(client),
 		updateClient:             cfg.UpdateClient,
@@ -688,10 +790,12 @@ func New(cfg Config) Model {
 		newFileInput:             newFileInput,
 		openPathInput:            openPathInput,
 		responseSaveInput:        responseSaveInput,
+		responseSaveFilter:       responseSaveFilter,
 		searchInput:              searchInput,
 		searchTarget:             searchTargetEditor,
 		streamMgr:                stream.NewManager(),
 		streamMsgChan:            make(chan tea.Msg, 128),
+		uploadProgressChan:       make(chan uploadProgressMsg, 1),
 		streamBatchWindow:        defaultStreamBatchWindow,
 		streamMaxEvents:          defaultStreamMaxEvents,
 		sessionHandles:           make(map[string]*stream.Session),
@@ -712,7 +816,14 @@ func New(cfg Config) Model {
 		compareSnapshots:   make(map[string]*responseSnapshot),
 	}
 	model.applyLayoutSettingsFromConfig(cfg.Settings.Layout)
+	model.scriptRunner.SetSandbox(cfg.Settings.SandboxScripts)
 	_ = model.setInsertMode(false, false)
+	if cfg.Settings.PersistGlobals {
+		model.globalsPath = strings.TrimSpace(cfg.GlobalsPath)
+	}
+	if err := model.loadPersistedGlobals(); err != nil {
+		model.setStatusMessage(statusMsg{level: statusWarn, text: err.Error()})
+	}
 
 	model.doc = parser.Parse(cfg.FilePath, []byte(cfg.InitialContent))
 	model.syncAllGlobals(model.doc)
@@ -732,6 +843,7 @@ func New(cfg Config) Model {
 		model.lastResponseSaveDir = model.workspaceRoot
 	}
 	model.initLatencyAnim()
+	model.openWhatsNew()
 
 	return model
 }
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+var requestNameLineRegex = regexp.MustCompile(`^(\s*(?:#|//)\s*@name\s+)(.+?)\s*$`)
+
+// duplicateRequestAtCursor copies the request block under the editor cursor
+// below itself, renaming its @name so both stay addressable. The insert is
+// a single undo step.
+func (m *Model) duplicateRequestAtCursor() tea.Cmd {
+	content := m.editor.Value()
+	doc := parser.Parse(m.currentFile, []byte(content))
+	req, _ := requestAtLine(doc, currentCursorLine(m.editor))
+	if req == nil {
+		return statusCmd(statusWarn, "No request at cursor")
+	}
+
+	lines := strings.Split(content, "\n")
+	start := req.LineRange.Start - 1
+	end := min(req.LineRange.End, len(lines))
+	if start < 0 || start >= end {
+		return statusCmd(statusWarn, "No request at cursor")
+	}
+	last := end
+	for last > start && strings.TrimSpace(lines[last-1]) == "" {
+		last--
+	}
+	block := append([]string(nil), lines[start:last]...)
+
+	sep := "###"
+	if start > 0 && strings.HasPrefix(strings.TrimSpace(lines[start-1]), "###") {
+		sep = strings.TrimSpace(lines[start-1])
+	}
+	name := ""
+	if req.Metadata.Name != "" {
+		name = uniqueRequestName(doc, req.Metadata.Name+"-copy")
+		renameRequestBlock(block, name)
+	}
+
+	insert := make([]string, 0, len(block)+3)
+	if strings.TrimSpace(lines[end-1]) != "" {
+		insert = append(insert, "")
+	}
+	insert = append(insert, sep)
+	cursorLine := end + len(insert)
+	insert = append(insert, block...)
+	if end < len(lines) {
+		insert = append(insert, "")
+	}
+
+	updated := make([]string, 0, len(lines)+len(insert))
+	updated = append(updated, lines[:end]...)
+	updated = append(updated, insert...)
+	updated = append(updated, lines[end:]...)
+
+	view := m.editor.ViewStart()
+	m.editor.pushUndoSnapshot()
+	m.editor.SetValue(strings.Join(updated, "\n"))
+	m.editor.SetViewStart(view)
+	m.editor.clearSelection()
+	m.editor.moveCursorTo(cursorLine, 0)
+	m.dirty = true
+
+	m.doc = parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	m.syncRequestList(m.doc)
+	if dup, _ := requestAtLine(m.doc, cursorLine+1); dup != nil {
+		m.revealRequestInEditor(dup)
+	}
+
+	text := "Duplicated request"
+	if name != "" {
+		text = fmt.Sprintf("Duplicated request as %s", name)
+	}
+	return statusCmd(statusInfo, text)
+}
+
+func uniqueRequestName(doc *restfile.Document, base string) string {
+	taken := make(map[string]struct{})
+	if doc != nil {
+		for _, req := range doc.Requests {
+			if req != nil && req.Metadata.Name != "" {
+				taken[req.Metadata.Name] = struct{}{}
+			}
+		}
+	}
+	name := base
+	for i := 2; ; i++ {
+		if _, ok := taken[name]; !ok {
+			return name
+		}
+		name = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+// renameRequestBlock rewrites the first @name directive in block, keeping
+// quotes when the new name needs them.
+func renameRequestBlock(block []string, name string) {
+	for i, line := range block {
+		match := requestNameLineRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		value := name
+		if strings.ContainsAny(name, " \t") || strings.HasPrefix(match[2], `"`) {
+			value = `"` + name + `"`
+		}
+		block[i] = match[1] + value
+		return
+	}
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDuplicateRequestAtCursorCopiesBlock(t *testing.T) {
+	src := strings.Join([]string{
+		"### Users",
+		"# @name getUser",
+		"GET https://example.com/users/1",
+		"Accept: application/json",
+		"",
+		"### Other",
+		"# @name getUser-copy",
+		"GET https://example.com/other",
+		"",
+	}, "\n")
+	model := New(Config{InitialContent: src})
+	model.editor.SetValue(src)
+	model.editor.moveCursorTo(2, 0)
+
+	status := statusFromCmd(t, model.duplicateRequestAtCursor())
+	if status == nil || status.level != statusInfo {
+		t.Fatalf("expected info status, got %+v", status)
+	}
+	want := strings.Join([]string{
+		"### Users",
+		"# @name getUser",
+		"GET https://example.com/users/1",
+		"Accept: application/json",
+		"",
+		"### Users",
+		"# @name getUser-copy-2",
+		"GET https://example.com/users/1",
+		"Accept: application/json",
+		"",
+		"### Other",
+		"# @name getUser-copy",
+		"GET https://example.com/other",
+		"",
+	}, "\n")
+	if got := model.editor.Value(); got != want {
+		t.Fatalf("unexpected editor content\nwant:\n%s\n\ngot:\n%s", want, got)
+	}
+	if line := model.editor.Line(); line != 6 {
+		t.Fatalf("expected cursor on duplicated block, got line %d", line)
+	}
+	if !model.dirty {
+		t.Fatalf("expected duplicate to mark the buffer dirty")
+	}
+	if len(model.doc.Requests) != 3 {
+		t.Fatalf("expected reparsed document with 3 requests, got %d", len(model.doc.Requests))
+	}
+
+	model.editor, _ = model.editor.UndoLastChange()
+	if got := model.editor.Value(); got != src {
+		t.Fatalf("expected single undo to restore original, got:\n%s", got)
+	}
+}
+
+func TestDuplicateRequestAtCursorAppendsAtEOF(t *testing.T) {
+	src := "GET https://example.com/a"
+	model := New(Config{InitialContent: src})
+	model.editor.SetValue(src)
+	model.editor.moveCursorTo(0, 0)
+
+	model.duplicateRequestAtCursor()
+	want := "GET https://example.com/a\n\n###\nGET https://example.com/a"
+	if got := model.editor.Value(); got != want {
+		t.Fatalf("unexpected editor content\nwant:\n%q\n\ngot:\n%q", want, got)
+	}
+}
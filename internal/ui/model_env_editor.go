@@ -0,0 +1,296 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+type envEditMode int
+
+const (
+	envEditBrowse envEditMode = iota
+	envEditValue
+	envEditKey
+)
+
+// envEditor holds the bulk environment editing modal. Entries are the
+// environment's own keys as written in the file; $shared defaults are not
+// listed and are left untouched on save.
+type envEditor struct {
+	on      bool
+	env     string
+	path    string
+	entries []vars.EnvEntry
+	sel     int
+	mode    envEditMode
+	input   textinput.Model
+	reveal  bool
+	dirty   bool
+	err     string
+}
+
+func newEnvEditorInput() textinput.Model {
+	in := textinput.New()
+	in.CharLimit = 0
+	in.Prompt = ""
+	return in
+}
+
+func (m *Model) openEnvEditor() tea.Cmd {
+	path := strings.TrimSpace(m.cfg.EnvironmentFile)
+	if path == "" {
+		return statusCmd(statusWarn, "No environment file loaded")
+	}
+	if vars.IsDotEnvPath(path) {
+		return statusCmd(statusWarn, "Editing is supported for JSON env files only")
+	}
+	env := vars.SelectEnv(m.cfg.EnvironmentSet, "", m.cfg.EnvironmentName)
+	if env == "" {
+		return statusCmd(statusWarn, "No environment selected")
+	}
+	entries, err := vars.ReadEnvironmentEntries(path, env)
+	if err != nil {
+		return statusCmd(statusError, err.Error())
+	}
+	m.showHelp = false
+	m.showEnvSelector = false
+	m.showThemeSelector = false
+	m.envEdit = envEditor{
+		on:      true,
+		env:     env,
+		path:    path,
+		entries: entries,
+		input:   newEnvEditorInput(),
+	}
+	return nil
+}
+
+func (m *Model) closeEnvEditor() {
+	m.envEdit.input.Blur()
+	m.envEdit = envEditor{}
+}
+
+func (m *Model) handleEnvEditorKey(msg tea.KeyMsg) tea.Cmd {
+	ed := &m.envEdit
+	if ed.mode != envEditBrowse {
+		switch msg.String() {
+		case "esc":
+			ed.mode = envEditBrowse
+			ed.input.Blur()
+			return nil
+		case "enter":
+			m.commitEnvEditInput()
+			return nil
+		}
+		var cmd tea.Cmd
+		ed.input, cmd = ed.input.Update(msg)
+		return cmd
+	}
+
+	switch msg.String() {
+	case "esc":
+		dirty := ed.dirty
+		m.closeEnvEditor()
+		if dirty {
+			return statusCmd(statusInfo, "Discarded environment changes")
+		}
+		return nil
+	case "up", "k":
+		ed.sel = max(ed.sel-1, 0)
+	case "down", "j":
+		ed.sel = min(ed.sel+1, max(len(ed.entries)-1, 0))
+	case "enter", "e":
+		if ed.sel < len(ed.entries) {
+			ed.mode = envEditValue
+			ed.input.SetValue(ed.entries[ed.sel].Value)
+			ed.input.CursorEnd()
+			return ed.input.Focus()
+		}
+	case "a":
+		ed.mode = envEditKey
+		ed.input.SetValue("")
+		return ed.input.Focus()
+	case "d":
+		if ed.sel < len(ed.entries) {
+			ed.entries = append(ed.entries[:ed.sel], ed.entries[ed.sel+1:]...)
+			ed.sel = min(ed.sel, max(len(ed.entries)-1, 0))
+			ed.dirty = true
+		}
+	case "r":
+		ed.reveal = !ed.reveal
+	case "ctrl+s":
+		return m.saveEnvEditor()
+	}
+	return nil
+}
+
+func (m *Model) commitEnvEditInput() {
+	ed := &m.envEdit
+	value := ed.input.Value()
+	ed.err = ""
+	switch ed.mode {
+	case envEditKey:
+		key := strings.TrimSpace(value)
+		if key == "" {
+			ed.err = "Enter a key name"
+			return
+		}
+		for i, e := range ed.entries {
+			if e.Key == key {
+				ed.sel = i
+				ed.err = fmt.Sprintf("%s already exists", key)
+				return
+			}
+		}
+		ed.entries = append(ed.entries, vars.EnvEntry{Key: key})
+		ed.sel = len(ed.entries) - 1
+		ed.mode = envEditValue
+		ed.input.SetValue("")
+		ed.dirty = true
+		return
+	case envEditValue:
+		if ed.sel < len(ed.entries) && ed.entries[ed.sel].Value != value {
+			ed.entries[ed.sel].Value = value
+			ed.dirty = true
+		}
+	}
+	ed.mode = envEditBrowse
+	ed.input.Blur()
+}
+
+func (m *Model) saveEnvEditor() tea.Cmd {
+	ed := &m.envEdit
+	if !ed.dirty {
+		m.closeEnvEditor()
+		return statusCmd(statusInfo, "No environment changes to save")
+	}
+	if err := vars.WriteEnvironmentEntries(ed.path, ed.env, ed.entries); err != nil {
+		ed.err = err.Error()
+		return nil
+	}
+	envs, err := vars.LoadEnvironmentFile(ed.path)
+	if err != nil {
+		ed.err = err.Error()
+		return nil
+	}
+	m.cfg.EnvironmentSet = envs
+	m.envList.SetItems(makeEnvItems(envs))
+	env, path := ed.env, ed.path
+	m.closeEnvEditor()
+	return statusCmd(
+		statusSuccess,
+		fmt.Sprintf("Saved %s to %s", env, filepath.Base(path)),
+	)
+}
+
+// envKeySecret guesses whether a key holds a credential so its value is
+// masked until revealed.
+func envKeySecret(key string) bool {
+	k := strings.ToLower(key)
+	for _, word := range []string{
+		"secret", "token", "password", "passwd", "pwd", "apikey", "api_key",
+		"api-key", "auth", "credential", "private",
+	} {
+		if strings.Contains(k, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m Model) renderEnvEditorModal() string {
+	ed := m.envEdit
+	width := minInt(m.width-10, 90)
+	if width < 40 {
+		width = 40
+	}
+	inner := width - 8
+
+	keyWidth := 0
+	for _, e := range ed.entries {
+		keyWidth = max(keyWidth, lipgloss.Width(e.Key))
+	}
+	keyWidth = min(keyWidth, inner/2)
+
+	rows := max(m.height-16, 3)
+	start := 0
+	if ed.sel >= rows {
+		start = ed.sel - rows + 1
+	}
+	end := min(start+rows, len(ed.entries))
+
+	var body []string
+	if len(ed.entries) == 0 {
+		body = append(body, m.theme.HeaderValue.Render("No keys yet. Press a to add one."))
+	}
+	for i := start; i < end; i++ {
+		e := ed.entries[i]
+		value := e.Value
+		if !ed.reveal && envKeySecret(e.Key) {
+			value = maskSecret(value, true)
+		}
+		line := fmt.Sprintf("%-*s  %s", keyWidth, e.Key, value)
+		line = truncateToWidth(line, inner)
+		if i == ed.sel {
+			line = m.theme.NavigatorTitleSelected.Render(line)
+		}
+		body = append(body, line)
+	}
+
+	hint := func(key string) string { return m.theme.CommandBarHint.Render(key) }
+	info := fmt.Sprintf(
+		"%s Edit  %s Add  %s Delete  %s Reveal  %s Save  %s Close",
+		hint("Enter"), hint("a"), hint("d"), hint("r"), hint("Ctrl+S"), hint("Esc"),
+	)
+	switch ed.mode {
+	case envEditKey:
+		body = append(body, "", "New key: "+ed.input.View())
+		info = fmt.Sprintf("%s Next    %s Cancel", hint("Enter"), hint("Esc"))
+	case envEditValue:
+		label := "Value"
+		if ed.sel < len(ed.entries) {
+			label = ed.entries[ed.sel].Key
+			if ed.entries[ed.sel].Raw {
+				label += " (JSON)"
+			}
+		}
+		body = append(body, "", label+": "+ed.input.View())
+		info = fmt.Sprintf("%s Apply    %s Cancel", hint("Enter"), hint("Esc"))
+	}
+
+	title := fmt.Sprintf("Environment: %s", ed.env)
+	if ed.dirty {
+		title += " *"
+	}
+	lines := []string{
+		m.theme.HeaderTitle.
+			Width(width - 4).
+			Align(lipgloss.Center).
+			Render(title),
+		lipgloss.NewStyle().
+			Padding(0, 2).
+			Render(m.theme.HeaderValue.Render(filepath.Base(ed.path))),
+		"",
+		lipgloss.NewStyle().
+			Padding(0, 2).
+			Render(lipgloss.JoinVertical(lipgloss.Left, body...)),
+	}
+	if ed.err != "" {
+		lines = append(lines, "", m.theme.Error.Padding(0, 2).Render(ed.err))
+	}
+	lines = append(lines, "", m.theme.HeaderValue.Padding(0, 2).Render(info))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	box := m.theme.BrowserBorder.Width(width).Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("#1A1823")),
+	)
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestEnvEditorEditsAndSaves(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resterm.env.json")
+	data := `{"dev": {"host": "dev.example", "apiToken": "abc123"}}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("write env file: %v", err)
+	}
+	model := New(Config{
+		EnvironmentSet: map[string]map[string]string{
+			"dev": {"host": "dev.example", "apiToken": "abc123"},
+		},
+		EnvironmentName: "dev",
+		EnvironmentFile: path,
+	})
+	model.ready = true
+	model.width = 100
+	model.height = 30
+
+	if cmd := model.openEnvEditor(); cmd != nil {
+		t.Fatalf("expected editor to open, got %v", cmd())
+	}
+	view := model.renderEnvEditorModal()
+	if strings.Contains(view, "abc123") || !strings.Contains(view, "dev.example") {
+		t.Fatalf("expected token to be masked and host visible:\n%s", view)
+	}
+	model.handleEnvEditorKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if view := model.renderEnvEditorModal(); !strings.Contains(view, "abc123") {
+		t.Fatalf("expected reveal to show the token:\n%s", view)
+	}
+
+	model.handleEnvEditorKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.envEdit.input.SetValue("staging.example")
+	model.handleEnvEditorKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.handleEnvEditorKey(tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	if model.envEdit.on {
+		t.Fatalf("expected editor to close after save, err %q", model.envEdit.err)
+	}
+	if got := model.cfg.EnvironmentSet["dev"]["host"]; got != "staging.example" {
+		t.Fatalf("expected reloaded environment, got %q", got)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read env file: %v", err)
+	}
+	if !strings.Contains(string(raw), "staging.example") {
+		t.Fatalf("expected value written to env file, got %s", raw)
+	}
+}
+
+func TestEnvKeySecret(t *testing.T) {
+	for _, key := range []string{"apiToken", "client_secret", "DB_PASSWORD", "authHeader"} {
+		if !envKeySecret(key) {
+			t.Fatalf("expected %q to be treated as secret", key)
+		}
+	}
+	if envKeySecret("baseUrl") {
+		t.Fatalf("expected baseUrl to be visible")
+	}
+}
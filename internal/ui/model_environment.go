@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
 )
 
 func (m *Model) openEnvironmentSelector() {
@@ -58,15 +60,27 @@ func (m *Model) applyEnvironmentSelection() tea.Cmd {
 	}
 
 	m.cfg.EnvironmentName = item.name
-	if m.globals != nil {
-		m.globals.clear(item.name)
-	}
-	if m.fileVars != nil {
-		m.fileVars.clearEnv(item.name)
-	}
 	msg := fmt.Sprintf("Environment set to %s", item.name)
 	m.setStatusMessage(statusMsg{level: statusInfo, text: msg})
-	m.syncRequestList(m.doc)
+	m.refreshForEnvironment()
 	m.syncHistory()
 	return nil
 }
+
+// refreshForEnvironment re-evaluates everything rendered through the display
+// resolver after an environment switch. The document is reparsed from the
+// editor buffer so unsaved edits are kept and reflected; runtime globals and
+// file captures stay keyed by environment and are not cleared here.
+func (m *Model) refreshForEnvironment() {
+	if m.dirty {
+		m.doc = parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	}
+	m.syncAllGlobals(m.doc)
+	m.syncRequestList(m.doc)
+	if m.showRequestDetails {
+		if req, doc, path := m.requestDetailContext(); req != nil {
+			m.requestDetailFields = m.buildRequestDetailFields(req, doc, path)
+			m.requestDetailTitle = m.requestDetailTitleFor(req, doc)
+		}
+	}
+}
@@ -33,3 +33,55 @@ func TestEnvironmentSelectorRendersItems(t *testing.T) {
 func containsSubstring(view, substr string) bool {
 	return strings.Contains(view, substr)
 }
+
+func TestEnvironmentSwitchKeepsBufferAndCaptures(t *testing.T) {
+	cfg := Config{
+		EnvironmentSet: map[string]map[string]string{
+			"dev":  {"baseUrl": "https://dev"},
+			"prod": {"baseUrl": "https://prod"},
+		},
+		EnvironmentName: "dev",
+		InitialContent:  "### one\nGET {{baseUrl}}/one\n",
+	}
+	model := New(cfg)
+	m := &model
+	m.globals.set("dev", "token", "dev-token", false)
+	m.fileVars.set("dev", m.currentFile, "id", "42", false)
+
+	edited := "### one\nGET {{baseUrl}}/one\n\n### two\nGET {{baseUrl}}/two\n"
+	m.editor.SetValue(edited)
+	m.dirty = true
+
+	selectEnv := func(name string) {
+		m.openEnvironmentSelector()
+		for i, item := range m.envList.Items() {
+			if it, ok := item.(envItem); ok && it.name == name {
+				m.envList.Select(i)
+			}
+		}
+		m.applyEnvironmentSelection()
+	}
+
+	selectEnv("prod")
+	if m.cfg.EnvironmentName != "prod" {
+		t.Fatalf("expected prod, got %q", m.cfg.EnvironmentName)
+	}
+	if m.editor.Value() != edited || !m.dirty {
+		t.Fatalf("expected unsaved buffer to be preserved")
+	}
+	if len(m.doc.Requests) != 2 {
+		t.Fatalf("expected document reparsed from buffer, got %d requests", len(m.doc.Requests))
+	}
+	m.globals.set("prod", "token", "prod-token", false)
+
+	selectEnv("dev")
+	if got := m.globals.snapshot("dev"); len(got) != 1 {
+		t.Fatalf("expected dev globals to survive the round trip, got %v", got)
+	}
+	if got := m.fileVars.snapshot("dev", m.currentFile); len(got) != 1 {
+		t.Fatalf("expected dev file captures to survive the round trip, got %v", got)
+	}
+	if got := m.globals.snapshot("prod"); len(got) != 1 {
+		t.Fatalf("expected prod globals to be kept, got %v", got)
+	}
+}
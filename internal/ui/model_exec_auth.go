@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+// execTokenCache keeps bearer tokens produced by @auth bearer-exec commands.
+// Entries are keyed by environment plus cache_key (or the command itself) and
+// remember the command that produced them so an edited command runs again.
+type execTokenCache struct {
+	mu      sync.Mutex
+	entries map[string]execToken
+}
+
+type execToken struct {
+	command string
+	dir     string
+	token   string
+}
+
+func newExecTokenCache() *execTokenCache {
+	return &execTokenCache{entries: make(map[string]execToken)}
+}
+
+func (c *execTokenCache) token(
+	ctx context.Context,
+	key, dir, command string,
+	timeout time.Duration,
+) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok && e.command == command && e.dir == dir {
+		return e.token, nil
+	}
+	out, err := vars.RunCommand(ctx, dir, command, timeout)
+	if err != nil {
+		return "", err
+	}
+	if out == "" {
+		return "", errdef.New(errdef.CodeScript, "exec %q: command printed no token", command)
+	}
+	c.entries[key] = execToken{command: command, dir: dir, token: out}
+	return out, nil
+}
+
+func (c *execTokenCache) clear() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := len(c.entries)
+	clear(c.entries)
+	return n
+}
+
+// ensureExecBearer runs the @auth bearer-exec command for req, or reuses the
+// cached token, and sets the Authorization header unless one is present.
+func (m *Model) ensureExecBearer(
+	ctx context.Context,
+	req *restfile.Request,
+	resolver *vars.Resolver,
+	baseDir string,
+	envName string,
+) error {
+	if req == nil || req.Metadata.Auth == nil {
+		return nil
+	}
+	auth := req.Metadata.Auth
+	if !strings.EqualFold(auth.Type, "bearer-exec") {
+		return nil
+	}
+	if req.Headers != nil && req.Headers.Get("Authorization") != "" {
+		return nil
+	}
+	if m.execVars == nil || m.execTokens == nil {
+		return errdef.New(
+			errdef.CodeScript,
+			"@auth bearer-exec is disabled; set allow_exec_vars = true in settings",
+		)
+	}
+
+	expand := func(key string) (string, error) {
+		val := strings.TrimSpace(auth.Params[key])
+		if val == "" || resolver == nil {
+			return val, nil
+		}
+		out, err := resolver.ExpandTemplates(val)
+		if err != nil {
+			return "", errdef.Wrap(errdef.CodeScript, err, "expand bearer-exec %s", key)
+		}
+		return strings.TrimSpace(out), nil
+	}
+	command, err := expand("command")
+	if err != nil {
+		return err
+	}
+	if command == "" {
+		return errdef.New(errdef.CodeScript, "@auth bearer-exec requires a command")
+	}
+	cacheKey, err := expand("cache_key")
+	if err != nil {
+		return err
+	}
+	if cacheKey == "" {
+		cacheKey = command
+	}
+	var timeout time.Duration
+	if raw := strings.TrimSpace(auth.Params["timeout"]); raw != "" {
+		timeout, err = time.ParseDuration(raw)
+		if err != nil || timeout <= 0 {
+			return errdef.New(errdef.CodeScript, "invalid bearer-exec timeout %q", raw)
+		}
+	}
+
+	envKey := vars.SelectEnv(m.cfg.EnvironmentSet, envName, m.cfg.EnvironmentName)
+	token, err := m.execTokens.token(ctx, envKey+"\x00"+cacheKey, baseDir, command, timeout)
+	if err != nil {
+		return err
+	}
+	if req.Headers == nil {
+		req.Headers = make(http.Header)
+	}
+	req.Headers.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (m *Model) clearExecTokens() tea.Cmd {
+	if m.execTokens == nil {
+		return statusCmd(statusInfo, "No cached exec tokens")
+	}
+	n := m.execTokens.clear()
+	if n == 0 {
+		return statusCmd(statusInfo, "No cached exec tokens")
+	}
+	return statusCmd(statusInfo, fmt.Sprintf("Cleared %d cached exec token(s)", n))
+}
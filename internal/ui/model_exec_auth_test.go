@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+func TestEnsureExecBearerCachesUntilCommandChangesOrCleared(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	dir := t.TempDir()
+	model := Model{
+		cfg:        Config{EnvironmentName: "dev"},
+		execVars:   vars.NewExecRunner(0),
+		execTokens: newExecTokenCache(),
+	}
+	send := func(command string) string {
+		t.Helper()
+		req := &restfile.Request{Metadata: restfile.RequestMetadata{
+			Auth: &restfile.AuthSpec{Type: "bearer-exec", Params: map[string]string{
+				"command":   command,
+				"cache_key": "api",
+			}},
+		}}
+		err := model.ensureExecBearer(context.Background(), req, vars.NewResolver(), dir, "")
+		if err != nil {
+			t.Fatalf("ensureExecBearer: %v", err)
+		}
+		return req.Headers.Get("Authorization")
+	}
+	runs := func() int {
+		data, _ := os.ReadFile(filepath.Join(dir, "count"))
+		return strings.Count(string(data), "x")
+	}
+
+	script := "echo x >> count; echo tok-1"
+	if got := send(script); got != "Bearer tok-1" {
+		t.Fatalf("expected bearer header, got %q", got)
+	}
+	send(script)
+	if n := runs(); n != 1 {
+		t.Fatalf("expected cached token, command ran %d times", n)
+	}
+
+	if got := send("echo x >> count; echo tok-2"); got != "Bearer tok-2" {
+		t.Fatalf("expected changed command to run again, got %q", got)
+	}
+	model.clearExecTokens()
+	send("echo x >> count; echo tok-2")
+	if n := runs(); n != 3 {
+		t.Fatalf("expected clear to force a rerun, command ran %d times", n)
+	}
+}
+
+func TestEnsureExecBearerRequiresOptIn(t *testing.T) {
+	model := Model{}
+	req := &restfile.Request{Metadata: restfile.RequestMetadata{
+		Auth: &restfile.AuthSpec{Type: "bearer-exec", Params: map[string]string{
+			"command": "echo tok",
+		}},
+	}}
+	err := model.ensureExecBearer(context.Background(), req, nil, t.TempDir(), "")
+	if err == nil || !strings.Contains(err.Error(), "allow_exec_vars") {
+		t.Fatalf("expected opt-in error, got %v", err)
+	}
+	if req.Headers.Get("Authorization") != "" {
+		t.Fatalf("expected no header without opt-in")
+	}
+}
+
+func TestBuildResolverRunsExecOnlyForDeclaredVariables(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	dir := t.TempDir()
+	model := Model{execVars: vars.NewExecRunner(0)}
+	req := &restfile.Request{Variables: []restfile.Variable{
+		{Name: "token", Value: `exec("echo x >> count; echo tok")`, Line: 2},
+		{Name: "id", Value: "1", Line: 3},
+	}}
+	upsertVariable(&req.Variables, restfile.ScopeRequest, "id", `exec("echo x >> count")`, false)
+
+	res := model.buildResolver(context.Background(), nil, req, "", dir, nil)
+	out, err := res.ExpandTemplates("{{token}} {{id}}")
+	if err != nil {
+		t.Fatalf("expand: %v", err)
+	}
+	if out != `tok exec("echo x >> count")` {
+		t.Fatalf("expected captured exec value to stay literal, got %q", out)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "count"))
+	if n := strings.Count(string(data), "x"); n != 1 {
+		t.Fatalf("expected only the declared command to run, ran %d times", n)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/unkn0wn-root/resterm/internal/curl"
 	"github.com/unkn0wn-root/resterm/internal/errdef"
 	"github.com/unkn0wn-root/resterm/internal/grpcclient"
+	"github.com/unkn0wn-root/resterm/internal/history"
 	"github.com/unkn0wn-root/resterm/internal/httpclient"
 	"github.com/unkn0wn-root/resterm/internal/httpver"
 	"github.com/unkn0wn-root/resterm/internal/k8s"
@@ -27,6 +29,7 @@ import (
 	"github.com/unkn0wn-root/resterm/internal/settings"
 	"github.com/unkn0wn-root/resterm/internal/ssh"
 	"github.com/unkn0wn-root/resterm/internal/stream"
+	"github.com/unkn0wn-root/resterm/internal/telemetry"
 	"github.com/unkn0wn-root/resterm/internal/tracebudget"
 	"github.com/unkn0wn-root/resterm/internal/tunnel"
 	"github.com/unkn0wn-root/resterm/internal/urltpl"
@@ -81,6 +84,9 @@ func (m *Model) cancelStatus() string {
 	if m.hasReflowPending() {
 		return "Canceling response reflow..."
 	}
+	if m.webhookWait != nil {
+		return "Webhook wait canceled"
+	}
 	return "Canceling..."
 }
 
@@ -108,7 +114,7 @@ func (m Model) spinnerActive() bool {
 }
 
 func (m *Model) cancelActiveRuns() tea.Cmd {
-	if !m.hasActiveRun() && !m.responseLoading && !m.hasReflowPending() {
+	if !m.hasActiveRun() && !m.responseLoading && !m.hasReflowPending() && m.webhookWait == nil {
 		return nil
 	}
 	return m.cancelRuns(m.cancelStatus())
@@ -134,6 +140,7 @@ func (m *Model) cancelRuns(status string) tea.Cmd {
 		cmds = append(cmds, cmd)
 	}
 	m.cancelInFlightSend(status)
+	m.cancelWebhookWait()
 	if m.responseLoading {
 		if cmd := m.cancelResponseFormatting(""); cmd != nil {
 			cmds = append(cmds, cmd)
@@ -256,6 +263,7 @@ func (m *Model) sendActiveRequest() tea.Cmd {
 	m.doc = doc
 	m.syncRequestList(doc)
 	m.setActiveRequest(req)
+	m.recordRecentRequest(req)
 	m.syncAllGlobals(doc)
 
 	cloned := cloneRequest(req)
@@ -311,11 +319,14 @@ func (m *Model) sendActiveRequest() tea.Cmd {
 	}
 
 	spin := m.startSending()
-	target := m.statusRequestTarget(doc, cloned, "")
+	target := m.statusRequestTarget(doc, cloned, requestEnv(cloned))
 	base := "Sending"
 	if trimmed := strings.TrimSpace(target); trimmed != "" {
 		base = fmt.Sprintf("Sending %s", trimmed)
 	}
+	if note := m.envOverrideNote(requestEnv(cloned)); note != "" {
+		base = fmt.Sprintf("%s (%s)", base, note)
+	}
 	m.statusPulseBase = base
 	m.statusPulseFrame = -1
 	m.setStatusMessage(statusMsg{text: base, level: statusInfo})
@@ -396,11 +407,13 @@ func (m *Model) executeRequest(
 	extras ...map[string]string,
 ) tea.Cmd {
 	options = m.resolveHTTPOptions(options)
+	docPath := m.documentRuntimePath(doc)
 	if req != nil && tunnel.HasConflict(req.SSH != nil, req.K8s != nil) {
 		return func() tea.Msg {
 			return responseMsg{
 				err:      errdef.New(errdef.CodeHTTP, "@ssh cannot be combined with @k8s"),
 				executed: req,
+				docPath:  docPath,
 			}
 		}
 	}
@@ -415,7 +428,18 @@ func (m *Model) executeRequest(
 	runner := m.scriptRunner
 	sendCtx, sendCancel := context.WithCancel(context.Background())
 	m.sendCancel = sendCancel
-
+	m.cancelWebhookWait()
+	uploadProgress := m.uploadProgressFunc()
+
+	// A compare override wins over @env, which wins over the selected env.
+	if strings.TrimSpace(envOverride) == "" {
+		envOverride = requestEnv(req)
+		if err := m.checkRequestEnv(envOverride); err != nil {
+			return func() tea.Msg {
+				return responseMsg{err: err, executed: req, docPath: docPath}
+			}
+		}
+	}
 	// selecting env this way lets compare overrides win without persisting the change.
 	envName := vars.SelectEnv(m.cfg.EnvironmentSet, envOverride, m.cfg.EnvironmentName)
 	baseVars := m.collectVariables(doc, req, envName)
@@ -429,16 +453,29 @@ func (m *Model) executeRequest(
 			}
 		}
 	}
+	// Snapshot the request before templates and scripts touch it so the
+	// Sent tab can show what changed on the way out.
+	sourceText := renderRequestText(req)
 
-	return func() tea.Msg {
+	return func() (out tea.Msg) {
 		select {
 		case <-sendCtx.Done():
-			return responseMsg{err: context.Canceled, executed: req}
+			return responseMsg{err: context.Canceled, executed: req, docPath: docPath}
 		default:
 		}
 
 		defer sendCancel()
 
+		// Globals changed before an early return still have to reach disk.
+		changed := changedGlobals{env: envName}
+		defer func() {
+			if msg, ok := out.(responseMsg); ok {
+				msg.globals = changed
+				msg.docPath = docPath
+				out = msg
+			}
+		}()
+
 		if req != nil && req.Metadata.When != nil {
 			shouldRun, reason, err := m.evalCondition(
 				sendCtx,
@@ -510,7 +547,7 @@ func (m *Model) executeRequest(
 		}
 
 		if len(rtsResult.Globals) > 0 {
-			m.applyGlobalMutations(rtsResult.Globals, envName)
+			changed.add(m.applyGlobalMutations(rtsResult.Globals, envName)...)
 			preGlobals = m.collectGlobalValues(doc, envName)
 		}
 
@@ -540,10 +577,10 @@ func (m *Model) executeRequest(
 			return responseMsg{err: err, executed: req}
 		}
 
-		m.applyGlobalMutations(preResult.Globals, envName)
+		changed.add(m.applyGlobalMutations(preResult.Globals, envName)...)
 
 		scriptVars := mergeVariableMaps(rtsResult.Variables, preResult.Variables)
-		resolverExtras := make([]map[string]string, 0, len(extras)+1)
+		resolverExtras := make([]map[string]string, 0, len(extras)+2)
 		if len(scriptVars) > 0 {
 			resolverExtras = append(resolverExtras, scriptVars)
 		}
@@ -553,6 +590,18 @@ func (m *Model) executeRequest(
 			}
 		}
 
+		hook, err := startWebhook(req)
+		if err != nil {
+			return responseMsg{err: err, executed: req}
+		}
+		// Closed here unless the listener is handed to the response below.
+		defer func() { hook.close() }()
+		if hook != nil {
+			resolverExtras = append(resolverExtras, map[string]string{
+				webhookVar: hook.listener.URL(),
+			})
+		}
+
 		resolver := m.buildResolver(
 			sendCtx,
 			doc,
@@ -561,6 +610,18 @@ func (m *Model) executeRequest(
 			options.BaseDir,
 			extraVals,
 			resolverExtras...)
+		if err := applyPathParams(req, resolver); err != nil {
+			return responseMsg{
+				err:      errdef.Wrap(errdef.CodeHTTP, err, "expand path params"),
+				executed: req,
+			}
+		}
+		if err := m.applyBaseURL(req, resolver, envName); err != nil {
+			return responseMsg{err: err, executed: req}
+		}
+		if err := expandRequestMethod(req, resolver); err != nil {
+			return responseMsg{err: err, executed: req}
+		}
 		sshPlan, err := m.resolveSSH(doc, req, resolver, envName)
 		if err != nil {
 			return responseMsg{err: errdef.Wrap(errdef.CodeHTTP, err, "resolve ssh"), executed: req}
@@ -571,13 +632,28 @@ func (m *Model) executeRequest(
 		}
 		options.SSH = sshPlan
 		options.K8s = k8sPlan
+		if options.Trace {
+			export, err := resolveTraceExport(req, resolver)
+			if err != nil {
+				return responseMsg{
+					err:      errdef.Wrap(errdef.CodeHTTP, err, "expand @trace export"),
+					executed: req,
+				}
+			}
+			options.TraceExport = export
+		}
 
 		globalSettings := settings.FromEnv(m.cfg.EnvironmentSet, envName)
 		fileSettings := map[string]string{}
 		if doc != nil && doc.Settings != nil {
 			fileSettings = doc.Settings
 		}
-		mergedSettings := settings.Merge(globalSettings, fileSettings, req.Settings)
+		mergedSettings := settings.Merge(
+			globalSettings,
+			fileSettings,
+			doc.SettingsForTags(req.Metadata.Tags),
+			req.Settings,
+		)
 		req.Settings = mergedSettings
 
 		var grpcOpts grpcclient.Options
@@ -602,18 +678,26 @@ func (m *Model) executeRequest(
 		if _, err := applier.ApplyAll(mergedSettings); err != nil {
 			return responseMsg{err: err, executed: req}
 		}
+		if !useGRPC {
+			httpclient.ApplyRequestID(req, options.RequestIDHeader)
+		}
 
 		effectiveTimeout := defaultTimeout(resolveRequestTimeout(req, options.Timeout))
-		if err := m.ensureOAuth(
+		authGrant, err := m.ensureOAuth(
 			sendCtx,
 			req,
 			resolver,
 			options,
 			envName,
 			effectiveTimeout,
-		); err != nil {
+		)
+		if err != nil {
 			return responseMsg{err: err, executed: req}
 		}
+		if err := m.ensureExecBearer(sendCtx, req, resolver, options.BaseDir, envName); err != nil {
+			return responseMsg{err: err, executed: req}
+		}
+		httpclient.RemoveHeaders(req.Headers, req.Metadata.RemoveHeaders)
 
 		var (
 			ctx          context.Context
@@ -656,6 +740,7 @@ func (m *Model) executeRequest(
 					err:         grpcErr,
 					executed:    req,
 					requestText: renderRequestText(req),
+					sourceText:  sourceText,
 					environment: envName,
 				}
 			}
@@ -669,7 +754,7 @@ func (m *Model) executeRequest(
 				capVars = mergeVariableMaps(capVars, extra)
 			}
 			var captures captureResult
-			if err := m.applyCaptures(captureRun{
+			err := m.applyCaptures(captureRun{
 				doc:  doc,
 				req:  req,
 				res:  resolver,
@@ -678,7 +763,9 @@ func (m *Model) executeRequest(
 				env:  envName,
 				v:    capVars,
 				x:    extraVals,
-			}); err != nil {
+			})
+			changed.add(captures.globals...)
+			if err != nil {
 				return responseMsg{err: err, executed: req}
 			}
 
@@ -706,7 +793,7 @@ func (m *Model) executeRequest(
 					BaseDir:   options.BaseDir,
 				},
 			)
-			m.applyGlobalMutations(globalChanges, envName)
+			changed.add(m.applyGlobalMutations(globalChanges, envName)...)
 
 			return responseMsg{
 				grpc:        grpcResp,
@@ -714,6 +801,7 @@ func (m *Model) executeRequest(
 				scriptErr:   mergeErr(assertErr, testErr),
 				executed:    req,
 				requestText: renderRequestText(req),
+				sourceText:  sourceText,
 				environment: envName,
 			}
 		}
@@ -758,7 +846,18 @@ func (m *Model) executeRequest(
 				response, err = httpclient.CompleteSSE(handle)
 			}
 		default:
-			response, err = client.Execute(ctx, req, resolver, options)
+			options.UploadProgress = uploadProgress
+			send := func() (*httpclient.Response, error) {
+				return client.Execute(ctx, req, resolver, options)
+			}
+			response, err = executeWithRetry(ctx, req.Metadata.Retry, send)
+			if err == nil && authGrant != nil && response != nil &&
+				response.StatusCode == http.StatusUnauthorized {
+				if refreshErr := m.refreshOAuth(sendCtx, req, authGrant, options); refreshErr != nil {
+					return responseMsg{response: response, err: refreshErr, executed: req}
+				}
+				response, err = send()
+			}
 		}
 		if err != nil {
 			return responseMsg{response: response, err: err, executed: req}
@@ -781,7 +880,7 @@ func (m *Model) executeRequest(
 			capVars = mergeVariableMaps(capVars, extra)
 		}
 		var captures captureResult
-		if err := m.applyCaptures(captureRun{
+		err = m.applyCaptures(captureRun{
 			doc:    doc,
 			req:    req,
 			res:    resolver,
@@ -791,7 +890,9 @@ func (m *Model) executeRequest(
 			env:    envName,
 			v:      capVars,
 			x:      extraVals,
-		}); err != nil {
+		})
+		changed.add(captures.globals...)
+		if err != nil {
 			return responseMsg{err: err, executed: req}
 		}
 
@@ -819,16 +920,19 @@ func (m *Model) executeRequest(
 			Stream:    streamInfo,
 			Trace:     traceInput,
 		})
-		m.applyGlobalMutations(globalChanges, envName)
+		changed.add(m.applyGlobalMutations(globalChanges, envName)...)
 
-		return responseMsg{
+		msg := responseMsg{
 			response:    response,
 			tests:       append(asserts, tests...),
 			scriptErr:   mergeErr(assertErr, testErr),
 			executed:    req,
 			requestText: renderRequestText(req),
+			sourceText:  sourceText,
 			environment: envName,
 		}
+		msg.webhook, hook = hook, nil
+		return msg
 	}
 }
 
@@ -909,13 +1013,14 @@ func httpScriptResponse(resp *httpclient.Response) *scripts.Response {
 		return nil
 	}
 	return &scripts.Response{
-		Kind:   scripts.ResponseKindHTTP,
-		Status: resp.Status,
-		Code:   resp.StatusCode,
-		URL:    resp.EffectiveURL,
-		Time:   resp.Duration,
-		Header: cloneHeader(resp.Headers),
-		Body:   append([]byte(nil), resp.Body...),
+		Kind:      scripts.ResponseKindHTTP,
+		Status:    resp.Status,
+		Code:      resp.StatusCode,
+		URL:       resp.EffectiveURL,
+		Time:      resp.Duration,
+		Header:    cloneHeader(resp.Headers),
+		Body:      append([]byte(nil), resp.Body...),
+		Redirects: append([]httpclient.Redirect(nil), resp.Redirects...),
 	}
 }
 
@@ -991,6 +1096,7 @@ func (m *Model) startSending() tea.Cmd {
 
 func (m *Model) stopSending() {
 	m.sending = false
+	m.uploadProgress = ""
 	m.stopTabSpinIfIdle()
 }
 
@@ -1093,14 +1199,77 @@ func (m *Model) handleStatusPulse(msg statusPulseMsg) tea.Cmd {
 		return m.scheduleStatusPulse()
 	}
 
+	m.setStatusMessage(statusMsg{text: m.sendingStatusText(), level: statusInfo})
+	return m.scheduleStatusPulse()
+}
+
+func (m *Model) sendingStatusText() string {
 	base := strings.TrimSpace(m.statusPulseBase)
 	if base == "" {
 		base = "Sending"
 	}
+	text := base + strings.Repeat(".", max(m.statusPulseFrame, 0)+1)
+	if m.uploadProgress != "" {
+		text += " " + m.uploadProgress
+	}
+	return text
+}
 
-	dots := strings.Repeat(".", m.statusPulseFrame+1)
-	m.setStatusMessage(statusMsg{text: base + dots, level: statusInfo})
-	return m.scheduleStatusPulse()
+// requestEnv returns the environment pinned with @env, if any.
+func requestEnv(req *restfile.Request) string {
+	if req == nil {
+		return ""
+	}
+	return strings.TrimSpace(req.Metadata.Env)
+}
+
+// resolveTraceExport expands the collector named by @trace export=otlp.
+// A nil result leaves spans on the globally configured exporter.
+func resolveTraceExport(
+	req *restfile.Request,
+	resolver *vars.Resolver,
+) (*telemetry.Config, error) {
+	if req == nil || req.Metadata.Trace == nil || req.Metadata.Trace.Export == nil {
+		return nil, nil
+	}
+	export := req.Metadata.Trace.Export
+	endpoint, err := resolver.ExpandTemplates(export.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("endpoint: %w", err)
+	}
+	service, err := resolver.ExpandTemplates(export.Service)
+	if err != nil {
+		return nil, fmt.Errorf("service: %w", err)
+	}
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		return nil, errors.New("endpoint expanded to an empty value")
+	}
+	return &telemetry.Config{
+		Endpoint:    endpoint,
+		ServiceName: strings.TrimSpace(service),
+		Insecure:    export.Insecure,
+	}, nil
+}
+
+func (m *Model) checkRequestEnv(env string) error {
+	if env == "" || len(m.cfg.EnvironmentSet) == 0 {
+		return nil
+	}
+	if _, ok := m.cfg.EnvironmentSet[env]; ok {
+		return nil
+	}
+	return errdef.New(errdef.CodeHTTP, "@env %s: environment not found", env)
+}
+
+// envOverrideNote labels statuses for sends that ran outside the selected
+// environment.
+func (m *Model) envOverrideNote(env string) string {
+	env = strings.TrimSpace(env)
+	if env == "" || env == strings.TrimSpace(m.cfg.EnvironmentName) {
+		return ""
+	}
+	return "env " + env
 }
 
 func defaultTimeout(timeout time.Duration) time.Duration {
@@ -1147,13 +1316,7 @@ func (m *Model) buildResolver(
 	}
 
 	if req != nil {
-		reqVars := make(map[string]string)
-		for _, v := range req.Variables {
-			reqVars[v.Name] = v.Value
-		}
-		if len(reqVars) > 0 {
-			providers = append(providers, vars.NewMapProvider("request", reqVars))
-		}
+		providers = appendVariableProviders(providers, "request", req.Variables, nil)
 	}
 
 	if m.globals != nil {
@@ -1180,15 +1343,12 @@ func (m *Model) buildResolver(
 		}
 	}
 
-	fileVars := make(map[string]string)
+	runtimeVars := make(map[string]string)
+	m.mergeFileRuntimeVars(runtimeVars, doc, resolvedEnv)
 	if doc != nil {
-		for _, v := range doc.Variables {
-			fileVars[v.Name] = v.Value
-		}
-	}
-	m.mergeFileRuntimeVars(fileVars, doc, resolvedEnv)
-	if len(fileVars) > 0 {
-		providers = append(providers, vars.NewMapProvider("file", fileVars))
+		providers = appendVariableProviders(providers, "file", doc.Variables, runtimeVars)
+	} else if len(runtimeVars) > 0 {
+		providers = append(providers, vars.NewMapProvider("file", runtimeVars))
 	}
 
 	if envValues := vars.EnvValues(m.cfg.EnvironmentSet, resolvedEnv); len(envValues) > 0 {
@@ -1198,101 +1358,72 @@ func (m *Model) buildResolver(
 	providers = append(providers, vars.EnvProvider{})
 	res := vars.NewResolver(providers...)
 	res.AddRefResolver(vars.EnvRefResolver)
+	if m.execVars != nil {
+		res.SetExec(func(cmd string) (string, error) {
+			return m.execVars.Run(ctx, base, cmd)
+		})
+	}
 	res.SetExprEval(m.rtsEval(ctx, doc, req, resolvedEnv, base, false, extraVals, extras...))
 	res.SetExprPos(m.rtsPos(doc, req))
 	return res
 }
 
+// appendVariableProviders adds the values of vs under label. Only values
+// declared in the file may run exec("..."); values set at runtime, including
+// captures and the overrides passed in runtime, are always plain text and
+// take precedence over the declared ones.
+func appendVariableProviders(
+	providers []vars.Provider,
+	label string,
+	vs []restfile.Variable,
+	runtime map[string]string,
+) []vars.Provider {
+	declared := make(map[string]string)
+	set := make(map[string]string, len(runtime))
+	for _, v := range vs {
+		if v.Line > 0 {
+			declared[v.Name] = v.Value
+		} else {
+			set[v.Name] = v.Value
+		}
+	}
+	for name, value := range runtime {
+		set[name] = value
+	}
+	if len(set) > 0 {
+		providers = append(providers, vars.NewMapProvider(label, set))
+	}
+	if len(declared) > 0 {
+		providers = append(providers, vars.NewExecProvider(label, declared))
+	}
+	return providers
+}
+
 // buildDisplayResolver is a best-effort resolver for UI/status rendering that
-// avoids expanding secret values.
+// avoids expanding secret values. With maskSecrets they resolve to the mask
+// instead of staying unresolved, so a preview shows where they go.
 func (m *Model) buildDisplayResolver(
 	ctx context.Context,
 	doc *restfile.Document,
 	req *restfile.Request,
 	envName, base string,
+	maskSecrets bool,
 	extraVals map[string]rts.Value,
 	extras ...map[string]string,
 ) *vars.Resolver {
 	resolvedEnv := vars.SelectEnv(m.cfg.EnvironmentSet, envName, m.cfg.EnvironmentName)
-	providers := make([]vars.Provider, 0, 9)
-
-	if doc != nil && len(doc.Constants) > 0 {
-		constValues := make(map[string]string, len(doc.Constants))
-		for _, c := range doc.Constants {
-			constValues[c.Name] = c.Value
+	scopes := m.displayScopes(doc, req, resolvedEnv, extras...)
+	providers := make([]vars.Provider, 0, len(scopes)+1)
+	for _, scope := range scopes {
+		values := scope.public()
+		if maskSecrets {
+			values = scope.masked()
 		}
-		providers = append(providers, vars.NewMapProvider("const", constValues))
-	}
-
-	for _, extra := range extras {
-		if len(extra) > 0 {
-			providers = append(providers, vars.NewMapProvider("script", extra))
+		if len(values) > 0 {
+			providers = append(providers, vars.NewMapProvider(scope.label, values))
 		}
 	}
 
-	if req != nil {
-		reqVars := make(map[string]string)
-		for _, v := range req.Variables {
-			if v.Secret {
-				continue
-			}
-			reqVars[v.Name] = v.Value
-		}
-		if len(reqVars) > 0 {
-			providers = append(providers, vars.NewMapProvider("request", reqVars))
-		}
-	}
-
-	if m.globals != nil {
-		if snapshot := m.globals.snapshot(resolvedEnv); len(snapshot) > 0 {
-			values := make(map[string]string, len(snapshot))
-			for key, entry := range snapshot {
-				if entry.Secret {
-					continue
-				}
-				name := entry.Name
-				if strings.TrimSpace(name) == "" {
-					name = key
-				}
-				values[name] = entry.Value
-			}
-			if len(values) > 0 {
-				providers = append(providers, vars.NewMapProvider("global", values))
-			}
-		}
-	}
-
-	if doc != nil {
-		globalVars := make(map[string]string)
-		for _, v := range doc.Globals {
-			if v.Secret {
-				continue
-			}
-			globalVars[v.Name] = v.Value
-		}
-		if len(globalVars) > 0 {
-			providers = append(providers, vars.NewMapProvider("document-global", globalVars))
-		}
-	}
-
-	fileVars := make(map[string]string)
-	if doc != nil {
-		for _, v := range doc.Variables {
-			if v.Secret {
-				continue
-			}
-			fileVars[v.Name] = v.Value
-		}
-	}
-	m.mergeFileRuntimeVarsSafe(fileVars, doc, resolvedEnv)
-	if len(fileVars) > 0 {
-		providers = append(providers, vars.NewMapProvider("file", fileVars))
-	}
-
-	if envValues := vars.EnvValues(m.cfg.EnvironmentSet, resolvedEnv); len(envValues) > 0 {
-		providers = append(providers, vars.NewMapProvider("environment", envValues))
-	}
-
 	providers = append(providers, vars.EnvProvider{})
 	res := vars.NewResolver(providers...)
 	res.AddRefResolver(vars.EnvRefResolver)
@@ -1329,6 +1460,15 @@ func (m *Model) resolveSSH(
 	return &ssh.Plan{Manager: manager, Config: cfg}, nil
 }
 
+// insecureTLSWarning flags responses whose effective options skipped TLS
+// verification while the --insecure default still verifies.
+func (m *Model) insecureTLSWarning(resp *httpclient.Response) string {
+	if resp == nil || !resp.Insecure || m.cfg.HTTPOptions.InsecureSkipVerify {
+		return ""
+	}
+	return "insecure setting (TLS verification off)"
+}
+
 func (m *Model) resolveK8s(
 	doc *restfile.Document,
 	req *restfile.Request,
@@ -1556,23 +1696,31 @@ func (m *Model) collectGlobalValues(
 	return globals
 }
 
-func (m *Model) applyGlobalMutations(changes map[string]scripts.GlobalValue, envName string) {
+// applyGlobalMutations updates the in-memory globals and returns the names
+// it touched so the caller can persist them.
+func (m *Model) applyGlobalMutations(
+	changes map[string]scripts.GlobalValue,
+	envName string,
+) []string {
 	if len(changes) == 0 || m.globals == nil {
-		return
+		return nil
 	}
 
 	env := vars.SelectEnv(m.cfg.EnvironmentSet, envName, m.cfg.EnvironmentName)
+	names := make([]string, 0, len(changes))
 	for _, change := range changes {
 		name := strings.TrimSpace(change.Name)
 		if name == "" {
 			continue
 		}
+		names = append(names, name)
 		if change.Delete {
 			m.globals.delete(env, name)
 			continue
 		}
 		m.globals.set(env, name, change.Value, change.Secret)
 	}
+	return names
 }
 
 func (m *Model) showGlobalSummary() tea.Cmd {
@@ -1604,7 +1752,7 @@ func (m *Model) buildGlobalSummary() string {
 		for _, entry := range entries {
 			parts = append(
 				parts,
-				fmt.Sprintf("%s=%s", entry.name, maskSecret(entry.value, entry.secret)),
+				fmt.Sprintf("%s=%s", entry.name, m.displaySecret(entry.value, entry.secret)),
 			)
 		}
 		segments = append(segments, "Globals: "+strings.Join(parts, ", "))
@@ -1628,7 +1776,7 @@ func (m *Model) buildGlobalSummary() string {
 			for _, entry := range entries {
 				parts = append(
 					parts,
-					fmt.Sprintf("%s=%s", entry.name, maskSecret(entry.value, entry.secret)),
+					fmt.Sprintf("%s=%s", entry.name, m.displaySecret(entry.value, entry.secret)),
 				)
 			}
 			segments = append(segments, "Doc: "+strings.Join(parts, ", "))
@@ -1653,6 +1801,9 @@ func (m *Model) clearGlobalValues() tea.Cmd {
 
 	env := m.cfg.EnvironmentName
 	m.globals.clear(env)
+	if m.fileVars != nil {
+		m.fileVars.clearEnv(env)
+	}
 	label := env
 	if strings.TrimSpace(label) == "" {
 		label = "default"
@@ -1672,11 +1823,21 @@ type summaryEntry struct {
 
 func maskSecret(value string, secret bool) string {
 	if secret {
-		return "•••"
+		return history.RedactedMask
 	}
 	return value
 }
 
+// oauthGrant records the token ensureOAuth injected so a 401 can be
+// answered with a refresh and a single retry.
+type oauthGrant struct {
+	env     string
+	cfg     oauth.Config
+	header  string
+	token   string
+	timeout time.Duration
+}
+
 func (m *Model) ensureOAuth(
 	ctx context.Context,
 	req *restfile.Request,
@@ -1684,26 +1845,26 @@ func (m *Model) ensureOAuth(
 	opts httpclient.Options,
 	envName string,
 	timeout time.Duration,
-) error {
+) (*oauthGrant, error) {
 	if req == nil || req.Metadata.Auth == nil {
-		return nil
+		return nil, nil
 	}
 	if !strings.EqualFold(req.Metadata.Auth.Type, "oauth2") {
-		return nil
+		return nil, nil
 	}
 	if m.oauth == nil {
-		return errdef.New(errdef.CodeHTTP, "oauth support is not initialised")
+		return nil, errdef.New(errdef.CodeHTTP, "oauth support is not initialised")
 	}
 
 	cfg, err := m.buildOAuthConfig(req.Metadata.Auth, resolver)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	envKey := vars.SelectEnv(m.cfg.EnvironmentSet, envName, m.cfg.EnvironmentName)
 	cfg = m.oauth.MergeCachedConfig(envKey, cfg)
 	if cfg.TokenURL == "" {
-		return errdef.New(
+		return nil, errdef.New(
 			errdef.CodeHTTP,
 			"@auth oauth2 requires token_url (include it once per cache_key to seed the cache)",
 		)
@@ -1715,7 +1876,7 @@ func (m *Model) ensureOAuth(
 		header = "Authorization"
 	}
 	if req.Headers != nil && req.Headers.Get(header) != "" {
-		return nil
+		return nil, nil
 	}
 
 	tokenTimeout := timeout
@@ -1735,15 +1896,45 @@ func (m *Model) ensureOAuth(
 
 	token, err := m.oauth.Token(ctx, envKey, cfg, opts)
 	if err != nil {
-		return errdef.Wrap(errdef.CodeHTTP, err, "fetch oauth token")
+		return nil, errdef.Wrap(errdef.CodeHTTP, err, "fetch oauth token")
 	}
 	if req.Headers == nil {
 		req.Headers = make(http.Header)
 	}
 	if req.Headers.Get(header) != "" {
-		return nil
+		return nil, nil
 	}
 
+	setOAuthHeader(req, header, token)
+	return &oauthGrant{
+		env:     envKey,
+		cfg:     cfg,
+		header:  header,
+		token:   token.AccessToken,
+		timeout: tokenTimeout,
+	}, nil
+}
+
+// refreshOAuth swaps the rejected token on req for a fresh one.
+func (m *Model) refreshOAuth(
+	ctx context.Context,
+	req *restfile.Request,
+	g *oauthGrant,
+	opts httpclient.Options,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	token, err := m.oauth.Refresh(ctx, g.env, g.cfg, g.token, opts)
+	if err != nil {
+		return errdef.Wrap(errdef.CodeHTTP, err, "refresh oauth token")
+	}
+	setOAuthHeader(req, g.header, token)
+	g.token = token.AccessToken
+	return nil
+}
+
+func setOAuthHeader(req *restfile.Request, header string, token oauth.Token) {
 	value := token.AccessToken
 	if strings.EqualFold(header, "authorization") {
 		typeValue := strings.TrimSpace(token.TokenType)
@@ -1752,9 +1943,7 @@ func (m *Model) ensureOAuth(
 		}
 		value = strings.TrimSpace(typeValue) + " " + token.AccessToken
 	}
-
 	req.Headers.Set(header, value)
-	return nil
 }
 
 func (m *Model) buildOAuthConfig(
@@ -1960,6 +2149,14 @@ func (m *Model) prepareGRPCRequest(
 	grpcReq.MessageExpanded = ""
 	grpcReq.MessageExpandedSet = false
 
+	if len(grpcReq.MetadataFiles) > 0 {
+		filePairs, err := loadGRPCMetadataFiles(grpcReq.MetadataFiles, baseDir, resolver)
+		if err != nil {
+			return err
+		}
+		grpcReq.Metadata = mergeGRPCMetadata(filePairs, grpcReq.Metadata)
+	}
+
 	if err := grpcclient.ValidateMetaPairs(grpcReq.Metadata); err != nil {
 		return err
 	}
@@ -2011,6 +2208,20 @@ func (m *Model) prepareGRPCRequest(
 			}
 			grpcReq.Authority = strings.TrimSpace(expanded)
 		}
+		if ca := strings.TrimSpace(grpcReq.RootCA); ca != "" {
+			expanded, err := resolver.ExpandTemplates(ca)
+			if err != nil {
+				return errdef.Wrap(errdef.CodeHTTP, err, "expand grpc ca")
+			}
+			grpcReq.RootCA = strings.TrimSpace(expanded)
+		}
+		if name := strings.TrimSpace(grpcReq.ServerName); name != "" {
+			expanded, err := resolver.ExpandTemplates(name)
+			if err != nil {
+				return errdef.Wrap(errdef.CodeHTTP, err, "expand grpc server name")
+			}
+			grpcReq.ServerName = strings.TrimSpace(expanded)
+		}
 		if descriptor := strings.TrimSpace(grpcReq.DescriptorSet); descriptor != "" {
 			expanded, err := resolver.ExpandTemplates(descriptor)
 			if err != nil {
@@ -2018,6 +2229,24 @@ func (m *Model) prepareGRPCRequest(
 			}
 			grpcReq.DescriptorSet = strings.TrimSpace(expanded)
 		}
+		if expr := strings.TrimSpace(grpcReq.ReflectionExpr); expr != "" {
+			expanded, err := resolver.ExpandTemplates(expr)
+			if err != nil {
+				return errdef.Wrap(errdef.CodeHTTP, err, "expand grpc reflection")
+			}
+			useReflection, err := parseGRPCReflection(expanded)
+			if err != nil {
+				return err
+			}
+			grpcReq.UseReflection = useReflection
+		}
+		if target := strings.TrimSpace(grpcReq.ReflectionTarget); target != "" {
+			expanded, err := resolver.ExpandTemplates(target)
+			if err != nil {
+				return errdef.Wrap(errdef.CodeHTTP, err, "expand grpc reflection target")
+			}
+			grpcReq.ReflectionTarget = expanded
+		}
 
 		if req.Headers != nil {
 			for key, values := range req.Headers {
@@ -2038,9 +2267,43 @@ func (m *Model) prepareGRPCRequest(
 		return errdef.New(errdef.CodeHTTP, "grpc target not specified")
 	}
 	req.URL = grpcReq.Target
+	normalizeGRPCReflectionTarget(grpcReq)
 	return nil
 }
 
+// normalizeGRPCReflectionTarget strips the scheme from the reflection
+// target, letting grpcs:// and https:// imply TLS just for that endpoint.
+func normalizeGRPCReflectionTarget(grpcReq *restfile.GRPCRequest) {
+	if strings.TrimSpace(grpcReq.ReflectionTarget) == "" {
+		return
+	}
+	scheme := restfile.GRPCRequest{
+		Plaintext:    grpcReq.ReflectionPlaintext,
+		PlaintextSet: grpcReq.ReflectionPlaintextSet,
+	}
+	grpcReq.ReflectionTarget = normalizeGRPCTarget(grpcReq.ReflectionTarget, &scheme)
+	grpcReq.ReflectionPlaintext = scheme.Plaintext
+	grpcReq.ReflectionPlaintextSet = scheme.PlaintextSet
+}
+
+// parseGRPCReflection reads an expanded @grpc-reflection value. An empty
+// value keeps reflection on, matching the bare directive.
+func parseGRPCReflection(value string) (bool, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return true, nil
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errdef.New(
+			errdef.CodeHTTP,
+			"invalid grpc-reflection value %q (use true or false)",
+			value,
+		)
+	}
+	return enabled, nil
+}
+
 func expandGRPCMessageFile(
 	path string,
 	baseDir string,
@@ -2064,6 +2327,71 @@ func expandGRPCMessageFile(
 	return expanded, nil
 }
 
+// loadGRPCMetadataFiles reads key: value lines from each file. Blank lines
+// and lines starting with # are skipped; values are expanded later along
+// with inline metadata.
+func loadGRPCMetadataFiles(
+	paths []string,
+	baseDir string,
+	resolver *vars.Resolver,
+) ([]restfile.MetadataPair, error) {
+	var pairs []restfile.MetadataPair
+	for _, path := range paths {
+		if resolver != nil {
+			expanded, err := resolver.ExpandTemplates(path)
+			if err != nil {
+				return nil, errdef.Wrap(errdef.CodeHTTP, err, "expand grpc metadata file path")
+			}
+			path = strings.TrimSpace(expanded)
+		}
+		full := path
+		if !filepath.IsAbs(full) && baseDir != "" {
+			full = filepath.Join(baseDir, full)
+		}
+		data, err := os.ReadFile(full)
+		if err != nil {
+			return nil, errdef.Wrap(errdef.CodeFilesystem, err, "read grpc metadata file %s", path)
+		}
+		for i, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			key, value, ok := strings.Cut(line, ":")
+			if !ok || strings.TrimSpace(key) == "" {
+				return nil, errdef.New(
+					errdef.CodeHTTP,
+					"grpc metadata file %s:%d: expected key: value",
+					path,
+					i+1,
+				)
+			}
+			pairs = append(pairs, restfile.MetadataPair{
+				Key:   strings.TrimSpace(key),
+				Value: strings.TrimSpace(value),
+			})
+		}
+	}
+	return pairs, nil
+}
+
+// mergeGRPCMetadata puts file pairs first and lets inline @grpc-metadata
+// replace any file pair with the same key.
+func mergeGRPCMetadata(file, inline []restfile.MetadataPair) []restfile.MetadataPair {
+	seen := make(map[string]struct{}, len(inline))
+	for _, pair := range inline {
+		seen[strings.ToLower(strings.TrimSpace(pair.Key))] = struct{}{}
+	}
+	merged := make([]restfile.MetadataPair, 0, len(file)+len(inline))
+	for _, pair := range file {
+		if _, ok := seen[strings.ToLower(pair.Key)]; ok {
+			continue
+		}
+		merged = append(merged, pair)
+	}
+	return append(merged, inline...)
+}
+
 func normalizeGRPCTarget(target string, grpcReq *restfile.GRPCRequest) string {
 	trimmed := strings.TrimSpace(target)
 	if trimmed == "" {
@@ -2148,6 +2476,96 @@ func applyPreRequestQuery(req *restfile.Request, q map[string]string) error {
 	return nil
 }
 
+// applyPathParams fills single-brace {name} placeholders in the URL path
+// from @path-param values before template expansion. Values may use templates.
+func applyPathParams(req *restfile.Request, resolver *vars.Resolver) error {
+	if req == nil || req.GRPC != nil {
+		return nil
+	}
+	params := make(map[string]string, len(req.Metadata.PathParams))
+	for name, value := range req.Metadata.PathParams {
+		if resolver != nil {
+			expanded, err := resolver.ExpandTemplates(value)
+			if err != nil {
+				return fmt.Errorf("path param %s: %w", name, err)
+			}
+			value = expanded
+		}
+		params[name] = value
+	}
+	updated, err := urltpl.ExpandPathParams(req.URL, params)
+	if err != nil {
+		return err
+	}
+	req.URL = updated
+	return nil
+}
+
+// expandRequestMethod resolves a templated method line such as
+// {{method}} /users and checks that the result is a known HTTP method.
+func expandRequestMethod(req *restfile.Request, resolver *vars.Resolver) error {
+	if req == nil || req.GRPC != nil || !strings.Contains(req.Method, "{{") {
+		return nil
+	}
+	if resolver == nil {
+		return errdef.New(errdef.CodeHTTP, "request method %s cannot be expanded", req.Method)
+	}
+	expanded, err := resolver.ExpandTemplates(req.Method)
+	if err != nil {
+		return errdef.Wrap(errdef.CodeHTTP, err, "expand request method")
+	}
+	method := strings.ToUpper(strings.TrimSpace(expanded))
+	if !isInlineHTTPMethod(method) && method != http.MethodTrace && method != http.MethodConnect {
+		return errdef.New(
+			errdef.CodeHTTP,
+			"invalid request method %q (from %s)",
+			expanded,
+			req.Method,
+		)
+	}
+	req.Method = method
+	return nil
+}
+
+// envBaseURLKey names the environment value prefixed to relative URLs.
+const envBaseURLKey = "baseUrl"
+
+// applyBaseURL prefixes a relative request URL (one starting with "/") with
+// the environment's baseUrl, which may itself use templates. Absolute URLs
+// and templated URLs such as {{baseUrl}}/users are left alone.
+func (m *Model) applyBaseURL(
+	req *restfile.Request,
+	resolver *vars.Resolver,
+	envName string,
+) error {
+	if req == nil || req.GRPC != nil || !strings.HasPrefix(strings.TrimSpace(req.URL), "/") {
+		return nil
+	}
+	base := strings.TrimSpace(vars.EnvValues(m.cfg.EnvironmentSet, envName)[envBaseURLKey])
+	if base == "" {
+		label := envName
+		if label == "" {
+			label = "the active environment"
+		}
+		return errdef.New(
+			errdef.CodeHTTP,
+			"relative URL %s needs a %s in %s",
+			strings.TrimSpace(req.URL),
+			envBaseURLKey,
+			label,
+		)
+	}
+	if resolver != nil {
+		expanded, err := resolver.ExpandTemplates(base)
+		if err != nil {
+			return errdef.Wrap(errdef.CodeHTTP, err, "expand %s", envBaseURLKey)
+		}
+		base = expanded
+	}
+	req.URL = strings.TrimRight(base, "/") + strings.TrimSpace(req.URL)
+	return nil
+}
+
 func cloneRequest(req *restfile.Request) *restfile.Request {
 	if req == nil {
 		return nil
@@ -2193,6 +2611,11 @@ func cloneRequest(req *restfile.Request) *restfile.Request {
 		}
 		clone.Metadata.Compare = &spec
 	}
+	if req.Metadata.Retry != nil {
+		retry := *req.Metadata.Retry
+		retry.On = append([]int(nil), retry.On...)
+		clone.Metadata.Retry = &retry
+	}
 	if req.Body.GraphQL != nil {
 		gql := *req.Body.GraphQL
 		clone.Body.GraphQL = &gql
@@ -2294,15 +2717,30 @@ func renderRequestText(req *restfile.Request) string {
 		if grpc.DescriptorSet != "" {
 			builder.WriteString("# @grpc-descriptor " + grpc.DescriptorSet + "\n")
 		}
-		if !grpc.UseReflection {
+		if grpc.ReflectionExpr != "" {
+			builder.WriteString("# @grpc-reflection " + grpc.ReflectionExpr + "\n")
+		} else if !grpc.UseReflection {
 			builder.WriteString("# @grpc-reflection false\n")
 		}
+		if grpc.ReflectionTarget != "" {
+			builder.WriteString("# @grpc-reflection-target " + grpc.ReflectionTarget)
+			if grpc.ReflectionPlaintextSet {
+				builder.WriteString(fmt.Sprintf(" plaintext=%t", grpc.ReflectionPlaintext))
+			}
+			builder.WriteString("\n")
+		}
 		if grpc.PlaintextSet {
 			builder.WriteString(fmt.Sprintf("# @grpc-plaintext %t\n", grpc.Plaintext))
 		}
 		if grpc.Authority != "" {
 			builder.WriteString("# @grpc-authority " + grpc.Authority + "\n")
 		}
+		if grpc.RootCA != "" {
+			builder.WriteString("# @grpc-ca " + grpc.RootCA + "\n")
+		}
+		if grpc.ServerName != "" {
+			builder.WriteString("# @grpc-server-name " + grpc.ServerName + "\n")
+		}
 		if len(grpc.Metadata) > 0 {
 			for _, pair := range grpc.Metadata {
 				builder.WriteString(fmt.Sprintf("# @grpc-metadata %s: %s\n", pair.Key, pair.Value))
@@ -2372,6 +2810,13 @@ func renderSSESection(sse *restfile.SSERequest) string {
 	if sse.Options.MaxBytes > 0 {
 		parts = append(parts, fmt.Sprintf("max-bytes=%d", sse.Options.MaxBytes))
 	}
+	if sse.Options.Out != "" {
+		parts = append(parts, fmt.Sprintf("out=%s", sse.Options.Out))
+		if sse.Options.OutRotate {
+			parts = append(parts, "out-mode=rotate")
+		}
+	}
+	parts = append(parts, renderStreamBufferParts(sse.Options.Buffer)...)
 	line := "# @sse"
 	if len(parts) > 0 {
 		line += " " + strings.Join(parts, " ")
@@ -2379,6 +2824,17 @@ func renderSSESection(sse *restfile.SSERequest) string {
 	return line + "\n\n"
 }
 
+func renderStreamBufferParts(buf restfile.StreamBuffer) []string {
+	if buf.Max <= 0 {
+		return nil
+	}
+	parts := []string{fmt.Sprintf("max-buffer=%d", buf.Max)}
+	if buf.DropNewest {
+		parts = append(parts, "drop=newest")
+	}
+	return parts
+}
+
 func renderWebSocketSection(ws *restfile.WebSocketRequest) string {
 	if ws == nil {
 		return ""
@@ -2393,7 +2849,7 @@ func renderWebSocketSection(ws *restfile.WebSocketRequest) string {
 }
 
 func renderWebSocketDirectiveLine(opts restfile.WebSocketOptions) string {
-	parts := make([]string, 0, 5)
+	parts := make([]string, 0, 8)
 	if opts.HandshakeTimeout > 0 {
 		parts = append(parts, fmt.Sprintf("timeout=%s", opts.HandshakeTimeout))
 	}
@@ -2409,6 +2865,16 @@ func renderWebSocketDirectiveLine(opts restfile.WebSocketOptions) string {
 	if opts.CompressionSet {
 		parts = append(parts, fmt.Sprintf("compression=%t", opts.Compression))
 	}
+	if opts.Reconnect {
+		parts = append(parts, "reconnect=true")
+		if opts.MaxRetries > 0 {
+			parts = append(parts, fmt.Sprintf("max-retries=%d", opts.MaxRetries))
+		}
+		if opts.ReconnectBackoff > 0 {
+			parts = append(parts, fmt.Sprintf("backoff=%s", opts.ReconnectBackoff))
+		}
+	}
+	parts = append(parts, renderStreamBufferParts(opts.Buffer)...)
 	line := "# @websocket"
 	if len(parts) > 0 {
 		line += " " + strings.Join(parts, " ")
@@ -3,6 +3,7 @@ package ui
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"io"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -195,6 +197,107 @@ func TestInlineCurlRequestMultiline(t *testing.T) {
 	}
 }
 
+func TestPrepareGRPCRequestResolvesDescriptorAndReflectionPerEnv(t *testing.T) {
+	newReq := func() *restfile.Request {
+		return &restfile.Request{
+			Method: "GRPC",
+			GRPC: &restfile.GRPCRequest{
+				Target:         "localhost:50051",
+				FullMethod:     "/pkg.Service/Get",
+				DescriptorSet:  "{{descriptor_path}}",
+				UseReflection:  true,
+				ReflectionExpr: "{{use_reflection}}",
+			},
+		}
+	}
+	var model Model
+
+	prod := vars.NewResolver(vars.NewMapProvider("env", map[string]string{
+		"descriptor_path": "descriptors/prod.pb",
+		"use_reflection":  "false",
+	}))
+	req := newReq()
+	if err := model.prepareGRPCRequest(req, prod, ""); err != nil {
+		t.Fatalf("prepareGRPCRequest returned error: %v", err)
+	}
+	if req.GRPC.DescriptorSet != "descriptors/prod.pb" || req.GRPC.UseReflection {
+		t.Fatalf("expected prod descriptor without reflection, got %+v", req.GRPC)
+	}
+
+	staging := vars.NewResolver(vars.NewMapProvider("env", map[string]string{
+		"descriptor_path": "",
+		"use_reflection":  "TRUE",
+	}))
+	req = newReq()
+	if err := model.prepareGRPCRequest(req, staging, ""); err != nil {
+		t.Fatalf("prepareGRPCRequest returned error: %v", err)
+	}
+	if req.GRPC.DescriptorSet != "" || !req.GRPC.UseReflection {
+		t.Fatalf("expected staging to use reflection, got %+v", req.GRPC)
+	}
+
+	bad := vars.NewResolver(vars.NewMapProvider("env", map[string]string{
+		"descriptor_path": "",
+		"use_reflection":  "sometimes",
+	}))
+	err := model.prepareGRPCRequest(newReq(), bad, "")
+	if err == nil || !strings.Contains(err.Error(), `invalid grpc-reflection value "sometimes"`) {
+		t.Fatalf("expected invalid reflection error, got %v", err)
+	}
+}
+
+func TestPrepareGRPCRequestLoadsMetadataFile(t *testing.T) {
+	dir := t.TempDir()
+	content := "# shared context\nauthorization: Bearer {{token}}\n\nx-tenant: acme\n"
+	if err := os.WriteFile(filepath.Join(dir, "meta.txt"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write metadata file: %v", err)
+	}
+	resolver := vars.NewResolver(vars.NewMapProvider("env", map[string]string{"token": "abcd"}))
+
+	req := &restfile.Request{
+		Method: "GRPC",
+		GRPC: &restfile.GRPCRequest{
+			Target:        "localhost:50051",
+			FullMethod:    "/pkg.Service/GetUser",
+			MetadataFiles: []string{"meta.txt"},
+			Metadata: []restfile.MetadataPair{
+				{Key: "X-Tenant", Value: "override"},
+			},
+		},
+	}
+
+	var model Model
+	if err := model.prepareGRPCRequest(req, resolver, dir); err != nil {
+		t.Fatalf("prepareGRPCRequest returned error: %v", err)
+	}
+	want := []restfile.MetadataPair{
+		{Key: "authorization", Value: "Bearer abcd"},
+		{Key: "X-Tenant", Value: "override"},
+	}
+	if !reflect.DeepEqual(req.GRPC.Metadata, want) {
+		t.Fatalf("unexpected metadata %#v", req.GRPC.Metadata)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "bad.txt"), []byte("grpc-timeout: 1s\n"), 0o644); err != nil {
+		t.Fatalf("write metadata file: %v", err)
+	}
+	req.GRPC.MetadataFiles = []string{"bad.txt"}
+	req.GRPC.Metadata = nil
+	if err := model.prepareGRPCRequest(req, resolver, dir); err == nil ||
+		!strings.Contains(err.Error(), "reserved") {
+		t.Fatalf("expected reserved key error, got %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "broken.txt"), []byte("no separator\n"), 0o644); err != nil {
+		t.Fatalf("write metadata file: %v", err)
+	}
+	req.GRPC.MetadataFiles = []string{"broken.txt"}
+	if err := model.prepareGRPCRequest(req, resolver, dir); err == nil ||
+		!strings.Contains(err.Error(), "broken.txt:1") {
+		t.Fatalf("expected line error, got %v", err)
+	}
+}
+
 func TestPrepareGRPCRequestUsesBodyOverride(t *testing.T) {
 	resolver := vars.NewResolver()
 	req := &restfile.Request{
@@ -666,7 +769,7 @@ func TestEnsureOAuthSetsAuthorizationHeader(t *testing.T) {
 	}}
 	req := &restfile.Request{Metadata: restfile.RequestMetadata{Auth: auth}}
 	resolver := vars.NewResolver()
-	if err := model.ensureOAuth(
+	if _, err := model.ensureOAuth(
 		context.Background(),
 		req,
 		resolver,
@@ -688,7 +791,7 @@ func TestEnsureOAuthSetsAuthorizationHeader(t *testing.T) {
 	}
 
 	req2 := &restfile.Request{Metadata: restfile.RequestMetadata{Auth: auth}}
-	if err := model.ensureOAuth(
+	if _, err := model.ensureOAuth(
 		context.Background(),
 		req2,
 		resolver,
@@ -729,7 +832,7 @@ func TestEnsureOAuthSkipsWhenHeaderPresent(t *testing.T) {
 			}},
 		},
 	}
-	if err := model.ensureOAuth(
+	if _, err := model.ensureOAuth(
 		context.Background(),
 		req,
 		vars.NewResolver(),
@@ -747,6 +850,81 @@ func TestEnsureOAuthSkipsWhenHeaderPresent(t *testing.T) {
 	}
 }
 
+func TestExecuteRequestRefreshesOAuthTokenOn401(t *testing.T) {
+	var seen []string
+	fakeClient := httpclient.NewClient(nil)
+	fakeClient.SetHTTPFactory(func(httpclient.Options) (*http.Client, error) {
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			auth := req.Header.Get("Authorization")
+			seen = append(seen, auth)
+			status := http.StatusOK
+			if auth != "Bearer token-fresh" {
+				status = http.StatusUnauthorized
+			}
+			return &http.Response{
+				Status:     http.StatusText(status),
+				StatusCode: status,
+				Proto:      "HTTP/1.1",
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader("{}")),
+				Request:    req,
+			}, nil
+		})
+		return &http.Client{Transport: transport}, nil
+	})
+
+	model := New(Config{Client: fakeClient})
+	var grants []string
+	model.oauth.SetRequestFunc(
+		func(ctx context.Context, req *restfile.Request, opts httpclient.Options) (*httpclient.Response, error) {
+			values, err := url.ParseQuery(req.Body.Text)
+			if err != nil {
+				t.Fatalf("parse form: %v", err)
+			}
+			grants = append(grants, values.Get("grant_type"))
+			body := `{"access_token":"token-stale","expires_in":3600,"refresh_token":"refresh-1"}`
+			if values.Get("grant_type") == "refresh_token" {
+				body = `{"access_token":"token-fresh","expires_in":3600}`
+			}
+			return &httpclient.Response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       []byte(body),
+				Headers:    http.Header{},
+			}, nil
+		},
+	)
+
+	req := &restfile.Request{
+		Method: "GET",
+		URL:    "https://api.local/items",
+		Metadata: restfile.RequestMetadata{
+			Auth: &restfile.AuthSpec{Type: "oauth2", Params: map[string]string{
+				"token_url":     "https://auth.local/token",
+				"client_id":     "client",
+				"client_secret": "secret",
+			}},
+		},
+	}
+
+	msg, ok := model.executeRequest(nil, req, httpclient.Options{}, "", nil)().(responseMsg)
+	if !ok {
+		t.Fatalf("expected responseMsg from command")
+	}
+	if msg.err != nil {
+		t.Fatalf("unexpected error: %v", msg.err)
+	}
+	if msg.response == nil || msg.response.StatusCode != http.StatusOK {
+		t.Fatalf("expected retried request to succeed, got %+v", msg.response)
+	}
+	if len(seen) != 2 || seen[0] != "Bearer token-stale" || seen[1] != "Bearer token-fresh" {
+		t.Fatalf("unexpected authorization sequence %v", seen)
+	}
+	if len(grants) != 2 || grants[1] != "refresh_token" {
+		t.Fatalf("expected refresh_token grant, got %v", grants)
+	}
+}
+
 func copyValues(src url.Values) url.Values {
 	dst := make(url.Values, len(src))
 	for k, v := range src {
@@ -784,7 +962,7 @@ func TestEnsureOAuthUsesEnvironmentOverride(t *testing.T) {
 	}}
 	req := &restfile.Request{Metadata: restfile.RequestMetadata{Auth: auth}}
 
-	if err := model.ensureOAuth(
+	if _, err := model.ensureOAuth(
 		context.Background(),
 		req,
 		vars.NewResolver(),
@@ -795,7 +973,7 @@ func TestEnsureOAuthUsesEnvironmentOverride(t *testing.T) {
 		t.Fatalf("ensureOAuth stage: %v", err)
 	}
 	req.Headers = nil
-	if err := model.ensureOAuth(
+	if _, err := model.ensureOAuth(
 		context.Background(),
 		req,
 		vars.NewResolver(),
@@ -810,7 +988,7 @@ func TestEnsureOAuthUsesEnvironmentOverride(t *testing.T) {
 	}
 
 	req.Headers = nil
-	if err := model.ensureOAuth(
+	if _, err := model.ensureOAuth(
 		context.Background(),
 		req,
 		vars.NewResolver(),
@@ -849,7 +1027,7 @@ func TestEnsureOAuthCancelsWithContext(t *testing.T) {
 	done := make(chan struct{})
 	go func() {
 		defer close(done)
-		if err := model.ensureOAuth(
+		if _, err := model.ensureOAuth(
 			ctx,
 			req,
 			resolver,
@@ -1896,3 +2074,273 @@ func TestExecuteRequestWithTraceSpecPopulatesTimeline(t *testing.T) {
 		t.Fatalf("expected timeline to be populated in snapshot")
 	}
 }
+
+func TestApplyPathParamsExpandsTemplates(t *testing.T) {
+	req := &restfile.Request{
+		Method: "GET",
+		URL:    "{{base}}/users/{id}?fields={id}",
+		Metadata: restfile.RequestMetadata{
+			PathParams: map[string]string{"id": "{{userId}}"},
+		},
+	}
+	resolver := vars.NewResolver(vars.NewMapProvider("file", map[string]string{
+		"userId": "u 7",
+	}))
+	if err := applyPathParams(req, resolver); err != nil {
+		t.Fatalf("applyPathParams: %v", err)
+	}
+	if req.URL != "{{base}}/users/u%207?fields={id}" {
+		t.Fatalf("unexpected url %q", req.URL)
+	}
+
+	missing := &restfile.Request{Method: "GET", URL: "https://x.test/users/{id}"}
+	if err := applyPathParams(missing, resolver); err == nil {
+		t.Fatalf("expected unresolved path param error")
+	}
+}
+
+func TestApplyBaseURLPrefixesRelativeURLs(t *testing.T) {
+	model := New(Config{
+		EnvironmentSet: map[string]map[string]string{
+			"dev":  {"baseUrl": "https://{{host}}/v1/"},
+			"bare": {"token": "x"},
+		},
+	})
+	resolver := vars.NewResolver(vars.NewMapProvider("file", map[string]string{
+		"host": "api.example.com",
+	}))
+
+	rel := &restfile.Request{Method: "GET", URL: "/users"}
+	if err := model.applyBaseURL(rel, resolver, "dev"); err != nil {
+		t.Fatalf("applyBaseURL: %v", err)
+	}
+	if rel.URL != "https://api.example.com/v1/users" {
+		t.Fatalf("unexpected url %q", rel.URL)
+	}
+
+	abs := &restfile.Request{Method: "GET", URL: "https://other.test/users"}
+	if err := model.applyBaseURL(abs, resolver, "dev"); err != nil {
+		t.Fatalf("applyBaseURL: %v", err)
+	}
+	if abs.URL != "https://other.test/users" {
+		t.Fatalf("expected absolute url to be untouched, got %q", abs.URL)
+	}
+
+	missing := &restfile.Request{Method: "GET", URL: "/users"}
+	err := model.applyBaseURL(missing, resolver, "bare")
+	if err == nil || !strings.Contains(err.Error(), "baseUrl") {
+		t.Fatalf("expected missing baseUrl error, got %v", err)
+	}
+}
+
+func TestExpandRequestMethod(t *testing.T) {
+	resolver := vars.NewResolver(vars.NewMapProvider("file", map[string]string{
+		"verb": " patch ",
+		"bad":  "FETCH",
+	}))
+
+	req := &restfile.Request{Method: "{{verb}}", URL: "/users"}
+	if err := expandRequestMethod(req, resolver); err != nil {
+		t.Fatalf("expandRequestMethod: %v", err)
+	}
+	if req.Method != "PATCH" {
+		t.Fatalf("expected PATCH, got %q", req.Method)
+	}
+
+	bad := &restfile.Request{Method: "{{bad}}", URL: "/users"}
+	err := expandRequestMethod(bad, resolver)
+	if err == nil || !strings.Contains(err.Error(), `invalid request method "FETCH"`) {
+		t.Fatalf("expected invalid method error, got %v", err)
+	}
+
+	missing := &restfile.Request{Method: "{{nope}}", URL: "/users"}
+	if err := expandRequestMethod(missing, resolver); err == nil {
+		t.Fatalf("expected error for unresolved method")
+	}
+}
+
+func TestConsumeHTTPResponseWarnsOnPerRequestInsecure(t *testing.T) {
+	model := New(Config{})
+	resp := &httpclient.Response{Status: "200 OK", StatusCode: 200, Insecure: true}
+	model.consumeHTTPResponse(resp, nil, nil, "")
+	if model.statusMessage.level != statusWarn ||
+		!strings.Contains(model.statusMessage.text, "TLS verification off") {
+		t.Fatalf("expected insecure warning, got %+v", model.statusMessage)
+	}
+
+	model = New(Config{HTTPOptions: httpclient.Options{InsecureSkipVerify: true}})
+	model.consumeHTTPResponse(resp, nil, nil, "")
+	if strings.Contains(model.statusMessage.text, "TLS verification off") {
+		t.Fatalf("expected no per-request warning when insecure globally")
+	}
+}
+
+func TestConsumeGRPCResponseProtoTextTab(t *testing.T) {
+	model := New(Config{})
+	model.ready = true
+	model.width = 120
+	model.height = 40
+	if cmd := model.applyLayout(); cmd != nil {
+		collectMsgs(cmd)
+	}
+	req := &restfile.Request{
+		Method: "GRPC",
+		GRPC:   &restfile.GRPCRequest{FullMethod: "/pkg.Service/Get"},
+	}
+	resp := &grpcclient.Response{
+		StatusCode: codes.OK,
+		Body:       []byte(`{"name": "ana"}`),
+		Text:       "name: \"ana\"\n",
+	}
+	if cmd := model.consumeGRPCResponse(resp, nil, nil, req, ""); cmd != nil {
+		collectMsgs(cmd)
+	}
+	if indexOfResponseTab(model.availableResponseTabs(), responseTabProto) == -1 {
+		t.Fatalf("expected Proto tab for gRPC response")
+	}
+	pane := model.pane(responsePanePrimary)
+	pane.setActiveTab(responseTabProto)
+	model.syncResponsePane(responsePanePrimary)
+	if view := stripANSIEscape(pane.viewport.View()); !strings.Contains(view, `name: "ana"`) {
+		t.Fatalf("expected proto text in view, got:\n%s", view)
+	}
+
+	resp.Text = ""
+	if cmd := model.consumeGRPCResponse(resp, nil, nil, req, ""); cmd != nil {
+		collectMsgs(cmd)
+	}
+	got := model.responseLatest.protoText
+	if !strings.Contains(got, protoTextFallbackNote) || !strings.Contains(got, `"ana"`) {
+		t.Fatalf("expected JSON fallback in proto tab, got %q", got)
+	}
+}
+
+func TestExecuteRequestUsesRequestEnv(t *testing.T) {
+	var hosts []string
+	fakeClient := httpclient.NewClient(nil)
+	fakeClient.SetHTTPFactory(func(httpclient.Options) (*http.Client, error) {
+		transport := transportFunc(func(req *http.Request) (*http.Response, error) {
+			hosts = append(hosts, req.URL.Host)
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Proto:      "HTTP/1.1",
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader("ok")),
+				Request:    req,
+			}, nil
+		})
+		return &http.Client{Transport: transport}, nil
+	})
+
+	model := New(Config{
+		Client:          fakeClient,
+		EnvironmentName: "dev",
+		EnvironmentSet: vars.EnvironmentSet{
+			"dev":     {"host": "dev.local"},
+			"staging": {"host": "staging.local"},
+			"prod":    {"host": "prod.local"},
+		},
+	})
+	req := &restfile.Request{
+		Method:   "GET",
+		URL:      "https://{{host}}/token",
+		Metadata: restfile.RequestMetadata{Env: "staging"},
+	}
+	doc := &restfile.Document{Requests: []*restfile.Request{req}}
+	model.doc = doc
+
+	send := func(envOverride string) responseMsg {
+		t.Helper()
+		msg, ok := model.executeRequest(doc, req, model.cfg.HTTPOptions, envOverride, nil)().(responseMsg)
+		if !ok || msg.err != nil {
+			t.Fatalf("expected response, got %+v", msg)
+		}
+		return msg
+	}
+	if msg := send(""); msg.environment != "staging" {
+		t.Fatalf("expected @env to pick staging, got %q", msg.environment)
+	}
+	if msg := send("prod"); msg.environment != "prod" {
+		t.Fatalf("expected compare override to win, got %q", msg.environment)
+	}
+	if len(hosts) != 2 || hosts[0] != "staging.local" || hosts[1] != "prod.local" {
+		t.Fatalf("unexpected hosts %v", hosts)
+	}
+	if note := model.envOverrideNote("staging"); note != "env staging" {
+		t.Fatalf("expected status note, got %q", note)
+	}
+
+	req.Metadata.Env = "qa"
+	msg, _ := model.executeRequest(doc, req, model.cfg.HTTPOptions, "", nil)().(responseMsg)
+	if msg.err == nil || !strings.Contains(msg.err.Error(), "@env qa") {
+		t.Fatalf("expected unknown env error, got %v", msg.err)
+	}
+}
+
+func TestExecuteRequestGraphQLVariablesUseCapturedValues(t *testing.T) {
+	var gqlBody []byte
+	fakeClient := httpclient.NewClient(nil)
+	fakeClient.SetHTTPFactory(func(httpclient.Options) (*http.Client, error) {
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			body := `{"token":"tok-123"}`
+			if req.URL.Path == "/graphql" {
+				data, err := io.ReadAll(req.Body)
+				if err != nil {
+					return nil, err
+				}
+				gqlBody = data
+				body = `{"data":{"me":{"id":"1"}}}`
+			}
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Proto:      "HTTP/1.1",
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}, nil
+		})
+		return &http.Client{Transport: transport}, nil
+	})
+
+	src := strings.Join([]string{
+		"### Login",
+		"# @name login",
+		"# @capture global gqlToken {{response.json.token}}",
+		"POST https://api.local/login",
+		"",
+		"### Me",
+		"# @name me",
+		"# @graphql",
+		"POST https://api.local/graphql",
+		"",
+		"query Me($token: String!) { me(token: $token) { id } }",
+		"",
+		"# @variables",
+		`{"token": "{{gqlToken}}"}`,
+	}, "\n")
+	model := New(Config{Client: fakeClient})
+	doc := parser.Parse("gql.http", []byte(src))
+	if len(doc.Requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(doc.Requests))
+	}
+	model.doc = doc
+
+	for _, req := range doc.Requests {
+		msg, ok := model.executeRequest(doc, req, httpclient.Options{}, "", nil)().(responseMsg)
+		if !ok || msg.err != nil {
+			t.Fatalf("request %s failed: %+v", req.Metadata.Name, msg.err)
+		}
+	}
+
+	var payload struct {
+		Variables map[string]any `json:"variables"`
+	}
+	if err := json.Unmarshal(gqlBody, &payload); err != nil {
+		t.Fatalf("decode graphql payload %q: %v", gqlBody, err)
+	}
+	if payload.Variables["token"] != "tok-123" {
+		t.Fatalf("expected captured token in graphql variables, got %s", gqlBody)
+	}
+}
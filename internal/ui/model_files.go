@@ -103,6 +103,7 @@ func (m *Model) saveFile() tea.Cmd {
 		m.openSaveAsModal()
 		return nil
 	}
+	m.formatOnSave()
 	content := []byte(m.editor.Value())
 	if err := os.WriteFile(m.currentFile, content, 0o644); err != nil {
 		return func() tea.Msg {
@@ -119,6 +120,25 @@ func (m *Model) saveFile() tea.Cmd {
 	}
 }
 
+// formatOnSave canonicalizes request files before they are written. The
+// rewrite is a single undo step so the original layout is one undo away.
+func (m *Model) formatOnSave() {
+	if !m.cfg.Settings.FormatOnSave || !filesvc.IsRequestFile(m.currentFile) {
+		return
+	}
+	value := m.editor.Value()
+	formatted := parser.Format(value)
+	if formatted == value {
+		return
+	}
+	caret := m.editor.caretPosition()
+	view := m.editor.ViewStart()
+	m.editor.pushUndoSnapshot()
+	m.editor.SetValue(formatted)
+	m.editor.SetViewStart(view)
+	m.editor.moveCursorTo(caret.Line, caret.Column)
+}
+
 func (m *Model) reloadWorkspace() tea.Cmd {
 	entries, err := filesvc.ListRequestFiles(m.workspaceRoot, m.workspaceRecursive)
 	if err != nil {
@@ -128,6 +148,7 @@ func (m *Model) reloadWorkspace() tea.Cmd {
 	}
 	m.fileList.SetItems(makeFileItems(entries))
 	m.rebuildNavigator(entries)
+	m.gotoIndex = nil
 	return func() tea.Msg {
 		return statusMsg{text: "Workspace refreshed", level: statusSuccess}
 	}
@@ -159,6 +180,7 @@ func (m *Model) ensureWorkspaceFile(path string) bool {
 
 func (m *Model) reparseDocument() tea.Cmd {
 	m.doc = parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	m.clearRequestOutcomes(m.currentFile)
 	m.syncAllGlobals(m.doc)
 	m.syncRequestList(m.doc)
 	m.rebuildNavigator(nil)
@@ -214,6 +236,7 @@ func (m *Model) reloadFileFromDisk() tea.Cmd {
 
 func (m *Model) refreshCurrentDocument(content []byte) {
 	m.doc = parser.Parse(m.currentFile, content)
+	m.clearRequestOutcomes(m.currentFile)
 	m.syncAllGlobals(m.doc)
 	m.syncRequestList(m.doc)
 	m.rebuildNavigator(nil)
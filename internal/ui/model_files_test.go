@@ -79,6 +79,44 @@ func TestSaveFilePromptsForPathAndSavesContent(t *testing.T) {
 	}
 }
 
+func TestSaveFileFormatsWhenEnabled(t *testing.T) {
+	tmp := t.TempDir()
+	th := theme.DefaultTheme()
+	path := filepath.Join(tmp, "fmt.http")
+	const raw = "#   @name   Ping\nGET https://example.com\ncontent-type: text/plain\n"
+	if err := os.WriteFile(path, []byte(raw), 0o644); err != nil {
+		t.Fatalf("failed to write sample file: %v", err)
+	}
+
+	cfg := Config{WorkspaceRoot: tmp, Theme: &th}
+	cfg.Settings.FormatOnSave = true
+	model := New(cfg)
+	m := &model
+	if cmd := m.openFile(path); cmd != nil {
+		cmd()
+	}
+	if cmd := m.saveFile(); cmd != nil {
+		cmd()
+	}
+
+	const want = "# @name Ping\nGET https://example.com\nContent-Type: text/plain\n"
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read saved file: %v", err)
+	}
+	if string(data) != want {
+		t.Fatalf("expected formatted file, got %q", string(data))
+	}
+	if m.editor.Value() != want {
+		t.Fatalf("expected editor to hold formatted text, got %q", m.editor.Value())
+	}
+
+	m.editor, _ = m.editor.UndoLastChange()
+	if m.editor.Value() != raw {
+		t.Fatalf("expected a single undo to restore the original, got %q", m.editor.Value())
+	}
+}
+
 func TestOpenTemporaryDocumentResetsState(t *testing.T) {
 	tmp := t.TempDir()
 	th := theme.DefaultTheme()
@@ -43,6 +43,7 @@ func (m *Model) forgetFileWatch(path string) {
 	if m.fileWatcher != nil && path != "" {
 		m.fileWatcher.Forget(path)
 	}
+	m.clearBodyFileWatches()
 	m.fileStale = false
 	m.fileMissing = false
 	m.pendingReloadConfirm = false
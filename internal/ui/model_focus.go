@@ -57,6 +57,19 @@ func (m *Model) ensureVisibleFocus() tea.Cmd {
 	return m.setFocus(next)
 }
 
+// focusResponseAfterSend moves focus to the response pane once a response
+// arrives when focus_response_on_send is enabled. Multi-step runs only move
+// focus after their last response.
+func (m *Model) focusResponseAfterSend(msg responseMsg) tea.Cmd {
+	if !m.cfg.Settings.FocusResponseOnSend || msg.skipped {
+		return nil
+	}
+	if m.compareRun != nil || m.workflowRun != nil || m.profileRun != nil {
+		return nil
+	}
+	return m.setFocus(focusResponse)
+}
+
 func (m *Model) setFocus(target paneFocus) tea.Cmd {
 	var cmds []tea.Cmd
 	region := regionFromFocus(target)
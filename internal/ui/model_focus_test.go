@@ -1,6 +1,11 @@
 package ui
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+)
 
 func TestCycleFocusSkipsCollapsedPane(t *testing.T) {
 	model := New(Config{WorkspaceRoot: t.TempDir()})
@@ -20,3 +25,28 @@ func TestCycleFocusSkipsCollapsedPane(t *testing.T) {
 		t.Fatalf("expected focus to skip collapsed response pane, got %v", model.focus)
 	}
 }
+
+func TestFocusResponseOnSend(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		model := New(Config{
+			WorkspaceRoot: t.TempDir(),
+			Settings:      config.Settings{FocusResponseOnSend: enabled},
+		})
+		model.width = 140
+		model.height = 50
+		model.ready = true
+		_ = model.applyLayout()
+		_ = model.setFocus(focusEditor)
+
+		resp := &httpclient.Response{Status: "200 OK", StatusCode: 200}
+		updated, _ := model.Update(responseMsg{response: resp})
+		got := updated.(Model).focus
+		want := focusEditor
+		if enabled {
+			want = focusResponse
+		}
+		if got != want {
+			t.Fatalf("focus_response_on_send=%v: expected focus %v, got %v", enabled, want, got)
+		}
+	}
+}
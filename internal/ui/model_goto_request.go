@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/unkn0wn-root/resterm/internal/filesvc"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+// gotoMaxResults caps the rows handed to the list so a query over a large
+// workspace stays cheap to render.
+const gotoMaxResults = 200
+
+// openGotoRequest opens the workspace-wide request search. Every request
+// file in the workspace is indexed on first use; reloadWorkspace drops the
+// index along with the file list it was built from.
+func (m *Model) openGotoRequest() tea.Cmd {
+	m.gotoEntries = m.workspaceRequests()
+	if len(m.gotoEntries) == 0 {
+		return statusCmd(statusInfo, "No requests found in the workspace")
+	}
+	m.gotoInput.SetValue("")
+	m.gotoInput.Focus()
+	m.gotoPending = ""
+	m.filterGotoRequests()
+	m.showGotoRequest = true
+	m.showHelp = false
+	m.showEnvSelector = false
+	m.showThemeSelector = false
+	return textinput.Blink
+}
+
+func (m *Model) closeGotoRequest() {
+	m.showGotoRequest = false
+	m.gotoPending = ""
+	m.gotoEntries = nil
+	m.gotoInput.Blur()
+}
+
+// gotoIndexEntry holds the requests indexed from doc. The entry is reused
+// while loadDocFor keeps returning the same document, which it does until
+// the file changes on disk (or, for the open file, until it is reparsed).
+type gotoIndexEntry struct {
+	doc  *restfile.Document
+	reqs []recentRequest
+}
+
+// workspaceRequests lists the requests of every request file in the
+// workspace, reindexing only files whose document changed.
+func (m *Model) workspaceRequests() []recentRequest {
+	if m.gotoIndex == nil {
+		m.gotoIndex = make(map[string]gotoIndexEntry)
+	}
+	entries := m.entriesFromList()
+	if len(entries) == 0 && m.currentFile != "" {
+		entries = []filesvc.FileEntry{{Path: m.currentFile}}
+	}
+	var out []recentRequest
+	for _, entry := range entries {
+		path := entry.Path
+		if path == "" || !filesvc.IsRequestFile(path) {
+			continue
+		}
+		doc := m.loadDocFor(path)
+		if doc == nil {
+			continue
+		}
+		cached, ok := m.gotoIndex[path]
+		if !ok || cached.doc != doc {
+			cached = gotoIndexEntry{doc: doc, reqs: indexRequests(path, doc)}
+			m.gotoIndex[path] = cached
+		}
+		out = append(out, cached.reqs...)
+	}
+	return out
+}
+
+func indexRequests(path string, doc *restfile.Document) []recentRequest {
+	out := make([]recentRequest, 0, len(doc.Requests))
+	for _, req := range doc.Requests {
+		if req == nil {
+			continue
+		}
+		out = append(out, recentRequest{
+			path:  path,
+			key:   requestKey(req),
+			line:  req.LineRange.Start,
+			title: requestBaseTitle(req),
+		})
+	}
+	return out
+}
+
+// filterGotoRequests ranks the indexed requests against the query with the
+// list's fuzzy matcher. The title and the file location are both searched,
+// so typing part of a file name narrows to its requests.
+func (m *Model) filterGotoRequests() {
+	query := strings.TrimSpace(m.gotoInput.Value())
+	descs := make([]string, len(m.gotoEntries))
+	for i, entry := range m.gotoEntries {
+		descs[i] = m.recentRequestLocation(entry)
+	}
+
+	var order []int
+	if query == "" {
+		order = make([]int, len(m.gotoEntries))
+		for i := range order {
+			order[i] = i
+		}
+	} else {
+		targets := make([]string, len(m.gotoEntries))
+		for i, entry := range m.gotoEntries {
+			targets[i] = entry.title + " " + descs[i]
+		}
+		for _, rank := range list.DefaultFilter(query, targets) {
+			order = append(order, rank.Index)
+		}
+	}
+	if len(order) > gotoMaxResults {
+		order = order[:gotoMaxResults]
+	}
+
+	items := make([]list.Item, 0, len(order))
+	for _, idx := range order {
+		items = append(items, recentItem{entry: m.gotoEntries[idx], desc: descs[idx]})
+	}
+	m.gotoList.SetItems(items)
+	m.gotoList.Select(0)
+}
+
+func (m *Model) handleGotoRequestKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.closeGotoRequest()
+		return nil
+	case "enter":
+		item, ok := m.gotoList.SelectedItem().(recentItem)
+		if !ok {
+			return nil
+		}
+		return m.jumpToRequest(item.entry, &m.gotoPending, m.closeGotoRequest, false)
+	case "up", "down", "ctrl+p", "ctrl+n", "pgup", "pgdown":
+		switch msg.String() {
+		case "ctrl+p":
+			msg = tea.KeyMsg{Type: tea.KeyUp}
+		case "ctrl+n":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		}
+		var cmd tea.Cmd
+		m.gotoList, cmd = m.gotoList.Update(msg)
+		if item, ok := m.gotoList.SelectedItem().(recentItem); ok &&
+			item.entry.id() != m.gotoPending {
+			m.gotoPending = ""
+		}
+		return cmd
+	}
+	prev := m.gotoInput.Value()
+	var cmd tea.Cmd
+	m.gotoInput, cmd = m.gotoInput.Update(msg)
+	if m.gotoInput.Value() != prev {
+		m.gotoPending = ""
+		m.filterGotoRequests()
+	}
+	return cmd
+}
+
+func (m Model) renderGotoRequestModal() string {
+	width := minInt(m.width-10, 72)
+	if width < 32 {
+		width = 32
+	}
+
+	body := m.gotoList.View()
+	if len(m.gotoList.Items()) == 0 {
+		body = m.theme.HeaderValue.Render("No matching requests")
+	}
+
+	commands := fmt.Sprintf(
+		"%s Jump    %s Move    %s Cancel",
+		m.theme.CommandBarHint.Render("Enter"),
+		m.theme.CommandBarHint.Render("↑/↓"),
+		m.theme.CommandBarHint.Render("Esc"),
+	)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.gotoInput.View(),
+		"",
+		body,
+		"",
+		commands,
+	)
+
+	box := m.theme.BrowserBorder.Width(width).Render(content)
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("#1A1823")),
+	)
+}
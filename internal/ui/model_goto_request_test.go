@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestGotoRequestSearchesWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "users.http")
+	second := filepath.Join(dir, "orders.http")
+	writeFile := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	writeFile(first, "### list\n# @name listUsers\nGET https://example.com/users\n")
+	writeFile(second, "### a\n# @name createOrder\nPOST https://example.com/orders\n\n"+
+		"### b\n# @name cancelOrder\nDELETE https://example.com/orders/1\n")
+
+	model := New(Config{WorkspaceRoot: dir})
+	m := &model
+	m.openFile(first)
+
+	if cmd := m.openGotoRequest(); !m.showGotoRequest {
+		t.Fatalf("expected modal to open, got %v", statusFromCmd(t, cmd))
+	}
+	if got := len(m.gotoList.Items()); got != 3 {
+		t.Fatalf("expected 3 indexed requests, got %d", got)
+	}
+
+	for _, r := range "cnclord" {
+		m.handleGotoRequestKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	items := m.gotoList.Items()
+	if len(items) == 0 || items[0].(recentItem).entry.key != "name:cancelOrder" {
+		t.Fatalf("expected cancelOrder to rank first, got %+v", items)
+	}
+
+	m.handleGotoRequestKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showGotoRequest {
+		t.Fatalf("expected modal to close after jumping")
+	}
+	if !samePath(m.currentFile, second) {
+		t.Fatalf("expected orders.http to be opened, got %q", m.currentFile)
+	}
+	if m.currentRequest == nil || m.currentRequest.Metadata.Name != "cancelOrder" {
+		t.Fatalf("expected cancelOrder to be selected, got %+v", m.currentRequest)
+	}
+}
+
+func TestGotoRequestIndexRefreshesOnReload(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "a.http")
+	if err := os.WriteFile(first, []byte("# @name one\nGET https://example.com\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	model := New(Config{WorkspaceRoot: dir})
+	m := &model
+	m.openFile(first)
+	m.openGotoRequest()
+	m.closeGotoRequest()
+
+	added := filepath.Join(dir, "b.http")
+	if err := os.WriteFile(added, []byte("# @name two\nGET https://example.com\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	m.reloadWorkspace()
+	m.openGotoRequest()
+	if got := len(m.gotoList.Items()); got != 2 {
+		t.Fatalf("expected new file to be indexed after reload, got %d requests", got)
+	}
+}
@@ -0,0 +1,137 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+	"github.com/unkn0wn-root/resterm/internal/grpcclient"
+	"github.com/unkn0wn-root/resterm/internal/settings"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+type grpcSchemaMsg struct {
+	title  string
+	schema *grpcclient.MethodSchema
+	err    error
+}
+
+// showGRPCSchema resolves the descriptor of the active gRPC request and
+// opens a modal describing its input/output messages.
+func (m *Model) showGRPCSchema() tea.Cmd {
+	req, doc, path := m.requestDetailContext()
+	if req == nil || req.GRPC == nil {
+		return func() tea.Msg {
+			return statusMsg{text: "Select a gRPC request to view its schema", level: statusInfo}
+		}
+	}
+
+	req = cloneRequest(req)
+	envName := vars.SelectEnv(m.cfg.EnvironmentSet, "", m.cfg.EnvironmentName)
+	baseDir := ""
+	if path != "" {
+		baseDir = filepath.Dir(path)
+	}
+	opts := m.grpcOptions
+	if opts.BaseDir == "" {
+		opts.BaseDir = baseDir
+	}
+	title := fmt.Sprintf("gRPC Schema %s", m.requestDetailTitleFor(req, doc))
+	client := m.grpcClient
+
+	m.setStatusMessage(statusMsg{text: "Resolving gRPC schema...", level: statusInfo})
+	return func() tea.Msg {
+		ctx := context.Background()
+		resolver := m.buildResolver(ctx, doc, req, envName, opts.BaseDir, nil)
+		fileSettings := map[string]string{}
+		if doc != nil && doc.Settings != nil {
+			fileSettings = doc.Settings
+		}
+		req.Settings = settings.Merge(
+			settings.FromEnv(m.cfg.EnvironmentSet, envName),
+			fileSettings,
+			doc.SettingsForTags(req.Metadata.Tags),
+			req.Settings,
+		)
+		applier := settings.New(settings.GRPCHandler(&opts, resolver))
+		if _, err := applier.ApplyAll(req.Settings); err != nil {
+			return grpcSchemaMsg{title: title, err: err}
+		}
+		if err := m.prepareGRPCRequest(req, resolver, opts.BaseDir); err != nil {
+			return grpcSchemaMsg{title: title, err: err}
+		}
+		sshPlan, err := m.resolveSSH(doc, req, resolver, envName)
+		if err != nil {
+			err = errdef.Wrap(errdef.CodeHTTP, err, "resolve ssh")
+			return grpcSchemaMsg{title: title, err: err}
+		}
+		k8sPlan, err := m.resolveK8s(doc, req, resolver, envName)
+		if err != nil {
+			err = errdef.Wrap(errdef.CodeHTTP, err, "resolve k8s")
+			return grpcSchemaMsg{title: title, err: err}
+		}
+		opts.SSH = sshPlan
+		opts.K8s = k8sPlan
+		if opts.DialTimeout == 0 {
+			opts.DialTimeout = defaultTimeout(resolveRequestTimeout(req, 0))
+		}
+		schema, err := client.Describe(ctx, req.GRPC, opts)
+		return grpcSchemaMsg{title: title, schema: schema, err: err}
+	}
+}
+
+func (m *Model) handleGRPCSchemaMsg(msg grpcSchemaMsg) {
+	if msg.err != nil {
+		m.setStatusMessage(statusMsg{
+			text:  fmt.Sprintf("gRPC schema unavailable: %s", errdef.Message(msg.err)),
+			level: statusError,
+		})
+		return
+	}
+	if msg.schema == nil {
+		m.setStatusMessage(statusMsg{text: "gRPC schema unavailable", level: statusWarn})
+		return
+	}
+	m.openInfoModal(msg.title, formatGRPCSchema(msg.schema))
+	m.setStatusMessage(statusMsg{text: "gRPC schema loaded", level: statusInfo})
+}
+
+func formatGRPCSchema(schema *grpcclient.MethodSchema) string {
+	if schema == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("Method: " + schema.FullMethod + "\n")
+	b.WriteString("Input:  " + streamPrefix(schema.ClientStreaming) + schema.Input + "\n")
+	b.WriteString("Output: " + streamPrefix(schema.ServerStreaming) + schema.Output + "\n")
+	for _, msg := range schema.Messages {
+		b.WriteString("\nmessage " + msg.Name + " {\n")
+		if len(msg.Fields) == 0 {
+			b.WriteString("  (no fields)\n")
+		}
+		for _, f := range msg.Fields {
+			b.WriteString("  ")
+			if f.Label != "" {
+				b.WriteString(f.Label + " ")
+			}
+			fmt.Fprintf(&b, "%s %s = %d;", f.Type, f.Name, f.Number)
+			if f.Oneof != "" {
+				b.WriteString(" // oneof " + f.Oneof)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("}\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func streamPrefix(streaming bool) string {
+	if streaming {
+		return "stream "
+	}
+	return ""
+}
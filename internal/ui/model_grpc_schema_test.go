@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/grpcclient"
+)
+
+func TestFormatGRPCSchemaListsFields(t *testing.T) {
+	schema := &grpcclient.MethodSchema{
+		FullMethod:      "/demo.Users/List",
+		Input:           "demo.ListRequest",
+		Output:          "demo.User",
+		ServerStreaming: true,
+		Messages: []grpcclient.MessageSchema{
+			{
+				Name: "demo.ListRequest",
+				Fields: []grpcclient.FieldSchema{
+					{Name: "ids", Number: 1, Type: "int64", Label: "repeated"},
+					{Name: "filter", Number: 2, Type: "string", Label: "optional"},
+					{Name: "email", Number: 3, Type: "string", Oneof: "lookup"},
+				},
+			},
+			{Name: "demo.User"},
+		},
+	}
+
+	out := formatGRPCSchema(schema)
+	expect := []string{
+		"Method: /demo.Users/List",
+		"Output: stream demo.User",
+		"message demo.ListRequest {",
+		"repeated int64 ids = 1;",
+		"optional string filter = 2;",
+		"string email = 3; // oneof lookup",
+		"(no fields)",
+	}
+	for _, want := range expect {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected schema to include %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestGRPCSchemaMsgOpensInfoModal(t *testing.T) {
+	model := New(Config{})
+	model.ready = true
+	model.width = 120
+	model.height = 40
+
+	model.handleGRPCSchemaMsg(grpcSchemaMsg{
+		title:  "gRPC Schema demo",
+		schema: &grpcclient.MethodSchema{FullMethod: "/demo.Users/Get"},
+	})
+	if !model.showInfoModal {
+		t.Fatalf("expected schema modal to open")
+	}
+	if !strings.Contains(model.infoModalContent, "/demo.Users/Get") {
+		t.Fatalf("expected modal content to describe method, got %q", model.infoModalContent)
+	}
+	if view := model.View(); !strings.Contains(view, "gRPC Schema demo") {
+		t.Fatalf("expected modal title in view")
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(Model)
+	if model.showInfoModal {
+		t.Fatalf("expected esc to close schema modal")
+	}
+
+	model.handleGRPCSchemaMsg(grpcSchemaMsg{err: errors.New("no reflection")})
+	if model.showInfoModal {
+		t.Fatalf("expected errors to keep modal closed")
+	}
+	if !strings.Contains(model.statusMessage.text, "no reflection") {
+		t.Fatalf("expected error in status, got %q", model.statusMessage.text)
+	}
+}
+
+func TestShowGRPCSchemaRequiresGRPCRequest(t *testing.T) {
+	model := New(Config{})
+	cmd := model.showGRPCSchema()
+	if cmd == nil {
+		t.Fatalf("expected status command")
+	}
+	msg, ok := cmd().(statusMsg)
+	if !ok || msg.level != statusInfo {
+		t.Fatalf("expected info status, got %#v", msg)
+	}
+}
@@ -0,0 +1,397 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/parser/grpcbuilder"
+	"github.com/unkn0wn-root/resterm/internal/parser/httpbuilder"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+type hdrEditMode int
+
+const (
+	hdrEditBrowse hdrEditMode = iota
+	hdrEditName
+	hdrEditValue
+)
+
+// headerRow is one header line of the request. raw keeps the original text
+// so untouched rows are written back byte for byte; it is cleared on edit.
+// line is the buffer line index, or -1 for rows added in the panel.
+type headerRow struct {
+	name  string
+	value string
+	raw   string
+	line  int
+}
+
+// headerEditor holds the structured header panel. It edits the header block
+// between the request line and the first blank line; comments and directives
+// in that block are kept in place.
+type headerEditor struct {
+	on     bool
+	title  string
+	src    string
+	start  int
+	end    int
+	cursor int
+	rows   []headerRow
+	sel    int
+	mode   hdrEditMode
+	adding bool
+	input  textinput.Model
+	dirty  bool
+	err    string
+}
+
+func (m *Model) openHeaderEditor() tea.Cmd {
+	src := m.editor.Value()
+	doc := parser.Parse(m.currentFile, []byte(src))
+	req, _ := requestAtLine(doc, currentCursorLine(m.editor))
+	if req == nil {
+		return statusCmd(statusWarn, "No request at cursor")
+	}
+	lines := strings.Split(src, "\n")
+	start, end, rows, ok := scanRequestHeaders(lines, req)
+	if !ok {
+		return statusCmd(statusWarn, "Request line not found")
+	}
+	m.showHelp = false
+	m.showEnvSelector = false
+	m.showThemeSelector = false
+	m.hdrEdit = headerEditor{
+		on:     true,
+		title:  requestBaseTitle(req),
+		src:    src,
+		start:  start,
+		end:    end,
+		cursor: m.editor.Line(),
+		rows:   rows,
+		input:  newEnvEditorInput(),
+	}
+	return nil
+}
+
+func (m *Model) closeHeaderEditor() {
+	m.hdrEdit.input.Blur()
+	m.hdrEdit = headerEditor{}
+}
+
+// scanRequestHeaders finds the header block of req in lines. start is the
+// line after the request line and end the first blank line (or the end of
+// the request), both as zero-based indexes.
+func scanRequestHeaders(
+	lines []string,
+	req *restfile.Request,
+) (int, int, []headerRow, bool) {
+	first := max(req.LineRange.Start-1, 0)
+	last := min(req.LineRange.End, len(lines))
+	method := -1
+	for i := first; i < last; i++ {
+		if isRequestTextLine(lines[i]) {
+			method = i
+			break
+		}
+	}
+	if method < 0 {
+		return 0, 0, nil, false
+	}
+	end := method + 1
+	for end < last && strings.TrimSpace(lines[end]) != "" {
+		end++
+	}
+	var rows []headerRow
+	for i := method + 1; i < end; i++ {
+		if name, value, ok := splitHeaderLine(lines[i]); ok {
+			rows = append(rows, headerRow{name: name, value: value, raw: lines[i], line: i})
+		}
+	}
+	return method + 1, end, rows, true
+}
+
+func isRequestTextLine(line string) bool {
+	if grpcbuilder.IsMethodLine(line) {
+		return true
+	}
+	if _, _, _, ok := httpbuilder.ParseMethodLine(line); ok {
+		return true
+	}
+	_, ok := httpbuilder.ParseWebSocketURLLine(line)
+	return ok
+}
+
+func splitHeaderLine(line string) (string, string, bool) {
+	trimmed := strings.TrimSpace(line)
+	for _, prefix := range []string{"#", "//", "--", ">", "@", "/*"} {
+		if strings.HasPrefix(trimmed, prefix) {
+			return "", "", false
+		}
+	}
+	name, value, ok := strings.Cut(trimmed, ":")
+	name = strings.TrimSpace(name)
+	if !ok || name == "" || strings.ContainsAny(name, " \t") {
+		return "", "", false
+	}
+	return name, strings.TrimSpace(value), true
+}
+
+func (m *Model) handleHeaderEditorKey(msg tea.KeyMsg) tea.Cmd {
+	ed := &m.hdrEdit
+	if ed.mode != hdrEditBrowse {
+		switch msg.String() {
+		case "esc":
+			ed.mode = hdrEditBrowse
+			ed.adding = false
+			ed.input.Blur()
+			return nil
+		case "enter":
+			m.commitHeaderEditInput()
+			return nil
+		}
+		var cmd tea.Cmd
+		ed.input, cmd = ed.input.Update(msg)
+		return cmd
+	}
+
+	switch msg.String() {
+	case "esc":
+		dirty := ed.dirty
+		m.closeHeaderEditor()
+		if dirty {
+			return statusCmd(statusInfo, "Discarded header changes")
+		}
+		return nil
+	case "up", "k":
+		ed.sel = max(ed.sel-1, 0)
+	case "down", "j":
+		ed.sel = min(ed.sel+1, max(len(ed.rows)-1, 0))
+	case "enter", "e":
+		if ed.sel < len(ed.rows) {
+			ed.mode = hdrEditValue
+			ed.input.SetValue(ed.rows[ed.sel].value)
+			ed.input.CursorEnd()
+			return ed.input.Focus()
+		}
+	case "n":
+		if ed.sel < len(ed.rows) {
+			ed.mode = hdrEditName
+			ed.input.SetValue(ed.rows[ed.sel].name)
+			ed.input.CursorEnd()
+			return ed.input.Focus()
+		}
+	case "a":
+		ed.mode = hdrEditName
+		ed.adding = true
+		ed.input.SetValue("")
+		return ed.input.Focus()
+	case "d":
+		if ed.sel < len(ed.rows) {
+			ed.rows = append(ed.rows[:ed.sel], ed.rows[ed.sel+1:]...)
+			ed.sel = min(ed.sel, max(len(ed.rows)-1, 0))
+			ed.dirty = true
+		}
+	case "ctrl+s":
+		return m.applyHeaderEditor()
+	}
+	return nil
+}
+
+func (m *Model) commitHeaderEditInput() {
+	ed := &m.hdrEdit
+	value := ed.input.Value()
+	ed.err = ""
+	switch ed.mode {
+	case hdrEditName:
+		name := strings.TrimSpace(value)
+		if name == "" || strings.ContainsAny(name, " \t:") {
+			ed.err = "Header names cannot be empty or contain spaces or colons"
+			return
+		}
+		if ed.adding {
+			ed.rows = append(ed.rows, headerRow{name: name, line: -1})
+			ed.sel = len(ed.rows) - 1
+			ed.adding = false
+			ed.mode = hdrEditValue
+			ed.input.SetValue("")
+			ed.dirty = true
+			return
+		}
+		if ed.sel < len(ed.rows) && ed.rows[ed.sel].name != name {
+			ed.rows[ed.sel].name = name
+			ed.rows[ed.sel].raw = ""
+			ed.dirty = true
+		}
+	case hdrEditValue:
+		if ed.sel < len(ed.rows) && ed.rows[ed.sel].value != value {
+			ed.rows[ed.sel].value = value
+			ed.rows[ed.sel].raw = ""
+			ed.dirty = true
+		}
+	}
+	ed.mode = hdrEditBrowse
+	ed.input.Blur()
+}
+
+// applyHeaderEditor rewrites the header block in the editor buffer as one
+// undo step. Rows added in the panel go after the last existing header.
+func (m *Model) applyHeaderEditor() tea.Cmd {
+	ed := &m.hdrEdit
+	if !ed.dirty {
+		m.closeHeaderEditor()
+		return statusCmd(statusInfo, "No header changes to apply")
+	}
+	if m.editor.Value() != ed.src {
+		ed.err = "The editor changed since the panel opened; reopen it"
+		return nil
+	}
+
+	lines := strings.Split(ed.src, "\n")
+	updated := rewriteHeaderBlock(lines, ed.start, ed.end, ed.rows)
+
+	view := m.editor.ViewStart()
+	m.editor.pushUndoSnapshot()
+	m.editor.SetValue(strings.Join(updated, "\n"))
+	m.editor.SetViewStart(view)
+	m.editor.clearSelection()
+	m.editor.moveCursorTo(ed.cursor, 0)
+	m.dirty = true
+
+	m.doc = parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	m.syncRequestList(m.doc)
+
+	title := ed.title
+	m.closeHeaderEditor()
+	return statusCmd(statusInfo, fmt.Sprintf("Updated headers for %s", title))
+}
+
+func rewriteHeaderBlock(lines []string, start, end int, rows []headerRow) []string {
+	kept := make(map[int]headerRow, len(rows))
+	var added []string
+	for _, r := range rows {
+		if r.line >= 0 {
+			kept[r.line] = r
+			continue
+		}
+		added = append(added, formatHeaderLine(r))
+	}
+
+	block := make([]string, 0, end-start+len(added))
+	insertAt := 0
+	for i := start; i < end; i++ {
+		if _, _, ok := splitHeaderLine(lines[i]); !ok {
+			block = append(block, lines[i])
+			continue
+		}
+		r, ok := kept[i]
+		if !ok {
+			continue
+		}
+		if r.raw != "" {
+			block = append(block, r.raw)
+		} else {
+			block = append(block, formatHeaderLine(r))
+		}
+		insertAt = len(block)
+	}
+	block = append(block[:insertAt], append(added, block[insertAt:]...)...)
+
+	out := make([]string, 0, len(lines)-(end-start)+len(block))
+	out = append(out, lines[:start]...)
+	out = append(out, block...)
+	out = append(out, lines[end:]...)
+	return out
+}
+
+func formatHeaderLine(r headerRow) string {
+	if r.value == "" {
+		return r.name + ":"
+	}
+	return r.name + ": " + r.value
+}
+
+func (m Model) renderHeaderEditorModal() string {
+	ed := m.hdrEdit
+	width := minInt(m.width-10, 90)
+	if width < 40 {
+		width = 40
+	}
+	inner := width - 8
+
+	nameWidth := 0
+	for _, r := range ed.rows {
+		nameWidth = max(nameWidth, lipgloss.Width(r.name)+1)
+	}
+	nameWidth = min(nameWidth, inner/2)
+
+	rows := max(m.height-16, 3)
+	start := 0
+	if ed.sel >= rows {
+		start = ed.sel - rows + 1
+	}
+	end := min(start+rows, len(ed.rows))
+
+	var body []string
+	if len(ed.rows) == 0 {
+		body = append(body, m.theme.HeaderValue.Render("No headers yet. Press a to add one."))
+	}
+	for i := start; i < end; i++ {
+		r := ed.rows[i]
+		line := fmt.Sprintf("%-*s %s", nameWidth, r.name+":", r.value)
+		line = truncateToWidth(line, inner)
+		if i == ed.sel {
+			line = m.theme.NavigatorTitleSelected.Render(line)
+		}
+		body = append(body, line)
+	}
+
+	hint := func(key string) string { return m.theme.CommandBarHint.Render(key) }
+	info := fmt.Sprintf(
+		"%s Value  %s Name  %s Add  %s Delete  %s Apply  %s Close",
+		hint("Enter"), hint("n"), hint("a"), hint("d"), hint("Ctrl+S"), hint("Esc"),
+	)
+	switch ed.mode {
+	case hdrEditName:
+		body = append(body, "", "Name: "+ed.input.View())
+		info = fmt.Sprintf("%s Next    %s Cancel", hint("Enter"), hint("Esc"))
+	case hdrEditValue:
+		label := "Value"
+		if ed.sel < len(ed.rows) {
+			label = ed.rows[ed.sel].name
+		}
+		body = append(body, "", label+": "+ed.input.View())
+		info = fmt.Sprintf("%s Apply    %s Cancel", hint("Enter"), hint("Esc"))
+	}
+
+	title := fmt.Sprintf("Headers: %s", ed.title)
+	if ed.dirty {
+		title += " *"
+	}
+	lines := []string{
+		m.theme.HeaderTitle.
+			Width(width - 4).
+			Align(lipgloss.Center).
+			Render(truncateToWidth(title, width-4)),
+		"",
+		lipgloss.NewStyle().
+			Padding(0, 2).
+			Render(lipgloss.JoinVertical(lipgloss.Left, body...)),
+	}
+	if ed.err != "" {
+		lines = append(lines, "", m.theme.Error.Padding(0, 2).Render(ed.err))
+	}
+	lines = append(lines, "", m.theme.HeaderValue.Padding(0, 2).Render(info))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	box := m.theme.BrowserBorder.Width(width).Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("#1A1823")),
+	)
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func headerEditorKeys(t *testing.T, model *Model, keys ...string) {
+	t.Helper()
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "ctrl+s":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlS}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		model.handleHeaderEditorKey(msg)
+	}
+}
+
+func TestHeaderEditorRewritesHeaderBlock(t *testing.T) {
+	src := strings.Join([]string{
+		"### Users",
+		"# @name getUser",
+		"GET https://example.com/users/1",
+		"Accept:   application/json",
+		"# keep me",
+		"Authorization: Bearer {{token}}",
+		"X-Trace: abc",
+		"",
+		"{\"a\": 1}",
+	}, "\n")
+	model := New(Config{InitialContent: src})
+	model.editor.SetValue(src)
+	model.editor.moveCursorTo(2, 0)
+
+	if cmd := model.openHeaderEditor(); cmd != nil {
+		t.Fatalf("expected header editor to open, got %+v", statusFromCmd(t, cmd))
+	}
+	if !model.hdrEdit.on || len(model.hdrEdit.rows) != 3 {
+		t.Fatalf("expected 3 header rows, got %+v", model.hdrEdit.rows)
+	}
+	if got := model.hdrEdit.rows[1].value; got != "Bearer {{token}}" {
+		t.Fatalf("expected raw template value, got %q", got)
+	}
+
+	// Edit Authorization, delete X-Trace, add X-New.
+	model.hdrEdit.sel = 1
+	headerEditorKeys(t, &model, "e")
+	model.hdrEdit.input.SetValue("Bearer xyz")
+	headerEditorKeys(t, &model, "enter", "j", "d", "a")
+	model.hdrEdit.input.SetValue("X-New")
+	headerEditorKeys(t, &model, "enter")
+	model.hdrEdit.input.SetValue("1")
+	headerEditorKeys(t, &model, "enter", "ctrl+s")
+
+	if model.hdrEdit.on {
+		t.Fatalf("expected header editor to close after apply")
+	}
+	want := strings.Join([]string{
+		"### Users",
+		"# @name getUser",
+		"GET https://example.com/users/1",
+		"Accept:   application/json",
+		"# keep me",
+		"Authorization: Bearer xyz",
+		"X-New: 1",
+		"",
+		"{\"a\": 1}",
+	}, "\n")
+	if got := model.editor.Value(); got != want {
+		t.Fatalf("unexpected editor content\nwant:\n%s\n\ngot:\n%s", want, got)
+	}
+	if !model.dirty {
+		t.Fatalf("expected buffer to be marked dirty")
+	}
+}
+
+func TestHeaderEditorAddsAfterRequestLineWithoutHeaders(t *testing.T) {
+	lines := []string{"### A", "GET https://example.com", "", "body"}
+	out := rewriteHeaderBlock(lines, 2, 2, []headerRow{{name: "Accept", value: "*/*", line: -1}})
+	want := []string{"### A", "GET https://example.com", "Accept: */*", "", "body"}
+	if strings.Join(out, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected lines %q", out)
+	}
+}
@@ -29,6 +29,9 @@ type responseLoadingTickMsg struct{}
 
 func (m *Model) handleResponseMessage(msg responseMsg) tea.Cmd {
 	m.recordResponseLatency(msg)
+	// Runs, skips and errors never wait for a webhook callback.
+	hook := msg.webhook
+	defer func() { hook.close() }()
 
 	if state := m.compareRun; state != nil {
 		if state.matches(msg.executed) || (msg.executed == nil && state.current != nil) {
@@ -49,6 +52,11 @@ func (m *Model) handleResponseMessage(msg responseMsg) tea.Cmd {
 	m.lastError = nil
 	m.testResults = msg.tests
 	m.scriptError = msg.scriptErr
+	m.syncBodyFileWatch(msg.executed)
+
+	if failed, ok := responseFailed(msg); ok {
+		m.recordRequestOutcome(msg.docPath, msg.executed, failed)
+	}
 
 	if msg.skipped {
 		m.lastError = nil
@@ -60,11 +68,13 @@ func (m *Model) handleResponseMessage(msg responseMsg) tea.Cmd {
 	}
 
 	if msg.grpc != nil {
-		if msg.err != nil {
+		canceled := grpcCanceled(msg.err, msg.grpc)
+		if msg.err != nil && !canceled {
 			m.lastError = msg.err
 		} else {
 			m.lastError = nil
 		}
+		m.responseSent = m.sentRequestView(msg)
 		cmd := m.consumeGRPCResponse(
 			msg.grpc,
 			msg.tests,
@@ -72,6 +82,10 @@ func (m *Model) handleResponseMessage(msg responseMsg) tea.Cmd {
 			msg.executed,
 			msg.environment,
 		)
+		m.responseSent = ""
+		if canceled {
+			m.setStatusMessage(statusMsg{text: grpcCanceledText(msg.grpc), level: statusWarn})
+		}
 		m.recordGRPCHistory(msg.grpc, msg.executed, msg.requestText, msg.environment)
 		return cmd
 	}
@@ -103,11 +117,37 @@ func (m *Model) handleResponseMessage(msg responseMsg) tea.Cmd {
 		return cmd
 	}
 
+	m.responseSent = m.sentRequestView(msg)
 	cmd := m.consumeHTTPResponse(msg.response, msg.tests, msg.scriptErr, msg.environment)
+	m.responseSent = ""
 	m.recordHTTPHistory(msg.response, msg.executed, msg.requestText, msg.environment)
+	if hook != nil {
+		cmd = batchCmds([]tea.Cmd{cmd, m.awaitWebhook(hook, msg)})
+		hook = nil
+	}
 	return cmd
 }
 
+// grpcCanceled reports whether a gRPC call ended because the user canceled
+// it. The stream keeps whatever messages arrived before that.
+func grpcCanceled(err error, resp *grpcclient.Response) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, context.Canceled) || (resp != nil && resp.StatusCode == codes.Canceled)
+}
+
+func grpcCanceledText(resp *grpcclient.Response) string {
+	if resp == nil || resp.Messages == 0 {
+		return "Request canceled"
+	}
+	noun := "messages"
+	if resp.Messages == 1 {
+		noun = "message"
+	}
+	return fmt.Sprintf("Stream canceled after %d %s", resp.Messages, noun)
+}
+
 func (m *Model) recordResponseLatency(msg responseMsg) {
 	if msg.response != nil {
 		m.addLatency(msg.response.Duration)
@@ -372,10 +412,22 @@ func (m *Model) consumeHTTPResponse(
 		statusLevel = statusWarn
 	}
 
+	if warning := m.insecureTLSWarning(resp); warning != "" {
+		statusText = fmt.Sprintf("%s – %s", statusText, warning)
+		statusLevel = statusWarn
+	}
+	if note := m.envOverrideNote(environment); note != "" {
+		statusText = fmt.Sprintf("%s – %s", statusText, note)
+	}
+
 	m.setStatusMessage(statusMsg{text: statusText, level: statusLevel})
 
 	token := nextResponseRenderToken()
-	snapshot := &responseSnapshot{id: token, environment: environment}
+	snapshot := &responseSnapshot{id: token, environment: environment, sent: m.responseSent}
+	snapshot.headerDiff = m.recordHeaderDiff(resp)
+	if resp != nil && len(resp.Wire) > 0 {
+		snapshot.rawWire = formatWire(resp.Wire, resp.WireTruncated)
+	}
 	m.responseRenderToken = token
 	m.responsePending = snapshot
 	m.responseLatest = snapshot
@@ -512,7 +564,7 @@ func (m *Model) handleResponseRendered(msg responseRenderedMsg) tea.Cmd {
 	snapshot.pretty = msg.pretty
 	snapshot.raw = msg.raw
 	snapshot.rawSummary = msg.rawSummary
-	snapshot.headers = msg.headers
+	snapshot.headers = joinSections(msg.headers, snapshot.headerDiff)
 	snapshot.requestHeaders = msg.requestHeaders
 	snapshot.body = append([]byte(nil), msg.body...)
 	snapshot.bodyMeta = msg.meta
@@ -698,6 +750,9 @@ func (m *Model) consumeGRPCResponse(
 	if resp.StatusMessage != "" {
 		statusLine += " (" + resp.StatusMessage + ")"
 	}
+	if resp.HealthStatus != "" {
+		statusLine += "\nHealth: " + resp.HealthStatus
+	}
 
 	viewBody := append([]byte(nil), resp.Body...)
 	if len(viewBody) == 0 && strings.TrimSpace(resp.Message) != "" {
@@ -737,6 +792,8 @@ func (m *Model) consumeGRPCResponse(
 		rawHex:      bv.rawHex,
 		rawBase64:   bv.rawBase64,
 		rawMode:     bv.mode,
+		protoText:   grpcProtoText(statusLine, resp.Text, bv.pretty),
+		sent:        m.responseSent,
 		responseHeaders: func() http.Header {
 			if len(resp.Headers) == 0 && len(resp.Trailers) == 0 {
 				return nil
@@ -761,6 +818,9 @@ func (m *Model) consumeGRPCResponse(
 		}
 	}
 
+	if note := m.envOverrideNote(environment); note != "" {
+		statusLine = fmt.Sprintf("%s – %s", statusLine, note)
+	}
 	switch {
 	case resp.StatusCode != codes.OK:
 		m.setStatusMessage(statusMsg{text: statusLine, level: statusWarn})
@@ -1836,7 +1896,7 @@ func (m *Model) previewRequest(req *restfile.Request) tea.Cmd {
 	if req == nil {
 		return nil
 	}
-	preview := renderRequestText(req)
+	preview := m.resolvedRequestPreview(m.doc, req)
 	title := strings.TrimSpace(m.statusRequestTitle(m.doc, req, ""))
 	if title == "" {
 		title = requestDisplayName(req)
@@ -8,7 +8,10 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/unkn0wn-root/resterm/internal/grpcclient"
 	"github.com/unkn0wn-root/resterm/internal/history"
 	histdb "github.com/unkn0wn-root/resterm/internal/history/sqlite"
 	"github.com/unkn0wn-root/resterm/internal/httpclient"
@@ -606,3 +609,34 @@ func TestTogglePaneFollowLatestPinsSnapshot(t *testing.T) {
 		t.Fatalf("expected live pane to receive new response")
 	}
 }
+
+func TestGRPCStreamCancelKeepsPartialResponse(t *testing.T) {
+	model := New(Config{})
+	req := &restfile.Request{
+		Method: "GRPC",
+		URL:    "grpc.local:443",
+		GRPC:   &restfile.GRPCRequest{FullMethod: "/pkg.Service/Watch"},
+	}
+	msg := responseMsg{
+		grpc: &grpcclient.Response{
+			StatusCode:    codes.Canceled,
+			StatusMessage: "context canceled",
+			Body:          []byte(`[{"n":1},{"n":2},{"n":3}]`),
+			Messages:      3,
+		},
+		err:      status.Error(codes.Canceled, "context canceled"),
+		executed: req,
+	}
+	if cmd := model.handleResponseMessage(msg); cmd != nil {
+		collectMsgs(cmd)
+	}
+	if model.lastError != nil {
+		t.Fatalf("expected cancel not to be recorded as an error, got %v", model.lastError)
+	}
+	if model.lastGRPC == nil || model.lastGRPC.Messages != 3 {
+		t.Fatalf("expected partial stream response to be kept, got %#v", model.lastGRPC)
+	}
+	if got := model.statusMessage.text; got != "Stream canceled after 3 messages" {
+		t.Fatalf("unexpected status %q", got)
+	}
+}
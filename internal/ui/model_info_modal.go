@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openInfoModal shows a read-only, scrollable text modal.
+func (m *Model) openInfoModal(title, body string) {
+	m.infoModalTitle = title
+	m.infoModalContent = body
+	m.showInfoModal = true
+	m.showHelp = false
+	m.showEnvSelector = false
+	m.showThemeSelector = false
+	if vp := m.infoModalViewport; vp != nil {
+		vp.SetYOffset(0)
+		vp.GotoTop()
+	}
+}
+
+func (m *Model) closeInfoModal() {
+	m.showInfoModal = false
+	m.infoModalTitle = ""
+	m.infoModalContent = ""
+	m.dismissWhatsNew()
+	if vp := m.infoModalViewport; vp != nil {
+		vp.SetYOffset(0)
+		vp.GotoTop()
+	}
+}
+
+func (m *Model) handleInfoModalKey(msg tea.KeyMsg) tea.Cmd {
+	vp := m.infoModalViewport
+	switch msg.String() {
+	case "esc", "enter", "q":
+		m.closeInfoModal()
+	case "ctrl+q", "ctrl+d":
+		return tea.Quit
+	case "down", "j":
+		if vp != nil {
+			vp.ScrollDown(1)
+		}
+	case "up", "k":
+		if vp != nil {
+			vp.ScrollUp(1)
+		}
+	case "pgdown", "ctrl+f":
+		if vp != nil {
+			vp.ScrollDown(vp.Height)
+		}
+	case "pgup", "ctrl+b", "ctrl+u":
+		if vp != nil {
+			vp.ScrollUp(vp.Height)
+		}
+	case "home", "g":
+		if vp != nil {
+			vp.GotoTop()
+		}
+	case "end", "G", "shift+g":
+		if vp != nil {
+			vp.GotoBottom()
+		}
+	}
+	return nil
+}
+
+func (m Model) renderInfoModal() string {
+	width := minInt(m.width-6, 100)
+	if width < 48 {
+		candidate := m.width - 4
+		if candidate > 0 {
+			width = maxInt(36, candidate)
+		} else {
+			width = 48
+		}
+	}
+	contentWidth := maxInt(width-4, 32)
+	title := strings.TrimSpace(m.infoModalTitle)
+	if title == "" {
+		title = "Info"
+	}
+	body := m.infoModalContent
+	if strings.TrimSpace(body) == "" {
+		body = "Nothing to show."
+	}
+	viewWidth := maxInt(contentWidth-4, 20)
+	bodyHeight := maxInt(min(m.height-12, 30), 8)
+	if bodyHeight > m.height-6 {
+		bodyHeight = maxInt(m.height-6, 8)
+	}
+
+	var bodyView string
+	wrapped := wrapPreformattedContent(body, viewWidth)
+	if vp := m.infoModalViewport; vp != nil {
+		vp.SetContent(wrapped)
+		vp.Width = viewWidth
+		vp.Height = bodyHeight
+		bodyView = lipgloss.NewStyle().
+			Padding(0, 2).
+			Width(contentWidth).
+			Render(vp.View())
+	} else {
+		bodyView = lipgloss.NewStyle().
+			Padding(0, 2).
+			Width(contentWidth).
+			Render(wrapped)
+	}
+
+	headerView := m.theme.HeaderTitle.
+		Width(contentWidth).
+		Align(lipgloss.Center).
+		Render(title)
+	instructions := fmt.Sprintf(
+		"%s Scroll  %s / %s Close",
+		m.theme.CommandBarHint.Render("↑/↓"),
+		m.theme.CommandBarHint.Render("Esc"),
+		m.theme.CommandBarHint.Render("Enter"),
+	)
+	instructionsView := m.theme.HeaderValue.
+		Padding(0, 2).
+		Render(instructions)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		headerView,
+		"",
+		bodyView,
+		"",
+		instructionsView,
+	)
+	box := m.theme.BrowserBorder.Width(width).Render(content)
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("#1A1823")),
+	)
+}
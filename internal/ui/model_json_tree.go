@@ -0,0 +1,129 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/ui/scroll"
+)
+
+// snapshotJSONTree parses the snapshot body on first use and keeps the
+// result so expansion and selection survive tab switches.
+// Nodes deeper than maxDepth start collapsed.
+func snapshotJSONTree(snapshot *responseSnapshot, maxDepth int) (*jsonTreeView, error) {
+	if snapshot == nil || !snapshot.ready {
+		return nil, nil
+	}
+	if snapshot.jsonTree == nil && snapshot.jsonTreeErr == nil {
+		snapshot.jsonTree, snapshot.jsonTreeErr = newJSONTreeView(snapshot.body)
+		if snapshot.jsonTree != nil {
+			snapshot.jsonTree.foldBelow(maxDepth)
+		}
+	}
+	return snapshot.jsonTree, snapshot.jsonTreeErr
+}
+
+func (m *Model) snapshotHasJSONTree() bool {
+	hasJSON := func(snapshot *responseSnapshot) bool {
+		return snapshot != nil && snapshot.ready && looksLikeJSONContainer(snapshot.body)
+	}
+	for _, id := range m.visiblePaneIDs() {
+		pane := m.pane(id)
+		if pane != nil && hasJSON(pane.snapshot) {
+			return true
+		}
+	}
+	return hasJSON(m.responseLatest)
+}
+
+func (m *Model) currentJSONTree() (*responseSnapshot, *jsonTreeView) {
+	pane := m.focusedPane()
+	if pane == nil || pane.activeTab != responseTabTree {
+		return nil, nil
+	}
+	view, _ := snapshotJSONTree(pane.snapshot, m.cfg.Settings.JSONMaxDepth)
+	if view == nil {
+		return nil, nil
+	}
+	return pane.snapshot, view
+}
+
+func (m *Model) moveJSONTreeSelection(delta int) tea.Cmd {
+	snapshot, view := m.currentJSONTree()
+	if view == nil || !view.move(delta) {
+		return nil
+	}
+	m.invalidateJSONTreeCaches(snapshot)
+	return m.syncResponsePanes()
+}
+
+func (m *Model) toggleJSONTreeNode() tea.Cmd {
+	snapshot, view := m.currentJSONTree()
+	if view == nil || !view.toggle() {
+		return nil
+	}
+	m.invalidateJSONTreeCaches(snapshot)
+	return m.syncResponsePanes()
+}
+
+func (m *Model) foldAllJSONTree(expand bool) tea.Cmd {
+	snapshot, view := m.currentJSONTree()
+	if view == nil {
+		return nil
+	}
+	if expand {
+		view.expandAll()
+	} else {
+		view.collapseAll()
+	}
+	m.invalidateJSONTreeCaches(snapshot)
+	return m.syncResponsePanes()
+}
+
+func (m *Model) invalidateJSONTreeCaches(snapshot *responseSnapshot) {
+	if snapshot == nil {
+		return
+	}
+	for _, id := range m.visiblePaneIDs() {
+		pane := m.pane(id)
+		if pane == nil || pane.snapshot != snapshot {
+			continue
+		}
+		pane.wrapCache[responseTabTree] = cachedWrap{}
+		pane.search.markStale()
+	}
+}
+
+func (m *Model) syncJSONTreePane(
+	pane *responsePaneState,
+	width int,
+	snapshot *responseSnapshot,
+	view *jsonTreeView,
+) tea.Cmd {
+	render := view.render(width)
+	pane.setCacheForTab(responseTabTree, rawViewText, pane.headersView, cachedWrap{
+		width:   width,
+		content: render.content,
+		valid:   true,
+	})
+	decorated := m.decorateResponseContentForPane(
+		pane,
+		responseTabTree,
+		render.content,
+		width,
+		snapshot.ready,
+		snapshot.id,
+	)
+	pane.viewport.SetContent(decorated)
+	pane.restoreScrollForActiveTab()
+	if render.selected >= 0 {
+		pane.viewport.SetYOffset(scroll.Align(
+			render.selected,
+			pane.viewport.YOffset,
+			pane.viewport.Height,
+			render.lines,
+		))
+	}
+	ensureResponseMatchInView(pane, render.content)
+	pane.setCurrPosition()
+	return nil
+}
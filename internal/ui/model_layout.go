@@ -418,6 +418,16 @@ func (m *Model) applyLayout() tea.Cmd {
 		}
 		m.envList.SetSize(envWidth, envHeight)
 	}
+	recentWidth := minInt(64, m.width-6)
+	if recentWidth < 28 {
+		recentWidth = 28
+	}
+	recentHeight := minInt(paneHeight-4, 2*maxRecentRequests)
+	if recentHeight < 6 {
+		recentHeight = 6
+	}
+	m.recentList.SetSize(recentWidth, recentHeight)
+	m.gotoList.SetSize(recentWidth, recentHeight)
 	if len(m.themeList.Items()) > 0 {
 		themeWidth := minInt(48, m.width-6)
 		if themeWidth < 24 {
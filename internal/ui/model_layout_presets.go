@@ -0,0 +1,274 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+)
+
+// layoutPresetPicker lists the named layouts from settings. Enter applies
+// the selected preset, s saves the current layout under a name and d
+// deletes the selection.
+type layoutPresetPicker struct {
+	on     bool
+	names  []string
+	sel    int
+	naming bool
+	input  textinput.Model
+	err    string
+}
+
+func (m *Model) openLayoutPresets() tea.Cmd {
+	m.showHelp = false
+	m.presets = layoutPresetPicker{on: true, names: m.layoutPresetNames()}
+	if len(m.presets.names) == 0 {
+		return m.startLayoutPresetNaming()
+	}
+	return nil
+}
+
+func (m *Model) closeLayoutPresets() {
+	m.presets.input.Blur()
+	m.presets = layoutPresetPicker{}
+}
+
+func (m *Model) layoutPresetNames() []string {
+	names := make([]string, 0, len(m.cfg.Settings.LayoutPresets))
+	for name := range m.cfg.Settings.LayoutPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (m *Model) startLayoutPresetNaming() tea.Cmd {
+	p := &m.presets
+	input := textinput.New()
+	input.Prompt = ""
+	input.CharLimit = 40
+	if p.sel < len(p.names) {
+		input.SetValue(p.names[p.sel])
+		input.CursorEnd()
+	}
+	p.input = input
+	p.naming = true
+	p.err = ""
+	return p.input.Focus()
+}
+
+func (m *Model) handleLayoutPresetKey(msg tea.KeyMsg) tea.Cmd {
+	p := &m.presets
+	if p.naming {
+		switch msg.String() {
+		case "esc":
+			if len(p.names) == 0 {
+				m.closeLayoutPresets()
+				return nil
+			}
+			p.input.Blur()
+			p.naming = false
+			p.err = ""
+			return nil
+		case "enter":
+			return m.saveLayoutPreset(strings.TrimSpace(p.input.Value()))
+		}
+		var cmd tea.Cmd
+		p.input, cmd = p.input.Update(msg)
+		p.err = ""
+		return cmd
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		m.closeLayoutPresets()
+	case "up", "k":
+		if p.sel > 0 {
+			p.sel--
+		}
+	case "down", "j":
+		if p.sel < len(p.names)-1 {
+			p.sel++
+		}
+	case "s":
+		return m.startLayoutPresetNaming()
+	case "d":
+		return m.deleteLayoutPreset()
+	case "enter":
+		if p.sel >= len(p.names) {
+			return nil
+		}
+		name := p.names[p.sel]
+		m.closeLayoutPresets()
+		return m.applyLayoutPreset(name, m.cfg.Settings.LayoutPresets[name])
+	}
+	return nil
+}
+
+func (m *Model) currentLayoutPreset() config.LayoutPreset {
+	return config.LayoutPreset{
+		Layout:            m.currentLayoutSettings(),
+		SidebarCollapsed:  m.sidebarCollapsed,
+		EditorCollapsed:   m.editorCollapsed,
+		ResponseCollapsed: m.responseCollapsed,
+	}
+}
+
+// applyLayoutPreset switches to preset right away. Zoom is cleared so the
+// stored collapse states are what the user sees.
+func (m *Model) applyLayoutPreset(name string, preset config.LayoutPreset) tea.Cmd {
+	layout := config.NormaliseLayoutSettings(preset.Layout)
+	orientation := responseSplitOrientationFor(layout.ResponseOrientation)
+	switch {
+	case layout.ResponseSplit:
+		_ = m.enableResponseSplit(orientation)
+	case m.responseSplit:
+		_ = m.disableResponseSplit()
+	}
+	m.applyLayoutSettingsFromConfig(layout)
+
+	m.clearZoom()
+	m.sidebarCollapsed = false
+	m.editorCollapsed = false
+	m.responseCollapsed = false
+	m.setCollapseState(paneRegionSidebar, preset.SidebarCollapsed)
+	m.setCollapseState(paneRegionEditor, preset.EditorCollapsed)
+	m.setCollapseState(paneRegionResponse, preset.ResponseCollapsed)
+	if m.collapseState(regionFromFocus(m.focus)) {
+		if m.editorCollapsed {
+			m.setFocus(focusResponse)
+		} else {
+			m.setFocus(focusEditor)
+		}
+	}
+
+	return batchCommands(
+		m.applyLayout(),
+		statusCmd(statusSuccess, fmt.Sprintf("Layout preset %q applied", name)),
+	)
+}
+
+func (m *Model) saveLayoutPreset(name string) tea.Cmd {
+	p := &m.presets
+	if name == "" {
+		p.err = "Name is required"
+		return nil
+	}
+	presets := make(map[string]config.LayoutPreset, len(m.cfg.Settings.LayoutPresets)+1)
+	for k, v := range m.cfg.Settings.LayoutPresets {
+		presets[k] = v
+	}
+	presets[name] = m.currentLayoutPreset()
+	if err := m.storeLayoutPresets(presets); err != nil {
+		p.err = err.Error()
+		return nil
+	}
+	m.closeLayoutPresets()
+	return statusCmd(statusSuccess, fmt.Sprintf("Layout preset %q saved", name))
+}
+
+func (m *Model) deleteLayoutPreset() tea.Cmd {
+	p := &m.presets
+	if p.sel >= len(p.names) {
+		return nil
+	}
+	name := p.names[p.sel]
+	presets := make(map[string]config.LayoutPreset, len(m.cfg.Settings.LayoutPresets))
+	for k, v := range m.cfg.Settings.LayoutPresets {
+		if k != name {
+			presets[k] = v
+		}
+	}
+	if err := m.storeLayoutPresets(presets); err != nil {
+		p.err = err.Error()
+		return nil
+	}
+	p.names = m.layoutPresetNames()
+	p.sel = min(p.sel, max(len(p.names)-1, 0))
+	return statusCmd(statusInfo, fmt.Sprintf("Layout preset %q deleted", name))
+}
+
+// storeLayoutPresets writes presets to the settings file and only keeps
+// them in memory once that succeeded.
+func (m *Model) storeLayoutPresets(presets map[string]config.LayoutPreset) error {
+	settings := m.cfg.Settings
+	settings.LayoutPresets = presets
+	if err := config.SaveSettings(settings, m.settingsHandle); err != nil {
+		return fmt.Errorf("layout preset save error: %w", err)
+	}
+	m.cfg.Settings.LayoutPresets = config.NormaliseLayoutPresets(presets)
+	return nil
+}
+
+func (m Model) renderLayoutPresetsModal() string {
+	p := m.presets
+	width := max(minInt(m.width-10, 60), 40)
+	inner := width - 8
+	pad := lipgloss.NewStyle().Padding(0, 2)
+	hint := func(key string) string { return m.theme.CommandBarHint.Render(key) }
+
+	lines := []string{
+		m.theme.HeaderTitle.Width(width - 4).Align(lipgloss.Center).Render("Layout Presets"),
+		"",
+	}
+	for i, name := range p.names {
+		label := " " + truncateToWidth(name, inner/2) + " "
+		if i == p.sel && !p.naming {
+			label = m.theme.NavigatorTitleSelected.Render(label)
+		}
+		summary := layoutPresetSummary(m.cfg.Settings.LayoutPresets[name])
+		lines = append(lines, pad.Render(label+"  "+m.theme.HeaderValue.Render(summary)))
+	}
+	if len(p.names) == 0 {
+		lines = append(lines, m.theme.HeaderValue.Padding(0, 2).Render("No presets saved yet"))
+	}
+
+	var info string
+	if p.naming {
+		lines = append(lines, "", pad.Render("Save current layout as: "+p.input.View()))
+		info = fmt.Sprintf("%s Save    %s Back", hint("Enter"), hint("Esc"))
+	} else {
+		info = fmt.Sprintf(
+			"%s Apply    %s Save current    %s Delete    %s Close",
+			hint("Enter"), hint("s"), hint("d"), hint("Esc"),
+		)
+	}
+	if p.err != "" {
+		lines = append(lines, "", m.theme.Error.Padding(0, 2).Render(truncateToWidth(p.err, inner)))
+	}
+	lines = append(lines, "", m.theme.HeaderValue.Padding(0, 2).Render(info))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	box := m.theme.BrowserBorder.Width(width).Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("#1A1823")),
+	)
+}
+
+// layoutPresetSummary is the short description shown next to a preset name.
+func layoutPresetSummary(p config.LayoutPreset) string {
+	parts := []string{string(p.Layout.MainSplit)}
+	if p.Layout.ResponseSplit {
+		parts = append(parts, "split "+string(p.Layout.ResponseOrientation))
+	}
+	var hidden []string
+	if p.SidebarCollapsed {
+		hidden = append(hidden, "sidebar")
+	}
+	if p.EditorCollapsed {
+		hidden = append(hidden, "editor")
+	}
+	if p.ResponseCollapsed {
+		hidden = append(hidden, "response")
+	}
+	if len(hidden) > 0 {
+		parts = append(parts, "hides "+strings.Join(hidden, ", "))
+	}
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+)
+
+func TestLayoutPresetsSaveAndApply(t *testing.T) {
+	dir := t.TempDir()
+	handle := config.SettingsHandle{
+		Path:   filepath.Join(dir, "settings.toml"),
+		Format: config.SettingsFormatTOML,
+	}
+	base := New(Config{WorkspaceRoot: dir, SettingsHandle: handle})
+	model := &base
+	model.ready = true
+	model.width = 120
+	model.height = 40
+	_ = model.applyLayout()
+	press := func(key string) {
+		t.Helper()
+		updated, _ := model.Update(keyMsgFor(key))
+		next := updated.(Model)
+		model = &next
+	}
+
+	model.sidebarWidth = 0.1
+	model.editorSplit = 0.4
+	model.mainSplitOrientation = mainSplitHorizontal
+	model.sidebarCollapsed = true
+	model.openLayoutPresets()
+	if !model.presets.on || !model.presets.naming {
+		t.Fatalf("expected picker to open in naming mode without presets")
+	}
+	model.presets.input.SetValue("review")
+	press("enter")
+	if model.presets.on {
+		t.Fatalf("expected picker closed after save, err %q", model.presets.err)
+	}
+
+	_ = model.toggleResponseSplitVertical()
+	model.sidebarWidth = 0.3
+	model.editorSplit = 0.6
+	model.mainSplitOrientation = mainSplitVertical
+	model.sidebarCollapsed = false
+
+	t.Setenv("RESTERM_CONFIG_DIR", dir)
+	loaded, _, err := config.LoadSettings()
+	if err != nil {
+		t.Fatalf("load settings: %v", err)
+	}
+	preset, ok := loaded.LayoutPresets["review"]
+	if !ok || !preset.SidebarCollapsed || preset.Layout.EditorSplit != 0.4 {
+		t.Fatalf("expected preset persisted, got %+v", loaded.LayoutPresets)
+	}
+
+	model.openLayoutPresets()
+	press("enter")
+	if model.presets.on {
+		t.Fatalf("expected picker closed after apply")
+	}
+	if model.sidebarWidth != 0.1 || model.editorSplit != 0.4 {
+		t.Fatalf("expected sizes restored, got %v/%v", model.sidebarWidth, model.editorSplit)
+	}
+	if model.mainSplitOrientation != mainSplitHorizontal || model.responseSplit {
+		t.Fatalf("expected horizontal main split without response split")
+	}
+	if !model.sidebarCollapsed {
+		t.Fatalf("expected sidebar collapsed by preset")
+	}
+
+	model.openLayoutPresets()
+	press("d")
+	if len(model.cfg.Settings.LayoutPresets) != 0 || len(model.presets.names) != 0 {
+		t.Fatalf("expected preset deleted, got %+v", model.cfg.Settings.LayoutPresets)
+	}
+}
@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -43,6 +45,10 @@ func navigatorRequestID(path string, idx int) string {
 	return fmt.Sprintf("req:%s:%d", path, idx)
 }
 
+func navigatorGroupID(path, name string) string {
+	return fmt.Sprintf("group:%s:%s", path, name)
+}
+
 func (m *Model) buildNavTree(entries []filesvc.FileEntry) []*navigator.Node[any] {
 	if len(entries) == 0 {
 		return nil
@@ -119,6 +125,7 @@ func (m *Model) buildRequestNodes(doc *restfile.Document, filePath string) []*na
 	}
 
 	nodes := make([]*navigator.Node[any], 0, len(doc.Requests)+len(doc.Workflows))
+	groups := make(map[string]*navigator.Node[any])
 	for idx, req := range doc.Requests {
 		resolver := m.statusResolver(doc, req, m.cfg.EnvironmentName)
 		title := requestNavLabel(req, resolver, fmt.Sprintf("Request %d", idx+1))
@@ -131,7 +138,7 @@ func (m *Model) buildRequestNodes(doc *restfile.Document, filePath string) []*na
 		}
 
 		badges := requestBadges(req)
-		nodes = append(nodes, &navigator.Node[any]{
+		node := &navigator.Node[any]{
 			ID:      navigatorRequestID(filePath, idx),
 			Title:   title,
 			Desc:    desc,
@@ -141,8 +148,28 @@ func (m *Model) buildRequestNodes(doc *restfile.Document, filePath string) []*na
 			Target:  target,
 			Badges:  badges,
 			HasName: hasName,
+			Failed:  m.navFailed[navRunKey(filePath, req)],
 			Payload: navigator.Payload[any]{FilePath: filePath, Data: req},
-		})
+		}
+		name := strings.TrimSpace(req.Metadata.Group)
+		if name == "" {
+			nodes = append(nodes, node)
+			continue
+		}
+		group, ok := groups[name]
+		if !ok {
+			group = &navigator.Node[any]{
+				ID:       navigatorGroupID(filePath, name),
+				Title:    name,
+				Kind:     navigator.KindGroup,
+				Expanded: true,
+				Payload:  navigator.Payload[any]{FilePath: filePath, Data: name},
+			}
+			groups[name] = group
+			nodes = append(nodes, group)
+		}
+		group.Children = append(group.Children, node)
+		group.Count++
 	}
 	for idx := range doc.Workflows {
 		wf := &doc.Workflows[idx]
@@ -257,7 +284,7 @@ func (m *Model) expandNavigatorFile(path string) {
 	m.navigator.ReplaceChildren("file:"+path, children)
 	node := m.navigator.Find("file:" + path)
 	if node != nil && len(children) > 0 {
-		node.Count = len(children)
+		node.Count = len(doc.Requests)
 		node.Expanded = true
 	}
 }
@@ -302,7 +329,7 @@ func (m *Model) ensureNavigatorDataForFilter() {
 
 	filter := strings.TrimSpace(m.navigatorFilter.Value())
 	need := filter != "" || len(m.navigator.MethodFilters()) > 0 ||
-		len(m.navigator.TagFilters()) > 0
+		len(m.navigator.TagFilters()) > 0 || m.navigator.FailedFilter()
 	if !need {
 		return
 	}
@@ -358,6 +385,88 @@ func requestBadges(req *restfile.Request) []string {
 	return b
 }
 
+func navRunKey(path string, req *restfile.Request) string {
+	key := requestKey(req)
+	if key == "" {
+		return ""
+	}
+	return filepath.Clean(path) + "|" + key
+}
+
+// responseFailed reports whether a response should mark its request as failing.
+// The second value is false when the run says nothing about pass/fail state.
+func responseFailed(msg responseMsg) (bool, bool) {
+	if msg.skipped {
+		return false, false
+	}
+	if msg.err != nil {
+		if errors.Is(msg.err, context.Canceled) {
+			return false, false
+		}
+		return true, true
+	}
+	if msg.scriptErr != nil {
+		return true, true
+	}
+	for _, test := range msg.tests {
+		if !test.Passed {
+			return true, true
+		}
+	}
+	return false, true
+}
+
+func (m *Model) recordRequestOutcome(path string, req *restfile.Request, failed bool) {
+	key := navRunKey(path, req)
+	if path == "" || key == "" {
+		return
+	}
+	if failed {
+		if m.navFailed == nil {
+			m.navFailed = make(map[string]bool)
+		}
+		m.navFailed[key] = true
+	} else {
+		delete(m.navFailed, key)
+	}
+	m.markNavigatorFailed(path, key, failed)
+}
+
+func (m *Model) markNavigatorFailed(path, key string, failed bool) {
+	if m.navigator == nil {
+		return
+	}
+	file := m.navigator.Find("file:" + path)
+	if file == nil {
+		return
+	}
+	changed := false
+	for _, n := range navRequestNodes(file) {
+		req, ok := n.Payload.Data.(*restfile.Request)
+		if !ok || navRunKey(path, req) != key || n.Failed == failed {
+			continue
+		}
+		n.Failed = failed
+		changed = true
+	}
+	if changed {
+		m.navigator.Refresh()
+	}
+}
+
+// clearRequestOutcomes drops last-run state for a file, e.g. after a reparse.
+func (m *Model) clearRequestOutcomes(path string) {
+	if len(m.navFailed) == 0 || path == "" {
+		return
+	}
+	prefix := filepath.Clean(path) + "|"
+	for key := range m.navFailed {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.navFailed, key)
+		}
+	}
+}
+
 func (m *Model) syncNavigatorSelection() {
 	if m.navigator == nil {
 		return
@@ -426,6 +535,12 @@ func (m *Model) syncNavigatorSelection() {
 	case navigator.KindDir:
 		m.setActiveRequest(nil)
 		m.requestList.Select(-1)
+	case navigator.KindGroup:
+		if path != "" {
+			_ = m.selectFileByPath(path)
+		}
+		m.setActiveRequest(nil)
+		m.requestList.Select(-1)
 	default:
 		m.setActiveRequest(nil)
 		m.requestList.Select(-1)
@@ -437,7 +552,7 @@ func (m *Model) syncNavigatorFocus(n *navigator.Node[any]) {
 		return
 	}
 	switch n.Kind {
-	case navigator.KindRequest:
+	case navigator.KindRequest, navigator.KindGroup:
 		_ = m.setFocus(focusRequests)
 	case navigator.KindWorkflow:
 		_ = m.setFocus(focusWorkflows)
@@ -510,6 +625,7 @@ func (m *Model) syncNavigatorWithEditorCursor() {
 	}
 
 	m.ensureNavigatorRequestsForFile(m.currentFile)
+	m.revealNavigatorRequest(m.currentFile, targetID)
 	if !m.navigator.SelectByID(targetID) {
 		m.lastCursorLine = line
 		m.lastCursorFile = m.currentFile
@@ -534,8 +650,13 @@ func applyNavigatorExpansion(nodes []*navigator.Node[any], prev *navigator.Model
 		if n == nil {
 			return
 		}
-		if old := prev.Find(n.ID); old != nil && old.Expanded && navHasKids(n) {
-			n.Expanded = true
+		if old := prev.Find(n.ID); old != nil && navHasKids(n) {
+			// Groups start expanded, so only they can carry a collapse over.
+			if old.Expanded {
+				n.Expanded = true
+			} else if n.Kind == navigator.KindGroup {
+				n.Expanded = false
+			}
 		}
 		for _, c := range n.Children {
 			walk(c)
@@ -546,6 +667,46 @@ func applyNavigatorExpansion(nodes []*navigator.Node[any], prev *navigator.Model
 	}
 }
 
+// navRequestNodes returns the request nodes below a file node, including
+// the ones nested in request groups.
+func navRequestNodes(file *navigator.Node[any]) []*navigator.Node[any] {
+	if file == nil {
+		return nil
+	}
+	var out []*navigator.Node[any]
+	for _, n := range file.Children {
+		switch {
+		case n == nil:
+		case n.Kind == navigator.KindRequest:
+			out = append(out, n)
+		case n.Kind == navigator.KindGroup:
+			out = append(out, navRequestNodes(n)...)
+		}
+	}
+	return out
+}
+
+// revealNavigatorRequest expands the collapsed group holding the request
+// node id so the editor cursor can select it.
+func (m *Model) revealNavigatorRequest(path, id string) {
+	file := m.navigator.Find("file:" + path)
+	if file == nil {
+		return
+	}
+	for _, n := range file.Children {
+		if n == nil || n.Kind != navigator.KindGroup || n.Expanded {
+			continue
+		}
+		for _, c := range n.Children {
+			if c != nil && c.ID == id {
+				n.Expanded = true
+				m.navigator.Refresh()
+				return
+			}
+		}
+	}
+}
+
 func navHasKids(n *navigator.Node[any]) bool {
 	return n != nil && len(n.Children) > 0
 }
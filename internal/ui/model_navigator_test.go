@@ -8,6 +8,7 @@ import (
 
 	"github.com/unkn0wn-root/resterm/internal/parser"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/scripts"
 	"github.com/unkn0wn-root/resterm/internal/ui/navigator"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -897,3 +898,55 @@ func TestNavigatorRequestLJumpsToDefinition(t *testing.T) {
 		t.Fatalf("expected cursor to jump to line %d, got %d", req.LineRange.Start, got)
 	}
 }
+
+func TestNavigatorMarksFailedRequests(t *testing.T) {
+	tmp := t.TempDir()
+	file := filepath.Join(tmp, "sample.http")
+	content := "### first\n# @name first\nGET https://example.com/one\n\n" +
+		"### second\n# @name second\nGET https://example.com/two\n"
+	writeSampleFile(t, file, content)
+
+	model := New(Config{WorkspaceRoot: tmp, FilePath: file, InitialContent: content})
+	m := &model
+	req := m.doc.Requests[1]
+	id := navigatorRequestID(file, 1)
+
+	m.handleResponseMessage(responseMsg{
+		executed: req,
+		docPath:  filepath.Join(tmp, "other.http"),
+		tests:    []scripts.TestResult{{Name: "status", Passed: false}},
+	})
+	if n := m.navigator.Find(id); n == nil || n.Failed {
+		t.Fatalf("expected a run from another file to leave this file unmarked")
+	}
+
+	m.handleResponseMessage(responseMsg{
+		executed: req,
+		docPath:  file,
+		tests:    []scripts.TestResult{{Name: "status", Passed: false}},
+	})
+	if n := m.navigator.Find(id); n == nil || !n.Failed {
+		t.Fatalf("expected request to be marked failed, got %#v", n)
+	}
+
+	m.navigator.ToggleFailedFilter()
+	if navigatorIndex(m, id) < 0 || navigatorIndex(m, navigatorRequestID(file, 0)) >= 0 {
+		t.Fatalf("expected failing filter to show only the failed request")
+	}
+	m.navigator.ClearFailedFilter()
+
+	m.handleResponseMessage(responseMsg{
+		executed: req,
+		docPath:  file,
+		tests:    []scripts.TestResult{{Name: "status", Passed: true}},
+	})
+	if n := m.navigator.Find(id); n == nil || n.Failed {
+		t.Fatalf("expected successful run to clear failed marker")
+	}
+
+	m.recordRequestOutcome(file, req, true)
+	_ = m.reparseDocument()
+	if n := m.navigator.Find(id); n == nil || n.Failed {
+		t.Fatalf("expected reparse to clear failed marker")
+	}
+}
@@ -15,7 +15,8 @@ func (m *Model) openOpenModal() {
 	m.showOpenModal = true
 	m.openPathError = ""
 	m.openPathInput.SetValue("")
-	m.openPathInput.Focus()
+	m.openPathInput.Blur()
+	m.initOpenBrowser()
 	m.showHelp = false
 	m.showEnvSelector = false
 	m.showThemeSelector = false
@@ -27,6 +28,9 @@ func (m *Model) closeOpenModal() {
 	m.openPathError = ""
 	m.openPathInput.Blur()
 	m.openPathInput.SetValue("")
+	m.openBrowser = nil
+	m.openBrowserRoot = ""
+	m.openBrowserFocus = false
 }
 
 func (m *Model) submitOpenPath() tea.Cmd {
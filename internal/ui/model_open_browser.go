@@ -0,0 +1,259 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+	"github.com/unkn0wn-root/resterm/internal/filesvc"
+	"github.com/unkn0wn-root/resterm/internal/ui/navigator"
+)
+
+const (
+	openBrowserHeight = 12
+	openBrowserParent = ".."
+)
+
+// initOpenBrowser roots the file browser at the last browsed directory,
+// falling back to the workspace and then the working directory.
+func (m *Model) initOpenBrowser() {
+	root := ""
+	for _, dir := range []string{m.cfg.Settings.LastBrowseDir, m.workspaceRoot} {
+		if dir == "" {
+			continue
+		}
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			root = dir
+			break
+		}
+	}
+	if root == "" {
+		if wd, err := os.Getwd(); err == nil {
+			root = wd
+		}
+	}
+	if abs, err := filepath.Abs(root); err == nil {
+		root = abs
+	}
+	m.setOpenBrowserRoot(root, "")
+	m.openBrowserFocus = true
+}
+
+func (m *Model) setOpenBrowserRoot(root, selectID string) {
+	m.openBrowserRoot = root
+	nodes := []*navigator.Node[any]{{
+		ID:      openBrowserParent,
+		Title:   openBrowserParent,
+		Kind:    navigator.KindDir,
+		Payload: navigator.Payload[any]{FilePath: filepath.Dir(root)},
+	}}
+	children, err := browseDirNodes(root)
+	if err != nil {
+		m.openPathError = fmt.Sprintf("read dir: %v", err)
+	}
+	nodes = append(nodes, children...)
+	if m.openBrowser == nil {
+		m.openBrowser = navigator.New(nodes)
+	} else {
+		m.openBrowser.SetNodes(nodes)
+	}
+	if selectID == "" || !m.openBrowser.SelectByID(selectID) {
+		m.openBrowser.SelectFirst()
+		if len(children) > 0 {
+			m.openBrowser.Move(1)
+		}
+	}
+}
+
+// browseDirNodes lists visible subdirectories followed by request files.
+// Children of directories are loaded lazily when they are expanded.
+func browseDirNodes(dir string) ([]*navigator.Node[any], error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var dirs, files []*navigator.Node[any]
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		node := &navigator.Node[any]{
+			ID:      path,
+			Title:   name,
+			Payload: navigator.Payload[any]{FilePath: path},
+		}
+		switch {
+		case entry.IsDir():
+			node.Kind = navigator.KindDir
+			dirs = append(dirs, node)
+		case filesvc.IsRequestFile(name):
+			node.Kind = navigator.KindFile
+			files = append(files, node)
+		}
+	}
+	byTitle := func(nodes []*navigator.Node[any]) {
+		sort.Slice(nodes, func(i, j int) bool {
+			return strings.ToLower(nodes[i].Title) < strings.ToLower(nodes[j].Title)
+		})
+	}
+	byTitle(dirs)
+	byTitle(files)
+	return append(dirs, files...), nil
+}
+
+func (m *Model) toggleOpenBrowserFocus() {
+	m.openBrowserFocus = !m.openBrowserFocus
+	if m.openBrowserFocus {
+		m.openPathInput.Blur()
+		return
+	}
+	if n := m.openBrowserSelected(); n != nil && m.openPathInput.Value() == "" {
+		m.openPathInput.SetValue(n.Payload.FilePath)
+		m.openPathInput.CursorEnd()
+	}
+	m.openPathInput.Focus()
+}
+
+func (m *Model) openBrowserSelected() *navigator.Node[any] {
+	if m.openBrowser == nil {
+		return nil
+	}
+	return m.openBrowser.Selected()
+}
+
+func (m *Model) handleOpenBrowserKey(msg tea.KeyMsg) tea.Cmd {
+	if m.openBrowser == nil {
+		return nil
+	}
+	m.openPathError = ""
+	switch msg.String() {
+	case "up", "k":
+		m.openBrowser.Move(-1)
+	case "down", "j":
+		m.openBrowser.Move(1)
+	case "pgup":
+		m.openBrowser.Move(-openBrowserHeight)
+	case "pgdown":
+		m.openBrowser.Move(openBrowserHeight)
+	case "home", "g":
+		m.openBrowser.SelectFirst()
+	case "end", "G", "shift+g":
+		m.openBrowser.SelectLast()
+	case "right", "l":
+		if n := m.openBrowserSelected(); n != nil && n.Kind == navigator.KindDir {
+			m.expandBrowseDir(n, true)
+		}
+	case "left", "h":
+		m.collapseBrowseSelection()
+	case "backspace":
+		m.browseUp()
+	case "enter":
+		return m.activateBrowseSelection()
+	}
+	return nil
+}
+
+func (m *Model) activateBrowseSelection() tea.Cmd {
+	n := m.openBrowserSelected()
+	if n == nil {
+		return nil
+	}
+	switch {
+	case n.ID == openBrowserParent:
+		m.browseUp()
+	case n.Kind == navigator.KindDir:
+		m.expandBrowseDir(n, !n.Expanded)
+	default:
+		m.rememberBrowseDir(filepath.Dir(n.Payload.FilePath))
+		return m.applyOpenFilePath(n.Payload.FilePath)
+	}
+	return nil
+}
+
+func (m *Model) expandBrowseDir(n *navigator.Node[any], expand bool) {
+	if n.ID == openBrowserParent {
+		if expand {
+			m.browseUp()
+		}
+		return
+	}
+	if !expand {
+		n.Expanded = false
+		m.openBrowser.Refresh()
+		return
+	}
+	children, err := browseDirNodes(n.Payload.FilePath)
+	if err != nil {
+		m.openPathError = fmt.Sprintf("read dir: %v", err)
+		return
+	}
+	if len(children) == 0 {
+		m.openPathError = fmt.Sprintf("%s has no request files", n.Title)
+		return
+	}
+	m.openBrowser.ReplaceChildren(n.ID, children)
+	n.Expanded = true
+	m.openBrowser.Refresh()
+}
+
+// collapseBrowseSelection closes the selected directory, or jumps to the
+// enclosing directory row when the selection is already collapsed.
+func (m *Model) collapseBrowseSelection() {
+	n := m.openBrowserSelected()
+	if n == nil {
+		return
+	}
+	if n.Kind == navigator.KindDir && n.Expanded {
+		m.expandBrowseDir(n, false)
+		return
+	}
+	parent := filepath.Dir(n.Payload.FilePath)
+	if n.ID == openBrowserParent || parent == m.openBrowserRoot {
+		m.browseUp()
+		return
+	}
+	m.openBrowser.SelectByID(parent)
+}
+
+func (m *Model) browseUp() {
+	parent := filepath.Dir(m.openBrowserRoot)
+	if parent == m.openBrowserRoot {
+		return
+	}
+	m.setOpenBrowserRoot(parent, m.openBrowserRoot)
+}
+
+// rememberBrowseDir persists the directory a file was picked from so the
+// browser starts there next time.
+func (m *Model) rememberBrowseDir(dir string) {
+	if dir == "" || dir == m.cfg.Settings.LastBrowseDir {
+		return
+	}
+	m.cfg.Settings.LastBrowseDir = dir
+	if err := config.SaveSettings(m.cfg.Settings, m.settingsHandle); err != nil {
+		m.setStatusMessage(statusMsg{
+			text:  fmt.Sprintf("settings save error: %v", err),
+			level: statusWarn,
+		})
+	}
+}
+
+func (m Model) renderOpenBrowser(width int) string {
+	if m.openBrowser == nil {
+		return ""
+	}
+	rootLabel := m.theme.HeaderValue.Render(truncateToWidth(m.openBrowserRoot, width))
+	list := navigator.ListView(m.openBrowser, m.theme, width, openBrowserHeight, m.openBrowserFocus)
+	if strings.TrimSpace(list) == "" {
+		list = m.theme.NavigatorSubtitle.Render("No request files here")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rootLabel, list)
+}
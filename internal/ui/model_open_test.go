@@ -3,8 +3,12 @@ package ui
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
 	"github.com/unkn0wn-root/resterm/internal/theme"
 )
 
@@ -89,3 +93,96 @@ func TestSubmitOpenPathRejectsInvalidFile(t *testing.T) {
 		t.Fatalf("modal should remain open on error")
 	}
 }
+
+func TestOpenBrowserListsRequestFilesAndDirs(t *testing.T) {
+	tmp := t.TempDir()
+	for _, dir := range []string{"api", ".git"} {
+		if err := os.MkdirAll(filepath.Join(tmp, dir), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	names := []string{"b.http", "a.rest", "notes.txt", filepath.Join("api", "users.http")}
+	for _, name := range names {
+		path := filepath.Join(tmp, name)
+		if err := os.WriteFile(path, []byte("GET https://example.com"), 0o644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+	}
+
+	th := theme.DefaultTheme()
+	model := New(Config{WorkspaceRoot: tmp, Theme: &th})
+	m := &model
+	m.openOpenModal()
+	if m.openBrowser == nil || !m.openBrowserFocus {
+		t.Fatalf("expected file browser to be focused")
+	}
+
+	var titles []string
+	for _, row := range m.openBrowser.Rows() {
+		titles = append(titles, row.Node.Title)
+	}
+	want := []string{"..", "api", "a.rest", "b.http"}
+	if strings.Join(titles, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected rows %v, got %v", want, titles)
+	}
+	if sel := m.openBrowserSelected(); sel == nil || sel.Title != "api" {
+		t.Fatalf("expected first entry to be selected, got %+v", sel)
+	}
+
+	m.handleOpenBrowserKey(tea.KeyMsg{Type: tea.KeyRight})
+	m.handleOpenBrowserKey(tea.KeyMsg{Type: tea.KeyDown})
+	sel := m.openBrowserSelected()
+	if sel == nil || sel.Title != "users.http" {
+		t.Fatalf("expected expanded child to be selectable, got %+v", sel)
+	}
+
+	m.handleOpenBrowserKey(tea.KeyMsg{Type: tea.KeyLeft})
+	if sel := m.openBrowserSelected(); sel == nil || sel.Title != "api" {
+		t.Fatalf("expected left to jump to parent dir, got %+v", sel)
+	}
+}
+
+func TestOpenBrowserOpensFileAndRemembersDir(t *testing.T) {
+	t.Setenv("RESTERM_CONFIG_DIR", t.TempDir())
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, "api")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	file := filepath.Join(dir, "users.http")
+	if err := os.WriteFile(file, []byte("GET https://example.com"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	th := theme.DefaultTheme()
+	model := New(Config{WorkspaceRoot: tmp, Theme: &th})
+	m := &model
+	m.openOpenModal()
+	m.handleOpenBrowserKey(tea.KeyMsg{Type: tea.KeyEnter})
+	m.handleOpenBrowserKey(tea.KeyMsg{Type: tea.KeyDown})
+	if cmd := m.activateBrowseSelection(); cmd != nil {
+		cmd()
+	}
+
+	if m.showOpenModal {
+		t.Fatalf("expected modal to close after opening a file")
+	}
+	if m.currentFile != file {
+		t.Fatalf("expected current file %q, got %q", file, m.currentFile)
+	}
+	if m.cfg.Settings.LastBrowseDir != dir {
+		t.Fatalf("expected last browse dir %q, got %q", dir, m.cfg.Settings.LastBrowseDir)
+	}
+	saved, _, err := config.LoadSettings()
+	if err != nil {
+		t.Fatalf("load settings: %v", err)
+	}
+	if saved.LastBrowseDir != dir {
+		t.Fatalf("expected persisted browse dir %q, got %q", dir, saved.LastBrowseDir)
+	}
+
+	m.openOpenModal()
+	if m.openBrowserRoot != dir {
+		t.Fatalf("expected browser to reopen at %q, got %q", dir, m.openBrowserRoot)
+	}
+}
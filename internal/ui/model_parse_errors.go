@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+// jumpToNextParseError moves the editor cursor to the first parse error
+// below it, wrapping to the top. The buffer is parsed fresh so unsaved
+// edits are checked too.
+func (m *Model) jumpToNextParseError() tea.Cmd {
+	doc := parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	errs := append([]restfile.ParseError(nil), doc.Errors...)
+	if len(errs) == 0 {
+		return statusCmd(statusSuccess, "No parse errors")
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Line < errs[j].Line
+	})
+
+	current := currentCursorLine(m.editor)
+	index, wrapped := 0, true
+	for i, e := range errs {
+		if e.Line > current {
+			index, wrapped = i, false
+			break
+		}
+	}
+	target := errs[index]
+
+	var focusCmd tea.Cmd
+	if m.focus != focusEditor {
+		focusCmd = m.setFocus(focusEditor)
+	}
+	m.moveCursorToLine(target.Line)
+
+	text := fmt.Sprintf(
+		"Parse error %d/%d on line %d: %s",
+		index+1,
+		len(errs),
+		target.Line,
+		target.Message,
+	)
+	if wrapped && len(errs) > 1 {
+		text += " (wrapped)"
+	}
+	return batchCommands(focusCmd, statusCmd(statusError, text))
+}
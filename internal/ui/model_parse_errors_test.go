@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJumpToNextParseErrorCyclesErrors(t *testing.T) {
+	content := "# @assert jsonpath count == 5\n" +
+		"GET https://example.com/a\n" +
+		"\n###\n\n" +
+		"# @assert jsonpath $.id ~ 5\n" +
+		"GET https://example.com/b\n"
+	model := newTestModelWithDoc(content)
+	_ = model.setFocus(focusEditor)
+
+	evt := editorEventFromCmd(t, model.jumpToNextParseError())
+	if evt.status == nil || !strings.HasPrefix(evt.status.text, "Parse error 2/2 on line 6") {
+		t.Fatalf("expected second error first from line 1, got %+v", evt.status)
+	}
+	if line := currentCursorLine(model.editor); line != 6 {
+		t.Fatalf("expected cursor on line 6, got %d", line)
+	}
+
+	evt = editorEventFromCmd(t, model.jumpToNextParseError())
+	if evt.status == nil || !strings.Contains(evt.status.text, "1/2 on line 1") ||
+		!strings.HasSuffix(evt.status.text, "(wrapped)") {
+		t.Fatalf("expected wrap to the first error, got %+v", evt.status)
+	}
+	if line := currentCursorLine(model.editor); line != 1 {
+		t.Fatalf("expected cursor on line 1, got %d", line)
+	}
+}
+
+func TestJumpToNextParseErrorCleanFile(t *testing.T) {
+	model := newTestModelWithDoc("GET https://example.com\n")
+	evt := editorEventFromCmd(t, model.jumpToNextParseError())
+	if evt.status == nil || evt.status.text != "No parse errors" {
+		t.Fatalf("expected clean status, got %+v", evt.status)
+	}
+}
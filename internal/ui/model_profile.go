@@ -11,6 +11,7 @@ import (
 	"github.com/unkn0wn-root/resterm/internal/errdef"
 	"github.com/unkn0wn-root/resterm/internal/httpclient"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/scripts"
 )
 
 type profileState struct {
@@ -33,6 +34,7 @@ type profileState struct {
 	cancelReason  string
 	skipped       bool
 	skipReason    string
+	asserts       []scripts.TestResult
 }
 
 type profileFailure struct {
@@ -323,13 +325,18 @@ func (m *Model) finalizeProfileRun(msg responseMsg, state *profileState) tea.Cmd
 	if len(state.successes) > 0 {
 		stats = analysis.ComputeLatencyStats(state.successes, []int{50, 90, 95, 99}, 10)
 		statsPtr = &stats
-		report = m.buildProfileReport(state, stats)
-	} else {
-		report = m.buildProfileReport(state, stats)
 	}
+	canceled := state != nil && state.canceled
+	if state != nil && !canceled && !state.skipped && state.base != nil {
+		state.asserts = evalProfileAsserts(state.base.Metadata.Asserts, statsPtr)
+		if len(state.asserts) > 0 {
+			msg.tests = append(append([]scripts.TestResult(nil), msg.tests...), state.asserts...)
+			m.testResults = msg.tests
+		}
+	}
+	report = m.buildProfileReport(state, stats)
 
 	var cmds []tea.Cmd
-	canceled := state != nil && state.canceled
 	if msg.err != nil && (!canceled || !isCanceled(msg.err)) {
 		if cmd := m.consumeRequestError(msg.err); cmd != nil {
 			cmds = append(cmds, cmd)
@@ -390,6 +397,8 @@ func (m *Model) finalizeProfileRun(msg responseMsg, state *profileState) tea.Cmd
 	level := statusInfo
 	if canceled || (state != nil && state.skipped) {
 		level = statusWarn
+	} else if state != nil && countFailedTests(state.asserts) > 0 {
+		level = statusError
 	}
 	m.setStatusMessage(statusMsg{text: summary, level: level})
 
@@ -437,13 +446,17 @@ func buildProfileSummary(state *profileState) string {
 		)
 	}
 
-	return fmt.Sprintf(
+	summary := fmt.Sprintf(
 		"Profiling complete: %d/%d success (%d failure, %d warmup)",
 		mt.success,
 		state.spec.Count,
 		mt.failures,
 		mt.warmup,
 	)
+	if failed := countFailedTests(state.asserts); failed > 0 {
+		summary += fmt.Sprintf(" – %d/%d profile asserts failed", failed, len(state.asserts))
+	}
+	return summary
 }
 
 func (m *Model) buildProfileReport(state *profileState, stats analysis.LatencyStats) string {
@@ -454,6 +467,7 @@ func (m *Model) buildProfileReport(state *profileState, stats analysis.LatencySt
 	writeProfileSummary(&b, state, mt)
 	writeLatencySection(&b, stats)
 	writeDistributionSection(&b, stats)
+	writeProfileAssertSection(&b, state)
 	writeFailureSection(&b, state)
 
 	return strings.TrimRight(b.String(), "\n")
@@ -847,6 +861,24 @@ func writeFailureSection(b *strings.Builder, state *profileState) {
 	}
 }
 
+func writeProfileAssertSection(b *strings.Builder, state *profileState) {
+	if state == nil || len(state.asserts) == 0 {
+		return
+	}
+	b.WriteString("\nAssertions:\n")
+	for _, res := range state.asserts {
+		mark := "PASS"
+		if !res.Passed {
+			mark = "FAIL"
+		}
+		line := fmt.Sprintf("  - %s %s", mark, res.Name)
+		if msg := strings.TrimSpace(res.Message); msg != "" {
+			line += ": " + msg
+		}
+		b.WriteString(line + "\n")
+	}
+}
+
 func formatProfileFailure(failure profileFailure) string {
 	label := fmt.Sprintf("Run %d", failure.Iteration)
 	if failure.Warmup {
@@ -278,3 +278,80 @@ func TestProfileCancelRefreshesStatsPane(t *testing.T) {
 		t.Fatalf("expected stats tab to remain active after cancel, got %v", primary.activeTab)
 	}
 }
+
+func TestProfileAssertsEvaluatedOnFinalize(t *testing.T) {
+	model := New(Config{})
+	model.ready = true
+	model.width = 120
+	model.height = 42
+	model.frameWidth = model.width + 2
+	model.frameHeight = model.height + 2
+	if cmd := model.applyLayout(); cmd != nil {
+		collectMsgs(cmd)
+	}
+
+	req := &restfile.Request{
+		Method: "GET",
+		URL:    "https://example.com/profile",
+		Metadata: restfile.RequestMetadata{
+			Profile: &restfile.ProfileSpec{Count: 1},
+			Asserts: []restfile.AssertSpec{
+				{
+					Expression: "profile.p99 < 10ms",
+					Profile: &restfile.ProfileAssert{
+						Stat: "p99", Op: "<", Value: 10 * time.Millisecond,
+					},
+				},
+				{
+					Expression: "profile.min >= 1ms",
+					Profile: &restfile.ProfileAssert{
+						Stat: "min", Op: ">=", Value: time.Millisecond,
+					},
+				},
+			},
+		},
+	}
+	state := &profileState{
+		base:        cloneRequest(req),
+		doc:         &restfile.Document{Requests: []*restfile.Request{req}},
+		spec:        restfile.ProfileSpec{Count: 1},
+		total:       1,
+		current:     req,
+		messageBase: "Profiling " + requestBaseTitle(req),
+		start:       time.Now(),
+	}
+	model.profileRun = state
+
+	resp := &httpclient.Response{
+		Status:       "200 OK",
+		StatusCode:   200,
+		Body:         []byte(`{}`),
+		Duration:     25 * time.Millisecond,
+		EffectiveURL: "https://example.com/profile",
+	}
+	cmd := model.handleProfileResponse(responseMsg{response: resp, executed: req})
+	drainResponseCommands(t, &model, cmd)
+
+	if len(state.asserts) != 2 {
+		t.Fatalf("expected 2 profile assert results, got %d", len(state.asserts))
+	}
+	if state.asserts[0].Passed {
+		t.Fatalf("expected p99 assert to fail")
+	}
+	if !strings.Contains(state.asserts[0].Message, "got 25ms") {
+		t.Fatalf("expected measured value in failure, got %q", state.asserts[0].Message)
+	}
+	if !state.asserts[1].Passed || !strings.Contains(state.asserts[1].Message, "got 25ms") {
+		t.Fatalf("expected passing min assert with measurement, got %+v", state.asserts[1])
+	}
+	if len(model.testResults) != 2 {
+		t.Fatalf("expected profile asserts in test results, got %d", len(model.testResults))
+	}
+	if !strings.Contains(model.responseLatest.stats, "FAIL profile.p99 < 10ms") {
+		t.Fatalf("expected assertions in profile report, got:\n%s", model.responseLatest.stats)
+	}
+	if model.statusMessage.level != statusError ||
+		!strings.Contains(model.statusMessage.text, "1/2 profile asserts failed") {
+		t.Fatalf("unexpected status %+v", model.statusMessage)
+	}
+}
@@ -0,0 +1,227 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+const maxRecentRequests = 20
+
+// recentRequest identifies a request that was sent from a saved file. key is
+// requestKey at send time; line is the fallback when the key no longer
+// matches (an unnamed request moved or changed method).
+type recentRequest struct {
+	path  string
+	key   string
+	line  int
+	title string
+}
+
+func (r recentRequest) id() string {
+	return filepath.Clean(r.path) + "\x00" + r.key
+}
+
+type recentItem struct {
+	entry recentRequest
+	desc  string
+}
+
+func (i recentItem) Title() string {
+	return i.entry.title
+}
+
+func (i recentItem) Description() string {
+	return i.desc
+}
+
+func (i recentItem) FilterValue() string {
+	return i.entry.title
+}
+
+// recordRecentRequest moves req to the front of the recent list. Scratch
+// buffers have no file to reopen and are not recorded.
+func (m *Model) recordRecentRequest(req *restfile.Request) {
+	path := strings.TrimSpace(m.currentFile)
+	if req == nil || path == "" {
+		return
+	}
+	entry := recentRequest{
+		path:  path,
+		key:   requestKey(req),
+		line:  req.LineRange.Start,
+		title: requestBaseTitle(req),
+	}
+	out := make([]recentRequest, 0, maxRecentRequests)
+	out = append(out, entry)
+	for _, r := range m.recentReqs {
+		if r.id() == entry.id() {
+			continue
+		}
+		if len(out) == maxRecentRequests {
+			break
+		}
+		out = append(out, r)
+	}
+	m.recentReqs = out
+}
+
+func (m *Model) openRecentRequests() tea.Cmd {
+	if len(m.recentReqs) == 0 {
+		return statusCmd(statusInfo, "No recent requests yet")
+	}
+	items := make([]list.Item, 0, len(m.recentReqs))
+	for _, r := range m.recentReqs {
+		items = append(items, recentItem{entry: r, desc: m.recentRequestLocation(r)})
+	}
+	m.recentList.SetItems(items)
+	m.recentList.Select(0)
+	m.recentPending = ""
+	m.showRecentRequests = true
+	m.showHelp = false
+	m.showEnvSelector = false
+	m.showThemeSelector = false
+	return nil
+}
+
+func (m *Model) closeRecentRequests() {
+	m.showRecentRequests = false
+	m.recentPending = ""
+}
+
+func (m *Model) recentRequestLocation(r recentRequest) string {
+	path := r.path
+	if root := strings.TrimSpace(m.workspaceRoot); root != "" {
+		if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+			path = rel
+		}
+	}
+	return fmt.Sprintf("%s:%d", filepath.ToSlash(path), r.line)
+}
+
+func (m *Model) handleRecentRequestsKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.closeRecentRequests()
+		return nil
+	case "enter":
+		return m.applyRecentSelection(false)
+	case "r":
+		return m.applyRecentSelection(true)
+	}
+	var cmd tea.Cmd
+	m.recentList, cmd = m.recentList.Update(msg)
+	if item, ok := m.recentList.SelectedItem().(recentItem); ok &&
+		item.entry.id() != m.recentPending {
+		m.recentPending = ""
+	}
+	return cmd
+}
+
+func (m *Model) applyRecentSelection(send bool) tea.Cmd {
+	item, ok := m.recentList.SelectedItem().(recentItem)
+	if !ok {
+		m.closeRecentRequests()
+		return nil
+	}
+	return m.jumpToRequest(item.entry, &m.recentPending, m.closeRecentRequests, send)
+}
+
+// jumpToRequest opens entry's file when needed, moves the cursor to the
+// request and optionally sends it. Switching away from a dirty buffer asks
+// for a second confirmation, as the navigator does; pending remembers which
+// entry was warned about. closeModal runs once the jump goes ahead.
+func (m *Model) jumpToRequest(
+	entry recentRequest,
+	pending *string,
+	closeModal func(),
+	send bool,
+) tea.Cmd {
+	var cmds []tea.Cmd
+	if !samePath(entry.path, m.currentFile) {
+		if m.dirty && *pending != entry.id() {
+			*pending = entry.id()
+			return statusCmd(statusWarn, fmt.Sprintf(
+				"Unsaved changes will be discarded when opening %s. Press Enter again to continue.",
+				filepath.Base(entry.path),
+			))
+		}
+		if cmd := m.openFile(entry.path); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+		if !samePath(entry.path, m.currentFile) {
+			closeModal()
+			return batchCmds(cmds)
+		}
+	}
+	closeModal()
+
+	if !m.selectRecentRequest(entry) {
+		cmds = append(cmds, statusCmd(statusWarn, fmt.Sprintf(
+			"%s is no longer in %s",
+			entry.title,
+			filepath.Base(entry.path),
+		)))
+		return batchCmds(cmds)
+	}
+	m.syncEditorWithRequestSelection(-1)
+	m.revealRequestInEditor(m.currentRequest)
+	if cmd := m.setFocus(focusRequests); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+	if send {
+		cmds = append(cmds, m.sendActiveRequest())
+	}
+	return batchCmds(cmds)
+}
+
+func (m *Model) selectRecentRequest(entry recentRequest) bool {
+	if m.selectRequestItemByKey(entry.key) {
+		return true
+	}
+	for idx, item := range m.requestItems {
+		if item.request != nil && item.request.LineRange.Start == entry.line {
+			m.requestList.Select(idx)
+			return true
+		}
+	}
+	return false
+}
+
+func (m Model) renderRecentRequestsModal() string {
+	width := minInt(m.width-10, 72)
+	if width < 32 {
+		width = 32
+	}
+
+	commands := fmt.Sprintf(
+		"%s Jump    %s Jump & send    %s Cancel",
+		m.theme.CommandBarHint.Render("Enter"),
+		m.theme.CommandBarHint.Render("r"),
+		m.theme.CommandBarHint.Render("Esc"),
+	)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		m.recentList.View(),
+		"",
+		commands,
+	)
+
+	box := m.theme.BrowserBorder.Width(width).Render(content)
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(lipgloss.Color("#1A1823")),
+	)
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestRecordRecentRequestDedupesAndCaps(t *testing.T) {
+	model := New(Config{})
+	m := &model
+	m.currentFile = "/tmp/a.http"
+	for i := 0; i < maxRecentRequests+5; i++ {
+		req := &restfile.Request{Method: "GET", URL: "https://example.com"}
+		req.Metadata.Name = fmt.Sprintf("r%d", i)
+		m.recordRecentRequest(req)
+	}
+	if len(m.recentReqs) != maxRecentRequests {
+		t.Fatalf("expected %d entries, got %d", maxRecentRequests, len(m.recentReqs))
+	}
+	again := &restfile.Request{Method: "GET", Metadata: restfile.RequestMetadata{Name: "r10"}}
+	m.recordRecentRequest(again)
+	if m.recentReqs[0].key != "name:r10" || len(m.recentReqs) != maxRecentRequests {
+		t.Fatalf("expected r10 moved to front without growing, got %+v", m.recentReqs[0])
+	}
+
+	m.currentFile = ""
+	scratch := &restfile.Request{Method: "GET"}
+	scratch.Metadata.Name = "scratch"
+	m.recordRecentRequest(scratch)
+	if m.recentReqs[0].key == "name:scratch" {
+		t.Fatalf("expected scratch buffer requests to be skipped")
+	}
+}
+
+func TestRecentRequestsJumpAcrossFiles(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.http")
+	second := filepath.Join(dir, "second.http")
+	writeFile := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	writeFile(first, "### one\n# @name one\nGET https://example.com/one\n")
+	writeFile(second, "### a\n# @name a\nGET https://example.com/a\n\n"+
+		"### b\n# @name b\nGET https://example.com/b\n")
+
+	model := New(Config{WorkspaceRoot: dir})
+	m := &model
+	m.openFile(second)
+	m.recordRecentRequest(m.doc.Requests[1])
+	m.openFile(first)
+	m.recordRecentRequest(m.doc.Requests[0])
+
+	if cmd := m.openRecentRequests(); cmd != nil {
+		t.Fatalf("expected modal to open, got %v", statusFromCmd(t, cmd))
+	}
+	if !m.showRecentRequests || len(m.recentList.Items()) != 2 {
+		t.Fatalf("expected two recent entries")
+	}
+	if got := m.recentList.Items()[1].(recentItem).desc; got != "second.http:6" {
+		t.Fatalf("unexpected location %q", got)
+	}
+
+	m.editor.SetValue(m.editor.Value() + "\n# edit\n")
+	m.dirty = true
+	m.recentList.Select(1)
+	m.handleRecentRequestsKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if !samePath(m.currentFile, first) || !m.showRecentRequests {
+		t.Fatalf("expected confirmation before discarding unsaved changes")
+	}
+	m.handleRecentRequestsKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if !samePath(m.currentFile, second) {
+		t.Fatalf("expected second file to be opened, got %q", m.currentFile)
+	}
+	if m.showRecentRequests {
+		t.Fatalf("expected modal to close after jumping")
+	}
+	if m.currentRequest == nil || m.currentRequest.Metadata.Name != "b" {
+		t.Fatalf("expected request b to be selected, got %+v", m.currentRequest)
+	}
+	if line := currentCursorLine(m.editor); line < 5 {
+		t.Fatalf("expected cursor on request b, got line %d", line)
+	}
+}
@@ -66,7 +66,13 @@ func headerLabelTextWithIcon(label, iconOverride string) string {
 	return fmt.Sprintf("%s %s", icon, labelText)
 }
 
+// View renders the frame. A pending OSC52 clipboard sequence leads the
+// frame so it reaches the terminal through the renderer; it is zero-width.
 func (m Model) View() string {
+	return m.osc52 + m.view()
+}
+
+func (m Model) view() string {
 	if !m.ready {
 		return m.renderWithinAppFrame("Initialising...")
 	}
@@ -87,6 +93,10 @@ func (m Model) View() string {
 		return m.renderWithinAppFrame(m.renderRequestDetailsModal())
 	}
 
+	if m.showInfoModal {
+		return m.renderWithinAppFrame(m.renderInfoModal())
+	}
+
 	if m.showResponseSaveModal {
 		return m.renderWithinAppFrame(m.renderResponseSaveModal())
 	}
@@ -98,6 +108,21 @@ func (m Model) View() string {
 	if m.showNewFileModal {
 		return m.renderWithinAppFrame(m.renderNewFileModal())
 	}
+	if m.envEdit.on {
+		return m.renderWithinAppFrame(m.renderEnvEditorModal())
+	}
+	if m.hdrEdit.on {
+		return m.renderWithinAppFrame(m.renderHeaderEditorModal())
+	}
+	if m.bodyEdit.on {
+		return m.renderWithinAppFrame(m.renderBodyEditorModal())
+	}
+	if m.capPrompt.on {
+		return m.renderWithinAppFrame(m.renderCapturePromptModal())
+	}
+	if m.presets.on {
+		return m.renderWithinAppFrame(m.renderLayoutPresetsModal())
+	}
 	if m.showLayoutSaveModal {
 		return m.renderWithinAppFrame(m.renderLayoutSaveModal())
 	}
@@ -191,6 +216,12 @@ func (m Model) View() string {
 	if m.showEnvSelector {
 		return m.renderWithinAppFrame(m.renderEnvironmentModal())
 	}
+	if m.showRecentRequests {
+		return m.renderWithinAppFrame(m.renderRecentRequestsModal())
+	}
+	if m.showGotoRequest {
+		return m.renderWithinAppFrame(m.renderGotoRequestModal())
+	}
 	return m.renderWithinAppFrame(base)
 }
 
@@ -417,6 +448,10 @@ func (m Model) renderNavigatorFilter(width int, active bool) string {
 	if tags := m.navigatorTagChips(); tags != "" {
 		row = lipgloss.JoinHorizontal(lipgloss.Left, row, " ", tags)
 	}
+	if m.navigator != nil && m.navigator.FailedFilter() {
+		chip := m.theme.Error.Bold(true).Underline(true).Render("FAILED")
+		row = lipgloss.JoinHorizontal(lipgloss.Left, row, " ", chip)
+	}
 	if !active && !input.Focused() {
 		row = lipgloss.NewStyle().Faint(true).Render(row)
 	}
@@ -1492,13 +1527,12 @@ func (m Model) renderSearchPrompt() string {
 		Faint(true).
 		PaddingLeft(2).
 		Render("Enter confirm  Esc cancel  Ctrl+R toggle regex")
-	row := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		label,
-		input,
-		modeBadge,
-		hints,
-	)
+	parts := []string{label, input, modeBadge}
+	if count := m.editorSearchCount(); count != "" {
+		parts = append(parts, lipgloss.NewStyle().PaddingLeft(2).Render(count))
+	}
+	parts = append(parts, hints)
+	row := lipgloss.JoinHorizontal(lipgloss.Top, parts...)
 	return renderCommandBarContainer(
 		m.theme.CommandBar,
 		row,
@@ -1506,6 +1540,20 @@ func (m Model) renderSearchPrompt() string {
 	)
 }
 
+func (m Model) editorSearchCount() string {
+	if m.searchTarget != searchTargetEditor || !m.searchPreviewed {
+		return ""
+	}
+	search := m.editor.search
+	if search.query == "" {
+		return ""
+	}
+	if len(search.matches) == 0 {
+		return "no matches"
+	}
+	return fmt.Sprintf("%d/%d", search.index+1, len(search.matches))
+}
+
 func (m Model) renderResponseSearchPrompt(width int) string {
 	if width <= 0 {
 		width = defaultResponseViewportWidth
@@ -1962,6 +2010,12 @@ func (m Model) renderStatusBar() string {
 			mode = "VISUAL"
 		}
 		segments = append(segments, fmt.Sprintf("Mode: %s", mode))
+		if m.bodyBadge != "" {
+			segments = append(segments, m.bodyBadge)
+		}
+	}
+	if m.revealSecrets {
+		segments = append(segments, "SECRETS VISIBLE")
 	}
 	if m.zoomActive {
 		segments = append(segments, fmt.Sprintf("Zoom: %s", m.collapsedStatusLabel(m.zoomRegion)))
@@ -2553,13 +2607,49 @@ func (m Model) renderHelpOverlay() string {
 		{
 			title: "Requests & Files",
 			entries: sortedHelpEntries([]helpEntry{
-				{"Enter", "Run selected request"},
-				{"Space", "Preview selected request / toggle file expansion"},
+				{"Enter", "Run selected request or request group"},
+				{"Space", "Preview selected request / toggle file or group expansion"},
 				{
 					m.helpActionKey(bindings.ActionShowRequestDetails, "g ,"),
 					"Show selected request details",
 				},
+				{
+					m.helpActionKey(bindings.ActionShowGRPCSchema, "g Shift+M"),
+					"Show gRPC message schema",
+				},
+				{
+					m.helpActionKey(bindings.ActionDuplicateRequest, "g d"),
+					"Duplicate request at cursor",
+				},
+				{
+					m.helpActionKey(bindings.ActionShowVariableRefs, "g u"),
+					"Requests using variable at cursor",
+				},
+				{
+					m.helpActionKey(bindings.ActionJumpParseError, "g Shift+V"),
+					"Jump to next parse error",
+				},
+				{
+					m.helpActionKey(bindings.ActionCopyResolvedURL, "g Shift+U"),
+					"Copy resolved URL of request at cursor",
+				},
+				{
+					m.helpActionKey(bindings.ActionOpenRecentRequests, "g q"),
+					"Recent requests (jump / re-send)",
+				},
+				{
+					m.helpActionKey(bindings.ActionGotoRequest, "g /"),
+					"Go to request in workspace",
+				},
 				{m.helpActionKey(bindings.ActionSendRequest, "Ctrl+Enter"), "Send active request"},
+				{
+					m.helpActionKey(bindings.ActionSendVisibleRequests, "g n"),
+					"Send all requests visible in navigator",
+				},
+				{
+					m.helpActionKey(bindings.ActionSendVisibleUntilFail, "g Shift+N"),
+					"Send visible requests, stop on first failure",
+				},
 				{
 					m.helpActionKey(bindings.ActionCancelRun, "Ctrl+C"),
 					"Cancel in-flight run/request",
@@ -2569,6 +2659,10 @@ func (m Model) renderHelpOverlay() string {
 					m.helpActionKey(bindings.ActionSaveLayout, "g Shift+L"),
 					"Save layout to settings",
 				},
+				{
+					m.helpActionKey(bindings.ActionLayoutPresets, "g Shift+W"),
+					"Switch / save layout presets",
+				},
 				{m.helpActionKey(bindings.ActionOpenNewFileModal, "Ctrl+N"), "Create request file"},
 				{m.helpActionKey(bindings.ActionOpenPathModal, "Ctrl+O"), "Open file or folder"},
 				{
@@ -2591,6 +2685,7 @@ func (m Model) renderHelpOverlay() string {
 				{"/ (Esc clears)", "Focus navigator filter / reset filters"},
 				{"m", "Navigator: toggle method filter for selected request"},
 				{"t", "Navigator: toggle tag filters for selected item"},
+				{"f", "Navigator: show only requests that failed their last run"},
 				{"l / r", "Navigator: jump to selected request in editor"},
 				{
 					m.helpCombinedKey(
@@ -2635,6 +2730,14 @@ func (m Model) renderHelpOverlay() string {
 					m.helpActionKey(bindings.ActionCopyResponseTab, "Ctrl+Shift+C"),
 					"Copy Pretty / Raw / Headers response tab",
 				},
+				{
+					m.helpActionKey(bindings.ActionCopyResponseBody, "g Shift+Y"),
+					"Copy response body only (raw bytes on Raw tab)",
+				},
+				{
+					m.helpActionKey(bindings.ActionCopyRequestResponse, "g Shift+B"),
+					"Copy request + response bundle",
+				},
 				{
 					m.helpCombinedKey(
 						[]bindings.ActionID{
@@ -2645,6 +2748,17 @@ func (m Model) renderHelpOverlay() string {
 					),
 					"Response/History tab: top / bottom",
 				},
+				{
+					m.helpCombinedKey(
+						[]bindings.ActionID{
+							bindings.ActionJumpResponseStatus,
+							bindings.ActionJumpResponseHeaders,
+							bindings.ActionJumpResponseBody,
+						},
+						"g 4 / g 5 / g 6",
+					),
+					"Response: jump to status / headers / body",
+				},
 				{
 					m.helpActionKey(bindings.ActionToggleHeaderPreview, "g Shift+H"),
 					"Toggle request/response headers view",
@@ -2657,6 +2771,17 @@ func (m Model) renderHelpOverlay() string {
 					m.helpActionKey(bindings.ActionShowRawDump, "g Shift+D"),
 					"Load full raw dump (hex)",
 				},
+				{
+					m.helpActionKey(bindings.ActionCycleBodyFormat, "g f"),
+					"Pretty tab: force JSON / XML / HTML / text",
+				},
+				{"↑/↓ / Enter", "Tree tab: move selection / expand or collapse node"},
+				{"c / e", "Tree tab: collapse all / expand all"},
+				{"a", "Tree tab: add @capture for the selected value"},
+				{
+					m.helpActionKey(bindings.ActionPinBodyFormat, "g Shift+F"),
+					"Keep forced body format for new responses",
+				},
 				{
 					m.helpActionKey(bindings.ActionSaveResponseBody, "g Shift+S"),
 					"Save response body to file",
@@ -2665,6 +2790,10 @@ func (m Model) renderHelpOverlay() string {
 					m.helpActionKey(bindings.ActionOpenResponseExternally, "g Shift+E"),
 					"Open response in external app",
 				},
+				{
+					m.helpActionKey(bindings.ActionOpenResponseBrowser, "g o"),
+					"Preview HTML response in browser",
+				},
 				{"Ctrl+F or Ctrl+B, ←/→", "Send future responses to selected pane"},
 				{
 					m.helpCombinedKey(
@@ -2707,11 +2836,44 @@ func (m Model) renderHelpOverlay() string {
 			title: "Environment & Themes",
 			entries: sortedHelpEntries([]helpEntry{
 				{m.helpActionKey(bindings.ActionShowGlobals, "Ctrl+G"), "Show globals summary"},
+				{
+					m.helpActionKey(bindings.ActionRevealSecrets, "g Shift+X"),
+					"Reveal secrets briefly",
+				},
 				{
 					m.helpActionKey(bindings.ActionClearGlobals, "Ctrl+Shift+G"),
 					"Clear globals for environment",
 				},
+				{
+					m.helpActionKey(bindings.ActionClearPersistedGlobals, "g Shift+G"),
+					"Clear globals saved to disk for environment",
+				},
+				{
+					m.helpActionKey(bindings.ActionClearExecTokens, "g x"),
+					"Clear cached bearer-exec tokens",
+				},
 				{m.helpActionKey(bindings.ActionOpenEnvSelector, "Ctrl+E"), "Environment selector"},
+				{m.helpActionKey(bindings.ActionEditEnvironment, "g e"), "Edit environment values"},
+				{
+					m.helpActionKey(bindings.ActionEditRequestHeaders, "g a"),
+					"Edit request headers",
+				},
+				{
+					m.helpActionKey(bindings.ActionEditRequestBody, "g Shift+A"),
+					"Edit JSON body with validation",
+				},
+				{
+					m.helpActionKey(bindings.ActionFormatRequestBody, "g Shift+P"),
+					"Pretty-print / minify JSON body",
+				},
+				{
+					m.helpActionKey(bindings.ActionEscapeSelectionJSON, "g Shift+Q"),
+					"Escape selection into a JSON string",
+				},
+				{
+					m.helpActionKey(bindings.ActionUnescapeSelectionJSON, "g Shift+C"),
+					"Unescape JSON string selection",
+				},
 				{
 					m.helpActionKey(bindings.ActionSelectTimelineTab, "Ctrl+Alt+L / g t"),
 					"Timeline tab",
@@ -2752,6 +2914,7 @@ func (m Model) renderHelpOverlay() string {
 			title: "Search",
 			entries: []helpEntry{
 				{"Shift+F", "Open search prompt (Ctrl+R toggles regex)"},
+				{"/", "Editor: search as you type (Esc restores cursor)"},
 				{"n / p", "Next / previous match (wraps around)"},
 			},
 		},
@@ -2879,8 +3042,9 @@ func (m Model) renderOpenModal() string {
 		Render(m.openPathInput.View())
 
 	enter := m.theme.CommandBarHint.Render("Enter")
+	tab := m.theme.CommandBarHint.Render("Tab")
 	esc := m.theme.CommandBarHint.Render("Esc")
-	info := fmt.Sprintf("%s Open    %s Cancel", enter, esc)
+	info := fmt.Sprintf("%s Open    %s Browse/Type    %s Cancel", enter, tab, esc)
 
 	lines := []string{
 		m.theme.HeaderTitle.
@@ -2895,6 +3059,11 @@ func (m Model) renderOpenModal() string {
 			Padding(0, 2).
 			Render(inputView),
 	}
+	if m.openBrowser != nil {
+		lines = append(lines, "", lipgloss.NewStyle().
+			Padding(0, 2).
+			Render(m.renderOpenBrowser(width-8)))
+	}
 	if m.openPathError != "" {
 		errorLine := m.theme.Error.
 			Padding(0, 2).
@@ -2947,6 +3116,32 @@ func (m Model) renderResponseSaveModal() string {
 			Padding(0, 2).
 			Render(inputBox),
 	}
+	if ext := m.responseSaveExtension(); ext != "" {
+		tab := m.theme.CommandBarHint.Render("Tab")
+		hint := fmt.Sprintf("No extension; %s appends %s", tab, ext)
+		lines = append(lines, lipgloss.NewStyle().Padding(0, 2).Faint(true).Render(hint))
+	}
+	if m.responseSaveHasText() {
+		variant := "raw bytes"
+		if m.responseSavePretty {
+			variant = "pretty-printed"
+		}
+		toggle := m.theme.CommandBarHint.Render("Ctrl+P")
+		format := fmt.Sprintf("Body: %s  %s toggle", variant, toggle)
+		lines = append(lines, lipgloss.NewStyle().Padding(0, 2).Render(format))
+
+		filterBox := lipgloss.NewStyle().
+			Width(width - 8).
+			Background(bg).
+			Render(m.responseSaveFilter.View())
+		switchKey := m.theme.CommandBarHint.Render("↑/↓")
+		filterLabel := fmt.Sprintf("Save only a JSONPath match  %s switch field", switchKey)
+		lines = append(lines,
+			"",
+			lipgloss.NewStyle().Padding(0, 2).Render(filterLabel),
+			lipgloss.NewStyle().Padding(0, 2).Render(filterBox),
+		)
+	}
 	if m.responseSaveError != "" {
 		errorLine := m.theme.Error.
 			Padding(0, 2).
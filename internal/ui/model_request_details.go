@@ -3,17 +3,23 @@ package ui
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/unkn0wn-root/resterm/internal/restfile"
 	"github.com/unkn0wn-root/resterm/internal/theme"
 	"github.com/unkn0wn-root/resterm/internal/ui/navigator"
+	"github.com/unkn0wn-root/resterm/internal/vars"
 )
 
 type requestDetailField struct {
 	label string
 	value string
 	dim   bool
+	// note trails the value in a muted style; heading fields render the
+	// label alone as a section title.
+	note    string
+	heading bool
 }
 
 func (m *Model) openRequestDetails() {
@@ -113,7 +119,7 @@ func (m *Model) buildRequestDetailFields(
 	doc *restfile.Document,
 	path string,
 ) []requestDetailField {
-	env := m.cfg.EnvironmentName
+	env := vars.SelectEnv(m.cfg.EnvironmentSet, requestEnv(req), m.cfg.EnvironmentName)
 	res := m.statusResolver(doc, req, env)
 	name := expandStatusText(res, req.Metadata.Name)
 	if name == "" {
@@ -135,7 +141,70 @@ func (m *Model) buildRequestDetailFields(
 	if meta := detailMeta(req); meta != "" {
 		fields = append(fields, requestDetailField{label: "Meta", value: meta})
 	}
-	return filterDetailFields(fields)
+	fields = filterDetailFields(fields)
+	if varFields := m.detailVariables(doc, req, res); len(varFields) > 0 {
+		fields = append(fields, requestDetailField{label: "Variables", heading: true})
+		fields = append(fields, varFields...)
+	}
+	return fields
+}
+
+// detailVariables lists each variable visible to req with its effective
+// value and the scope it came from. A name defined in several scopes also
+// names the scopes it shadows. Secrets are masked unless revealed.
+func (m *Model) detailVariables(
+	doc *restfile.Document,
+	req *restfile.Request,
+	res *vars.Resolver,
+) []requestDetailField {
+	env := vars.SelectEnv(m.cfg.EnvironmentSet, requestEnv(req), m.cfg.EnvironmentName)
+	scopes := m.displayScopes(doc, req, env)
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, scope := range scopes {
+		for name := range scope.vars {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+
+	fields := make([]requestDetailField, 0, len(names))
+	for _, name := range names {
+		var (
+			winner   displayScope
+			v        displayVar
+			shadowed []string
+			found    bool
+		)
+		for _, scope := range scopes {
+			entry, ok := scope.vars[name]
+			if !ok {
+				continue
+			}
+			if found {
+				shadowed = append(shadowed, scope.label)
+				continue
+			}
+			winner, v, found = scope, entry, true
+		}
+		value := m.displaySecret(v.value, v.secret)
+		if !v.secret {
+			value = expandStatusText(res, v.value)
+		}
+		if value == "" {
+			value = `""`
+		}
+		note := winner.label
+		if len(shadowed) > 0 {
+			note += " · shadows " + strings.Join(shadowed, ", ")
+		}
+		fields = append(fields, requestDetailField{label: name, value: value, note: note})
+	}
+	return fields
 }
 
 func detailTags(tags []string) string {
@@ -211,7 +280,11 @@ func detailMeta(req *restfile.Request) string {
 		parts = append(parts, "Compare")
 	}
 	if req.Metadata.Trace != nil && req.Metadata.Trace.Enabled {
-		parts = append(parts, "Trace")
+		if req.Metadata.Trace.Export != nil {
+			parts = append(parts, "Trace → OTLP")
+		} else {
+			parts = append(parts, "Trace")
+		}
 	}
 	if req.Metadata.Profile != nil {
 		count := req.Metadata.Profile.Count
@@ -220,6 +293,12 @@ func detailMeta(req *restfile.Request) string {
 		}
 		parts = append(parts, fmt.Sprintf("Profile x%d", count))
 	}
+	if req.Metadata.Retry != nil && req.Metadata.Retry.Count > 0 {
+		parts = append(parts, fmt.Sprintf("Retry x%d", req.Metadata.Retry.Count))
+	}
+	if env := strings.TrimSpace(req.Metadata.Env); env != "" {
+		parts = append(parts, "Env "+env)
+	}
 	if req.Metadata.NoLog {
 		parts = append(parts, "No log")
 	}
@@ -258,6 +337,9 @@ func renderDetailFields(fields []requestDetailField, width int, th theme.Theme)
 func formatDetailField(f requestDetailField, width int, th theme.Theme) string {
 	label := strings.TrimSpace(f.label)
 	val := strings.TrimSpace(f.value)
+	if f.heading && label != "" {
+		return "\n" + th.HeaderTitle.Bold(true).Render(label)
+	}
 	if label == "" || val == "" {
 		return ""
 	}
@@ -271,7 +353,11 @@ func formatDetailField(f requestDetailField, width int, th theme.Theme) string {
 	if avail < 8 {
 		avail = width
 	}
-	segments := wrapLineSegments(valueStyle.Render(val), avail)
+	rendered := valueStyle.Render(val)
+	if note := strings.TrimSpace(f.note); note != "" {
+		rendered += "  " + th.NavigatorSubtitle.Render(note)
+	}
+	segments := wrapLineSegments(rendered, avail)
 	indent := strings.Repeat(" ", visibleWidth(prefix))
 	for i, seg := range segments {
 		if i == 0 {
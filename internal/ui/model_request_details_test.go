@@ -8,6 +8,7 @@ import (
 
 	"github.com/unkn0wn-root/resterm/internal/restfile"
 	"github.com/unkn0wn-root/resterm/internal/theme"
+	"github.com/unkn0wn-root/resterm/internal/vars"
 )
 
 func TestOpenRequestDetailsCapturesFields(t *testing.T) {
@@ -81,3 +82,82 @@ func TestOpenRequestDetailsRequiresNavigatorFocus(t *testing.T) {
 		t.Fatalf("expected details modal to remain closed outside navigator focus")
 	}
 }
+
+func TestRequestDetailsShowVariableSources(t *testing.T) {
+	model := New(Config{
+		EnvironmentName: "dev",
+		EnvironmentSet: vars.EnvironmentSet{
+			"dev": {"host": "env.local", "region": "eu"},
+		},
+	})
+	req := &restfile.Request{
+		Method: "GET",
+		URL:    "https://{{host}}/users",
+		Variables: []restfile.Variable{
+			{Name: "host", Value: "req.local"},
+			{Name: "token", Value: "s3cr3t", Secret: true},
+		},
+	}
+	model.doc = &restfile.Document{
+		Variables: []restfile.Variable{
+			{Name: "host", Value: "file.local"},
+			{Name: "base", Value: "https://{{host}}"},
+		},
+		Requests: []*restfile.Request{req},
+	}
+	model.currentRequest = req
+	_ = model.setFocus(focusRequests)
+
+	model.openRequestDetails()
+
+	body := ansi.Strip(renderDetailFields(model.requestDetailFields, 120, theme.DefaultTheme()))
+	expect := []string{
+		"Variables",
+		"host: req.local  request · shadows file, environment",
+		"base: https://req.local  file",
+		"region: eu  environment",
+		"token: •••  request",
+	}
+	for _, want := range expect {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected details to include %q, got:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "s3cr3t") {
+		t.Fatalf("expected secret to be masked, got:\n%s", body)
+	}
+}
+
+func TestRequestDetailsUseRequestEnvAndKeepDeclaredFileVars(t *testing.T) {
+	model := New(Config{
+		EnvironmentName: "dev",
+		EnvironmentSet: vars.EnvironmentSet{
+			"dev":  {"region": "eu"},
+			"prod": {"region": "us"},
+		},
+	})
+	req := &restfile.Request{
+		Method:   "GET",
+		URL:      "https://{{host}}/{{region}}",
+		Metadata: restfile.RequestMetadata{Env: "prod"},
+	}
+	model.doc = &restfile.Document{
+		Variables: []restfile.Variable{{Name: "host", Value: "file.local"}},
+		Requests:  []*restfile.Request{req},
+	}
+	model.fileVars.set("prod", model.documentRuntimePath(model.doc), "host", "runtime", true)
+	model.currentRequest = req
+	_ = model.setFocus(focusRequests)
+
+	model.openRequestDetails()
+
+	body := ansi.Strip(renderDetailFields(model.requestDetailFields, 120, theme.DefaultTheme()))
+	for _, want := range []string{"host: file.local  file", "region: us  environment"} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected details to include %q, got:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "runtime") {
+		t.Fatalf("expected runtime secret not to replace the declared value, got:\n%s", body)
+	}
+}
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"context"
+
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/vars"
+)
+
+// resolvedRequestPreview renders req the way it would be sent: @path-param
+// placeholders and {{templates}} in the URL, headers and body are expanded,
+// secret values show as the mask. Nothing runs: no scripts, no exec values,
+// no {{= expressions}} and no dynamic values such as {{$uuid}}, which are
+// left as written. A template that does not resolve is left as written too.
+func (m *Model) resolvedRequestPreview(doc *restfile.Document, req *restfile.Request) string {
+	if req == nil {
+		return ""
+	}
+	clone := cloneRequest(req)
+	env := vars.SelectEnv(m.cfg.EnvironmentSet, requestEnv(clone), m.cfg.EnvironmentName)
+	res := m.buildDisplayResolver(context.Background(), doc, clone, env, m.rtsBase(doc, ""), true, nil)
+	_ = applyPathParams(clone, res)
+	_ = m.applyBaseURL(clone, res, env)
+
+	expand := func(raw string) string {
+		out, _ := res.ExpandTemplatesStatic(raw)
+		return out
+	}
+	clone.Method = expand(clone.Method)
+	clone.URL = expand(clone.URL)
+	for name, values := range clone.Headers {
+		for i, value := range values {
+			clone.Headers[name][i] = expand(value)
+		}
+	}
+	if clone.Body.Text != "" {
+		clone.Body.Text = expand(clone.Body.Text)
+	}
+	if gql := clone.Body.GraphQL; gql != nil {
+		cp := *gql
+		cp.Variables = expand(cp.Variables)
+		clone.Body.GraphQL = &cp
+	}
+
+	text := renderRequestText(clone)
+	if !req.Metadata.AllowSensitiveHeaders {
+		text = redactHistoryText(text, m.secretValuesForEnvironment(env, req), false)
+	}
+	return text
+}
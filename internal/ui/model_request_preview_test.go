@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResolvedRequestPreviewExpandsAndMasks(t *testing.T) {
+	content := "@host = api.example.com\n" +
+		"@file-secret token = s3cr3t\n\n" +
+		"# @name create-user\n" +
+		"# @path-param id 42\n" +
+		"POST https://{{host}}/users/{id}\n" +
+		"Authorization: Bearer {{token}}\n" +
+		"X-Trace: {{$uuid}}\n" +
+		"Content-Type: application/json\n\n" +
+		"{\"host\": \"{{host}}\", \"missing\": \"{{nope}}\"}\n"
+	model := newTestModelWithDoc(content)
+	if model.doc == nil || len(model.doc.Requests) != 1 {
+		t.Fatalf("expected one request")
+	}
+	req := model.doc.Requests[0]
+
+	got := model.resolvedRequestPreview(model.doc, req)
+	for _, want := range []string{
+		"POST https://api.example.com/users/42\n",
+		"Authorization: Bearer •••\n",
+		"X-Trace: {{$uuid}}\n",
+		`"host": "api.example.com"`,
+		`"missing": "{{nope}}"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected preview to contain %q, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "s3cr3t") {
+		t.Fatalf("expected secret to be masked, got:\n%s", got)
+	}
+	if !strings.Contains(req.URL, "{{host}}") {
+		t.Fatalf("expected the parsed request to be left alone, got %q", req.URL)
+	}
+}
@@ -1,9 +1,14 @@
 package ui
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/binaryview"
 )
 
 func (m *Model) copyResponseTab() tea.Cmd {
@@ -20,9 +25,33 @@ func (m *Model) copyResponseTab() tea.Cmd {
 	return (&m.editor).copyToClipboard(content, success)
 }
 
-func (m *Model) responseCopyPayload() (string, string, *statusMsg) {
+func (m *Model) copyResponseBody() tea.Cmd {
+	text, format, status := m.responseBodyCopyPayload()
+	if status != nil {
+		return statusCmd(status.level, status.text)
+	}
+	what := "response body"
+	if format != "" {
+		what += " as " + format
+	}
+	success := fmt.Sprintf("Copied %s (%s)", what, formatByteSize(int64(len(text))))
+	return (&m.editor).copyToClipboard(text, success)
+}
+
+func (m *Model) copyRequestResponse() tea.Cmd {
+	text, status := m.requestResponseCopyPayload()
+	if status != nil {
+		return statusCmd(status.level, status.text)
+	}
+	success := fmt.Sprintf("Copied request + response (%s)", formatByteSize(int64(len(text))))
+	return (&m.editor).copyToClipboard(text, success)
+}
+
+// copySource returns the focused pane and its snapshot when there is a
+// response to copy.
+func (m *Model) copySource() (*responsePaneState, *responseSnapshot, *statusMsg) {
 	if m.focus != focusResponse {
-		return "", "", &statusMsg{
+		return nil, nil, &statusMsg{
 			text:  "Focus the response pane to copy its contents",
 			level: statusInfo,
 		}
@@ -30,12 +59,20 @@ func (m *Model) responseCopyPayload() (string, string, *statusMsg) {
 
 	pane := m.focusedPane()
 	if pane == nil {
-		return "", "", &statusMsg{text: "Response pane unavailable", level: statusWarn}
+		return nil, nil, &statusMsg{text: "Response pane unavailable", level: statusWarn}
 	}
 
 	snap := pane.snapshot
 	if snap == nil || !snap.ready {
-		return "", "", &statusMsg{text: "No response available to copy", level: statusWarn}
+		return nil, nil, &statusMsg{text: "No response available to copy", level: statusWarn}
+	}
+	return pane, snap, nil
+}
+
+func (m *Model) responseCopyPayload() (string, string, *statusMsg) {
+	pane, _, status := m.copySource()
+	if status != nil {
+		return "", "", status
 	}
 
 	label, ok := responseCopyTabLabel(pane.activeTab)
@@ -64,3 +101,85 @@ func responseCopyTabLabel(tab responseTab) (string, bool) {
 		return "", false
 	}
 }
+
+// responseBodyCopyPayload returns the body without any tab chrome and
+// names the format when it is not the body itself. The Raw tab copies what
+// its view mode shows (hex dump, base64, or the exact bytes); every other
+// tab copies a pretty-printed rendering.
+func (m *Model) responseBodyCopyPayload() (string, string, *statusMsg) {
+	pane, snap, status := m.copySource()
+	if status != nil {
+		return "", "", status
+	}
+	if len(snap.body) == 0 {
+		return "", "", &statusMsg{text: "Response body is empty", level: statusInfo}
+	}
+	if pane.activeTab != responseTabRaw {
+		return prettyBodyText(snap), "", nil
+	}
+	switch snap.rawMode {
+	case rawViewHex:
+		dump := snap.rawHex
+		if dump == "" {
+			dump = binaryview.HexDump(snap.body, binaryview.HexDumpBytesPerLine)
+		}
+		return withTrailingNewline(dump), "hex", nil
+	case rawViewBase64:
+		lines := snap.rawBase64
+		if lines == "" {
+			lines = binaryview.Base64Lines(snap.body, rawBase64LineWidth)
+		}
+		return withTrailingNewline(lines), "base64", nil
+	default:
+		return string(snap.body), "", nil
+	}
+}
+
+// requestResponseCopyPayload renders the request and response as a single
+// plain-text block suitable for pasting into a ticket.
+func (m *Model) requestResponseCopyPayload() (string, *statusMsg) {
+	_, snap, status := m.copySource()
+	if status != nil {
+		return "", status
+	}
+
+	request := strings.TrimSpace(stripANSIEscape(snap.requestHeaders))
+	if request == "" {
+		request = "<request not captured>"
+	}
+	response := strings.TrimSpace(stripANSIEscape(snap.headers))
+	if response == "" {
+		response = "<no headers>"
+	}
+	body := "<empty>"
+	if len(snap.body) > 0 {
+		body = strings.TrimRight(prettyBodyText(snap), "\n")
+	}
+
+	var b strings.Builder
+	b.WriteString("### Request\n")
+	b.WriteString(request)
+	b.WriteString("\n\n### Response\n")
+	b.WriteString(response)
+	b.WriteString("\n\n")
+	b.WriteString(body)
+	return withTrailingNewline(b.String()), nil
+}
+
+func prettyBodyText(snap *responseSnapshot) string {
+	body := snap.body
+	meta := snap.bodyMeta
+	if meta.Kind == binaryview.KindBinary && !meta.Printable {
+		return string(body)
+	}
+	if decoded, ok, _ := binaryview.DecodeText(body, meta.Charset); ok {
+		body = []byte(decoded)
+	}
+	if strings.Contains(strings.ToLower(snap.contentType), "json") {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, body, "", "  "); err == nil {
+			return withTrailingNewline(buf.String())
+		}
+	}
+	return withTrailingNewline(stripANSIEscape(prettifyBody(body, snap.contentType)))
+}
@@ -151,3 +151,83 @@ func TestCopyResponseTabRequiresFocus(t *testing.T) {
 		t.Fatalf("unexpected status text %q", status.text)
 	}
 }
+
+func TestResponseBodyCopyPayloadByTab(t *testing.T) {
+	snap := &responseSnapshot{
+		pretty:      withTrailingNewline("Status 200 OK\n{\n  \"a\": 1\n}"),
+		raw:         withTrailingNewline("raw"),
+		body:        []byte(`{"a":1}`),
+		contentType: "application/json",
+		ready:       true,
+	}
+
+	model := newModelWithResponseTab(responseTabRaw, snap)
+	text, _, status := model.responseBodyCopyPayload()
+	if status != nil {
+		t.Fatalf("expected nil status, got %+v", status)
+	}
+	if text != `{"a":1}` {
+		t.Fatalf("expected raw body bytes, got %q", text)
+	}
+
+	model = newModelWithResponseTab(responseTabPretty, snap)
+	text, _, status = model.responseBodyCopyPayload()
+	if status != nil {
+		t.Fatalf("expected nil status, got %+v", status)
+	}
+	if text != "{\n  \"a\": 1\n}\n" {
+		t.Fatalf("expected pretty body without chrome, got %q", text)
+	}
+}
+
+func TestResponseBodyCopyPayloadFollowsRawMode(t *testing.T) {
+	snap := &responseSnapshot{
+		body:        []byte("hi\x00"),
+		contentType: "application/octet-stream",
+		ready:       true,
+	}
+	model := newModelWithResponseTab(responseTabRaw, snap)
+	snap = model.pane(responsePanePrimary).snapshot
+
+	snap.rawMode = rawViewHex
+	text, format, status := model.responseBodyCopyPayload()
+	if status != nil {
+		t.Fatalf("expected nil status, got %+v", status)
+	}
+	if format != "hex" || !strings.HasPrefix(text, "00000000  68 69 00") {
+		t.Fatalf("expected hex dump, got %q (%s)", text, format)
+	}
+
+	snap.rawMode = rawViewBase64
+	text, format, _ = model.responseBodyCopyPayload()
+	if format != "base64" || text != "aGkA\n" {
+		t.Fatalf("expected base64 body, got %q (%s)", text, format)
+	}
+
+	snap.rawMode = rawViewText
+	text, format, _ = model.responseBodyCopyPayload()
+	if format != "" || text != "hi\x00" {
+		t.Fatalf("expected exact bytes in text mode, got %q (%s)", text, format)
+	}
+}
+
+func TestRequestResponseCopyPayload(t *testing.T) {
+	snap := &responseSnapshot{
+		headers:        withTrailingNewline("\x1b[1mStatus\x1b[0m 201 Created\nHeaders:\nX-Resp: ok"),
+		requestHeaders: withTrailingNewline("Request: POST https://example.com\nHeaders:\nX-Req: 1"),
+		body:           []byte("done"),
+		contentType:    "text/plain",
+		ready:          true,
+	}
+	model := newModelWithResponseTab(responseTabHeaders, snap)
+
+	text, status := model.requestResponseCopyPayload()
+	if status != nil {
+		t.Fatalf("expected nil status, got %+v", status)
+	}
+	want := "### Request\nRequest: POST https://example.com\nHeaders:\nX-Req: 1\n\n" +
+		"### Response\nStatus 201 Created\nHeaders:\nX-Resp: ok\n\ndone\n"
+	if text != want {
+		t.Fatalf("unexpected bundle:\n%q\nwant:\n%q", text, want)
+	}
+}
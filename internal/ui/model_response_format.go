@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// bodyFormat forces how the Pretty tab renders a body, for servers that
+// send the wrong Content-Type.
+type bodyFormat int
+
+const (
+	bodyFormatAuto bodyFormat = iota
+	bodyFormatJSON
+	bodyFormatXML
+	bodyFormatHTML
+	bodyFormatText
+)
+
+func (f bodyFormat) label() string {
+	switch f {
+	case bodyFormatJSON:
+		return "JSON"
+	case bodyFormatXML:
+		return "XML"
+	case bodyFormatHTML:
+		return "HTML"
+	case bodyFormatText:
+		return "text"
+	default:
+		return "auto"
+	}
+}
+
+func (f bodyFormat) next() bodyFormat {
+	if f >= bodyFormatText {
+		return bodyFormatAuto
+	}
+	return f + 1
+}
+
+// bodyFormatState is the per-pane override. It applies to snap only and
+// falls back to auto when a new response arrives, unless pinned.
+type bodyFormatState struct {
+	format bodyFormat
+	pinned bool
+	snap   *responseSnapshot
+}
+
+func (m *Model) cycleBodyFormat() tea.Cmd {
+	pane := m.focusedPane()
+	snap := m.focusedSnapshot("No response to format")
+	if snap == nil {
+		return nil
+	}
+	st := &pane.bodyFmt
+	if st.snap != snap {
+		st.snap = snap
+		if !st.pinned {
+			st.format = bodyFormatAuto
+		}
+	}
+	st.format = st.format.next()
+	if st.format == bodyFormatAuto {
+		st.pinned = false
+	}
+	pane.setActiveTab(responseTabPretty)
+	pane.invalidateCaches()
+
+	text := fmt.Sprintf("Body format: %s", st.format.label())
+	if st.pinned {
+		text += " (pinned)"
+	}
+	m.setStatusMessage(statusMsg{level: statusInfo, text: text})
+	return m.syncResponsePane(m.responsePaneFocus)
+}
+
+func (m *Model) toggleBodyFormatPin() tea.Cmd {
+	pane := m.focusedPane()
+	if pane == nil {
+		return nil
+	}
+	st := &pane.bodyFmt
+	if st.format == bodyFormatAuto || (st.snap != pane.snapshot && !st.pinned) {
+		m.setStatusMessage(statusMsg{level: statusInfo, text: "No body format override to pin"})
+		return nil
+	}
+	st.pinned = !st.pinned
+	text := fmt.Sprintf("Body format %s unpinned; resets on next response", st.format.label())
+	if st.pinned {
+		text = fmt.Sprintf("Body format %s pinned for new responses", st.format.label())
+	}
+	m.setStatusMessage(statusMsg{level: statusInfo, text: text})
+	return nil
+}
+
+// paneBodyFormat returns the override that applies to snap. An unpinned
+// override is dropped once the pane moves on to a new response.
+func paneBodyFormat(pane *responsePaneState, snap *responseSnapshot) (bodyFormat, bool) {
+	st := &pane.bodyFmt
+	if st.format == bodyFormatAuto || snap == nil || len(snap.body) == 0 {
+		return bodyFormatAuto, false
+	}
+	if st.snap != snap {
+		if !st.pinned {
+			*st = bodyFormatState{}
+			return bodyFormatAuto, false
+		}
+		st.snap = snap
+	}
+	return st.format, true
+}
+
+// paneFormattedBody returns the Pretty content with the pane's override
+// applied. ok is false when detection should be used as-is. Formatting runs
+// in formatBodyCmd; until it lands the tab shows a placeholder.
+func paneFormattedBody(pane *responsePaneState, snap *responseSnapshot) (string, bool) {
+	f, ok := paneBodyFormat(pane, snap)
+	if !ok {
+		return "", false
+	}
+	text, done := snap.bodyFormatted[f]
+	if !done {
+		text = fmt.Sprintf("Formatting body as %s...", f.label())
+	}
+	return joinSections(snap.rawSummary, text), true
+}
+
+// ensureBodyFormat starts formatting the pane's body when its override is
+// not cached on the snapshot yet.
+func (m *Model) ensureBodyFormat(pane *responsePaneState) tea.Cmd {
+	if pane == nil {
+		return nil
+	}
+	snap := pane.snapshot
+	f, ok := paneBodyFormat(pane, snap)
+	if !ok {
+		return nil
+	}
+	if _, done := snap.bodyFormatted[f]; done || snap.bodyFormatting[f] {
+		return nil
+	}
+	if snap.bodyFormatting == nil {
+		snap.bodyFormatting = make(map[bodyFormat]bool)
+	}
+	snap.bodyFormatting[f] = true
+	return formatBodyCmd(m.rt(), snap, f)
+}
+
+func formatBodyCmd(rt *respTasks, snap *responseSnapshot, f bodyFormat) tea.Cmd {
+	body := snap.body
+	return func() tea.Msg {
+		ctx := context.Background()
+		if !rt.fmtSlot(ctx) {
+			return nil
+		}
+		defer rt.fmtRel()
+		return bodyFormattedMsg{snapshot: snap, format: f, text: formatBodyAs(ctx, body, f)}
+	}
+}
+
+func (m *Model) handleBodyFormatted(msg bodyFormattedMsg) tea.Cmd {
+	snap := msg.snapshot
+	if snap == nil {
+		return nil
+	}
+	delete(snap.bodyFormatting, msg.format)
+	if snap.bodyFormatted == nil {
+		snap.bodyFormatted = make(map[bodyFormat]string)
+	}
+	snap.bodyFormatted[msg.format] = msg.text
+	visible := m.forEachSnapshotPane(snap, func(p *responsePaneState) {
+		if p.bodyFmt.format == msg.format {
+			p.invalidateCaches()
+		}
+	})
+	if !visible {
+		return nil
+	}
+	return m.syncResponsePanes()
+}
+
+func formatBodyAs(ctx context.Context, body []byte, f bodyFormat) string {
+	switch f {
+	case bodyFormatJSON:
+		return trimResponseBody(prettifyBodyCtx(ctx, body, "application/json"))
+	case bodyFormatXML:
+		if indented, ok := indentXML(body); ok {
+			body = []byte(indented)
+		}
+		return trimResponseBody(prettifyBodyCtx(ctx, body, "application/xml"))
+	case bodyFormatHTML:
+		return trimResponseBody(prettifyBodyCtx(ctx, body, "text/html"))
+	default:
+		return trimResponseBody(string(body))
+	}
+}
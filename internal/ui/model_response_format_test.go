@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCycleBodyFormatForcesJSONAndResetsOnNewResponse(t *testing.T) {
+	body := []byte(`{"id":1,"name":"rex"}`)
+	snap := &responseSnapshot{
+		pretty:      "Status: 200\n\n" + string(body),
+		rawSummary:  "Status: 200",
+		body:        body,
+		contentType: "text/plain",
+		ready:       true,
+	}
+	model := newModelWithResponseTab(responseTabPretty, snap)
+
+	cmd := model.cycleBodyFormat()
+	content, _ := model.paneContentBaseForTab(responsePanePrimary, responseTabPretty)
+	if !strings.Contains(content, "Formatting body as JSON...") {
+		t.Fatalf("expected placeholder until the format command lands, got %q", content)
+	}
+	applyBodyFormatted(t, model, cmd)
+	content, _ = model.paneContentBaseForTab(responsePanePrimary, responseTabPretty)
+	plain := stripANSIEscape(content)
+	if !strings.Contains(plain, "\n  name: ") && !strings.Contains(plain, "\n  \"name\": ") {
+		t.Fatalf("expected indented JSON in forced view, got %q", plain)
+	}
+	if !strings.HasPrefix(plain, "Status: 200") {
+		t.Fatalf("expected summary to be kept, got %q", plain)
+	}
+
+	next := &responseSnapshot{pretty: "fresh", body: []byte("x"), ready: true}
+	model.pane(responsePanePrimary).snapshot = next
+	content, _ = model.paneContentBaseForTab(responsePanePrimary, responseTabPretty)
+	if content != "fresh" {
+		t.Fatalf("expected override to reset on new response, got %q", content)
+	}
+}
+
+func TestPinnedBodyFormatSurvivesNewResponse(t *testing.T) {
+	snap := &responseSnapshot{pretty: "old", body: []byte("<a><b>1</b></a>"), ready: true}
+	model := newModelWithResponseTab(responseTabPretty, snap)
+
+	model.cycleBodyFormat()
+	applyBodyFormatted(t, model, model.cycleBodyFormat())
+	model.toggleBodyFormatPin()
+	pane := model.pane(responsePanePrimary)
+	if pane.bodyFmt.format != bodyFormatXML || !pane.bodyFmt.pinned {
+		t.Fatalf("expected pinned XML override, got %+v", pane.bodyFmt)
+	}
+
+	pane.snapshot = &responseSnapshot{pretty: "new", body: []byte("<c><d>2</d></c>"), ready: true}
+	applyBodyFormatted(t, model, model.syncResponsePane(responsePanePrimary))
+	content, _ := model.paneContentBaseForTab(responsePanePrimary, responseTabPretty)
+	plain := stripANSIEscape(content)
+	if !strings.Contains(plain, "\n  <d>2</d>") {
+		t.Fatalf("expected pinned XML formatting on new response, got %q", plain)
+	}
+}
+
+func TestBodyFormatIsCachedOnSnapshot(t *testing.T) {
+	model := newModelWithResponseTab(responseTabPretty, &responseSnapshot{
+		pretty: "raw",
+		body:   []byte(`{"a":1}`),
+	})
+
+	applyBodyFormatted(t, model, model.cycleBodyFormat())
+	snap := model.pane(responsePanePrimary).snapshot
+	if _, ok := snap.bodyFormatted[bodyFormatJSON]; !ok {
+		t.Fatalf("expected JSON text cached on the snapshot")
+	}
+	if cmd := model.ensureBodyFormat(model.pane(responsePanePrimary)); cmd != nil {
+		t.Fatalf("expected no second format command once cached")
+	}
+}
+
+// applyBodyFormatted runs cmd and hands its bodyFormattedMsg to the model.
+func applyBodyFormatted(t *testing.T, model *Model, cmd tea.Cmd) {
+	t.Helper()
+	for _, msg := range runCmdTree(cmd) {
+		if formatted, ok := msg.(bodyFormattedMsg); ok {
+			model.handleBodyFormatted(formatted)
+			return
+		}
+	}
+	t.Fatalf("expected a body format command")
+}
@@ -15,10 +15,9 @@ func (m *Model) cycleRawViewMode() tea.Cmd {
 		return nil
 	}
 
-	meta := ensureSnapshotMeta(snap)
-	sz := len(snap.body)
-	snap.rawMode = clampRawViewMode(meta, sz, snap.rawMode)
-	next := nextRawViewMode(meta, sz, snap.rawMode)
+	modes := snapshotRawModes(snap)
+	snap.rawMode = clampRawMode(modes, snap.rawMode)
+	next := nextRawMode(modes, snap.rawMode)
 	return m.applyRawMode(snap, next, "")
 }
 
@@ -46,8 +45,7 @@ func (m *Model) applyRawMode(snap *responseSnapshot, mode rawViewMode, msg strin
 		return nil
 	}
 
-	meta := ensureSnapshotMeta(snap)
-	mode = clampRawViewMode(meta, len(snap.body), mode)
+	mode = clampRawMode(snapshotRawModes(snap), mode)
 	if needsRawAsync(snap, mode) {
 		return m.loadRawDumpAsync(snap, mode)
 	}
@@ -93,8 +91,7 @@ func (m *Model) loadRawDumpAsync(snap *responseSnapshot, mode rawViewMode) tea.C
 		return nil
 	}
 
-	meta := ensureSnapshotMeta(snap)
-	mode = clampRawViewMode(meta, len(snap.body), mode)
+	mode = clampRawMode(snapshotRawModes(snap), mode)
 
 	if len(snap.body) == 0 || !needsRawAsync(snap, mode) {
 		return m.setRawMode(snap, mode, rawDumpLoadedMessage(mode))
@@ -234,7 +231,7 @@ func applyRawViewMode(snapshot *responseSnapshot, mode rawViewMode) {
 	}
 	meta := ensureSnapshotMeta(snapshot)
 	sz := len(snapshot.body)
-	mode = clampRawViewMode(meta, sz, mode)
+	mode = clampRawMode(snapshotRawModes(snapshot), mode)
 	if snapshot.rawText == "" && len(snapshot.body) > 0 &&
 		(meta.Kind == binaryview.KindText || meta.Printable) {
 		snapshot.rawText = formatRawBody(snapshot.body, snapshot.contentType)
@@ -252,6 +249,8 @@ func applyRawViewMode(snapshot *responseSnapshot, mode rawViewMode) {
 	switch mode {
 	case rawViewSummary:
 		body = rawSum(meta, sz)
+	case rawViewWire:
+		body = snapshot.rawWire
 	case rawViewHex:
 		if snapshot.rawHex != "" {
 			body = snapshot.rawHex
@@ -351,7 +350,21 @@ func ensureSnapshotMeta(snapshot *responseSnapshot) binaryview.Meta {
 }
 
 func clampRawViewMode(meta binaryview.Meta, sz int, mode rawViewMode) rawViewMode {
-	modes := allowedRawViewModes(meta, sz)
+	return clampRawMode(allowedRawViewModes(meta, sz), mode)
+}
+
+// snapshotRawModes lists the Raw tab modes for a response, adding the wire
+// view when the request ran with capture-wire.
+func snapshotRawModes(snap *responseSnapshot) []rawViewMode {
+	meta := ensureSnapshotMeta(snap)
+	modes := allowedRawViewModes(meta, len(snap.body))
+	if snap.rawWire != "" {
+		modes = append(modes, rawViewWire)
+	}
+	return modes
+}
+
+func clampRawMode(modes []rawViewMode, mode rawViewMode) rawViewMode {
 	for _, m := range modes {
 		if m == mode {
 			return mode
@@ -363,12 +376,11 @@ func clampRawViewMode(meta binaryview.Meta, sz int, mode rawViewMode) rawViewMod
 	return modes[0]
 }
 
-func nextRawViewMode(meta binaryview.Meta, sz int, current rawViewMode) rawViewMode {
-	modes := allowedRawViewModes(meta, sz)
+func nextRawMode(modes []rawViewMode, current rawViewMode) rawViewMode {
 	if len(modes) == 0 {
 		return current
 	}
-	current = clampRawViewMode(meta, sz, current)
+	current = clampRawMode(modes, current)
 	idx := 0
 	for i, m := range modes {
 		if m == current {
@@ -444,3 +444,60 @@ func TestHeavyHexGeneratedOnDemand(t *testing.T) {
 		t.Fatalf("expected summary to persist in hex view")
 	}
 }
+
+func TestCycleRawViewIncludesWireWhenCaptured(t *testing.T) {
+	body := []byte("hello world")
+	snap := &responseSnapshot{
+		rawText:     "hello world",
+		rawMode:     rawViewText,
+		body:        body,
+		contentType: "text/plain",
+		rawWire:     "HTTP/1.1 200 OK\n\n── chunk 1 · 11 bytes (0xb) ──\nhello world\n",
+		ready:       true,
+	}
+	model := newModelWithResponseTab(responseTabRaw, snap)
+	snapshot := model.pane(responsePanePrimary).snapshot
+
+	for _, want := range []rawViewMode{rawViewHex, rawViewBase64, rawViewWire, rawViewText} {
+		model.cycleRawViewMode()
+		if snapshot.rawMode != want {
+			t.Fatalf("expected %s mode, got %s", want.label(), snapshot.rawMode.label())
+		}
+		if want == rawViewWire && !strings.Contains(snapshot.raw, "chunk 1 · 11 bytes") {
+			t.Fatalf("expected wire view in raw content, got %q", snapshot.raw)
+		}
+	}
+
+	snapshot.rawWire = ""
+	applyRawViewMode(snapshot, rawViewWire)
+	if snapshot.rawMode != rawViewText {
+		t.Fatalf("expected wire mode to clamp without a capture, got %s", snapshot.rawMode.label())
+	}
+}
+
+func TestFormatWireAnnotatesChunks(t *testing.T) {
+	wire := "HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\nTrailer: X-Sum\r\n\r\n" +
+		"6\r\nhello \r\n5;ext=1\r\nworld\r\n0\r\nX-Sum: 11\r\n\r\n"
+	got := formatWire([]byte(wire), false)
+	for _, want := range []string{
+		"HTTP/1.1 200 OK\nTransfer-Encoding: chunked\n",
+		"── chunk 1 · 6 bytes (0x6) ──\nhello \n",
+		"── chunk 2 · 5 bytes (0x5) ;ext=1 ──\nworld\n",
+		"── last chunk (0) ──\nX-Sum: 11\n",
+		"── 2 chunk(s), 11 bytes ──",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in wire view, got:\n%s", want, got)
+		}
+	}
+
+	bad := formatWire([]byte("HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\nzz\r\nx"), true)
+	if !strings.Contains(bad, `bad chunk size "zz"`) || !strings.Contains(bad, "truncated") {
+		t.Fatalf("expected parse failure and truncation notes, got:\n%s", bad)
+	}
+
+	plain := formatWire([]byte("HTTP/1.1 200 OK\r\nContent-Length: 2\r\n\r\nok"), false)
+	if !strings.Contains(plain, "── body · 2 bytes ──\nok\n") {
+		t.Fatalf("expected body marker for non-chunked response, got:\n%s", plain)
+	}
+}
@@ -1,6 +1,10 @@
 package ui
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
 
 func (m *Model) scrollShortcutToEdge(top bool) (tea.Cmd, bool) {
 	switch m.focus {
@@ -105,3 +109,74 @@ func (m *Model) scrollResponseViewport(pane *responsePaneState, scrollFn func())
 	}
 	return nil
 }
+
+type respSection int
+
+const (
+	respSectionStatus respSection = iota
+	respSectionHeaders
+	respSectionBody
+)
+
+// jumpResponseSection scrolls the focused pane to the status line, the
+// header block or the body. Every content tab starts with the same summary,
+// so the section after it is the body on Pretty/Raw and the headers on the
+// Headers tab; asking for headers from Pretty/Raw switches tabs first.
+func (m *Model) jumpResponseSection(sec respSection) tea.Cmd {
+	if m.focus != focusResponse {
+		return nil
+	}
+	pane := m.focusedPane()
+	if !isScrollableResponsePane(pane) {
+		return nil
+	}
+	if pane.snapshot == nil || !pane.snapshot.ready {
+		return statusCmd(statusWarn, "No response available")
+	}
+
+	var cmds []tea.Cmd
+	switch sec {
+	case respSectionHeaders:
+		if pane.activeTab != responseTabHeaders {
+			pane.setActiveTab(responseTabHeaders)
+			cmds = append(cmds, m.syncResponsePane(m.responsePaneFocus))
+		}
+	case respSectionBody:
+		if pane.activeTab == responseTabHeaders {
+			pane.setActiveTab(responseTabRaw)
+			cmds = append(cmds, m.syncResponsePane(m.responsePaneFocus))
+		}
+	}
+
+	line := 0
+	if sec != respSectionStatus {
+		line = summarySectionLine(pane.snapshot.rawSummary)
+		if line < 0 {
+			return batchCmds(append(cmds, statusCmd(statusInfo, "Response has no summary")))
+		}
+	}
+
+	// Before the wrap cache exists the logical line is the best row estimate.
+	cache, ok := m.selCache(pane, pane.activeTab)
+	row := line
+	if ok {
+		row = cursorRowForLine(cache, line)
+	}
+	pane.viewport.SetYOffset(row)
+	pane.setCurrPosition()
+	if ok && pane.cursor.on && m.setRespCursor(pane, line) {
+		cmds = append(cmds, m.syncResponsePane(m.responsePaneFocus))
+	}
+	return batchCmds(cmds)
+}
+
+// summarySectionLine returns the line where the section following the
+// summary starts in a tab built with joinSections(summary, ...), or -1 when
+// there is no summary.
+func summarySectionLine(summary string) int {
+	summary = trimSection(summary)
+	if strings.TrimSpace(summary) == "" {
+		return -1
+	}
+	return strings.Count(summary, "\n") + 2
+}
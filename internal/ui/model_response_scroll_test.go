@@ -294,3 +294,50 @@ func TestResponseScrollBringsCursorIntoView(t *testing.T) {
 		t.Fatalf("expected cursor to snap into view at line %d, got %d", expected, pane.cursor.line)
 	}
 }
+
+func TestJumpResponseSections(t *testing.T) {
+	summary := "Status: 200 OK\nDuration: 12ms"
+	body := strings.Repeat("body\n", 40)
+	model := newModelWithResponseTab(responseTabRaw, &responseSnapshot{
+		rawSummary: summary,
+		raw:        joinSections(summary, body),
+		rawText:    body,
+		headers:    joinSections(summary, "Headers:\n"+strings.Repeat("X-Test: ok\n", 40)),
+		ready:      true,
+	})
+	pane := model.pane(responsePanePrimary)
+	pane.viewport.Height = 5
+	model.syncResponsePane(responsePanePrimary)
+
+	model.jumpResponseSection(respSectionBody)
+	if pane.viewport.YOffset != 3 {
+		t.Fatalf("expected body jump to offset 3, got %d", pane.viewport.YOffset)
+	}
+
+	model.jumpResponseSection(respSectionStatus)
+	if pane.viewport.YOffset != 0 {
+		t.Fatalf("expected status jump to offset 0, got %d", pane.viewport.YOffset)
+	}
+
+	model.jumpResponseSection(respSectionHeaders)
+	if pane.activeTab != responseTabHeaders {
+		t.Fatalf("expected headers jump to switch tabs, got %v", pane.activeTab)
+	}
+	if pane.viewport.YOffset != 3 {
+		t.Fatalf("expected headers jump to offset 3, got %d", pane.viewport.YOffset)
+	}
+
+	model.jumpResponseSection(respSectionBody)
+	if pane.activeTab != responseTabRaw {
+		t.Fatalf("expected body jump to return to Raw, got %v", pane.activeTab)
+	}
+}
+
+func TestSummarySectionLine(t *testing.T) {
+	if got := summarySectionLine(""); got != -1 {
+		t.Fatalf("expected -1 without summary, got %d", got)
+	}
+	if got := summarySectionLine("gRPC svc/Method - OK\n"); got != 2 {
+		t.Fatalf("expected gRPC message at line 2, got %d", got)
+	}
+}
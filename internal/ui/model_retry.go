@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+// Status codes retried when @retry does not list its own.
+var defaultRetryStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+const maxRetryBackoffShift = 10
+
+// executeWithRetry re-sends a request according to spec. Waits never extend
+// past the context deadline, so the request timeout caps the whole run; when
+// the next wait would overrun it the last outcome is returned as-is.
+func executeWithRetry(
+	ctx context.Context,
+	spec *restfile.RetrySpec,
+	send func() (*httpclient.Response, error),
+) (*httpclient.Response, error) {
+	resp, err := send()
+	if spec == nil {
+		return resp, err
+	}
+	for attempt := 0; attempt < spec.Count; attempt++ {
+		if !shouldRetry(spec, resp, err) {
+			return resp, err
+		}
+		wait := retryWait(spec, resp, attempt, time.Now())
+		if !sleepWithin(ctx, wait) {
+			return resp, err
+		}
+		resp, err = send()
+	}
+	return resp, err
+}
+
+func shouldRetry(spec *restfile.RetrySpec, resp *httpclient.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		// Transport failures are only retried by the catch-all form.
+		return len(spec.On) == 0
+	}
+	if resp == nil {
+		return false
+	}
+	codes := spec.On
+	if len(codes) == 0 {
+		codes = defaultRetryStatuses
+	}
+	return slices.Contains(codes, resp.StatusCode)
+}
+
+func retryWait(
+	spec *restfile.RetrySpec,
+	resp *httpclient.Response,
+	attempt int,
+	now time.Time,
+) time.Duration {
+	wait := spec.Delay << min(attempt, maxRetryBackoffShift)
+	if spec.RespectRetryAfter && resp != nil && resp.Headers != nil {
+		if after, ok := parseRetryAfter(resp.Headers.Get("Retry-After"), now); ok {
+			wait = after
+		}
+	}
+	if spec.Jitter && wait > 0 {
+		wait += rand.N(wait/2 + 1)
+	}
+	return wait
+}
+
+// parseRetryAfter accepts both forms allowed by RFC 9110: delay seconds and
+// an HTTP date.
+func parseRetryAfter(raw string, now time.Time) (time.Duration, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(raw); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	at, err := http.ParseTime(raw)
+	if err != nil {
+		return 0, false
+	}
+	wait := at.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
+
+func sleepWithin(ctx context.Context, wait time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+		return false
+	}
+	if wait <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestExecuteWithRetryStopsOnSuccess(t *testing.T) {
+	spec := &restfile.RetrySpec{Count: 3, Delay: time.Millisecond, On: []int{503}}
+	calls := 0
+	resp, err := executeWithRetry(
+		context.Background(),
+		spec,
+		func() (*httpclient.Response, error) {
+			calls++
+			if calls < 3 {
+				return &httpclient.Response{StatusCode: http.StatusServiceUnavailable}, nil
+			}
+			return &httpclient.Response{StatusCode: http.StatusOK}, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Fatalf("expected success after 3 calls, got status %d after %d", resp.StatusCode, calls)
+	}
+}
+
+func TestExecuteWithRetrySkipsUnlistedStatus(t *testing.T) {
+	spec := &restfile.RetrySpec{Count: 3, Delay: time.Millisecond, On: []int{429}}
+	calls := 0
+	_, _ = executeWithRetry(context.Background(), spec, func() (*httpclient.Response, error) {
+		calls++
+		return &httpclient.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	if calls != 1 {
+		t.Fatalf("expected a single call, got %d", calls)
+	}
+
+	calls = 0
+	_, _ = executeWithRetry(context.Background(), spec, func() (*httpclient.Response, error) {
+		calls++
+		return nil, errors.New("connection refused")
+	})
+	if calls != 1 {
+		t.Fatalf("expected transport errors to skip explicit status retries, got %d calls", calls)
+	}
+}
+
+func TestExecuteWithRetryStopsAtDeadline(t *testing.T) {
+	spec := &restfile.RetrySpec{Count: 5, RespectRetryAfter: true}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	calls := 0
+	resp, _ := executeWithRetry(ctx, spec, func() (*httpclient.Response, error) {
+		calls++
+		h := http.Header{}
+		h.Set("Retry-After", "30")
+		return &httpclient.Response{StatusCode: http.StatusTooManyRequests, Headers: h}, nil
+	})
+	if calls != 1 {
+		t.Fatalf("expected retry to stop when Retry-After exceeds the deadline, got %d", calls)
+	}
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected last response to be returned")
+	}
+}
+
+func TestRetryWaitHonoursRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	spec := &restfile.RetrySpec{Delay: time.Second, RespectRetryAfter: true}
+
+	h := http.Header{}
+	h.Set("Retry-After", "7")
+	resp := &httpclient.Response{Headers: h}
+	if got := retryWait(spec, resp, 0, now); got != 7*time.Second {
+		t.Fatalf("expected 7s from seconds form, got %s", got)
+	}
+
+	h.Set("Retry-After", now.Add(12*time.Second).Format(http.TimeFormat))
+	if got := retryWait(spec, resp, 0, now); got != 12*time.Second {
+		t.Fatalf("expected 12s from date form, got %s", got)
+	}
+
+	h.Del("Retry-After")
+	if got := retryWait(spec, resp, 2, now); got != 4*time.Second {
+		t.Fatalf("expected exponential backoff of 4s, got %s", got)
+	}
+
+	spec.Jitter = true
+	for range 20 {
+		got := retryWait(spec, resp, 0, now)
+		if got < time.Second || got > 1500*time.Millisecond {
+			t.Fatalf("jittered wait out of range: %s", got)
+		}
+	}
+}
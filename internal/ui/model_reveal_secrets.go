@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// revealSecretsTimeout bounds how long secret values stay visible after the
+// reveal toggle so a forgotten toggle does not leave tokens on screen.
+const revealSecretsTimeout = 30 * time.Second
+
+type revealSecretsExpiredMsg struct {
+	seq int
+}
+
+// toggleRevealSecrets shows secret values in the globals summary and the
+// request details panel until toggled again or revealSecretsTimeout passes.
+// It only changes what is drawn; history and logs keep their redaction.
+func (m *Model) toggleRevealSecrets() tea.Cmd {
+	m.revealSecretsSeq++
+	if m.revealSecrets {
+		m.hideSecrets("Secrets hidden")
+		return nil
+	}
+	m.revealSecrets = true
+	m.refreshRequestDetails()
+	m.setStatusMessage(statusMsg{
+		text: fmt.Sprintf(
+			"Secrets revealed for %s; toggle again to hide",
+			revealSecretsTimeout,
+		),
+		level: statusWarn,
+	})
+	seq := m.revealSecretsSeq
+	return tea.Tick(revealSecretsTimeout, func(time.Time) tea.Msg {
+		return revealSecretsExpiredMsg{seq: seq}
+	})
+}
+
+func (m *Model) handleRevealSecretsExpired(msg revealSecretsExpiredMsg) {
+	if msg.seq != m.revealSecretsSeq || !m.revealSecrets {
+		return
+	}
+	m.hideSecrets("Secrets hidden again")
+}
+
+func (m *Model) hideSecrets(text string) {
+	m.revealSecrets = false
+	m.refreshRequestDetails()
+	m.setStatusMessage(statusMsg{text: text, level: statusInfo})
+}
+
+// refreshRequestDetails rebuilds an open request details panel so its
+// variable values follow the reveal toggle.
+func (m *Model) refreshRequestDetails() {
+	if !m.showRequestDetails {
+		return
+	}
+	req, doc, path := m.requestDetailContext()
+	if req == nil {
+		return
+	}
+	m.requestDetailFields = m.buildRequestDetailFields(req, doc, path)
+}
+
+// displaySecret masks value for on-screen summaries unless secrets are
+// currently revealed. Anything written to disk must use maskSecret.
+func (m *Model) displaySecret(value string, secret bool) string {
+	if m.revealSecrets {
+		return value
+	}
+	return maskSecret(value, secret)
+}
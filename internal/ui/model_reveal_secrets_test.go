@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRevealSecretsTogglesGlobalSummary(t *testing.T) {
+	model := Model{
+		cfg:     Config{EnvironmentName: "dev"},
+		globals: newGlobalStore(),
+	}
+	model.globals.set("dev", "token", "secretValue", true)
+
+	if got := model.buildGlobalSummary(); got != "Globals: token=•••" {
+		t.Fatalf("expected masked summary, got %q", got)
+	}
+
+	cmd := model.toggleRevealSecrets()
+	if cmd == nil {
+		t.Fatalf("expected expiry command when revealing")
+	}
+	if got := model.buildGlobalSummary(); got != "Globals: token=secretValue" {
+		t.Fatalf("expected revealed summary, got %q", got)
+	}
+	if model.statusMessage.level != statusWarn {
+		t.Fatalf("expected warning status, got %v", model.statusMessage.level)
+	}
+
+	stale := revealSecretsExpiredMsg{seq: model.revealSecretsSeq - 1}
+	model.handleRevealSecretsExpired(stale)
+	if !model.revealSecrets {
+		t.Fatalf("expected stale expiry to be ignored")
+	}
+
+	model.handleRevealSecretsExpired(revealSecretsExpiredMsg{seq: model.revealSecretsSeq})
+	if model.revealSecrets {
+		t.Fatalf("expected secrets hidden after expiry")
+	}
+	if got := model.buildGlobalSummary(); !strings.Contains(got, "•••") {
+		t.Fatalf("expected masked summary after expiry, got %q", got)
+	}
+}
+
+func TestRevealSecretsToggleOffInvalidatesTimer(t *testing.T) {
+	model := Model{
+		cfg:     Config{EnvironmentName: "dev"},
+		globals: newGlobalStore(),
+	}
+	model.toggleRevealSecrets()
+	seq := model.revealSecretsSeq
+	if cmd := model.toggleRevealSecrets(); cmd != nil {
+		t.Fatalf("expected no command when hiding")
+	}
+	if model.revealSecrets {
+		t.Fatalf("expected secrets hidden")
+	}
+	model.toggleRevealSecrets()
+	model.handleRevealSecretsExpired(revealSecretsExpiredMsg{seq: seq})
+	if !model.revealSecrets {
+		t.Fatalf("expected old timer not to hide a newer reveal")
+	}
+}
@@ -68,6 +68,7 @@ func (m *Model) submitSearchPrompt() tea.Cmd {
 	}
 	m.searchInput.SetValue(query)
 	m.closeSearchPrompt()
+	m.searchPreviewed = false
 
 	switch m.searchTarget {
 	case searchTargetResponse:
@@ -85,6 +86,38 @@ func (m *Model) prepareEditorSearchContext() {
 	if strings.TrimSpace(m.searchInput.Value()) == "" {
 		m.searchInput.SetValue(m.editor.search.query)
 	}
+	m.searchOrigin = m.editor.caretPosition().Offset
+	m.searchPrevious = m.editor.search
+	m.searchPreviewed = false
+}
+
+// previewEditorSearch jumps to matches while the editor search prompt is
+// still open. The caret always starts from where it was when the prompt
+// opened so each keystroke searches the same stretch of the buffer.
+func (m *Model) previewEditorSearch() tea.Cmd {
+	if !m.showSearchPrompt || m.searchTarget != searchTargetEditor {
+		return nil
+	}
+	updated, cmd := m.editor.PreviewSearch(
+		m.searchInput.Value(),
+		m.searchIsRegex,
+		m.searchOrigin,
+	)
+	m.editor = updated
+	m.searchPreviewed = true
+	return cmd
+}
+
+// cancelEditorSearchPreview undoes a live preview when the prompt is
+// dismissed without confirming the query.
+func (m *Model) cancelEditorSearchPreview() tea.Cmd {
+	if m.searchTarget != searchTargetEditor || !m.searchPreviewed {
+		return nil
+	}
+	m.searchPreviewed = false
+	updated, cmd := m.editor.RestoreSearch(m.searchPrevious, m.searchOrigin)
+	m.editor = updated
+	return cmd
 }
 
 func (m *Model) responseSearchContent(
@@ -167,7 +200,7 @@ func (m *Model) applyResponseSearch(query string, isRegex bool) tea.Cmd {
 		return status
 	}
 
-	if err := pane.search.computeMatches(wrapped); err != nil {
+	if err := m.computePaneSearch(pane, tab, width, wrapped); err != nil {
 		pane.search.invalidate()
 		status := statusCmd(statusError, fmt.Sprintf("Invalid regex: %v", err))
 		if syncCmd := m.syncResponsePane(paneID); syncCmd != nil {
@@ -178,7 +211,11 @@ func (m *Model) applyResponseSearch(query string, isRegex bool) tea.Cmd {
 
 	if len(pane.search.matches) == 0 {
 		pane.search.active = false
-		status := statusCmd(statusWarn, fmt.Sprintf("No matches for %q", query))
+		text := fmt.Sprintf("No matches for %q", query)
+		if pane.search.jsonPath {
+			text = jsonPathMissText(query, tab)
+		}
+		status := statusCmd(statusWarn, text)
 		if syncCmd := m.syncResponsePane(paneID); syncCmd != nil {
 			return tea.Batch(syncCmd, status)
 		}
@@ -189,10 +226,11 @@ func (m *Model) applyResponseSearch(query string, isRegex bool) tea.Cmd {
 	pane.search.index = 0
 	match := pane.search.matches[pane.search.index]
 	ensureResponseMatchVisible(&pane.viewport, wrapped, match)
-	status := statusCmd(
-		statusInfo,
-		fmt.Sprintf("Match %d/%d for %q", pane.search.index+1, len(pane.search.matches), query),
-	)
+	text := fmt.Sprintf("Match %d/%d for %q", pane.search.index+1, len(pane.search.matches), query)
+	if pane.search.jsonPath {
+		text = "Jumped to " + query
+	}
+	status := statusCmd(statusInfo, text)
 	if syncCmd := m.syncResponsePane(paneID); syncCmd != nil {
 		return tea.Batch(syncCmd, status)
 	}
@@ -233,7 +271,7 @@ func (m *Model) advanceResponseSearch() tea.Cmd {
 	if pane.search.needsRefresh(snapshotID, cacheKey, width) {
 		prevIndex := pane.search.index
 		pane.search.prepare(pane.search.query, pane.search.isRegex, cacheKey, snapshotID, width)
-		if err := pane.search.computeMatches(wrapped); err != nil {
+		if err := m.computePaneSearch(pane, tab, width, wrapped); err != nil {
 			pane.search.invalidate()
 			return statusCmd(statusError, fmt.Sprintf("Invalid regex: %v", err))
 		}
@@ -316,7 +354,7 @@ func (m *Model) retreatResponseSearch() tea.Cmd {
 	if pane.search.needsRefresh(snapshotID, cacheKey, width) {
 		prevIndex := pane.search.index
 		pane.search.prepare(pane.search.query, pane.search.isRegex, cacheKey, snapshotID, width)
-		if err := pane.search.computeMatches(wrapped); err != nil {
+		if err := m.computePaneSearch(pane, tab, width, wrapped); err != nil {
 			pane.search.invalidate()
 			return statusCmd(statusError, fmt.Sprintf("Invalid regex: %v", err))
 		}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"strconv"
 	"strings"
 	"testing"
 
@@ -103,3 +104,114 @@ func TestClearResponseSearchOnEsc(t *testing.T) {
 		)
 	}
 }
+
+func TestApplyResponseSearchJSONPathJumpsToKey(t *testing.T) {
+	model := New(Config{})
+	model.responsePaneFocus = responsePanePrimary
+	model.searchResponsePane = responsePanePrimary
+	pane := model.pane(responsePanePrimary)
+	if pane == nil {
+		t.Fatal("expected response pane to be available")
+	}
+	var items []string
+	for i := 0; i < 30; i++ {
+		items = append(items, `{"id":`+strconv.Itoa(i)+`}`)
+	}
+	body := []byte(`{"items":[` + strings.Join(items, ",") + `],"meta":{"next page":"abc"}}`)
+	pane.viewport.Width = 80
+	pane.viewport.Height = 6
+	pane.snapshot = &responseSnapshot{
+		id:          "snap-json",
+		pretty:      joinSections("Status: 200 OK", prettifyBody(body, "application/json")),
+		body:        body,
+		contentType: "application/json",
+		ready:       true,
+	}
+	pane.activeTab = responseTabPretty
+
+	status := statusFromCmd(t, model.applyResponseSearch(`$.items[25].id`, false))
+	if status == nil || status.level != statusInfo {
+		t.Fatalf("expected info status, got %+v", status)
+	}
+	if len(pane.search.matches) != 1 {
+		t.Fatalf("expected a single path match, got %d", len(pane.search.matches))
+	}
+	_, _, wrapped := model.responseSearchContent(responsePanePrimary, responseTabPretty, 80)
+	runes := []rune(wrapped)
+	match := pane.search.matches[0]
+	if got := string(runes[match.start:match.end]); got != "id" {
+		t.Fatalf("expected match on key, got %q", got)
+	}
+	line := strings.Count(string(runes[:match.start]), "\n")
+	if line < pane.viewport.YOffset || line >= pane.viewport.YOffset+pane.viewport.Height {
+		t.Fatalf("expected line %d visible at offset %d", line, pane.viewport.YOffset)
+	}
+	plainLine := strings.Split(stripANSIEscape(wrapped), "\n")[line]
+	if strings.TrimSpace(plainLine) != "id: 25" {
+		t.Fatalf("expected id 25 line, got %q", plainLine)
+	}
+
+	model.applyResponseSearch(`$.meta["next page"]`, false)
+	if len(pane.search.matches) != 1 {
+		t.Fatalf("expected quoted key to resolve")
+	}
+
+	status = statusFromCmd(t, model.applyResponseSearch(`$.items[99]`, false))
+	if status == nil || status.level != statusWarn || status.text != "Path not found: $.items[99]" {
+		t.Fatalf("expected path not found warning, got %+v", status)
+	}
+}
+
+func TestEditorSearchPromptPreviewsWhileTyping(t *testing.T) {
+	model := newTestModelWithDoc("GET https://example.com/one\nX-Id: two\n# two more\n")
+	_ = model.setFocus(focusEditor)
+	model.searchInput.SetValue("")
+	model.openSearchPrompt()
+	model.searchJustOpened = false
+
+	for _, key := range []string{"t", "w", "o"} {
+		updated, _ := model.Update(keyMsgFor(key))
+		next := updated.(Model)
+		model = &next
+	}
+	if !model.showSearchPrompt {
+		t.Fatalf("expected prompt to stay open while typing")
+	}
+	if got := len(model.editor.search.matches); got != 2 {
+		t.Fatalf("expected 2 live matches, got %d", got)
+	}
+	if pos := model.editor.caretPosition(); pos.Line != 1 || pos.Column != 6 {
+		t.Fatalf("expected caret on first match, got line %d column %d", pos.Line, pos.Column)
+	}
+	if got := model.editorSearchCount(); got != "1/2" {
+		t.Fatalf("expected match count 1/2, got %q", got)
+	}
+
+	model.searchInput.SetValue("t.o")
+	model.previewEditorSearch()
+	if got := len(model.editor.search.matches); got != 0 {
+		t.Fatalf("expected literal search to miss, got %d", got)
+	}
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	next := updated.(Model)
+	model = &next
+	if !model.searchIsRegex || len(model.editor.search.matches) != 2 {
+		t.Fatalf(
+			"expected regex toggle to re-run the preview, got %d",
+			len(model.editor.search.matches),
+		)
+	}
+
+	updated, _ = model.Update(keyMsgFor("esc"))
+	next = updated.(Model)
+	model = &next
+	if model.showSearchPrompt {
+		t.Fatalf("expected prompt to close")
+	}
+	if pos := model.editor.caretPosition(); pos.Line != 0 || pos.Column != 0 {
+		t.Fatalf("expected caret restored, got line %d column %d", pos.Line, pos.Column)
+	}
+	if model.editor.search.query != "" || model.editor.search.active {
+		t.Fatalf("expected previous search restored, got %+v", model.editor.search)
+	}
+}
@@ -44,12 +44,29 @@ func (m *Model) attachStreamSession(session *stream.Session) {
 	id := session.ID()
 	ls := newLiveSession(id, m.streamMaxEvents)
 	ls.kind = session.Kind()
+	if buf := streamBuffer(m.sessionRequests[id]); buf.Max > 0 {
+		ls.maxEvents = buf.Max
+		ls.dropNewest = buf.DropNewest
+	}
 	m.liveSessions[id] = ls
 	m.sessionHandles[id] = session
 	go m.runStreamSession(session)
 	m.emitStreamMsg(streamReadyMsg{sessionID: id})
 }
 
+func streamBuffer(req *restfile.Request) restfile.StreamBuffer {
+	switch {
+	case req == nil:
+		return restfile.StreamBuffer{}
+	case req.SSE != nil:
+		return req.SSE.Options.Buffer
+	case req.WebSocket != nil:
+		return req.WebSocket.Options.Buffer
+	default:
+		return restfile.StreamBuffer{}
+	}
+}
+
 func (m *Model) attachGRPCSession(session *stream.Session, req *restfile.Request) {
 	if session == nil {
 		return
@@ -210,9 +227,31 @@ func (m *Model) handleStreamEvents(msg streamEventMsg) {
 			statusMsg{text: "Streaming response (receiving events)", level: statusInfo},
 		)
 	}
+	for _, evt := range msg.events {
+		if status, ok := websocketReconnectStatus(evt); ok {
+			m.setStatusMessage(status)
+		}
+	}
 	m.refreshStreamPanes()
 }
 
+func websocketReconnectStatus(evt *stream.Event) (statusMsg, bool) {
+	if evt == nil || evt.Kind != stream.KindWebSocket || evt.Metadata[wsMetaType] != "reconnect" {
+		return statusMsg{}, false
+	}
+	switch state := evt.Metadata[wsMetaReconnect]; state {
+	case "connected":
+		return statusMsg{text: "WebSocket reconnected", level: statusSuccess}, true
+	case "failed":
+		return statusMsg{text: "WebSocket reconnect failed", level: statusError}, true
+	default:
+		return statusMsg{
+			text:  fmt.Sprintf("WebSocket dropped; reconnecting (%s)", state),
+			level: statusWarn,
+		}, true
+	}
+}
+
 func (m *Model) handleStreamState(msg streamStateMsg) {
 	ls := m.ensureLiveSession(msg.sessionID)
 	if ls != nil {
@@ -561,6 +600,12 @@ func (m *Model) formatStreamContent(ls *liveSession) string {
 	builder.WriteString(header)
 	builder.WriteByte('\n')
 
+	if ls.kind == stream.KindGRPC {
+		counts := fmt.Sprintf("Messages: %d received, %d sent", ls.received, ls.sent)
+		builder.WriteString(th.StreamSummary.Render(counts))
+		builder.WriteByte('\n')
+	}
+
 	if ls.paused {
 		builder.WriteString(th.StreamSummary.Render("[PAUSED]"))
 		builder.WriteByte('\n')
@@ -575,6 +620,12 @@ func (m *Model) formatStreamContent(ls *liveSession) string {
 		)
 		builder.WriteByte('\n')
 	}
+	if ls.dropped > 0 {
+		builder.WriteString(
+			th.StreamSummary.Render(fmt.Sprintf("(dropped %d events)", ls.dropped)),
+		)
+		builder.WriteByte('\n')
+	}
 	if ls.err != nil {
 		builder.WriteString(th.StreamError.Render(fmt.Sprintf("Error: %v", ls.err)))
 		builder.WriteByte('\n')
@@ -761,6 +812,14 @@ func (m *Model) renderStreamEvent(evt *stream.Event) string {
 				info = fmt.Sprintf("%s %s", info, truncatePreview(reason))
 			}
 			parts = append(parts, style.Render(info))
+		case "reconnect":
+			info := "reconnect " + evt.Metadata[wsMetaReconnect]
+			style := th.StreamSummary
+			if why := strings.TrimSpace(evt.Metadata[wsMetaReconnectWhy]); why != "" {
+				info = fmt.Sprintf("%s (%s)", info, truncatePreview(why))
+				style = th.StreamError
+			}
+			parts = append(parts, style.Render(info))
 		default:
 			parts = append(
 				parts,
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -63,3 +64,54 @@ func TestBookmarkLabelFallback(t *testing.T) {
 		t.Fatalf("expected fallback label")
 	}
 }
+
+func TestLiveSessionDropPolicy(t *testing.T) {
+	recv := func(data string) *stream.Event {
+		return &stream.Event{Direction: stream.DirReceive, Payload: []byte(data)}
+	}
+	summary := &stream.Event{Direction: stream.DirNA}
+
+	oldest := newLiveSession("sse-1", 2)
+	oldest.append([]*stream.Event{recv("a"), recv("b"), recv("c")})
+	if len(oldest.events) != 2 || string(oldest.events[0].Payload) != "b" {
+		t.Fatalf("expected oldest event trimmed, got %d events", len(oldest.events))
+	}
+	if oldest.dropped != 1 {
+		t.Fatalf("expected 1 dropped event, got %d", oldest.dropped)
+	}
+
+	newest := newLiveSession("sse-2", 2)
+	newest.dropNewest = true
+	newest.append([]*stream.Event{recv("a"), recv("b")})
+	newest.append([]*stream.Event{recv("c"), recv("d"), summary})
+	if len(newest.events) != 3 {
+		t.Fatalf("expected two kept events plus summary, got %d", len(newest.events))
+	}
+	if string(newest.events[1].Payload) != "b" || newest.events[2].Direction != stream.DirNA {
+		t.Fatalf("expected newest events dropped, got %+v", newest.events)
+	}
+	if newest.dropped != 2 {
+		t.Fatalf("expected 2 dropped events, got %d", newest.dropped)
+	}
+
+	m := New(Config{})
+	if got := m.formatStreamContent(newest); !strings.Contains(got, "(dropped 2 events)") {
+		t.Fatalf("expected dropped indicator, got %q", got)
+	}
+}
+
+func TestGRPCStreamHeaderCountsMessages(t *testing.T) {
+	model := New(Config{})
+	ls := newLiveSession("grpc-1", 2)
+	ls.kind = stream.KindGRPC
+	ls.append([]*stream.Event{
+		{Kind: stream.KindGRPC, Direction: stream.DirSend, Payload: []byte(`{}`)},
+		{Kind: stream.KindGRPC, Direction: stream.DirReceive, Payload: []byte(`{"n":1}`)},
+		{Kind: stream.KindGRPC, Direction: stream.DirReceive, Payload: []byte(`{"n":2}`)},
+		{Kind: stream.KindGRPC, Direction: stream.DirReceive, Payload: []byte(`{"n":3}`)},
+	})
+	out := stripANSIEscape(model.formatStreamContent(ls))
+	if !strings.Contains(out, "Messages: 3 received, 1 sent") {
+		t.Fatalf("expected message counts to include dropped events, got:\n%s", out)
+	}
+}
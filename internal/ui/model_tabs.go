@@ -55,6 +55,12 @@ func indexOfResponseTab(tabs []responseTab, target responseTab) int {
 
 func (m *Model) availableResponseTabs() []responseTab {
 	tabs := []responseTab{responseTabPretty, responseTabRaw, responseTabHeaders}
+	if m.snapshotHasJSONTree() {
+		tabs = append(tabs, responseTabTree)
+	}
+	if m.snapshotHasProtoText() {
+		tabs = append(tabs, responseTabProto)
+	}
 	if m.hasActiveStream() {
 		tabs = append(tabs, responseTabStream)
 	}
@@ -64,6 +70,9 @@ func (m *Model) availableResponseTabs() []responseTab {
 	if m.snapshotHasTimeline() {
 		tabs = append(tabs, responseTabTimeline)
 	}
+	if m.snapshotHasSentRequest() {
+		tabs = append(tabs, responseTabSent)
+	}
 	if m.compareTabAvailable() {
 		tabs = append(tabs, responseTabCompare)
 	}
@@ -82,12 +91,18 @@ func (m *Model) responseTabLabel(tab responseTab) string {
 		return "Raw"
 	case responseTabHeaders:
 		return "Headers"
+	case responseTabTree:
+		return "Tree"
+	case responseTabProto:
+		return "Proto"
 	case responseTabStream:
 		return "Stream"
 	case responseTabStats:
 		return "Stats"
 	case responseTabTimeline:
 		return "Timeline"
+	case responseTabSent:
+		return "Sent"
 	case responseTabCompare:
 		return "Compare"
 	case responseTabDiff:
@@ -30,6 +30,9 @@ func (m Model) Init() tea.Cmd {
 	if cmd := m.nextStreamMsgCmd(); cmd != nil {
 		cmds = append(cmds, cmd)
 	}
+	if cmd := m.nextUploadProgressCmd(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
 	return tea.Batch(cmds...)
 }
 
@@ -55,6 +58,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if typed.status != nil {
 			m.setStatusMessage(*typed.status)
 		}
+		if typed.osc52 != "" {
+			cmds = append(cmds, m.emitOSC52(typed.osc52))
+		}
+	case osc52DoneMsg:
+		if m.osc52 == typed.seq {
+			m.osc52 = ""
+		}
 	case tea.KeyMsg:
 		if !m.showSearchPrompt && !m.showEnvSelector && !m.showFileChangeModal {
 			if cmd := m.handleKey(typed); cmd != nil {
@@ -64,12 +74,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case responseMsg:
 		m.stopSending()
 		m.sendCancel = nil
+		if cmd := m.persistGlobals(typed.globals); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 		if cmd := m.handleResponseMessage(typed); cmd != nil {
 			cmds = append(cmds, cmd)
 		}
+		if cmd := m.focusResponseAfterSend(typed); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 		m.stopStatusPulseIfIdle()
+	case globalsPersistedMsg:
+		m.handleGlobalsPersisted(typed)
+	case webhookMsg:
+		if cmd := m.handleWebhookMessage(typed); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 	case statusMsg:
 		m.setStatusMessage(typed)
+	case grpcSchemaMsg:
+		m.handleGRPCSchemaMsg(typed)
+	case bodyBadgeMsg:
+		m.handleBodyBadge(typed)
+	case revealSecretsExpiredMsg:
+		m.handleRevealSecretsExpired(typed)
 	case statusPulseMsg:
 		if cmd := m.handleStatusPulse(typed); cmd != nil {
 			cmds = append(cmds, cmd)
@@ -102,6 +130,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if cmd := m.handleRawDumpLoaded(typed); cmd != nil {
 			cmds = append(cmds, cmd)
 		}
+	case bodyFormattedMsg:
+		if cmd := m.handleBodyFormatted(typed); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 	case profileNextIterationMsg:
 		if cmd := m.executeProfileIteration(); cmd != nil {
 			cmds = append(cmds, cmd)
@@ -156,8 +188,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case streamReadyMsg:
 		m.handleStreamReady(typed)
 		cmds = append(cmds, m.nextStreamMsgCmd())
+	case uploadProgressMsg:
+		m.handleUploadProgress(typed)
+		cmds = append(cmds, m.nextUploadProgressCmd())
 	case fileChangedMsg:
-		m.handleFileChangeEvent(typed)
+		if cmd, ok := m.handleBodyFileChange(typed.path); ok {
+			cmds = append(cmds, cmd)
+		} else {
+			m.handleFileChangeEvent(typed)
+		}
 		cmds = append(cmds, m.nextFileWatchMsgCmd())
 	case wsConsoleResultMsg:
 		m.handleConsoleResult(typed)
@@ -287,6 +326,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if m.showInfoModal {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			return m, m.handleInfoModalKey(keyMsg)
+		}
+		return m, nil
+	}
+
 	if m.showResponseSaveModal {
 		if keyMsg, ok := msg.(tea.KeyMsg); ok {
 			if m.responseSaveJustOpened {
@@ -302,10 +348,24 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "enter":
 				cmd := m.submitResponseSave()
 				return m, cmd
+			case "tab":
+				if !m.responseSaveOnFilter {
+					m.appendResponseSaveExtension()
+				}
+				return m, nil
+			case "ctrl+p":
+				m.toggleResponseSavePretty()
+				return m, nil
+			case "up", "down":
+				return m, m.toggleResponseSaveField()
 			}
 		}
 		var inputCmd tea.Cmd
-		m.responseSaveInput, inputCmd = m.responseSaveInput.Update(msg)
+		if m.responseSaveOnFilter {
+			m.responseSaveFilter, inputCmd = m.responseSaveFilter.Update(msg)
+		} else {
+			m.responseSaveInput, inputCmd = m.responseSaveInput.Update(msg)
+		}
 		return m, inputCmd
 	}
 
@@ -317,10 +377,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			case "ctrl+q", "ctrl+d":
 				return m, tea.Quit
+			case "tab", "shift+tab":
+				m.toggleOpenBrowserFocus()
+				return m, nil
 			case "enter":
+				if m.openBrowserFocus {
+					return m, m.activateBrowseSelection()
+				}
 				cmd := m.submitOpenPath()
 				return m, cmd
 			}
+			if m.openBrowserFocus {
+				return m, m.handleOpenBrowserKey(keyMsg)
+			}
 		}
 		var inputCmd tea.Cmd
 		m.openPathInput, inputCmd = m.openPathInput.Update(msg)
@@ -352,6 +421,75 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, inputCmd
 	}
 
+	if m.envEdit.on {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			if keyMsg.String() == "ctrl+q" {
+				return m, tea.Quit
+			}
+			return m, m.handleEnvEditorKey(keyMsg)
+		}
+		if m.envEdit.mode != envEditBrowse {
+			var inputCmd tea.Cmd
+			m.envEdit.input, inputCmd = m.envEdit.input.Update(msg)
+			return m, inputCmd
+		}
+		return m, nil
+	}
+
+	if m.hdrEdit.on {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			if keyMsg.String() == "ctrl+q" {
+				return m, tea.Quit
+			}
+			return m, m.handleHeaderEditorKey(keyMsg)
+		}
+		if m.hdrEdit.mode != hdrEditBrowse {
+			var inputCmd tea.Cmd
+			m.hdrEdit.input, inputCmd = m.hdrEdit.input.Update(msg)
+			return m, inputCmd
+		}
+		return m, nil
+	}
+
+	if m.bodyEdit.on {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			if keyMsg.String() == "ctrl+q" {
+				return m, tea.Quit
+			}
+			return m, m.handleBodyEditorKey(keyMsg)
+		}
+		var inputCmd tea.Cmd
+		m.bodyEdit.input, inputCmd = m.bodyEdit.input.Update(msg)
+		return m, inputCmd
+	}
+
+	if m.capPrompt.on {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			if keyMsg.String() == "ctrl+q" {
+				return m, tea.Quit
+			}
+			return m, m.handleCapturePromptKey(keyMsg)
+		}
+		var inputCmd tea.Cmd
+		m.capPrompt.input, inputCmd = m.capPrompt.input.Update(msg)
+		return m, inputCmd
+	}
+
+	if m.presets.on {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			if keyMsg.String() == "ctrl+q" || keyMsg.String() == "ctrl+d" {
+				return m, tea.Quit
+			}
+			return m, m.handleLayoutPresetKey(keyMsg)
+		}
+		if m.presets.naming {
+			var inputCmd tea.Cmd
+			m.presets.input, inputCmd = m.presets.input.Update(msg)
+			return m, inputCmd
+		}
+		return m, nil
+	}
+
 	if m.showLayoutSaveModal {
 		if keyMsg, ok := msg.(tea.KeyMsg); ok {
 			switch keyMsg.String() {
@@ -373,26 +511,31 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.searchJustOpened {
 				m.searchJustOpened = false
 				switch keyMsg.String() {
-				case "shift+f", "F":
+				case "shift+f", "F", "/":
 					return m, nil
 				}
 			}
 			switch keyMsg.String() {
 			case "esc":
+				cmd := m.cancelEditorSearchPreview()
 				m.closeSearchPrompt()
-				return m, nil
+				return m, cmd
 			case "ctrl+q", "ctrl+d":
 				return m, tea.Quit
 			case "ctrl+r":
 				m.toggleSearchMode()
-				return m, nil
+				return m, m.previewEditorSearch()
 			case "enter":
 				cmd := m.submitSearchPrompt()
 				return m, cmd
 			}
 		}
+		before := m.searchInput.Value()
 		var inputCmd tea.Cmd
 		m.searchInput, inputCmd = m.searchInput.Update(msg)
+		if m.searchInput.Value() != before {
+			return m, batchCommands(inputCmd, m.previewEditorSearch())
+		}
 		return m, inputCmd
 	}
 
@@ -424,6 +567,29 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, themeCmd
 	}
 
+	if m.showRecentRequests {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			switch keyMsg.String() {
+			case "ctrl+q", "ctrl+d":
+				return m, tea.Quit
+			case "?", "shift+/":
+				m.toggleHelp()
+				return m, nil
+			}
+			return m, m.handleRecentRequestsKey(keyMsg)
+		}
+	}
+
+	if m.showGotoRequest {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			switch keyMsg.String() {
+			case "ctrl+q", "ctrl+d":
+				return m, tea.Quit
+			}
+			return m, m.handleGotoRequestKey(keyMsg)
+		}
+	}
+
 	if m.showEnvSelector {
 		if keyMsg, ok := msg.(tea.KeyMsg); ok {
 			switch keyMsg.String() {
@@ -471,6 +637,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		if m.focus == focusEditor {
 			m.syncNavigatorWithEditorCursor()
+			if cmd := m.scheduleBodyBadge(); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
 		}
 	}
 
@@ -890,13 +1059,25 @@ func (m *Model) runShortcutBinding(binding bindings.Binding, msg tea.KeyMsg) (te
 		return nil, true
 	case bindings.ActionShowGlobals:
 		return m.showGlobalSummary(), true
+	case bindings.ActionRevealSecrets:
+		return m.toggleRevealSecrets(), true
 	case bindings.ActionClearGlobals:
 		return m.clearGlobalValues(), true
+	case bindings.ActionClearPersistedGlobals:
+		return m.clearPersistedGlobals(), true
+	case bindings.ActionClearExecTokens:
+		return m.clearExecTokens(), true
+	case bindings.ActionSendVisibleRequests:
+		return m.sendVisibleRequests(false), true
+	case bindings.ActionSendVisibleUntilFail:
+		return m.sendVisibleRequests(true), true
 	case bindings.ActionSaveFile:
 		return m.saveFile(), true
 	case bindings.ActionSaveLayout:
 		m.openLayoutSaveModal()
 		return nil, true
+	case bindings.ActionLayoutPresets:
+		return m.openLayoutPresets(), true
 	case bindings.ActionToggleResponseSplitVert:
 		m.responsePaneChord = false
 		return m.toggleResponseSplitVertical(), true
@@ -993,20 +1174,62 @@ func (m *Model) runShortcutBinding(binding bindings.Binding, msg tea.KeyMsg) (te
 		return m.clearZoomCmd(), true
 	case bindings.ActionCopyResponseTab:
 		return m.copyResponseTab(), true
+	case bindings.ActionCopyResponseBody:
+		return m.copyResponseBody(), true
+	case bindings.ActionCopyRequestResponse:
+		return m.copyRequestResponse(), true
+	case bindings.ActionCopyResolvedURL:
+		return m.copyResolvedURL(), true
 	case bindings.ActionToggleHeaderPreview:
 		return m.toggleHeaderPreview(), true
 	case bindings.ActionCycleRawView:
 		return m.cycleRawViewMode(), true
 	case bindings.ActionShowRawDump:
 		return m.showRawDump(), true
+	case bindings.ActionCycleBodyFormat:
+		return m.cycleBodyFormat(), true
+	case bindings.ActionPinBodyFormat:
+		return m.toggleBodyFormatPin(), true
 	case bindings.ActionScrollResponseTop:
 		return m.scrollShortcutToEdge(true)
 	case bindings.ActionScrollResponseBottom:
 		return m.scrollShortcutToEdge(false)
+	case bindings.ActionJumpResponseStatus:
+		return m.jumpResponseSection(respSectionStatus), true
+	case bindings.ActionJumpResponseHeaders:
+		return m.jumpResponseSection(respSectionHeaders), true
+	case bindings.ActionJumpResponseBody:
+		return m.jumpResponseSection(respSectionBody), true
 	case bindings.ActionSaveResponseBody:
 		return m.saveResponseBody(), true
 	case bindings.ActionOpenResponseExternally:
 		return m.openResponseExternally(), true
+	case bindings.ActionOpenResponseBrowser:
+		return m.openResponseInBrowser(), true
+	case bindings.ActionShowGRPCSchema:
+		return m.showGRPCSchema(), true
+	case bindings.ActionDuplicateRequest:
+		return m.duplicateRequestAtCursor(), true
+	case bindings.ActionShowVariableRefs:
+		return m.showVariableRefs(), true
+	case bindings.ActionJumpParseError:
+		return m.jumpToNextParseError(), true
+	case bindings.ActionEscapeSelectionJSON:
+		return m.runEscapeSelectionJSON(), true
+	case bindings.ActionUnescapeSelectionJSON:
+		return m.runUnescapeSelectionJSON(), true
+	case bindings.ActionEditEnvironment:
+		return m.openEnvEditor(), true
+	case bindings.ActionEditRequestHeaders:
+		return m.openHeaderEditor(), true
+	case bindings.ActionEditRequestBody:
+		return m.openBodyEditor(), true
+	case bindings.ActionFormatRequestBody:
+		return m.toggleBodyFormat(), true
+	case bindings.ActionOpenRecentRequests:
+		return m.openRecentRequests(), true
+	case bindings.ActionGotoRequest:
+		return m.openGotoRequest(), true
 	default:
 		return nil, false
 	}
@@ -1055,9 +1278,17 @@ func (m *Model) modalBlocksKeys() bool {
 	return m.showErrorModal ||
 		m.showOpenModal ||
 		m.showNewFileModal ||
+		m.envEdit.on ||
+		m.hdrEdit.on ||
+		m.bodyEdit.on ||
+		m.capPrompt.on ||
+		m.presets.on ||
 		m.showEnvSelector ||
+		m.showRecentRequests ||
+		m.showGotoRequest ||
 		m.showHistoryPreview ||
 		m.showRequestDetails ||
+		m.showInfoModal ||
 		m.showLayoutSaveModal ||
 		m.showFileChangeModal
 }
@@ -1220,7 +1451,7 @@ func (m *Model) handleKeyWithChord(msg tea.KeyMsg, allowChord bool) tea.Cmd {
 	if m.focus == focusEditor {
 		if !m.editorInsertMode {
 			switch keyStr {
-			case "shift+f", "F":
+			case "shift+f", "F", "/":
 				cmd := m.openSearchPrompt()
 				m.suppressEditorKey = true
 				return combine(cmd)
@@ -1366,6 +1597,9 @@ func (m *Model) handleKeyWithChord(msg tea.KeyMsg, allowChord bool) tea.Cmd {
 	if m.focus == focusRequests {
 		switch {
 		case keyStr == "enter":
+			if cmd := m.sendNavigatorGroup(); cmd != nil {
+				return combine(cmd)
+			}
 			if cmd := m.sendNavigatorRequest(true); cmd != nil {
 				return combine(cmd)
 			}
@@ -1493,6 +1727,9 @@ func (m *Model) handleKeyWithChord(msg tea.KeyMsg, allowChord bool) tea.Cmd {
 			if pane.activeTab == responseTabHistory {
 				return combine(nil)
 			}
+			if pane.activeTab == responseTabTree {
+				return combine(m.moveJSONTreeSelection(1))
+			}
 			return combine(m.scrollResponseViewport(pane, func() {
 				pane.viewport.ScrollDown(1)
 			}))
@@ -1519,6 +1756,9 @@ func (m *Model) handleKeyWithChord(msg tea.KeyMsg, allowChord bool) tea.Cmd {
 			if pane.activeTab == responseTabHistory {
 				return combine(nil)
 			}
+			if pane.activeTab == responseTabTree {
+				return combine(m.moveJSONTreeSelection(-1))
+			}
 			return combine(m.scrollResponseViewport(pane, func() {
 				pane.viewport.ScrollUp(1)
 			}))
@@ -1529,6 +1769,9 @@ func (m *Model) handleKeyWithChord(msg tea.KeyMsg, allowChord bool) tea.Cmd {
 			if pane.activeTab == responseTabHistory {
 				break
 			}
+			if pane.activeTab == responseTabTree {
+				return combine(m.moveJSONTreeSelection(pane.viewport.Height))
+			}
 			return combine(m.scrollResponseViewport(pane, func() {
 				pane.viewport.PageDown()
 			}))
@@ -1539,6 +1782,9 @@ func (m *Model) handleKeyWithChord(msg tea.KeyMsg, allowChord bool) tea.Cmd {
 			if pane.activeTab == responseTabHistory {
 				break
 			}
+			if pane.activeTab == responseTabTree {
+				return combine(m.moveJSONTreeSelection(-pane.viewport.Height))
+			}
 			return combine(m.scrollResponseViewport(pane, func() {
 				pane.viewport.PageUp()
 			}))
@@ -1551,6 +1797,8 @@ func (m *Model) handleKeyWithChord(msg tea.KeyMsg, allowChord bool) tea.Cmd {
 				switch pane.activeTab {
 				case responseTabHistory:
 					return combine(m.loadHistorySelection(false))
+				case responseTabTree:
+					return combine(m.toggleJSONTreeNode())
 				case responseTabStats:
 					snapshot := pane.snapshot
 					if snapshot != nil && snapshot.statsKind == statsReportKindWorkflow &&
@@ -1560,6 +1808,16 @@ func (m *Model) handleKeyWithChord(msg tea.KeyMsg, allowChord bool) tea.Cmd {
 				}
 			}
 		}
+		if pane != nil && pane.activeTab == responseTabTree {
+			switch msg.String() {
+			case "c":
+				return combine(m.foldAllJSONTree(false))
+			case "e":
+				return combine(m.foldAllJSONTree(true))
+			case "a":
+				return combine(m.openCapturePrompt())
+			}
+		}
 		if pane != nil && pane.activeTab == responseTabHistory {
 			switch keyStr := msg.String(); keyStr {
 			case "c":
@@ -1951,6 +2209,24 @@ func (m *Model) runPasteClipboard(after bool) tea.Cmd {
 	})
 }
 
+func (m *Model) runEscapeSelectionJSON() tea.Cmd {
+	if m.focus != focusEditor {
+		return statusCmd(statusWarn, "Select text in the editor to escape")
+	}
+	return m.applyEditorMutation(func(ed requestEditor) (requestEditor, tea.Cmd) {
+		return ed.EscapeSelectionJSON()
+	})
+}
+
+func (m *Model) runUnescapeSelectionJSON() tea.Cmd {
+	if m.focus != focusEditor {
+		return statusCmd(statusWarn, "Select text in the editor to unescape")
+	}
+	return m.applyEditorMutation(func(ed requestEditor) (requestEditor, tea.Cmd) {
+		return ed.UnescapeSelectionJSON()
+	})
+}
+
 func (m *Model) runRedoLastChange() tea.Cmd {
 	return m.applyEditorMutation(func(ed requestEditor) (requestEditor, tea.Cmd) {
 		return ed.RedoLastChange()
@@ -103,10 +103,12 @@ func (m *Model) updateNavigator(msg tea.Msg) tea.Cmd {
 			hasFilter := strings.TrimSpace(m.navigatorFilter.Value()) != ""
 			hasMethod := len(m.navigator.MethodFilters()) > 0
 			hasTags := len(m.navigator.TagFilters()) > 0
-			if wasFocused || hasFilter || hasMethod || hasTags {
+			hasFailed := m.navigator.FailedFilter()
+			if wasFocused || hasFilter || hasMethod || hasTags || hasFailed {
 				m.navigatorFilter.SetValue("")
 				m.navigator.ClearMethodFilters()
 				m.navigator.ClearTagFilters()
+				m.navigator.ClearFailedFilter()
 				m.navigator.SetFilter("")
 				m.navigatorFilter.Blur()
 				m.syncNavigatorSelection()
@@ -179,6 +181,8 @@ func (m *Model) updateNavigator(msg tea.Msg) tea.Cmd {
 				m.navExpandFile(n, true)
 			case navigator.KindDir:
 				m.navExpandDir(n, true)
+			case navigator.KindGroup:
+				m.navigator.ToggleExpanded()
 			}
 		case "left", "h":
 			n := m.navigator.Selected()
@@ -201,6 +205,9 @@ func (m *Model) updateNavigator(msg tea.Msg) tea.Cmd {
 			} else {
 				m.navigator.ClearTagFilters()
 			}
+		case "f":
+			m.navigator.ToggleFailedFilter()
+			m.syncNavigatorSelection()
 		case "r":
 			res := m.navReqJumpCmd()
 			if res.ok {
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+)
+
+// uploadProgressFunc starts a new send and returns its progress callback.
+// Progress has its own one-slot channel that only ever holds the latest
+// update: a stale value is swapped out instead of stalling the upload or
+// crowding stream events off the shared stream channel.
+func (m *Model) uploadProgressFunc() httpclient.UploadProgressFunc {
+	ch := m.uploadProgressChan
+	if ch == nil {
+		return nil
+	}
+	m.uploadProgressID++
+	id := m.uploadProgressID
+	return func(sent, total int64) {
+		msg := uploadProgressMsg{id: id, sent: sent, total: total}
+		for {
+			select {
+			case ch <- msg:
+				return
+			default:
+			}
+			select {
+			case <-ch:
+			default:
+			}
+		}
+	}
+}
+
+func (m *Model) nextUploadProgressCmd() tea.Cmd {
+	if m.uploadProgressChan == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg, ok := <-m.uploadProgressChan
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// handleUploadProgress adds the bytes sent to the "Sending" status. Profile
+// and compare runs keep their own progress text.
+func (m *Model) handleUploadProgress(msg uploadProgressMsg) {
+	if !m.sending || msg.id != m.uploadProgressID || m.profileRun != nil ||
+		m.compareRun != nil {
+		return
+	}
+	m.uploadProgress = uploadProgressText(msg.sent, msg.total)
+	m.setStatusMessage(statusMsg{text: m.sendingStatusText(), level: statusInfo})
+}
+
+func uploadProgressText(sent, total int64) string {
+	if total <= 0 {
+		return fmt.Sprintf("(uploaded %s)", formatByteSize(sent))
+	}
+	pct := min(sent*100/total, 100)
+	return fmt.Sprintf(
+		"(uploaded %s of %s, %d%%)",
+		formatByteSize(sent),
+		formatByteSize(total),
+		pct,
+	)
+}
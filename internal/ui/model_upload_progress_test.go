@@ -0,0 +1,58 @@
+package ui
+
+import "testing"
+
+func TestHandleUploadProgressExtendsSendingStatus(t *testing.T) {
+	model := New(Config{})
+	model.sending = true
+	model.statusPulseBase = "Sending POST /upload"
+	model.statusPulseFrame = -1
+
+	model.handleUploadProgress(uploadProgressMsg{sent: 512 * 1024, total: 2 * 1024 * 1024})
+	want := "Sending POST /upload. (uploaded 512 KiB of 2 MiB, 25%)"
+	if got := model.statusMessage.text; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	model.handleUploadProgress(uploadProgressMsg{sent: 1536, total: -1})
+	if got := model.uploadProgress; got != "(uploaded 1.5 KiB)" {
+		t.Fatalf("unexpected progress without length: %q", got)
+	}
+
+	model.stopSending()
+	model.handleUploadProgress(uploadProgressMsg{sent: 10, total: 10})
+	if model.uploadProgress != "" {
+		t.Fatalf("expected progress to be ignored once sending stopped")
+	}
+}
+
+func TestUploadProgressKeepsLatestForCurrentSend(t *testing.T) {
+	model := New(Config{})
+	model.sending = true
+
+	stale := model.uploadProgressFunc()
+	report := model.uploadProgressFunc()
+	for _, sent := range []int64{1, 2, 3} {
+		report(sent, 10)
+	}
+	if n := len(model.uploadProgressChan); n != 1 {
+		t.Fatalf("expected one coalesced update, got %d", n)
+	}
+	msg := model.nextUploadProgressCmd()().(uploadProgressMsg)
+	if msg.sent != 3 {
+		t.Fatalf("expected latest update, got %+v", msg)
+	}
+	model.handleUploadProgress(msg)
+	if got := model.uploadProgress; got != "(uploaded 3 B of 10 B, 30%)" {
+		t.Fatalf("unexpected progress %q", got)
+	}
+
+	stale(9, 10)
+	model.handleUploadProgress(model.nextUploadProgressCmd()().(uploadProgressMsg))
+	if got := model.uploadProgress; got != "(uploaded 3 B of 10 B, 30%)" {
+		t.Fatalf("expected update from an earlier send to be ignored, got %q", got)
+	}
+	if n := len(model.streamMsgChan); n != 0 {
+		t.Fatalf("expected progress to stay off the stream channel, got %d", n)
+	}
+}
@@ -94,16 +94,28 @@ func formatTestSummary(results []scripts.TestResult, scriptErr error) string {
 	return strings.TrimRight(builder.String(), "\n")
 }
 
-func buildRespSum(resp *httpclient.Response, tests []scripts.TestResult, scriptErr error) string {
-	return buildRespSumWithLength(resp, tests, scriptErr, renderContentLengthLine)
+func buildRespSum(
+	resp *httpclient.Response,
+	tests []scripts.TestResult,
+	scriptErr error,
+	statusHint bool,
+) string {
+	return buildRespSumWithLength(resp, tests, scriptErr, renderContentLengthLine, statusHint)
 }
 
 func buildRespSumPretty(
 	resp *httpclient.Response,
 	tests []scripts.TestResult,
 	scriptErr error,
+	statusHint bool,
 ) string {
-	return buildRespSumWithLength(resp, tests, scriptErr, renderContentLengthLinePretty)
+	return buildRespSumWithLength(
+		resp,
+		tests,
+		scriptErr,
+		renderContentLengthLinePretty,
+		statusHint,
+	)
 }
 
 func buildRespSumWithLength(
@@ -111,6 +123,7 @@ func buildRespSumWithLength(
 	tests []scripts.TestResult,
 	scriptErr error,
 	lengthFn func(*httpclient.Response) string,
+	statusHint bool,
 ) string {
 	if resp == nil {
 		return ""
@@ -121,7 +134,7 @@ func buildRespSumWithLength(
 	}
 
 	var lines []string
-	statusLine := renderStatusLine(resp.Status, resp.StatusCode)
+	statusLine := renderStatusLine(resp.Status, resp.StatusCode, statusHint)
 	if statusLine != "" {
 		lines = append(lines, statusLine)
 	}
@@ -130,10 +143,30 @@ func buildRespSumWithLength(
 		lines = append(lines, lengthLine)
 	}
 
+	if enc := renderEncodingSummary(resp); enc != "" {
+		lines = append(lines, renderLabelValue("Encoding", enc, statsLabelStyle, statsValueStyle))
+	}
+
+	if resp.HeaderBytes > 0 {
+		truncated := fmt.Sprintf(
+			"truncated to %s (%s received; raise max-response-headers to see all)",
+			formatByteSize(resp.HeaderLimit),
+			formatByteSize(resp.HeaderBytes),
+		)
+		lines = append(
+			lines,
+			renderLabelValue("Headers", truncated, statsLabelStyle, statsCautionStyle),
+		)
+	}
+
 	if trimmedURL := strings.TrimSpace(resp.EffectiveURL); trimmedURL != "" {
 		lines = append(lines, renderLabelValue("URL", trimmedURL, statsLabelStyle, statsValueStyle))
 	}
 
+	if id := strings.TrimSpace(resp.RequestID); id != "" {
+		lines = append(lines, renderLabelValue("Request ID", id, statsLabelStyle, statsValueStyle))
+	}
+
 	if resp.Headers != nil {
 		if streamType := strings.TrimSpace(resp.Headers.Get(streamHeaderType)); streamType != "" {
 			lines = append(
@@ -168,13 +201,20 @@ func buildRespSumWithLength(
 	return summary
 }
 
-func renderStatusLine(status string, code int) string {
+func renderStatusLine(status string, code int, hint bool) string {
 	trimmed := strings.TrimSpace(status)
 	if trimmed == "" {
 		return ""
 	}
 	style := selectStatusStyle(code)
-	return renderLabelValue("Status", trimmed, statsLabelStyle, style)
+	line := renderLabelValue("Status", trimmed, statsLabelStyle, style)
+	if !hint {
+		return line
+	}
+	if meaning := statusMeaning(trimmed, code); meaning != "" {
+		line += " " + statsMessageStyle.Render("— "+meaning)
+	}
+	return line
 }
 
 type contentLen struct {
@@ -228,6 +268,24 @@ func renderContentLengthLinePretty(resp *httpclient.Response) string {
 	return renderLabelValue("Content-Length", value, statsLabelStyle, statsValueStyle)
 }
 
+// renderEncodingSummary compares the received and decoded sizes of a body
+// fetched with the accept-encoding setting.
+func renderEncodingSummary(resp *httpclient.Response) string {
+	if resp == nil || resp.ContentEncoding == "" {
+		return ""
+	}
+	received := formatByteSize(resp.EncodedSize)
+	if !resp.Decoded {
+		return fmt.Sprintf("%s, %s received (not decoded)", resp.ContentEncoding, received)
+	}
+	return fmt.Sprintf(
+		"%s, %s received, %s decoded",
+		resp.ContentEncoding,
+		received,
+		formatByteSize(int64(len(resp.Body))),
+	)
+}
+
 func formatByteQuantity(n int64) string {
 	if n == 1 {
 		return "1 byte"
@@ -0,0 +1,159 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+type varRef struct {
+	req  *restfile.Request
+	line int
+	text string
+}
+
+// showVariableRefs lists the requests that reference the variable under the
+// editor cursor (or the selected text), so env keys can be renamed safely.
+func (m *Model) showVariableRefs() tea.Cmd {
+	name := variableAtCursor(m.editor)
+	if name == "" {
+		return statusCmd(statusWarn, "Place the cursor on a variable to find references")
+	}
+	doc := parser.Parse(m.currentFile, []byte(m.editor.Value()))
+	refs := findVariableRefs(doc, name)
+	title := fmt.Sprintf("References to %s", name)
+	if len(refs) == 0 {
+		m.openInfoModal(title, fmt.Sprintf("No requests reference %s.", name))
+		return nil
+	}
+	m.openInfoModal(title, formatVariableRefs(refs))
+	return nil
+}
+
+// variableAtCursor returns the selected text, the {{template}} around the
+// caret, or the variable-like word under it.
+func variableAtCursor(ed requestEditor) string {
+	if sel := strings.TrimSpace(ed.selectedText()); sel != "" && !strings.Contains(sel, "\n") {
+		return trimTemplateMarkers(sel)
+	}
+	lines := strings.Split(ed.Value(), "\n")
+	row := ed.Line()
+	if row < 0 || row >= len(lines) {
+		return ""
+	}
+	info := ed.LineInfo()
+	return variableAt([]rune(lines[row]), info.StartColumn+info.ColumnOffset)
+}
+
+func variableAt(line []rune, col int) string {
+	if col < 0 || len(line) == 0 {
+		return ""
+	}
+	col = min(col, len(line)-1)
+	text := string(line)
+	for _, loc := range templateRefPattern.FindAllStringIndex(text, -1) {
+		start := len([]rune(text[:loc[0]]))
+		end := len([]rune(text[:loc[1]]))
+		if col >= start && col < end {
+			return trimTemplateMarkers(text[loc[0]:loc[1]])
+		}
+	}
+	start, end := col, col
+	for start > 0 && isVariableRune(line[start-1]) {
+		start--
+	}
+	for end < len(line) && isVariableRune(line[end]) {
+		end++
+	}
+	return strings.Trim(string(line[start:end]), ".-")
+}
+
+func isVariableRune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	case r == '_', r == '.', r == '-':
+		return true
+	}
+	return false
+}
+
+var templateRefPattern = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+
+func trimTemplateMarkers(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "{{")
+	s = strings.TrimSuffix(s, "}}")
+	return strings.TrimSpace(s)
+}
+
+// variableRefPattern matches {{name}} templates plus script lookups such as
+// vars.get("name"), env.require('name') and vars.name.
+func variableRefPattern(name string) *regexp.Regexp {
+	q := regexp.QuoteMeta(name)
+	template := `\{\{\s*` + q + `\s*\}\}`
+	call := `\b(?:vars|env)(?:\.global)?\.(?:get|set|has|require|delete)\(\s*["'\x60]` +
+		q + `["'\x60]`
+	member := `\b(?:vars|env)\.` + q + `(?:[^A-Za-z0-9_.-]|$)`
+	return regexp.MustCompile(template + "|" + call + "|" + member)
+}
+
+func findVariableRefs(doc *restfile.Document, name string) []varRef {
+	name = strings.TrimSpace(name)
+	if doc == nil || name == "" {
+		return nil
+	}
+	re := variableRefPattern(name)
+	var refs []varRef
+	for _, req := range doc.Requests {
+		if req == nil {
+			continue
+		}
+		for i, line := range strings.Split(req.OriginalText, "\n") {
+			if !re.MatchString(line) {
+				continue
+			}
+			refs = append(refs, varRef{
+				req:  req,
+				line: req.LineRange.Start + i,
+				text: strings.TrimSpace(line),
+			})
+		}
+	}
+	return refs
+}
+
+func formatVariableRefs(refs []varRef) string {
+	var b strings.Builder
+	var last *restfile.Request
+	count := 0
+	for _, ref := range refs {
+		if ref.req != last {
+			if last != nil {
+				b.WriteString("\n")
+			}
+			b.WriteString(varRefLabel(ref.req))
+			b.WriteString("\n")
+			last = ref.req
+			count++
+		}
+		fmt.Fprintf(&b, "  %4d  %s\n", ref.line, ref.text)
+	}
+	summary := fmt.Sprintf("Referenced by %d request(s):", count)
+	return summary + "\n\n" + strings.TrimRight(b.String(), "\n")
+}
+
+func varRefLabel(req *restfile.Request) string {
+	method := strings.ToUpper(strings.TrimSpace(req.Method))
+	target := strings.TrimSpace(req.URL)
+	label := strings.TrimSpace(method + " " + target)
+	if name := strings.TrimSpace(req.Metadata.Name); name != "" {
+		return fmt.Sprintf("%s (%s)", name, label)
+	}
+	return label
+}
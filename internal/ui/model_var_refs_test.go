@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShowVariableRefsListsRequests(t *testing.T) {
+	src := strings.Join([]string{
+		"@baseUrl = https://example.com",
+		"",
+		"### Users",
+		"# @name listUsers",
+		"GET {{baseUrl}}/users",
+		"Authorization: Bearer {{ token }}",
+		"",
+		"### Health",
+		"GET https://example.com/health",
+		"",
+		"### Login",
+		"# @name login",
+		"POST {{baseUrl}}/login",
+		"",
+		"> {%",
+		"vars.set(\"token\", response.json().token);",
+		"%}",
+		"",
+	}, "\n")
+	model := New(Config{InitialContent: src})
+	model.editor.SetValue(src)
+	model.editor.moveCursorTo(5, 27)
+
+	if cmd := model.showVariableRefs(); cmd != nil {
+		t.Fatalf("expected no status command, got %v", cmd)
+	}
+	if !model.showInfoModal {
+		t.Fatalf("expected references modal to open")
+	}
+	if model.infoModalTitle != "References to token" {
+		t.Fatalf("unexpected title %q", model.infoModalTitle)
+	}
+	body := model.infoModalContent
+	for _, want := range []string{
+		"Referenced by 2 request(s):",
+		"listUsers (GET {{baseUrl}}/users)",
+		"     6  Authorization: Bearer {{ token }}",
+		"login (POST {{baseUrl}}/login)",
+		"    16  vars.set(\"token\", response.json().token);",
+	} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected %q in modal body:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "health") {
+		t.Fatalf("unexpected unrelated request in modal body:\n%s", body)
+	}
+}
+
+func TestVariableAtCursor(t *testing.T) {
+	cases := []struct {
+		line string
+		col  int
+		want string
+	}{
+		{"GET {{ base.url }}/users", 5, "base.url"},
+		{"GET {{base.url}}/users", 16, ""},
+		{"@auth.token = abc", 3, "auth.token"},
+		{"x = vars.get(\"user-id\")", 15, "user-id"},
+		{"plain", 10, "plain"},
+	}
+	for _, tc := range cases {
+		if got := variableAt([]rune(tc.line), tc.col); got != tc.want {
+			t.Errorf("variableAt(%q, %d) = %q, want %q", tc.line, tc.col, got, tc.want)
+		}
+	}
+}
+
+func TestVariableRefPatternBoundaries(t *testing.T) {
+	re := variableRefPattern("token")
+	for _, line := range []string{"{{tokenType}}", "vars.tokenType", "env.get(\"token2\")"} {
+		if re.MatchString(line) {
+			t.Fatalf("expected %q not to reference token", line)
+		}
+	}
+	for _, line := range []string{
+		"{{token}}",
+		"vars.token + 1",
+		"env.require('token')",
+		"vars.global.get(\"token\")",
+	} {
+		if !re.MatchString(line) {
+			t.Fatalf("expected %q to reference token", line)
+		}
+	}
+}
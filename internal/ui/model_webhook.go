@@ -0,0 +1,161 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+	"github.com/unkn0wn-root/resterm/internal/webhook"
+)
+
+const (
+	// webhookVar is the template variable holding the listener URL.
+	webhookVar = "$webhook"
+	// webhookRenderWait spaces out re-checks while the request's own
+	// response is still being formatted.
+	webhookRenderWait = 50 * time.Millisecond
+)
+
+// pendingWebhook is a listener started for one request. It travels with the
+// response so the wait only begins once the request itself has finished.
+type pendingWebhook struct {
+	listener *webhook.Listener
+	timeout  time.Duration
+}
+
+// webhookWait identifies the wait in progress so a stale result from an
+// earlier send does not clear a newer one.
+type webhookWait struct {
+	cancel context.CancelFunc
+}
+
+// startWebhook opens the listener for a request with @webhook. Streaming
+// and gRPC requests are left alone.
+func startWebhook(req *restfile.Request) (*pendingWebhook, error) {
+	if req == nil || req.Metadata.Webhook == nil {
+		return nil, nil
+	}
+	if req.GRPC != nil || req.WebSocket != nil || req.SSE != nil {
+		return nil, nil
+	}
+	spec := req.Metadata.Webhook
+	ln, err := webhook.Listen(spec.Listen)
+	if err != nil {
+		return nil, err
+	}
+	timeout := spec.Timeout
+	if timeout <= 0 {
+		timeout = webhook.DefaultTimeout
+	}
+	return &pendingWebhook{listener: ln, timeout: timeout}, nil
+}
+
+func (h *pendingWebhook) close() {
+	if h != nil {
+		h.listener.Close()
+	}
+}
+
+// awaitWebhook waits in the background for the callback. Sending another
+// request or canceling stops the wait.
+func (m *Model) awaitWebhook(hook *pendingWebhook, msg responseMsg) tea.Cmd {
+	m.cancelWebhookWait()
+	ctx, cancel := context.WithTimeout(context.Background(), hook.timeout)
+	wait := &webhookWait{cancel: cancel}
+	m.webhookWait = wait
+
+	note := fmt.Sprintf("waiting %s for webhook on %s", hook.timeout, hook.listener.URL())
+	status := m.statusMessage
+	if status.text == "" {
+		status = statusMsg{text: note, level: statusInfo}
+	} else {
+		status.text += " – " + note
+	}
+	m.setStatusMessage(status)
+
+	return func() tea.Msg {
+		defer cancel()
+		cb, err := hook.listener.Wait(ctx)
+		return webhookMsg{
+			wait:        wait,
+			callback:    cb,
+			err:         err,
+			url:         hook.listener.URL(),
+			started:     hook.listener.Started(),
+			timeout:     hook.timeout,
+			environment: msg.environment,
+		}
+	}
+}
+
+// cancelWebhookWait stops the current wait. Its result is then ignored by
+// handleWebhookMessage.
+func (m *Model) cancelWebhookWait() {
+	if m.webhookWait != nil {
+		m.webhookWait.cancel()
+		m.webhookWait = nil
+	}
+}
+
+// handleWebhookMessage shows the callback as a new response, which leaves
+// the request's own response as the previous one for diff and split views.
+func (m *Model) handleWebhookMessage(msg webhookMsg) tea.Cmd {
+	if m.webhookWait != msg.wait {
+		return nil
+	}
+	if msg.err == nil && m.responsePending != nil {
+		// A fast callback must not abort the request's own response
+		// before it has rendered and become the previous one.
+		return tea.Tick(webhookRenderWait, func(time.Time) tea.Msg { return msg })
+	}
+	m.webhookWait = nil
+
+	if msg.err != nil {
+		status := statusMsg{text: errdef.Message(msg.err), level: statusError}
+		if errors.Is(msg.err, context.DeadlineExceeded) {
+			status = statusMsg{
+				text:  fmt.Sprintf("No webhook callback within %s", msg.timeout),
+				level: statusWarn,
+			}
+		}
+		m.setStatusMessage(status)
+		return nil
+	}
+
+	cb := msg.callback
+	cmd := m.consumeHTTPResponse(webhookResponse(msg), nil, nil, msg.environment)
+	m.setStatusMessage(statusMsg{
+		text: fmt.Sprintf(
+			"Webhook %s %s received after %s",
+			cb.Method,
+			cb.Target,
+			cb.ReceivedAt.Sub(msg.started).Round(time.Millisecond),
+		),
+		level: statusSuccess,
+	})
+	return cmd
+}
+
+// webhookResponse presents the callback in the response panes. It has no
+// status code of its own, so the status line names the request instead.
+func webhookResponse(msg webhookMsg) *httpclient.Response {
+	cb := msg.callback
+	headers := cb.Headers.Clone()
+	if cb.Truncated {
+		headers.Set(streamHeaderSummary, "webhook body truncated")
+	}
+	return &httpclient.Response{
+		Status:       fmt.Sprintf("Webhook %s %s", cb.Method, cb.Target),
+		Proto:        cb.Proto,
+		Headers:      headers,
+		Body:         cb.Body,
+		Duration:     cb.ReceivedAt.Sub(msg.started),
+		EffectiveURL: msg.url + cb.Target,
+	}
+}
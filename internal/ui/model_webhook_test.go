@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/parser"
+	"github.com/unkn0wn-root/resterm/internal/webhook"
+)
+
+func TestExecuteRequestWaitsForWebhookCallback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target, _ := io.ReadAll(r.Body)
+		go func() {
+			resp, err := http.Post(string(target), "application/json", strings.NewReader(`{"ok":true}`))
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	model := New(Config{})
+	content := "# @webhook timeout=5s\nPOST " + srv.URL + "/jobs\n\n{{$webhook}}/done?job=1\n"
+	doc := parser.Parse("webhook.http", []byte(content))
+	if len(doc.Requests) != 1 {
+		t.Fatalf("expected single request")
+	}
+	msg, ok := model.executeRequest(doc, doc.Requests[0], model.cfg.HTTPOptions, "", nil)().(responseMsg)
+	if !ok || msg.err != nil {
+		t.Fatalf("expected response, got %+v", msg)
+	}
+	if msg.webhook == nil {
+		t.Fatalf("expected the webhook listener to be handed to the response")
+	}
+
+	cmd := model.handleResponseMessage(msg)
+	if model.webhookWait == nil {
+		t.Fatalf("expected a webhook wait to be pending")
+	}
+	var wm webhookMsg
+	var rendered *responseRenderedMsg
+	for _, m := range runCmdTree(cmd) {
+		switch typed := m.(type) {
+		case webhookMsg:
+			wm = typed
+		case responseRenderedMsg:
+			rendered = &typed
+		}
+	}
+	if wm.wait == nil || wm.err != nil {
+		t.Fatalf("expected a webhook callback, got %+v", wm)
+	}
+
+	sent := model.responseLatest
+	if again := model.handleWebhookMessage(wm); again == nil || model.webhookWait == nil {
+		t.Fatalf("expected the callback to wait for the response to render")
+	}
+	if rendered == nil {
+		t.Fatalf("expected the response to render")
+	}
+	model.handleResponseRendered(*rendered)
+	model.handleWebhookMessage(wm)
+	if model.webhookWait != nil {
+		t.Fatalf("expected the wait to be cleared")
+	}
+	if model.responsePrevious != sent {
+		t.Fatalf("expected the request's response to become the previous one")
+	}
+	resp := model.lastResponse
+	if resp == nil || resp.Status != "Webhook POST /done?job=1" {
+		t.Fatalf("unexpected callback response %+v", resp)
+	}
+	if string(resp.Body) != `{"ok":true}` || resp.Headers.Get("Content-Type") != "application/json" {
+		t.Fatalf("unexpected callback payload %q %v", resp.Body, resp.Headers)
+	}
+	if !strings.Contains(model.statusMessage.text, "Webhook POST /done?job=1 received") {
+		t.Fatalf("unexpected status %q", model.statusMessage.text)
+	}
+}
+
+func TestHandleWebhookMessageReportsTimeout(t *testing.T) {
+	model := New(Config{})
+	hook := &pendingWebhook{timeout: 10 * time.Millisecond}
+	ln, err := webhook.Listen("")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	hook.listener = ln
+
+	wm, ok := model.awaitWebhook(hook, responseMsg{})().(webhookMsg)
+	if !ok {
+		t.Fatalf("expected webhookMsg")
+	}
+	model.handleWebhookMessage(wm)
+	status := model.statusMessage
+	if status.level != statusWarn || status.text != "No webhook callback within 10ms" {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	if model.webhookWait != nil {
+		t.Fatalf("expected the wait to be cleared")
+	}
+}
+
+// runCmdTree runs cmd and every command batched inside it.
+func runCmdTree(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var out []tea.Msg
+	for _, c := range batch {
+		out = append(out, runCmdTree(c)...)
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+	"github.com/unkn0wn-root/resterm/internal/update"
+)
+
+// openWhatsNew shows the release notes saved by --update the first time the
+// installed version is launched.
+func (m *Model) openWhatsNew() {
+	path := strings.TrimSpace(m.cfg.WhatsNewPath)
+	ver := strings.TrimSpace(m.cfg.Version)
+	if path == "" || ver == "" || ver == "dev" {
+		return
+	}
+	if sameVersion(m.cfg.Settings.LastSeenVersion, ver) {
+		return
+	}
+	notes, err := config.LoadWhatsNew(path)
+	if err != nil {
+		m.setStatusMessage(statusMsg{level: statusWarn, text: err.Error()})
+		return
+	}
+	body := strings.TrimSpace(notes.Notes)
+	if body == "" || !sameVersion(notes.Version, ver) {
+		return
+	}
+	m.whatsNewVersion = ver
+	m.openInfoModal(
+		fmt.Sprintf("What's new in %s", notes.Version),
+		strings.Join(update.FormatNotes(body), "\n"),
+	)
+}
+
+// dismissWhatsNew records the version as seen so the notes only show once.
+func (m *Model) dismissWhatsNew() {
+	ver := m.whatsNewVersion
+	if ver == "" {
+		return
+	}
+	m.whatsNewVersion = ""
+	m.cfg.Settings.LastSeenVersion = ver
+	if err := config.SaveSettings(m.cfg.Settings, m.settingsHandle); err != nil {
+		m.setStatusMessage(
+			statusMsg{level: statusWarn, text: fmt.Sprintf("settings save error: %v", err)},
+		)
+		return
+	}
+	if err := config.ClearWhatsNew(m.cfg.WhatsNewPath); err != nil {
+		m.setStatusMessage(statusMsg{level: statusWarn, text: err.Error()})
+	}
+}
+
+func sameVersion(a, b string) bool {
+	a = strings.TrimPrefix(strings.TrimSpace(a), "v")
+	b = strings.TrimPrefix(strings.TrimSpace(b), "v")
+	return a != "" && a == b
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+)
+
+func newWhatsNewModel(t *testing.T, lastSeen string) (Model, string, config.SettingsHandle) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("RESTERM_CONFIG_DIR", dir)
+	path := filepath.Join(dir, "whats-new.json")
+	notes := config.WhatsNew{Version: "v1.4.0", Notes: "- Added groups\n- Faster sends"}
+	if err := config.SaveWhatsNew(path, notes); err != nil {
+		t.Fatalf("save whats-new: %v", err)
+	}
+	handle := config.SettingsHandle{
+		Path:   filepath.Join(dir, "settings.toml"),
+		Format: config.SettingsFormatTOML,
+	}
+	model := New(Config{
+		Version:        "v1.4.0",
+		WhatsNewPath:   path,
+		Settings:       config.Settings{LastSeenVersion: lastSeen},
+		SettingsHandle: handle,
+	})
+	return model, path, handle
+}
+
+func TestWhatsNewShownAfterUpdate(t *testing.T) {
+	model, path, _ := newWhatsNewModel(t, "v1.3.0")
+	if !model.showInfoModal {
+		t.Fatal("expected what's new modal to be open")
+	}
+	if model.infoModalTitle != "What's new in v1.4.0" {
+		t.Fatalf("unexpected title %q", model.infoModalTitle)
+	}
+	if !strings.Contains(model.infoModalContent, "• Added groups") {
+		t.Fatalf("expected formatted notes, got %q", model.infoModalContent)
+	}
+
+	m := &model
+	_ = m.handleInfoModalKey(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showInfoModal {
+		t.Fatal("expected modal to close")
+	}
+	if m.cfg.Settings.LastSeenVersion != "v1.4.0" {
+		t.Fatalf("expected last seen version recorded, got %q", m.cfg.Settings.LastSeenVersion)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected whats-new file removed, got err %v", err)
+	}
+	settings, _, err := config.LoadSettings()
+	if err != nil {
+		t.Fatalf("load settings: %v", err)
+	}
+	if settings.LastSeenVersion != "v1.4.0" {
+		t.Fatalf("expected saved last seen version, got %q", settings.LastSeenVersion)
+	}
+}
+
+func TestWhatsNewSkippedWhenAlreadySeen(t *testing.T) {
+	model, _, _ := newWhatsNewModel(t, "1.4.0")
+	if model.showInfoModal {
+		t.Fatal("expected no modal for an already seen version")
+	}
+}
@@ -44,6 +44,8 @@ type workflowState struct {
 type workflowStepRuntime struct {
 	step    restfile.WorkflowStep
 	request *restfile.Request
+	// doc is set when steps come from different files (batch runs).
+	doc *restfile.Document
 }
 
 type workflowLoopState struct {
@@ -80,6 +82,7 @@ type workflowOrigin int
 const (
 	workflowOriginWorkflow workflowOrigin = iota
 	workflowOriginForEach
+	workflowOriginBatch
 )
 
 const (
@@ -101,8 +104,13 @@ func workflowIterationInfo(state *workflowState) (int, int) {
 }
 
 func workflowRunLabel(state *workflowState) string {
-	if state != nil && state.origin == workflowOriginForEach {
-		return "For-each"
+	if state != nil {
+		switch state.origin {
+		case workflowOriginForEach:
+			return "For-each"
+		case workflowOriginBatch:
+			return "Batch"
+		}
 	}
 	return "Workflow"
 }
@@ -675,6 +683,12 @@ func (m *Model) executeWorkflowRequestStep(
 			makeWorkflowResult(st, step, false, false, err.Error(), err),
 		)
 	}
+	if rt.doc != nil {
+		st.doc = rt.doc
+		if rt.doc.Path != "" {
+			opts.BaseDir = filepath.Dir(rt.doc.Path)
+		}
+	}
 	st.currentBranch = ""
 	stepVars := workflowStepVars(step)
 	workflowApplyVars(st, stepVars)
@@ -1085,7 +1099,12 @@ func (m *Model) wfConsume(st *workflowState, msg responseMsg) []tea.Cmd {
 		}
 	}
 
-	if st != nil && st.origin == workflowOriginForEach {
+	if st != nil && st.origin == workflowOriginBatch {
+		if failed, ok := responseFailed(msg); ok {
+			m.recordRequestOutcome(m.documentRuntimePath(st.doc), msg.executed, failed)
+		}
+	}
+	if st != nil && st.origin != workflowOriginWorkflow {
 		switch {
 		case msg.skipped:
 			m.recordSkippedHistory(msg.executed, msg.requestText, msg.environment, msg.skipReason)
@@ -1298,7 +1317,7 @@ func (m *Model) finalizeWorkflowRun(state *workflowState) tea.Cmd {
 	m.stopSending()
 	m.stopStatusPulseIfIdle()
 	m.setStatusMessage(statusMsg{text: summary, level: workflowStatusLevel(state)})
-	if state == nil || state.origin != workflowOriginForEach {
+	if state == nil || state.origin == workflowOriginWorkflow {
 		m.recordWorkflowHistory(state, summary, report)
 	}
 
@@ -1504,6 +1523,7 @@ func cloneGRPCResponse(resp *grpcclient.Response) *grpcclient.Response {
 		StatusCode:      resp.StatusCode,
 		StatusMessage:   resp.StatusMessage,
 		Duration:        resp.Duration,
+		Text:            resp.Text,
 	}
 }
 
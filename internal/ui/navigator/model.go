@@ -15,6 +15,7 @@ const (
 	KindRequest
 	KindWorkflow
 	KindDir
+	KindGroup
 )
 
 type Payload[T any] struct {
@@ -34,6 +35,7 @@ type Node[T any] struct {
 	Count    int
 	Badges   []string
 	HasName  bool
+	Failed   bool
 	Expanded bool
 	Children []*Node[T]
 	Payload  Payload[T]
@@ -55,6 +57,7 @@ type Model[T any] struct {
 	filter        string
 	methodFilters map[string]bool
 	tagFilters    map[string]bool
+	failedOnly    bool
 	compact       bool
 }
 
@@ -146,6 +149,26 @@ func (m *Model[T]) ClearTagFilters() {
 	m.refresh()
 }
 
+// ToggleFailedFilter flips the failing-only chip.
+func (m *Model[T]) ToggleFailedFilter() {
+	m.failedOnly = !m.failedOnly
+	m.refresh()
+}
+
+// ClearFailedFilter removes the failing-only chip.
+func (m *Model[T]) ClearFailedFilter() {
+	if !m.failedOnly {
+		return
+	}
+	m.failedOnly = false
+	m.refresh()
+}
+
+// FailedFilter reports whether only failing requests are shown.
+func (m *Model[T]) FailedFilter() bool {
+	return m.failedOnly
+}
+
 // MethodFilters returns active method chips.
 func (m *Model[T]) MethodFilters() map[string]bool {
 	out := make(map[string]bool, len(m.methodFilters))
@@ -313,7 +336,13 @@ func (m *Model[T]) Find(id string) *Node[T] {
 }
 
 func (m *Model[T]) refresh() {
-	m.flat = flatten(m.nodes, 0, m.filter, m.methodFilters, m.tagFilters)
+	f := rowFilter{
+		text:       m.filter,
+		methods:    m.methodFilters,
+		tags:       m.tagFilters,
+		failedOnly: m.failedOnly,
+	}
+	m.flat = flatten(m.nodes, 0, f)
 	if len(m.flat) == 0 {
 		m.sel = -1
 		m.offset = 0
@@ -341,16 +370,17 @@ func (m *Model[T]) ensureVisible() {
 	m.offset = scroll.Align(m.sel, m.offset, m.viewHeight, len(m.flat))
 }
 
-func flatten[T any](
-	nodes []*Node[T],
-	level int,
-	filter string,
-	methods map[string]bool,
-	tags map[string]bool,
-) []Flat[T] {
+type rowFilter struct {
+	text       string
+	methods    map[string]bool
+	tags       map[string]bool
+	failedOnly bool
+}
+
+func flatten[T any](nodes []*Node[T], level int, f rowFilter) []Flat[T] {
 	var rows []Flat[T]
 	for _, n := range nodes {
-		childRows, ok := visible(n, level, filter, methods, tags)
+		childRows, ok := visible(n, level, f)
 		if ok {
 			rows = append(rows, childRows...)
 		}
@@ -358,25 +388,19 @@ func flatten[T any](
 	return rows
 }
 
-func visible[T any](
-	n *Node[T],
-	level int,
-	filter string,
-	methods map[string]bool,
-	tags map[string]bool,
-) ([]Flat[T], bool) {
+func visible[T any](n *Node[T], level int, f rowFilter) ([]Flat[T], bool) {
 	if n == nil {
 		return nil, false
 	}
-	matches := nodeMatches(n, filter, methods, tags)
+	matches := nodeMatches(n, f)
 	var childRows []Flat[T]
 	childMatch := false
 	expanded := n.Expanded
-	if filter != "" {
+	if f.text != "" || f.failedOnly {
 		expanded = true
 	}
 	for _, c := range n.Children {
-		rows, ok := visible(c, level+1, filter, methods, tags)
+		rows, ok := visible(c, level+1, f)
 		if ok {
 			childMatch = true
 			if expanded {
@@ -396,31 +420,30 @@ func visible[T any](
 	return append([]Flat[T]{self}, childRows...), true
 }
 
-func nodeMatches[T any](
-	n *Node[T],
-	filter string,
-	methods map[string]bool,
-	tags map[string]bool,
-) bool {
+func nodeMatches[T any](n *Node[T], f rowFilter) bool {
 	if n == nil {
 		return false
 	}
-	if len(methods) > 0 {
+	// Failing-only mode keeps parents visible solely through failed children.
+	if f.failedOnly && (n.Kind != KindRequest || !n.Failed) {
+		return false
+	}
+	if len(f.methods) > 0 {
 		switch n.Kind {
 		case KindRequest:
-			if !methods[strings.ToUpper(n.Method)] {
+			if !f.methods[strings.ToUpper(n.Method)] {
 				return false
 			}
-		case KindWorkflow, KindFile, KindDir:
+		case KindWorkflow, KindFile, KindDir, KindGroup:
 		default:
 		}
 	}
 
-	if len(tags) > 0 && !containsAnyTag(n.Tags, tags) {
+	if len(f.tags) > 0 && !containsAnyTag(n.Tags, f.tags) {
 		return false
 	}
 
-	filter = strings.TrimSpace(filter)
+	filter := strings.TrimSpace(f.text)
 	if filter == "" {
 		return true
 	}
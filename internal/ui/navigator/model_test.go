@@ -12,3 +12,42 @@ func TestToggleExpandedCollapsesLeaf(t *testing.T) {
 		t.Fatalf("expected leaf node to collapse")
 	}
 }
+
+func TestFailedFilterShowsOnlyFailingRequests(t *testing.T) {
+	ok := &Node[any]{ID: "req:a:0", Kind: KindRequest, Title: "ok"}
+	bad := &Node[any]{ID: "req:a:1", Kind: KindRequest, Title: "bad", Failed: true}
+	wf := &Node[any]{ID: "wf:a:0", Kind: KindWorkflow, Title: "flow"}
+	file := &Node[any]{
+		ID:       "file:a",
+		Kind:     KindFile,
+		Title:    "a.http",
+		Children: []*Node[any]{ok, bad, wf},
+	}
+	other := &Node[any]{
+		ID:       "file:b",
+		Kind:     KindFile,
+		Title:    "b.http",
+		Children: []*Node[any]{{ID: "req:b:0", Kind: KindRequest, Title: "fine"}},
+	}
+	m := New([]*Node[any]{file, other})
+
+	m.ToggleFailedFilter()
+	if !m.FailedFilter() {
+		t.Fatalf("expected failed filter to be active")
+	}
+	rows := m.Rows()
+	if len(rows) != 2 {
+		t.Fatalf("expected file and failing request rows, got %d", len(rows))
+	}
+	if rows[0].Node != file || rows[1].Node != bad {
+		t.Fatalf("unexpected rows %q, %q", rows[0].Node.ID, rows[1].Node.ID)
+	}
+
+	m.ClearFailedFilter()
+	if m.FailedFilter() {
+		t.Fatalf("expected failed filter to clear")
+	}
+	if len(m.Rows()) != 2 {
+		t.Fatalf("expected collapsed files after clearing filter, got %d", len(m.Rows()))
+	}
+}
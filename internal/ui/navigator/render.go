@@ -18,6 +18,7 @@ const (
 	iconDirClosed   = "📁"
 	iconDirOpen     = "📂"
 	iconRTS         = "λ"
+	iconFailed      = "✗"
 )
 
 // ListView renders the navigator list with an optional height constraint.
@@ -53,7 +54,11 @@ func renderRow(
 	}
 
 	pad := strings.Repeat("  ", row.Level)
-	parts := []string{pad, rowIcon(n)}
+	icon := rowIcon(n)
+	if n.Kind == KindRequest && n.Failed {
+		icon = th.Error.Bold(true).Render(iconFailed)
+	}
+	parts := []string{pad, icon}
 	if n.Kind == KindWorkflow {
 		parts = append(parts, renderWorkflowBadge(th))
 	}
@@ -62,7 +67,7 @@ func renderRow(
 	}
 
 	title := n.Title
-	if n.Kind == KindFile && n.Count > 0 {
+	if (n.Kind == KindFile || n.Kind == KindGroup) && n.Count > 0 {
 		title = fmt.Sprintf("%s (%d)", title, n.Count)
 	}
 
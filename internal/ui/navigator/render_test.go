@@ -136,3 +136,26 @@ func TestRenderRTSUsesModuleIndicator(t *testing.T) {
 		t.Fatalf("expected rts icon, got %q", clean)
 	}
 }
+
+func TestRenderRowShowsFailedMarker(t *testing.T) {
+	th := theme.DefaultTheme()
+	row := Flat[any]{
+		Node: &Node[any]{
+			Kind:   KindRequest,
+			Title:  "Create order",
+			Method: "POST",
+			Failed: true,
+		},
+	}
+	out := renderRow(row, false, th, 80, true, false)
+	clean := ansi.Strip(out)
+	if !strings.Contains(clean, iconFailed) {
+		t.Fatalf("expected failed marker, got %q", clean)
+	}
+
+	row.Node.Failed = false
+	clean = ansi.Strip(renderRow(row, false, th, 80, true, false))
+	if strings.Contains(clean, iconFailed) {
+		t.Fatalf("expected no failed marker after success, got %q", clean)
+	}
+}
@@ -109,6 +109,8 @@ func (m *Model) respFmtCmd(
 	hdrs := cloneHeaders(rc.Headers)
 	url := strings.TrimSpace(rc.EffectiveURL)
 	rt := m.rt()
+	hint := !m.cfg.Settings.HideStatusMeaning
+	depth := m.cfg.Settings.JSONMaxDepth
 
 	return func() tea.Msg {
 		if !rt.fmtSlot(ctx) {
@@ -119,7 +121,7 @@ func (m *Model) respFmtCmd(
 		if ctx != nil && ctx.Err() != nil {
 			return nil
 		}
-		views := buildHTTPResponseViewsCtx(ctx, rc, tc, scriptErr)
+		views := buildHTTPResponseViewsCtx(ctx, rc, tc, scriptErr, hint, depth)
 		if ctx != nil && ctx.Err() != nil {
 			return nil
 		}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"mime"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -31,6 +32,10 @@ func (m *Model) openResponseSaveModal() tea.Cmd {
 
 	m.showResponseSaveModal = true
 	m.responseSaveError = ""
+	m.responseSavePretty = false
+	m.responseSaveOnFilter = false
+	m.responseSaveFilter.SetValue("")
+	m.responseSaveFilter.Blur()
 	m.responseSaveInput.SetValue(m.defaultResponseSavePath(snapshot))
 	m.responseSaveInput.CursorEnd()
 	m.responseSaveInput.Focus()
@@ -47,8 +52,76 @@ func (m *Model) closeResponseSaveModal() {
 	m.showResponseSaveModal = false
 	m.responseSaveError = ""
 	m.responseSaveJustOpened = false
+	m.responseSavePretty = false
 	m.responseSaveInput.Blur()
 	m.responseSaveInput.SetValue("")
+	m.responseSaveOnFilter = false
+	m.responseSaveFilter.Blur()
+	m.responseSaveFilter.SetValue("")
+}
+
+// toggleResponseSaveField moves focus between the path and the JSONPath
+// filter. The filter is only offered for text bodies.
+func (m *Model) toggleResponseSaveField() tea.Cmd {
+	if !m.responseSaveOnFilter && !m.responseSaveHasText() {
+		return nil
+	}
+	m.responseSaveOnFilter = !m.responseSaveOnFilter
+	if m.responseSaveOnFilter {
+		m.responseSaveInput.Blur()
+		return m.responseSaveFilter.Focus()
+	}
+	m.responseSaveFilter.Blur()
+	return m.responseSaveInput.Focus()
+}
+
+// responseSaveExtension is the extension the response Content-Type calls for,
+// or "" when the typed path already has one or the type is unknown.
+func (m *Model) responseSaveExtension() string {
+	snapshot, status := m.activeResponseSnapshot()
+	if status != nil {
+		return ""
+	}
+	path := strings.TrimSpace(m.responseSaveInput.Value())
+	if path == "" || filepath.Ext(path) != "" {
+		return ""
+	}
+	if strings.HasSuffix(path, string(filepath.Separator)) {
+		return ""
+	}
+	return binaryview.ExtensionForMIME(snapshot.contentType)
+}
+
+func (m *Model) appendResponseSaveExtension() {
+	ext := m.responseSaveExtension()
+	if ext == "" {
+		return
+	}
+	m.responseSaveInput.SetValue(strings.TrimSpace(m.responseSaveInput.Value()) + ext)
+	m.responseSaveInput.CursorEnd()
+	m.responseSaveError = ""
+}
+
+// responseSaveHasText reports whether the body can be saved pretty-printed.
+// Binary bodies are always written as raw bytes.
+func (m *Model) responseSaveHasText() bool {
+	snapshot, status := m.activeResponseSnapshot()
+	if status != nil {
+		return false
+	}
+	return !isBinarySnapshot(snapshot)
+}
+
+func (m *Model) toggleResponseSavePretty() {
+	if !m.responseSaveHasText() {
+		return
+	}
+	m.responseSavePretty = !m.responseSavePretty
+}
+
+func isBinarySnapshot(snapshot *responseSnapshot) bool {
+	meta := snapshot.bodyMeta
+	return meta.Kind == binaryview.KindBinary && !meta.Printable
 }
 
 func (m *Model) defaultResponseSavePath(snapshot *responseSnapshot) string {
@@ -70,6 +143,9 @@ func (m *Model) defaultResponseSavePath(snapshot *responseSnapshot) string {
 	return filepath.Join(base, name)
 }
 
+// openResponseExternally writes the body to a temp file and opens it with
+// the default app. Like HTML previews, the file is removed when resterm
+// exits.
 func (m *Model) openResponseExternally() tea.Cmd {
 	snapshot, status := m.activeResponseSnapshot()
 	if status != nil {
@@ -81,6 +157,9 @@ func (m *Model) openResponseExternally() tea.Cmd {
 		m.setStatusMessage(statusMsg{level: statusInfo, text: "No response body to open"})
 		return nil
 	}
+	if isHTMLContentType(snapshot.contentType) {
+		return m.openResponseHTML(body)
+	}
 
 	name := suggestResponseFilename(snapshot)
 	ext := filepath.Ext(name)
@@ -88,34 +167,121 @@ func (m *Model) openResponseExternally() tea.Cmd {
 		ext = ".bin"
 	}
 
-	tmpFile, err := os.CreateTemp("", "resterm-*"+ext)
+	tmpPath, err := writeResponseTemp(body, ext)
 	if err != nil {
 		m.setStatusMessage(statusMsg{level: statusWarn, text: fmt.Sprintf("Open failed: %v", err)})
 		return nil
 	}
-	tmpPath := tmpFile.Name()
-	if _, err := tmpFile.Write(body); err != nil {
-		_ = tmpFile.Close()
+	m.tempFiles.add(tmpPath)
+
+	if err := launchFile(tmpPath); err != nil {
 		m.setStatusMessage(statusMsg{level: statusWarn, text: fmt.Sprintf("Open failed: %v", err)})
 		return nil
 	}
-	if err := tmpFile.Close(); err != nil {
-		m.setStatusMessage(statusMsg{level: statusWarn, text: fmt.Sprintf("Open failed: %v", err)})
+
+	m.setStatusMessage(statusMsg{
+		level: statusInfo,
+		text:  fmt.Sprintf("Opening response body in external app (%s)", filepath.Base(tmpPath)),
+	})
+	return nil
+}
+
+// openResponseInBrowser previews an HTML response in the default browser.
+// Other content types are left to openResponseExternally.
+func (m *Model) openResponseInBrowser() tea.Cmd {
+	snapshot, status := m.activeResponseSnapshot()
+	if status != nil {
+		msg := *status
+		return func() tea.Msg { return msg }
+	}
+	if len(snapshot.body) == 0 {
+		m.setStatusMessage(statusMsg{level: statusInfo, text: "No response body to open"})
+		return nil
+	}
+	if !isHTMLContentType(snapshot.contentType) {
+		m.setStatusMessage(statusMsg{
+			level: statusInfo,
+			text:  "Response is not HTML; use g Shift+E to open it externally",
+		})
 		return nil
 	}
+	return m.openResponseHTML(snapshot.body)
+}
 
+// openResponseHTML writes body to a temp .html file so the OS opener hands it
+// to the browser rather than whatever the URL extension suggests. The file is
+// removed when resterm exits.
+func (m *Model) openResponseHTML(body []byte) tea.Cmd {
+	tmpPath, err := writeResponseTemp(body, ".html")
+	if err != nil {
+		m.setStatusMessage(statusMsg{level: statusWarn, text: fmt.Sprintf("Open failed: %v", err)})
+		return nil
+	}
+	m.tempFiles.add(tmpPath)
 	if err := launchFile(tmpPath); err != nil {
 		m.setStatusMessage(statusMsg{level: statusWarn, text: fmt.Sprintf("Open failed: %v", err)})
 		return nil
 	}
-
 	m.setStatusMessage(statusMsg{
 		level: statusInfo,
-		text:  fmt.Sprintf("Opening response body in external app (%s)", filepath.Base(tmpPath)),
+		text:  fmt.Sprintf("Opening response in browser (%s)", filepath.Base(tmpPath)),
 	})
 	return nil
 }
 
+func writeResponseTemp(body []byte, ext string) (string, error) {
+	tmpFile, err := os.CreateTemp("", "resterm-*"+ext)
+	if err != nil {
+		return "", err
+	}
+	tmpPath := tmpFile.Name()
+	if _, err := tmpFile.Write(body); err != nil {
+		_ = tmpFile.Close()
+		return "", err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return "", err
+	}
+	return tmpPath, nil
+}
+
+func isHTMLContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+// tempFiles tracks files written for external viewers so they can be
+// removed on exit. It is shared by pointer across Model copies.
+type tempFiles struct {
+	paths []string
+}
+
+func (t *tempFiles) add(path string) {
+	if t == nil {
+		return
+	}
+	t.paths = append(t.paths, path)
+}
+
+func (t *tempFiles) removeAll() {
+	if t == nil {
+		return
+	}
+	for _, path := range t.paths {
+		_ = os.Remove(path)
+	}
+	t.paths = nil
+}
+
+// Cleanup removes temp files created during the session. Call it after the
+// program exits.
+func (m Model) Cleanup() {
+	m.tempFiles.removeAll()
+}
+
 func (m *Model) submitResponseSave() tea.Cmd {
 	snapshot, status := m.activeResponseSnapshot()
 	if status != nil {
@@ -129,6 +295,22 @@ func (m *Model) submitResponseSave() tea.Cmd {
 		return nil
 	}
 
+	label := "response body"
+	filter := strings.TrimSpace(m.responseSaveFilter.Value())
+	switch {
+	case filter != "" && !isBinarySnapshot(snapshot):
+		out, err := filterResponseBody(body, filter, m.responseSavePretty)
+		if err != nil {
+			m.responseSaveError = err.Error()
+			return nil
+		}
+		body = out
+		label = filter + " of response body"
+	case m.responseSavePretty && !isBinarySnapshot(snapshot):
+		body = []byte(prettyBodyText(snapshot))
+		label = "pretty response body"
+	}
+
 	input := strings.TrimSpace(m.responseSaveInput.Value())
 	if input == "" {
 		m.responseSaveError = "Enter a path"
@@ -158,7 +340,8 @@ func (m *Model) submitResponseSave() tea.Cmd {
 	m.setStatusMessage(statusMsg{
 		level: statusInfo,
 		text: fmt.Sprintf(
-			"Saved response body (%s) to %s",
+			"Saved %s (%s) to %s",
+			label,
 			formatByteSize(int64(len(body))),
 			finalPath,
 		),
@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/binaryview"
 )
 
 func TestResponseSaveModalPrefillAndSaveWire(t *testing.T) {
@@ -55,3 +57,193 @@ func TestResponseSaveModalPrefillAndSaveWire(t *testing.T) {
 		t.Fatalf("expected lastResponseSaveDir to update, got %q", model.lastResponseSaveDir)
 	}
 }
+
+func TestResponseSaveAppendsExtensionAndSavesPretty(t *testing.T) {
+	dir := t.TempDir()
+	body := []byte(`{"id":1}`)
+	snap := &responseSnapshot{
+		body:        body,
+		bodyMeta:    binaryview.Analyze(body, "application/json"),
+		contentType: "application/json",
+		ready:       true,
+	}
+	model := newModelWithResponseTab(responseTabPretty, snap)
+	model.workspaceRoot = dir
+	model.lastResponseSaveDir = dir
+	if cmd := model.saveResponseBody(); cmd != nil {
+		collectMsgs(cmd)
+	}
+
+	target := filepath.Join(dir, "user")
+	model.responseSaveInput.SetValue(target)
+	if ext := model.responseSaveExtension(); ext != ".json" {
+		t.Fatalf("expected .json to be offered, got %q", ext)
+	}
+	model.appendResponseSaveExtension()
+	if got := model.responseSaveInput.Value(); got != target+".json" {
+		t.Fatalf("expected extension appended, got %q", got)
+	}
+	if ext := model.responseSaveExtension(); ext != "" {
+		t.Fatalf("expected no offer once the path has an extension, got %q", ext)
+	}
+
+	model.toggleResponseSavePretty()
+	if cmd := model.submitResponseSave(); cmd != nil {
+		collectMsgs(cmd)
+	}
+	data, err := os.ReadFile(target + ".json")
+	if err != nil {
+		t.Fatalf("expected file to be written: %v", err)
+	}
+	if string(data) != "{\n  \"id\": 1\n}\n" {
+		t.Fatalf("expected pretty body, got %q", data)
+	}
+	if !strings.Contains(model.statusMessage.text, "pretty") {
+		t.Fatalf("expected pretty save status, got %q", model.statusMessage.text)
+	}
+}
+
+func TestResponseSaveBinaryIgnoresPretty(t *testing.T) {
+	body := []byte{0x00, 0xFF, 0x10, 0x80}
+	snap := &responseSnapshot{
+		body:        body,
+		bodyMeta:    binaryview.Analyze(body, "image/png"),
+		contentType: "image/png",
+		ready:       true,
+	}
+	model := newModelWithResponseTab(responseTabPretty, snap)
+	if cmd := model.saveResponseBody(); cmd != nil {
+		collectMsgs(cmd)
+	}
+	model.toggleResponseSavePretty()
+	if model.responseSavePretty {
+		t.Fatalf("expected binary body to stay raw")
+	}
+}
+
+func TestResponseSaveJSONPathFilter(t *testing.T) {
+	dir := t.TempDir()
+	body := []byte(`{"data":{"token":"abc<1>","id":12345678901234567890},"items":[{"id":1},{"id":2}]}`)
+	snap := &responseSnapshot{
+		body:        body,
+		bodyMeta:    binaryview.Analyze(body, "application/json"),
+		contentType: "application/json",
+		ready:       true,
+	}
+	model := newModelWithResponseTab(responseTabPretty, snap)
+	model.workspaceRoot = dir
+	model.lastResponseSaveDir = dir
+	save := func(name, filter string) (string, bool) {
+		t.Helper()
+		if cmd := model.saveResponseBody(); cmd != nil {
+			collectMsgs(cmd)
+		}
+		model.toggleResponseSaveField()
+		if !model.responseSaveOnFilter {
+			t.Fatalf("expected focus on the filter field")
+		}
+		target := filepath.Join(dir, name)
+		model.responseSaveInput.SetValue(target)
+		model.responseSaveFilter.SetValue(filter)
+		model.submitResponseSave()
+		data, err := os.ReadFile(target)
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	}
+
+	if got, ok := save("token.txt", "$.data.token"); !ok || got != "abc<1>" {
+		t.Fatalf("expected raw token, got %q (written=%v)", got, ok)
+	}
+	if got, _ := save("data.json", "$.data"); got != `{"id":12345678901234567890,"token":"abc<1>"}` {
+		t.Fatalf("expected data object without envelope, got %q", got)
+	}
+	if got, _ := save("ids.json", "$.items[*].id"); got != "[1,2]" {
+		t.Fatalf("expected matched ids, got %q", got)
+	}
+	if _, ok := save("missing.json", "$.nope"); ok {
+		t.Fatalf("expected nothing to be written for an unmatched path")
+	}
+	if !model.showResponseSaveModal || model.responseSaveError != "$.nope not found in response body" {
+		t.Fatalf("expected modal to stay open with error, got %q", model.responseSaveError)
+	}
+}
+
+func TestIsHTMLContentType(t *testing.T) {
+	cases := map[string]bool{
+		"text/html":                 true,
+		"text/html; charset=utf-8":  true,
+		"TEXT/HTML":                 true,
+		"application/xhtml+xml":     true,
+		"application/json":          false,
+		"application/xml":           false,
+		"":                          false,
+		"text/plain; charset=utf-8": false,
+	}
+	for ct, want := range cases {
+		if got := isHTMLContentType(ct); got != want {
+			t.Fatalf("isHTMLContentType(%q) = %v, want %v", ct, got, want)
+		}
+	}
+}
+
+func TestOpenResponseInBrowserRejectsNonHTML(t *testing.T) {
+	snap := &responseSnapshot{
+		body:        []byte(`{"ok":true}`),
+		contentType: "application/json",
+		ready:       true,
+	}
+	model := newModelWithResponseTab(responseTabPretty, snap)
+	if cmd := model.openResponseInBrowser(); cmd != nil {
+		collectMsgs(cmd)
+	}
+	if !strings.Contains(model.statusMessage.text, "not HTML") {
+		t.Fatalf("expected not-HTML status, got %q", model.statusMessage.text)
+	}
+	if len(model.tempFiles.paths) != 0 {
+		t.Fatalf("expected no temp files, got %v", model.tempFiles.paths)
+	}
+}
+
+func TestCleanupRemovesTempFiles(t *testing.T) {
+	path, err := writeResponseTemp([]byte("<p>hi</p>"), ".html")
+	if err != nil {
+		t.Fatalf("write temp: %v", err)
+	}
+	if filepath.Ext(path) != ".html" {
+		t.Fatalf("expected .html temp file, got %q", path)
+	}
+	model := New(Config{})
+	model.tempFiles.add(path)
+	model.Cleanup()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected temp file to be removed, stat err %v", err)
+	}
+}
+
+func TestOpenResponseExternallyTracksTempFile(t *testing.T) {
+	// An empty PATH makes the opener fail to start; the file must still be
+	// tracked for cleanup.
+	t.Setenv("PATH", "")
+	snap := &responseSnapshot{
+		body:        []byte(`{"ok":true}`),
+		contentType: "application/json",
+		ready:       true,
+	}
+	model := newModelWithResponseTab(responseTabPretty, snap)
+	if cmd := model.openResponseExternally(); cmd != nil {
+		collectMsgs(cmd)
+	}
+	if len(model.tempFiles.paths) != 1 {
+		t.Fatalf("expected the temp file to be tracked, got %v", model.tempFiles.paths)
+	}
+	path := model.tempFiles.paths[0]
+	if filepath.Ext(path) != ".json" {
+		t.Fatalf("expected .json temp file, got %q", path)
+	}
+	model.Cleanup()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("expected temp file to be removed, stat err %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+)
+
+// Headers that change on nearly every response and would drown out real
+// differences. Used when header_diff_ignore is not configured.
+var defaultHeaderDiffIgnore = []string{"Date"}
+
+type headerChangeKind int
+
+const (
+	headerAdded headerChangeKind = iota
+	headerRemoved
+	headerChanged
+)
+
+type headerChange struct {
+	name   string
+	kind   headerChangeKind
+	before string
+	after  string
+}
+
+func diffResponseHeaders(prev, cur http.Header, ignore []string) []headerChange {
+	skip := make(map[string]struct{}, len(ignore))
+	for _, name := range ignore {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		skip[http.CanonicalHeaderKey(name)] = struct{}{}
+	}
+	before := canonicalHeaderValues(prev, skip)
+	after := canonicalHeaderValues(cur, skip)
+
+	var changes []headerChange
+	for name, value := range after {
+		old, ok := before[name]
+		switch {
+		case !ok:
+			changes = append(changes, headerChange{name: name, kind: headerAdded, after: value})
+		case old != value:
+			changes = append(changes, headerChange{
+				name:   name,
+				kind:   headerChanged,
+				before: old,
+				after:  value,
+			})
+		}
+	}
+	for name, value := range before {
+		if _, ok := after[name]; !ok {
+			changes = append(changes, headerChange{name: name, kind: headerRemoved, before: value})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].name < changes[j].name
+	})
+	return changes
+}
+
+func canonicalHeaderValues(h http.Header, skip map[string]struct{}) map[string]string {
+	out := make(map[string]string, len(h))
+	for name, values := range h {
+		key := http.CanonicalHeaderKey(name)
+		if _, ok := skip[key]; ok {
+			continue
+		}
+		sorted := append([]string(nil), values...)
+		sort.Strings(sorted)
+		joined := strings.Join(sorted, ", ")
+		if prev, ok := out[key]; ok {
+			joined = prev + ", " + joined
+		}
+		out[key] = joined
+	}
+	return out
+}
+
+func renderHeaderDiff(changes []headerChange) string {
+	var b strings.Builder
+	b.WriteString(statsHeadingStyle.Render("Changed since last run:"))
+	if len(changes) == 0 {
+		b.WriteString("\n")
+		b.WriteString(statsSubLabelStyle.Render("  no header changes"))
+		return b.String()
+	}
+	for _, ch := range changes {
+		b.WriteString("\n")
+		switch ch.kind {
+		case headerAdded:
+			b.WriteString(statsSuccessStyle.Render("+ " + ch.name + ": " + ch.after))
+		case headerRemoved:
+			b.WriteString(statsWarnStyle.Render("- " + ch.name + ": " + ch.before))
+		default:
+			b.WriteString(statsCautionStyle.Render(
+				"~ " + ch.name + ": " + ch.before + " → " + ch.after,
+			))
+		}
+	}
+	return b.String()
+}
+
+func (m *Model) headerDiffIgnore() []string {
+	if m.cfg.Settings.HeaderDiffIgnore == nil {
+		return defaultHeaderDiffIgnore
+	}
+	return m.cfg.Settings.HeaderDiffIgnore
+}
+
+func (m *Model) headerDiffKey(resp *httpclient.Response) string {
+	if resp == nil || resp.Request == nil {
+		return ""
+	}
+	return m.currentFile + "|" + requestKey(resp.Request)
+}
+
+// recordHeaderDiff remembers the response headers for the request and
+// returns the rendered diff against the previous run, if there was one.
+func (m *Model) recordHeaderDiff(resp *httpclient.Response) string {
+	if !m.cfg.Settings.HeaderDiff {
+		return ""
+	}
+	key := m.headerDiffKey(resp)
+	if key == "" {
+		return ""
+	}
+	if m.lastRespHeaders == nil {
+		m.lastRespHeaders = make(map[string]http.Header)
+	}
+	prev, ok := m.lastRespHeaders[key]
+	m.lastRespHeaders[key] = resp.Headers.Clone()
+	if !ok {
+		return ""
+	}
+	return renderHeaderDiff(diffResponseHeaders(prev, resp.Headers, m.headerDiffIgnore()))
+}
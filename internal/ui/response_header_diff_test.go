@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/config"
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestDiffResponseHeaders(t *testing.T) {
+	prev := http.Header{
+		"Etag":         {`"v1"`},
+		"X-Old":        {"gone"},
+		"Date":         {"Mon, 01 Jan 2024 00:00:00 GMT"},
+		"Content-Type": {"application/json"},
+	}
+	cur := http.Header{
+		"Etag":         {`"v2"`},
+		"X-New":        {"here"},
+		"Date":         {"Tue, 02 Jan 2024 00:00:00 GMT"},
+		"Content-Type": {"application/json"},
+	}
+
+	changes := diffResponseHeaders(prev, cur, []string{"date"})
+	if len(changes) != 3 {
+		t.Fatalf("expected 3 changes, got %+v", changes)
+	}
+	want := []headerChange{
+		{name: "Etag", kind: headerChanged, before: `"v1"`, after: `"v2"`},
+		{name: "X-New", kind: headerAdded, after: "here"},
+		{name: "X-Old", kind: headerRemoved, before: "gone"},
+	}
+	for i, ch := range changes {
+		if ch != want[i] {
+			t.Fatalf("change %d: expected %+v, got %+v", i, want[i], ch)
+		}
+	}
+}
+
+func TestHeaderDiffShownOnSecondRun(t *testing.T) {
+	model := New(Config{Settings: config.Settings{HeaderDiff: true}})
+	model.ready = true
+	model.width = 120
+	model.height = 40
+	if cmd := model.applyLayout(); cmd != nil {
+		collectMsgs(cmd)
+	}
+
+	req := &restfile.Request{Method: "GET", URL: "https://example.com"}
+	req.Metadata.Name = "users"
+	send := func(etag string) *responseSnapshot {
+		resp := &httpclient.Response{
+			Status:     "200 OK",
+			StatusCode: 200,
+			Headers: http.Header{
+				"Etag": {etag},
+				"Date": {etag},
+			},
+			Request: req,
+		}
+		drainResponseCommands(t, &model, model.consumeHTTPResponse(resp, nil, nil, ""))
+		return model.responseLatest
+	}
+
+	first := send("a")
+	if strings.Contains(stripANSIEscape(first.headers), "Changed since last run") {
+		t.Fatalf("expected no diff on first run, got %q", first.headers)
+	}
+	second := send("b")
+	plain := stripANSIEscape(second.headers)
+	if !strings.Contains(plain, "Changed since last run:") {
+		t.Fatalf("expected diff section, got %q", plain)
+	}
+	if !strings.Contains(plain, "~ Etag: a → b") {
+		t.Fatalf("expected etag change, got %q", plain)
+	}
+	if strings.Contains(plain, "~ Date") {
+		t.Fatalf("expected Date to be ignored by default, got %q", plain)
+	}
+}
@@ -0,0 +1,303 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+
+	js "github.com/unkn0wn-root/resterm/internal/parser/javascript"
+	"github.com/unkn0wn-root/resterm/internal/ui/navigator"
+)
+
+// Arrays longer than this start collapsed so huge lists don't bury the
+// rest of the document.
+const jsonTreeCollapseItems = 50
+
+type jsonTreeKind int
+
+const (
+	jsonTreeScalar jsonTreeKind = iota
+	jsonTreeObject
+	jsonTreeArray
+)
+
+type jsonTreeValue struct {
+	kind  jsonTreeKind
+	index bool
+	text  string
+}
+
+type jsonTreeNode = navigator.Node[jsonTreeValue]
+
+type jsonTreeView struct {
+	nav *navigator.Model[jsonTreeValue]
+}
+
+type jsonTreeRender struct {
+	content  string
+	selected int
+	lines    int
+}
+
+// looksLikeJSONContainer is a cheap check used to decide whether the Tree
+// tab is offered before the body is actually parsed.
+func looksLikeJSONContainer(body []byte) bool {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	return len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[')
+}
+
+func newJSONTreeView(body []byte) (*jsonTreeView, error) {
+	root, err := parseJSONTree(body)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonTreeView{nav: navigator.New([]*jsonTreeNode{root})}, nil
+}
+
+// parseJSONTree decodes body token by token so object keys keep the order
+// the server sent them in.
+func parseJSONTree(body []byte) (*jsonTreeNode, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	root, err := readJSONTreeNode(dec, "$", "", false)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return root, nil
+}
+
+func readJSONTreeNode(dec *json.Decoder, id, key string, index bool) (*jsonTreeNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	node := &jsonTreeNode{ID: id, Title: key}
+	val := jsonTreeValue{index: index}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			val.kind = jsonTreeObject
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				name, _ := keyTok.(string)
+				child, err := readJSONTreeNode(dec, id+js.PathSegment(name), name, false)
+				if err != nil {
+					return nil, err
+				}
+				node.Children = append(node.Children, child)
+			}
+		case '[':
+			val.kind = jsonTreeArray
+			for i := 0; dec.More(); i++ {
+				childID := fmt.Sprintf("%s[%d]", id, i)
+				child, err := readJSONTreeNode(dec, childID, strconv.Itoa(i), true)
+				if err != nil {
+					return nil, err
+				}
+				node.Children = append(node.Children, child)
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %q", t)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		node.Count = len(node.Children)
+		node.Expanded = val.kind == jsonTreeObject || node.Count <= jsonTreeCollapseItems
+	case string:
+		val.text = strconv.Quote(t)
+	case json.Number:
+		val.text = t.String()
+	case bool:
+		val.text = strconv.FormatBool(t)
+	case nil:
+		val.text = "null"
+	}
+	node.Payload.Data = val
+	return node, nil
+}
+
+func (v *jsonTreeView) move(delta int) bool {
+	before := v.nav.Selected()
+	v.nav.Move(delta)
+	return v.nav.Selected() != before
+}
+
+func (v *jsonTreeView) toggle() bool {
+	n := v.nav.Selected()
+	if n == nil || len(n.Children) == 0 {
+		return false
+	}
+	v.nav.ToggleExpanded()
+	return true
+}
+
+// collapseAll folds every container below the root so only the top-level
+// structure stays visible.
+func (v *jsonTreeView) collapseAll() {
+	v.reselect(func() {
+		v.nav.CollapseAll()
+		if rows := v.nav.Rows(); len(rows) > 0 {
+			rows[0].Node.Expanded = true
+		}
+	})
+}
+
+// foldBelow collapses containers nested maxDepth or more levels under the
+// root, matching the folding json_max_depth applies to the Pretty tab.
+func (v *jsonTreeView) foldBelow(maxDepth int) {
+	rows := v.nav.Rows()
+	if maxDepth <= 0 || len(rows) == 0 {
+		return
+	}
+	var fold func(n *jsonTreeNode, depth int)
+	fold = func(n *jsonTreeNode, depth int) {
+		if len(n.Children) == 0 {
+			return
+		}
+		if depth >= maxDepth {
+			n.Expanded = false
+		}
+		for _, child := range n.Children {
+			fold(child, depth+1)
+		}
+	}
+	v.reselect(func() { fold(rows[0].Node, 0) })
+}
+
+func (v *jsonTreeView) expandAll() {
+	v.reselect(v.nav.ExpandAll)
+}
+
+// reselect runs change and then keeps the previously selected node selected,
+// falling back to its closest visible ancestor when it was folded away.
+func (v *jsonTreeView) reselect(change func()) {
+	var path []*jsonTreeNode
+	if rows, sel := v.nav.Rows(), v.nav.Selected(); len(rows) > 0 && sel != nil {
+		path = jsonTreePathTo(rows[0].Node, sel)
+	}
+	change()
+	v.nav.Refresh()
+	for i := len(path) - 1; i >= 0; i-- {
+		if v.nav.SelectByID(path[i].ID) {
+			return
+		}
+	}
+}
+
+func jsonTreePathTo(root, target *jsonTreeNode) []*jsonTreeNode {
+	if root == target {
+		return []*jsonTreeNode{root}
+	}
+	for _, child := range root.Children {
+		if path := jsonTreePathTo(child, target); path != nil {
+			return append([]*jsonTreeNode{root}, path...)
+		}
+	}
+	return nil
+}
+
+func (v *jsonTreeView) render(width int) jsonTreeRender {
+	if width <= 0 {
+		width = defaultResponseViewportWidth
+	}
+	rows := v.nav.Rows()
+	sel := v.nav.Selected()
+	out := jsonTreeRender{selected: -1, lines: len(rows)}
+	lines := make([]string, 0, len(rows))
+	for i, row := range rows {
+		if row.Node == sel {
+			out.selected = i
+			plain := ansi.Truncate(jsonTreeRowText(row, false), width, "…")
+			lines = append(lines, statsSelectedStyle.Render(plain))
+			continue
+		}
+		lines = append(lines, ansi.Truncate(jsonTreeRowText(row, true), width, "…"))
+	}
+	out.content = strings.Join(lines, "\n")
+	if out.content != "" {
+		out.content += "\n"
+	}
+	return out
+}
+
+func jsonTreeRowText(row navigator.Flat[jsonTreeValue], colored bool) string {
+	style := func(s string, st func(...string) string) string {
+		if !colored {
+			return s
+		}
+		return st(s)
+	}
+	n := row.Node
+	val := n.Payload.Data
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat("  ", row.Level))
+	switch {
+	case len(n.Children) == 0:
+		b.WriteString("  ")
+	case n.Expanded:
+		b.WriteString("▾ ")
+	default:
+		b.WriteString("▸ ")
+	}
+	if row.Level > 0 {
+		if val.index {
+			b.WriteString(style("["+n.Title+"]", statsSubLabelStyle.Render))
+		} else {
+			b.WriteString(style(n.Title, statsLabelStyle.Render))
+		}
+		b.WriteString(": ")
+	}
+
+	switch val.kind {
+	case jsonTreeObject, jsonTreeArray:
+		open, closing, unit := "{", "}", "key"
+		if val.kind == jsonTreeArray {
+			open, closing, unit = "[", "]", "item"
+		}
+		switch {
+		case n.Count == 0:
+			b.WriteString(open + closing)
+		case n.Expanded:
+			b.WriteString(open)
+		default:
+			b.WriteString(open + "…" + closing)
+		}
+		if n.Count > 0 {
+			if n.Count != 1 {
+				unit += "s"
+			}
+			b.WriteString(" ")
+			b.WriteString(style(fmt.Sprintf("%d %s", n.Count, unit), statsMessageStyle.Render))
+		}
+	default:
+		b.WriteString(style(val.text, jsonTreeScalarStyle(val.text)))
+	}
+	return b.String()
+}
+
+func jsonTreeScalarStyle(text string) func(...string) string {
+	switch {
+	case strings.HasPrefix(text, `"`):
+		return statsHeaderValueStyle.Render
+	case text == "true" || text == "false" || text == "null":
+		return statsNeutralStyle.Render
+	default:
+		return statsDurationStyle.Render
+	}
+}
@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/unkn0wn-root/resterm/internal/httpclient"
+)
+
+func TestParseJSONTreeKeepsKeyOrderAndCollapsesLargeArrays(t *testing.T) {
+	items := make([]string, jsonTreeCollapseItems+1)
+	for i := range items {
+		items[i] = fmt.Sprint(i)
+	}
+	body := `{"zeta": 1, "alpha": {"b": true}, "big": [` + strings.Join(items, ",") +
+		`], "small": ["x"]}`
+
+	root, err := parseJSONTree([]byte(body))
+	if err != nil {
+		t.Fatalf("parseJSONTree: %v", err)
+	}
+	var keys []string
+	for _, child := range root.Children {
+		keys = append(keys, child.Title)
+	}
+	if got := strings.Join(keys, ","); got != "zeta,alpha,big,small" {
+		t.Fatalf("expected source key order, got %s", got)
+	}
+	big := root.Children[2]
+	if big.ID != `$.big` || big.Count != jsonTreeCollapseItems+1 || big.Expanded {
+		t.Fatalf("expected large array to start collapsed, got %+v", big)
+	}
+	if small := root.Children[3]; !small.Expanded {
+		t.Fatalf("expected small array to start expanded")
+	}
+
+	if _, err := parseJSONTree([]byte(`{"a": 1} trailing`)); err == nil {
+		t.Fatalf("expected error for trailing data")
+	}
+}
+
+func TestJSONTreeRenderShowsCollapsedCounts(t *testing.T) {
+	view, err := newJSONTreeView([]byte(`{"user": {"name": "ana", "tags": ["a", "b"]}}`))
+	if err != nil {
+		t.Fatalf("newJSONTreeView: %v", err)
+	}
+	plain := stripANSIEscape(view.render(80).content)
+	for _, want := range []string{`▾ {`, `▾ user: {`, `name: "ana"`, `▾ tags: [ 2 items`} {
+		if !strings.Contains(plain, want) {
+			t.Fatalf("expected %q in tree, got:\n%s", want, plain)
+		}
+	}
+
+	view.move(1)
+	if !view.toggle() {
+		t.Fatalf("expected toggle on object node")
+	}
+	plain = stripANSIEscape(view.render(80).content)
+	if !strings.Contains(plain, "▸ user: {…} 2 keys") || strings.Contains(plain, "name:") {
+		t.Fatalf("expected user collapsed with count, got:\n%s", plain)
+	}
+}
+
+func TestJSONTreeCollapseAndExpandAll(t *testing.T) {
+	view, err := newJSONTreeView([]byte(`{"a": {"b": {"c": 1}}, "d": [1, 2]}`))
+	if err != nil {
+		t.Fatalf("newJSONTreeView: %v", err)
+	}
+	view.move(3) // $.a.b.c
+	view.collapseAll()
+	plain := stripANSIEscape(view.render(80).content)
+	want := "▾ { 2 keys\n▸ a: {…} 1 key\n▸ d: […] 2 items\n"
+	if strings.ReplaceAll(plain, "  ", "") != strings.ReplaceAll(want, "  ", "") {
+		t.Fatalf("expected top-level overview, got:\n%s", plain)
+	}
+	if sel := view.nav.Selected(); sel == nil || sel.ID != "$.a" {
+		t.Fatalf("expected selection to move to visible ancestor, got %+v", sel)
+	}
+
+	view.expandAll()
+	plain = stripANSIEscape(view.render(80).content)
+	if !strings.Contains(plain, "c: 1") || !strings.Contains(plain, "[1]: 2") {
+		t.Fatalf("expected every node expanded, got:\n%s", plain)
+	}
+	if sel := view.nav.Selected(); sel == nil || sel.ID != "$.a" {
+		t.Fatalf("expected selection kept after expand, got %+v", sel)
+	}
+}
+
+func TestJSONTreeTabNavigation(t *testing.T) {
+	model := New(Config{})
+	model.ready = true
+	model.width = 120
+	model.height = 40
+	if cmd := model.applyLayout(); cmd != nil {
+		collectMsgs(cmd)
+	}
+	resp := &httpclient.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+		Headers:    http.Header{"Content-Type": {"application/json"}},
+		Body:       []byte(`{"a": {"b": 1}, "c": 2}`),
+	}
+	drainResponseCommands(t, &model, model.consumeHTTPResponse(resp, nil, nil, ""))
+
+	if indexOfResponseTab(model.availableResponseTabs(), responseTabTree) == -1 {
+		t.Fatalf("expected Tree tab for JSON response")
+	}
+	model.setFocus(focusResponse)
+	pane := model.pane(responsePanePrimary)
+	pane.setActiveTab(responseTabTree)
+	model.syncResponsePane(responsePanePrimary)
+
+	press := func(key string) {
+		t.Helper()
+		model.handleKey(keyMsgFor(key))
+	}
+	press("down")
+	press("enter")
+	view := stripANSIEscape(pane.viewport.View())
+	if !strings.Contains(view, "a: {…} 1 key") {
+		t.Fatalf("expected collapsed node in view, got:\n%s", view)
+	}
+	if strings.Contains(view, "b: 1") {
+		t.Fatalf("expected child hidden after collapse, got:\n%s", view)
+	}
+}
+
+func TestJSONTreeFoldBelowDepth(t *testing.T) {
+	view, err := newJSONTreeView([]byte(`{"a": {"b": {"c": 1}}, "d": [1, 2]}`))
+	if err != nil {
+		t.Fatalf("newJSONTreeView: %v", err)
+	}
+	view.foldBelow(2)
+	plain := stripANSIEscape(view.render(80).content)
+	if !strings.Contains(plain, "▸ b: {…} 1 key") || strings.Contains(plain, "c: 1") {
+		t.Fatalf("expected nodes below depth 2 collapsed, got:\n%s", plain)
+	}
+	if !strings.Contains(plain, "[1]: 2") {
+		t.Fatalf("expected shallow array to stay expanded, got:\n%s", plain)
+	}
+}
@@ -7,6 +7,7 @@ const (
 	rawViewHex
 	rawViewBase64
 	rawViewSummary
+	rawViewWire
 )
 
 func (m rawViewMode) label() string {
@@ -17,6 +18,8 @@ func (m rawViewMode) label() string {
 		return "base64"
 	case rawViewSummary:
 		return "summary"
+	case rawViewWire:
+		return "wire"
 	default:
 		return "text"
 	}
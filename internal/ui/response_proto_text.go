@@ -0,0 +1,27 @@
+package ui
+
+import "strings"
+
+const protoTextFallbackNote = "# Protobuf text unavailable (no descriptor); showing JSON"
+
+// grpcProtoText builds the Proto tab content for a gRPC response, falling
+// back to the JSON view when the message could not be rendered as text.
+func grpcProtoText(statusLine, text, pretty string) string {
+	if strings.TrimSpace(text) == "" {
+		return joinSections(statusLine, protoTextFallbackNote, pretty)
+	}
+	return joinSections(statusLine, text)
+}
+
+func (m *Model) snapshotHasProtoText() bool {
+	hasProto := func(snapshot *responseSnapshot) bool {
+		return snapshot != nil && snapshot.ready && snapshot.protoText != ""
+	}
+	for _, id := range m.visiblePaneIDs() {
+		pane := m.pane(id)
+		if pane != nil && hasProto(pane.snapshot) {
+			return true
+		}
+	}
+	return hasProto(m.responseLatest)
+}
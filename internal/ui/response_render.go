@@ -30,6 +30,17 @@ const (
 	defaultResponseViewportWidth   = 80
 )
 
+// responseCharsetSetting names the charset used to decode a response body
+// for display when the server omits or misstates it in Content-Type.
+const responseCharsetSetting = "response-charset"
+
+func responseCharset(resp *httpclient.Response) string {
+	if resp == nil || resp.Request == nil {
+		return ""
+	}
+	return strings.TrimSpace(resp.Request.Settings[responseCharsetSetting])
+}
+
 const (
 	compareColEnvWidth      = 11
 	compareColStatusWidth   = 13
@@ -111,21 +122,27 @@ func cloneHTTPResponse(resp *httpclient.Response) *httpclient.Response {
 	}
 
 	return &httpclient.Response{
-		Status:         resp.Status,
-		StatusCode:     resp.StatusCode,
-		Proto:          resp.Proto,
-		Headers:        headers,
-		ReqMethod:      resp.ReqMethod,
-		RequestHeaders: reqHeaders,
-		ReqHost:        resp.ReqHost,
-		ReqLen:         resp.ReqLen,
-		ReqTE:          reqTE,
-		Body:           body,
-		Duration:       resp.Duration,
-		EffectiveURL:   resp.EffectiveURL,
-		Request:        resp.Request,
-		Timeline:       timeline,
-		TraceReport:    traceReport,
+		Status:          resp.Status,
+		StatusCode:      resp.StatusCode,
+		Proto:           resp.Proto,
+		Headers:         headers,
+		ReqMethod:       resp.ReqMethod,
+		RequestHeaders:  reqHeaders,
+		ReqHost:         resp.ReqHost,
+		ReqLen:          resp.ReqLen,
+		ReqTE:           reqTE,
+		Body:            body,
+		Duration:        resp.Duration,
+		EffectiveURL:    resp.EffectiveURL,
+		Request:         resp.Request,
+		RequestID:       resp.RequestID,
+		Timeline:        timeline,
+		TraceReport:     traceReport,
+		ContentEncoding: resp.ContentEncoding,
+		EncodedSize:     resp.EncodedSize,
+		Decoded:         resp.Decoded,
+		HeaderBytes:     resp.HeaderBytes,
+		HeaderLimit:     resp.HeaderLimit,
 	}
 }
 
@@ -147,7 +164,7 @@ func buildHTTPResponseViews(
 	tests []scripts.TestResult,
 	scriptErr error,
 ) responseViews {
-	return buildHTTPResponseViewsCtx(context.Background(), resp, tests, scriptErr)
+	return buildHTTPResponseViewsCtx(context.Background(), resp, tests, scriptErr, false, 0)
 }
 
 func buildHTTPResponseViewsCtx(
@@ -155,6 +172,8 @@ func buildHTTPResponseViewsCtx(
 	resp *httpclient.Response,
 	tests []scripts.TestResult,
 	scriptErr error,
+	statusHint bool,
+	jsonDepth int,
 ) responseViews {
 	if resp == nil {
 		return responseViews{
@@ -167,16 +186,16 @@ func buildHTTPResponseViewsCtx(
 		}
 	}
 
-	summary := buildRespSum(resp, tests, scriptErr)
-	prettySummary := buildRespSumPretty(resp, tests, scriptErr)
+	summary := buildRespSum(resp, tests, scriptErr, statusHint)
+	prettySummary := buildRespSumPretty(resp, tests, scriptErr, statusHint)
 	coloredHeaders := formatHTTPHeaders(resp.Headers, true)
 
 	contentType := ""
 	if resp.Headers != nil {
 		contentType = resp.Headers.Get("Content-Type")
 	}
-	meta := binaryview.Analyze(resp.Body, contentType)
-	bv := buildBodyViewsCtx(ctx, resp.Body, contentType, &meta, nil, "")
+	meta := binaryview.AnalyzeCharset(resp.Body, contentType, responseCharset(resp))
+	bv := buildBodyViewsCtx(ctx, resp.Body, contentType, &meta, nil, "", jsonDepth)
 
 	headersSectionColored := ""
 	if coloredHeaders != "" {
@@ -295,6 +314,7 @@ func buildBodyViews(
 		meta,
 		viewBody,
 		viewContentType,
+		0,
 	)
 }
 
@@ -305,6 +325,7 @@ func buildBodyViewsCtx(
 	meta *binaryview.Meta,
 	viewBody []byte,
 	viewContentType string,
+	jsonDepth int,
 ) bodyViews {
 	var detected binaryview.Meta
 	if meta == nil {
@@ -366,7 +387,12 @@ func buildBodyViewsCtx(
 			rawMode = rawViewHex
 		}
 	} else {
-		prettyBody = trimResponseBody(prettifyBodyCtx(ctx, decoded, viewContentType))
+		prettyBody = trimResponseBody(
+			prettifyBodyDepthCtx(ctx, decoded, viewContentType, jsonDepth),
+		)
+		if warn := strings.TrimSpace(localMeta.DecodeErr); warn != "" {
+			prettyBody = joinSections(statsWarnStyle.Render("Decode warning: "+warn), prettyBody)
+		}
 	}
 	rawMode = clampRawViewMode(localMeta, sz, rawMode)
 	if rawMode == rawViewHex && rawHex == "" {
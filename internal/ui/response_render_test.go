@@ -94,6 +94,24 @@ func TestBuildHTTPResponseViewsPreservesLeadingWhitespace(t *testing.T) {
 	}
 }
 
+func TestBuildHTTPResponseViewsFoldsJSONBeyondMaxDepth(t *testing.T) {
+	resp := &httpclient.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+		Headers:    http.Header{"Content-Type": {"application/json"}},
+		Body:       []byte(`{"user":{"profile":{"name":"ana","tags":["a","b"]}}}`),
+	}
+
+	views := buildHTTPResponseViewsCtx(context.Background(), resp, nil, nil, false, 2)
+	pretty := stripANSIEscape(views.pretty)
+	if !strings.Contains(pretty, "profile: {…2 keys}") || strings.Contains(pretty, "ana") {
+		t.Fatalf("expected nested profile folded in pretty view, got %q", pretty)
+	}
+	if !strings.Contains(views.raw, `"name": "ana"`) {
+		t.Fatalf("expected raw view untouched, got %q", views.raw)
+	}
+}
+
 func TestBuildHTTPResponseViewsColorsSummaryExceptRaw(t *testing.T) {
 	resp := &httpclient.Response{
 		Status:     "201 Created",
@@ -249,3 +267,66 @@ func TestPrintableOctetStreamDefaultsToText(t *testing.T) {
 		t.Fatalf("expected raw text to be populated")
 	}
 }
+
+func TestResponseCharsetSettingDecodesLegacyBody(t *testing.T) {
+	// "café" in ISO-8859-1, served without a charset on a non-text type.
+	body := []byte{'c', 'a', 'f', 0xE9}
+	resp := &httpclient.Response{
+		Status:       "200 OK",
+		StatusCode:   200,
+		Headers:      http.Header{"Content-Type": {"application/x-legacy"}},
+		Body:         body,
+		EffectiveURL: "https://example.com/legacy",
+		Request: &restfile.Request{
+			Settings: map[string]string{responseCharsetSetting: "iso-8859-1"},
+		},
+	}
+
+	views := buildHTTPResponseViews(resp, nil, nil)
+	if !strings.Contains(views.pretty, "café") || !strings.Contains(views.rawText, "café") {
+		t.Fatalf("expected decoded text, got pretty %q raw %q", views.pretty, views.rawText)
+	}
+	if views.meta.Charset != "iso-8859-1" {
+		t.Fatalf("expected override charset in meta, got %q", views.meta.Charset)
+	}
+
+	resp.Request = nil
+	views = buildHTTPResponseViews(resp, nil, nil)
+	if !strings.Contains(views.pretty, "Binary body") {
+		t.Fatalf("expected binary summary without the setting, got %q", views.pretty)
+	}
+
+	resp.Headers.Set("Content-Type", "text/plain; charset=iso-8859-1")
+	views = buildHTTPResponseViews(resp, nil, nil)
+	if !strings.Contains(views.pretty, "café") {
+		t.Fatalf("expected Content-Type charset to be honoured, got %q", views.pretty)
+	}
+
+	resp.Request = &restfile.Request{
+		Settings: map[string]string{responseCharsetSetting: "klingon"},
+	}
+	views = buildHTTPResponseViews(resp, nil, nil)
+	if !strings.Contains(views.pretty, "Decode warning") {
+		t.Fatalf("expected decode warning for unknown charset, got %q", views.pretty)
+	}
+}
+
+func TestStatusLineShowsMeaning(t *testing.T) {
+	line := stripANSIEscape(renderStatusLine("429 Too Many Requests", 429, true))
+	if line != "Status: 429 Too Many Requests — client is rate limited" {
+		t.Fatalf("unexpected status line %q", line)
+	}
+	line = stripANSIEscape(renderStatusLine("451 Nope", 451, true))
+	want := "Status: 451 Nope — Unavailable For Legal Reasons: blocked by a legal demand"
+	if line != want {
+		t.Fatalf("expected canonical phrase for custom reason, got %q", line)
+	}
+	line = stripANSIEscape(renderStatusLine("299 Custom", 299, true))
+	if line != "Status: 299 Custom" {
+		t.Fatalf("expected unknown code left alone, got %q", line)
+	}
+	line = stripANSIEscape(renderStatusLine("418 I'm a teapot", 418, false))
+	if line != "Status: 418 I'm a teapot" {
+		t.Fatalf("expected no meaning when disabled, got %q", line)
+	}
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/unkn0wn-root/resterm/internal/rts"
+)
+
+// filterResponseBody extracts the part of a JSON body selected by a
+// JSONPath expression such as "$.data" or "$.items[*].id". A string match
+// is written as is, so a token lands in the file without quotes; anything
+// else is written as JSON. Paths with [*] produce an array of the matches.
+func filterResponseBody(body []byte, expr string, pretty bool) ([]byte, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("JSONPath must start with $: %q", expr)
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var data any
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("response body is not JSON; clear the filter to save it")
+	}
+
+	var value any
+	if strings.Contains(expr, "[*]") {
+		matches := rts.JSONPathAll(data, expr)
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("%s matched no values", expr)
+		}
+		value = matches
+	} else {
+		v, ok := rts.JSONPathGet(data, expr)
+		if !ok {
+			return nil, fmt.Errorf("%s not found in response body", expr)
+		}
+		value = v
+	}
+	if s, ok := value.(string); ok {
+		return []byte(s), nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if pretty {
+		enc.SetIndent("", "  ")
+	}
+	if err := enc.Encode(value); err != nil {
+		return nil, fmt.Errorf("encode %s: %v", expr, err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -13,6 +13,7 @@ import (
 type responseSearchState struct {
 	query      string
 	isRegex    bool
+	jsonPath   bool
 	matches    []searchMatch
 	index      int
 	active     bool
@@ -39,6 +40,7 @@ func (s *responseSearchState) clear() bool {
 	hadState := s.hasQuery() || len(s.matches) > 0 || s.active
 	s.query = ""
 	s.isRegex = false
+	s.jsonPath = false
 	s.matches = nil
 	s.index = -1
 	s.active = false
@@ -62,6 +64,7 @@ func (s *responseSearchState) prepare(
 ) {
 	s.query = query
 	s.isRegex = isRegex
+	s.jsonPath = !isRegex && isJSONPathQuery(query)
 	s.tab = tab
 	s.snapshotID = snapshotID
 	s.width = width
@@ -96,10 +99,15 @@ func (s *responseSearchState) computeMatches(content string) error {
 		if err != nil {
 			return err
 		}
-		s.matches = regexMatches(content, rx)
+		s.setMatches(regexMatches(content, rx))
 	} else {
-		s.matches = literalMatches(content, query)
+		s.setMatches(literalMatches(content, query))
 	}
+	return nil
+}
+
+func (s *responseSearchState) setMatches(matches []searchMatch) {
+	s.matches = matches
 	if len(s.matches) == 0 {
 		s.index = -1
 		s.active = false
@@ -108,7 +116,6 @@ func (s *responseSearchState) computeMatches(content string) error {
 		s.active = true
 	}
 	s.computed = true
-	return nil
 }
 
 func decorateResponseContent(
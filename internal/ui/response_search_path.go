@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	js "github.com/unkn0wn-root/resterm/internal/parser/javascript"
+)
+
+// isJSONPathQuery reports whether a response search should jump to a JSON
+// path instead of matching text.
+func isJSONPathQuery(query string) bool {
+	q := strings.TrimSpace(query)
+	return q == "$" || strings.HasPrefix(q, "$.") || strings.HasPrefix(q, "$[")
+}
+
+// canonicalJSONPath rewrites a user path into the form produced by
+// js.FormatValueWithPaths, so $['a'].b and $.a["b"] resolve alike.
+func canonicalJSONPath(query string) (string, bool) {
+	q := strings.TrimSpace(query)
+	if !strings.HasPrefix(q, "$") {
+		return "", false
+	}
+	var b strings.Builder
+	b.WriteString("$")
+	rest := q[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			if name == "" {
+				return "", false
+			}
+			b.WriteString(js.PathSegment(name))
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return "", false
+			}
+			inner := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			if idx, err := strconv.Atoi(inner); err == nil && idx >= 0 {
+				b.WriteString("[" + strconv.Itoa(idx) + "]")
+				continue
+			}
+			if len(inner) < 2 || (inner[0] != '"' && inner[0] != '\'') ||
+				inner[len(inner)-1] != inner[0] {
+				return "", false
+			}
+			b.WriteString(js.PathSegment(inner[1 : len(inner)-1]))
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+// jsonPathSearchMatches locates the rendered line for the pane's JSON path
+// query in the wrapped Pretty view and returns a match covering its key.
+func (m *Model) jsonPathSearchMatches(
+	pane *responsePaneState,
+	tab responseTab,
+	width int,
+) []searchMatch {
+	if pane == nil || pane.snapshot == nil || tab != responseTabPretty {
+		return nil
+	}
+	path, ok := canonicalJSONPath(pane.search.query)
+	if !ok {
+		return nil
+	}
+	snap := pane.snapshot
+	formatted, lines, err := js.FormatValueWithPaths(string(snap.body))
+	if err != nil {
+		return nil
+	}
+	line, ok := lines[path]
+	if !ok {
+		return nil
+	}
+
+	display := displayContent(snap.pretty)
+	plain := stripANSIEscape(display)
+	offset := strings.Index(plain, formatted)
+	if offset < 0 {
+		return nil
+	}
+	line += strings.Count(plain[:offset], "\n")
+
+	wrapped, spans, _ := wrapContentForTabMap(tab, display, width)
+
+	if line >= len(spans) {
+		return nil
+	}
+	match, ok := renderedKeyMatch(wrapped, spans[line].start, jsonPathLabel(path))
+	if !ok {
+		return nil
+	}
+	return []searchMatch{match}
+}
+
+// jsonPathLabel returns the key text the formatter renders for the last
+// path segment, or "" when the path ends in an array index.
+func jsonPathLabel(path string) string {
+	if strings.HasSuffix(path, "\"]") {
+		start := strings.LastIndex(path, "[\"")
+		if start >= 0 {
+			return path[start+1 : len(path)-1]
+		}
+	}
+	if strings.HasSuffix(path, "]") {
+		return ""
+	}
+	if idx := strings.LastIndexByte(path, '.'); idx >= 0 {
+		return path[idx+1:]
+	}
+	return ""
+}
+
+// renderedKeyMatch finds the first visible token on a wrapped line, skipping
+// ANSI styling and indentation. When label is set only that text is matched.
+func renderedKeyMatch(content string, line int, label string) (searchMatch, bool) {
+	pos := 0
+	for i := 0; i < line; i++ {
+		next := strings.IndexByte(content[pos:], '\n')
+		if next < 0 {
+			return searchMatch{}, false
+		}
+		pos += next + 1
+	}
+	lineEnd := strings.IndexByte(content[pos:], '\n')
+	if lineEnd < 0 {
+		lineEnd = len(content)
+	} else {
+		lineEnd += pos
+	}
+	for pos < lineEnd {
+		if content[pos] == '\x1b' {
+			loc := ansiSequenceRegex.FindStringIndex(content[pos:lineEnd])
+			if loc != nil && loc[0] == 0 {
+				pos += loc[1]
+				continue
+			}
+		}
+		if content[pos] != ' ' && content[pos] != '\t' {
+			break
+		}
+		pos++
+	}
+	if pos >= lineEnd {
+		return searchMatch{}, false
+	}
+	end := pos
+	if label != "" && strings.HasPrefix(content[pos:lineEnd], label) {
+		end = pos + len(label)
+	} else {
+		for end < lineEnd && content[end] != '\x1b' {
+			end++
+		}
+		for end > pos && (content[end-1] == ',' || content[end-1] == ' ') {
+			end--
+		}
+	}
+	if end <= pos {
+		return searchMatch{}, false
+	}
+	start := utf8.RuneCountInString(content[:pos])
+	return searchMatch{start: start, end: start + utf8.RuneCountInString(content[pos:end])}, true
+}
+
+// computePaneSearch refreshes a pane's matches for either a text or a JSON
+// path query.
+func (m *Model) computePaneSearch(
+	pane *responsePaneState,
+	tab responseTab,
+	width int,
+	wrapped string,
+) error {
+	if !pane.search.jsonPath {
+		return pane.search.computeMatches(wrapped)
+	}
+	pane.search.setMatches(m.jsonPathSearchMatches(pane, tab, width))
+	return nil
+}
+
+func jsonPathMissText(query string, tab responseTab) string {
+	if tab != responseTabPretty {
+		return "JSON path search needs the Pretty tab"
+	}
+	if _, ok := canonicalJSONPath(query); !ok {
+		return fmt.Sprintf("Invalid JSON path %q", query)
+	}
+	return fmt.Sprintf("Path not found: %s", query)
+}
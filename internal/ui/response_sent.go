@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"strings"
+
+	udiff "github.com/aymanbagabas/go-udiff"
+)
+
+// renderSentRequest builds the Sent tab: the request as it went out,
+// followed by a diff against the request before templates and scripts ran.
+// Secret values and sensitive headers are masked on the sent side only so
+// the diff still shows which placeholders resolved.
+func renderSentRequest(source, sent string, secrets []string, maskHeaders bool) string {
+	if strings.TrimSpace(sent) == "" {
+		return ""
+	}
+	sent = withTrailingNewline(redactHistoryText(sent, secrets, maskHeaders))
+	source = withTrailingNewline(source)
+
+	var b strings.Builder
+	b.WriteString("Sent request\n\n")
+	b.WriteString(sent)
+	b.WriteString("\n")
+	if strings.TrimSpace(source) == "" {
+		return b.String()
+	}
+	if source == sent {
+		b.WriteString("No changes from the editor request.\n")
+		return b.String()
+	}
+	b.WriteString("Changes from the editor request\n\n")
+	b.WriteString(colorizeDiff(udiff.Unified("editor", "sent", source, sent)))
+	return b.String()
+}
+
+// sentRequestView renders the Sent tab for a single send. The consume
+// functions pick it up through m.responseSent when building the snapshot.
+func (m *Model) sentRequestView(msg responseMsg) string {
+	req := msg.executed
+	if req == nil {
+		return ""
+	}
+	return renderSentRequest(
+		msg.sourceText,
+		msg.requestText,
+		m.secretValuesForRedaction(req),
+		!req.Metadata.AllowSensitiveHeaders,
+	)
+}
+
+func (m *Model) snapshotHasSentRequest() bool {
+	hasSent := func(snapshot *responseSnapshot) bool {
+		return snapshot != nil && snapshot.sent != ""
+	}
+	for _, id := range m.visiblePaneIDs() {
+		pane := m.pane(id)
+		if pane != nil && hasSent(pane.snapshot) {
+			return true
+		}
+	}
+	return hasSent(m.responseLatest)
+}
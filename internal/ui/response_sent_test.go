@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/unkn0wn-root/resterm/internal/grpcclient"
+	"github.com/unkn0wn-root/resterm/internal/restfile"
+)
+
+func TestRenderSentRequestMasksSecretsAndDiffsSource(t *testing.T) {
+	source := "GET {{base}}/users\nAuthorization: Bearer {{token}}\nX-Trace: {{trace}}\n\n"
+	sent := "GET https://api.local/users\nAuthorization: Bearer s3cr3t\nX-Trace: abc\n\n"
+
+	out := stripANSIEscape(renderSentRequest(source, sent, []string{"abc"}, true))
+	if strings.Contains(out, "s3cr3t") || strings.Contains(out, "abc") {
+		t.Fatalf("expected secrets masked, got:\n%s", out)
+	}
+	for _, want := range []string{
+		"+GET https://api.local/users",
+		"-GET {{base}}/users",
+		"-X-Trace: {{trace}}",
+		"+X-Trace: •••",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in sent view, got:\n%s", want, out)
+		}
+	}
+
+	same := renderSentRequest(sent, sent, nil, false)
+	if !strings.Contains(same, "No changes from the editor request.") {
+		t.Fatalf("expected unchanged note, got:\n%s", same)
+	}
+}
+
+func TestHandleResponseMessageAddsSentTab(t *testing.T) {
+	model := New(Config{})
+	model.ready = true
+	model.width = 120
+	model.height = 40
+	if cmd := model.applyLayout(); cmd != nil {
+		collectMsgs(cmd)
+	}
+	req := &restfile.Request{
+		Method:  "GRPC",
+		URL:     "grpc.local:443",
+		Headers: http.Header{"X-Id": {"42"}},
+		GRPC:    &restfile.GRPCRequest{FullMethod: "/pkg.Service/Get"},
+	}
+	msg := responseMsg{
+		grpc:        &grpcclient.Response{StatusCode: codes.OK, Body: []byte(`{}`)},
+		executed:    req,
+		sourceText:  "GRPC grpc.local:443\nX-Id: {{id}}\n\n",
+		requestText: renderRequestText(req),
+	}
+	if cmd := model.handleResponseMessage(msg); cmd != nil {
+		collectMsgs(cmd)
+	}
+	if indexOfResponseTab(model.availableResponseTabs(), responseTabSent) == -1 {
+		t.Fatalf("expected Sent tab after send")
+	}
+	pane := model.pane(responsePanePrimary)
+	pane.setActiveTab(responseTabSent)
+	model.syncResponsePane(responsePanePrimary)
+	view := stripANSIEscape(pane.viewport.View())
+	if !strings.Contains(view, "+X-Id: 42") || !strings.Contains(view, "-X-Id: {{id}}") {
+		t.Fatalf("expected header diff in Sent tab, got:\n%s", view)
+	}
+}
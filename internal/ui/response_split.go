@@ -33,10 +33,12 @@ type responseSnapshot struct {
 	rawText         string
 	rawHex          string
 	rawBase64       string
+	rawWire         string
 	rawMode         rawViewMode
 	rawLoading      bool
 	rawLoadingMode  rawViewMode
 	headers         string
+	headerDiff      string
 	requestHeaders  string
 	stats           string
 	statsColored    string
@@ -44,6 +46,10 @@ type responseSnapshot struct {
 	statsKind       statsReportKind
 	profileStats    *analysis.LatencyStats
 	workflowStats   *workflowStatsView
+	jsonTree        *jsonTreeView
+	jsonTreeErr     error
+	protoText       string
+	sent            string
 	ready           bool
 	timeline        *nettrace.Timeline
 	traceData       *nettrace.Report
@@ -56,6 +62,8 @@ type responseSnapshot struct {
 	contentType     string
 	responseHeaders http.Header
 	effectiveURL    string
+	bodyFormatted   map[bodyFormat]string
+	bodyFormatting  map[bodyFormat]bool
 }
 
 type headersViewMode int
@@ -83,6 +91,7 @@ type responsePaneState struct {
 	sel              respSel
 	cursor           respCursor
 	cursorStore      map[respCursorKey]respCursor
+	bodyFmt          bodyFormatState
 }
 
 type responseReflowState struct {
@@ -579,6 +588,11 @@ func (m *Model) responseContentWidth() int {
 }
 
 func (m *Model) syncResponsePane(id responsePaneID) tea.Cmd {
+	fmtCmd := m.ensureBodyFormat(m.pane(id))
+	return tea.Batch(fmtCmd, m.syncResponsePaneContent(id))
+}
+
+func (m *Model) syncResponsePaneContent(id responsePaneID) tea.Cmd {
 	pane := m.pane(id)
 	if pane == nil {
 		return nil
@@ -600,6 +614,11 @@ func (m *Model) syncResponsePane(id responsePaneID) tea.Cmd {
 			return m.syncWorkflowStatsPane(pane, w, snapshot)
 		}
 	}
+	if tab == responseTabTree {
+		if view, _ := snapshotJSONTree(pane.snapshot, m.cfg.Settings.JSONMaxDepth); view != nil {
+			return m.syncJSONTreePane(pane, w, pane.snapshot, view)
+		}
+	}
 
 	sr, sid := paneSnap(pane)
 
@@ -791,7 +810,7 @@ func (m *Model) decorateResponseContentForPane(
 	if pane.search.needsRefresh(snapshotID, tab, width) {
 		prevIndex := pane.search.index
 		pane.search.prepare(pane.search.query, pane.search.isRegex, tab, snapshotID, width)
-		if err := pane.search.computeMatches(base); err != nil {
+		if err := m.computePaneSearch(pane, tab, width, base); err != nil {
 			pane.search.invalidate()
 			return base
 		}
@@ -861,6 +880,9 @@ func (m *Model) paneContentBaseForTab(
 
 	switch tab {
 	case responseTabPretty:
+		if text, ok := paneFormattedBody(pane, snapshot); ok {
+			return text, tab
+		}
 		return snapshot.pretty, tab
 	case responseTabRaw:
 		return snapshot.raw, tab
@@ -875,6 +897,19 @@ func (m *Model) paneContentBaseForTab(
 			return "<no headers>\n", tab
 		}
 		return snapshot.headers, tab
+	case responseTabTree:
+		view, err := snapshotJSONTree(snapshot, m.cfg.Settings.JSONMaxDepth)
+		if view == nil {
+			if err != nil {
+				return "Tree view unavailable: " + err.Error() + "\n", tab
+			}
+			return "Tree view unavailable.\n", tab
+		}
+		return view.render(responseWrapWidth(tab, pane.viewport.Width)).content, tab
+	case responseTabProto:
+		return snapshot.protoText, tab
+	case responseTabSent:
+		return snapshot.sent, tab
 	case responseTabStats:
 		if strings.TrimSpace(snapshot.stats) == "" {
 			return "<no stats>\n", tab
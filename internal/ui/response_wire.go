@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const wireRule = "──"
+
+// formatWire renders a response captured with capture-wire for the Raw tab.
+// The status line and headers are shown as received. A chunked body is split
+// at its boundaries with the size of each chunk, so framing problems are
+// visible. Anything that does not parse is shown verbatim after a marker.
+func formatWire(wire []byte, truncated bool) string {
+	if len(wire) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	head, body, ok := bytes.Cut(wire, []byte("\r\n\r\n"))
+	if !ok {
+		b.WriteString(wireText(wire))
+		b.WriteString("\n")
+		writeWireTruncated(&b, truncated)
+		return b.String()
+	}
+	b.WriteString(strings.ReplaceAll(string(head), "\r\n", "\n"))
+	b.WriteString("\n\n")
+
+	if isChunkedHead(head) {
+		writeWireChunks(&b, body)
+	} else {
+		fmt.Fprintf(&b, "%s body · %d bytes %s\n", wireRule, len(body), wireRule)
+		b.WriteString(wireText(body))
+		b.WriteString("\n")
+	}
+	writeWireTruncated(&b, truncated)
+	return b.String()
+}
+
+func isChunkedHead(head []byte) bool {
+	for _, line := range strings.Split(string(head), "\r\n")[1:] {
+		name, value, ok := strings.Cut(line, ":")
+		name = textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))
+		if !ok || name != "Transfer-Encoding" {
+			continue
+		}
+		for _, coding := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(coding), "chunked") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func writeWireChunks(b *strings.Builder, body []byte) {
+	count, total := 0, 0
+	rest := body
+	for {
+		line, after, ok := bytes.Cut(rest, []byte("\r\n"))
+		if !ok {
+			writeWireUnparsed(b, "incomplete chunk size line", rest)
+			return
+		}
+		sizeText, ext, _ := strings.Cut(string(line), ";")
+		size, err := strconv.ParseUint(strings.TrimSpace(sizeText), 16, 31)
+		if err != nil {
+			writeWireUnparsed(b, fmt.Sprintf("bad chunk size %q", line), rest)
+			return
+		}
+		extNote := ""
+		if ext = strings.TrimSpace(ext); ext != "" {
+			extNote = " ;" + ext
+		}
+		if size == 0 {
+			fmt.Fprintf(b, "%s last chunk (0)%s %s\n", wireRule, extNote, wireRule)
+			if trailers := strings.TrimRight(string(after), "\r\n"); trailers != "" {
+				b.WriteString(strings.ReplaceAll(trailers, "\r\n", "\n"))
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(b, "%s %d chunk(s), %d bytes %s\n", wireRule, count, total, wireRule)
+			return
+		}
+		n := int(size)
+		count++
+		fmt.Fprintf(b, "%s chunk %d · %d bytes (0x%s)%s %s\n",
+			wireRule, count, n, strings.TrimSpace(sizeText), extNote, wireRule)
+		if len(after) < n {
+			writeWireUnparsed(b, fmt.Sprintf("only %d of %d bytes received", len(after), n), after)
+			return
+		}
+		total += n
+		b.WriteString(wireText(after[:n]))
+		b.WriteString("\n")
+		rest = after[n:]
+		if !bytes.HasPrefix(rest, []byte("\r\n")) {
+			writeWireUnparsed(b, "missing CRLF after chunk data", rest)
+			return
+		}
+		rest = rest[2:]
+	}
+}
+
+func writeWireUnparsed(b *strings.Builder, reason string, rest []byte) {
+	fmt.Fprintf(b, "%s %s %s\n", wireRule, reason, wireRule)
+	if len(rest) > 0 {
+		b.WriteString(wireText(rest))
+		b.WriteString("\n")
+	}
+}
+
+func writeWireTruncated(b *strings.Builder, truncated bool) {
+	if truncated {
+		fmt.Fprintf(b, "%s wire capture truncated %s\n", wireRule, wireRule)
+	}
+}
+
+func wireText(data []byte) string {
+	if !utf8.Valid(data) {
+		return fmt.Sprintf("<%d bytes of binary data>", len(data))
+	}
+	return string(data)
+}
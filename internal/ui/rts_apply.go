@@ -446,6 +446,7 @@ func setRequestVars(req *restfile.Request, vars map[string]string) {
 		key := strings.ToLower(name)
 		if idx, ok := existing[key]; ok {
 			req.Variables[idx].Value = value
+			req.Variables[idx].Line = 0
 		} else {
 			req.Variables = append(req.Variables, restfile.Variable{
 				Name:  name,
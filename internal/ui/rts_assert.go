@@ -98,17 +98,48 @@ func (m *Model) runAsserts(
 		if expr == "" {
 			continue
 		}
+		if as.JSONPath != nil {
+			results = append(results, jsonPathAssertResult(as, resp))
+			continue
+		}
+		if as.Status != nil {
+			results = append(results, statusAssertResult(as, resp))
+			continue
+		}
+		if as.Cookie != nil {
+			results = append(results, cookieAssertResult(as, resp))
+			continue
+		}
+		if as.XPath != nil {
+			results = append(results, xpathAssertResult(as, resp, req.Metadata.XMLNS))
+			continue
+		}
+		if as.Metric != nil {
+			results = append(results, metricAssertResult(as, resp))
+			continue
+		}
+		if as.Profile != nil {
+			// Evaluated once against the run's stats in finalizeProfileRun.
+			continue
+		}
 		rt.Site = "@assert " + expr
 		start := time.Now()
 		val, err := m.rtsEng.Eval(ctx, rt, expr, m.assertPos(doc, req, as.Line))
 		if err != nil {
 			return results, err
 		}
+		passed := val.IsTruthy()
 		msg := strings.TrimSpace(as.Message)
+		if as.Negate {
+			passed = !passed
+			if !passed {
+				msg = appendAssertDetail(msg, negatedAssertDetail(as))
+			}
+		}
 		results = append(results, scripts.TestResult{
-			Name:    expr,
+			Name:    assertName(as),
 			Message: msg,
-			Passed:  val.IsTruthy(),
+			Passed:  passed,
 			Elapsed: time.Since(start),
 		})
 	}
@@ -119,6 +150,70 @@ func (m *Model) runAsserts(
 	return results, nil
 }
 
+func jsonPathAssertResult(as restfile.AssertSpec, resp *rts.Resp) scripts.TestResult {
+	start := time.Now()
+	var body []byte
+	if resp != nil {
+		body = resp.Body
+	}
+	passed, detail := evalJSONPathAssert(as.JSONPath, body)
+	return builtinAssertResult(as, start, passed, detail)
+}
+
+func statusAssertResult(as restfile.AssertSpec, resp *rts.Resp) scripts.TestResult {
+	start := time.Now()
+	code := 0
+	if resp != nil {
+		code = resp.Code
+	}
+	passed, detail := evalStatusAssert(as.Status, code)
+	return builtinAssertResult(as, start, passed, detail)
+}
+
+// builtinAssertResult reports an assertion evaluated outside RTS, adding
+// detail to the message on failure.
+func builtinAssertResult(
+	as restfile.AssertSpec,
+	start time.Time,
+	passed bool,
+	detail string,
+) scripts.TestResult {
+	if as.Negate {
+		passed = !passed
+		detail = negatedAssertDetail(as)
+	}
+	msg := strings.TrimSpace(as.Message)
+	if !passed {
+		msg = appendAssertDetail(msg, detail)
+	}
+	return scripts.TestResult{
+		Name:    assertName(as),
+		Message: msg,
+		Passed:  passed,
+		Elapsed: time.Since(start),
+	}
+}
+
+func appendAssertDetail(msg, detail string) string {
+	if msg == "" {
+		return detail
+	}
+	return msg + " (" + detail + ")"
+}
+
+// assertName is the assertion as written, including a "not" prefix.
+func assertName(as restfile.AssertSpec) string {
+	expr := strings.TrimSpace(as.Expression)
+	if as.Negate {
+		return "not " + expr
+	}
+	return expr
+}
+
+func negatedAssertDetail(as restfile.AssertSpec) string {
+	return "expected NOT " + strings.TrimSpace(as.Expression)
+}
+
 func mergeErr(a, b error) error {
 	if a == nil {
 		return b
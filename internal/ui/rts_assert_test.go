@@ -3,7 +3,9 @@ package ui
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/unkn0wn-root/resterm/internal/parser"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
 	"github.com/unkn0wn-root/resterm/internal/rts"
 )
@@ -55,3 +57,454 @@ func TestRunAsserts(t *testing.T) {
 		t.Fatalf("unexpected assert message: %q", results[2].Message)
 	}
 }
+
+func TestRunAssertsJSONPath(t *testing.T) {
+	model := New(Config{})
+	doc := &restfile.Document{Path: "assert.http"}
+	jp := func(path, op, want string) restfile.AssertSpec {
+		return restfile.AssertSpec{
+			Expression: "jsonpath " + path + " " + op + " " + want,
+			JSONPath:   &restfile.JSONPathAssert{Path: path, Op: op, Expected: want},
+		}
+	}
+	req := &restfile.Request{
+		Metadata: restfile.RequestMetadata{
+			Asserts: []restfile.AssertSpec{
+				jp("$.count", "==", "5"),
+				jp("$.count", ">=", "4.5"),
+				jp("$.id", "==", "42"),
+				jp("$.name", "==", `"alice"`),
+				jp("$.active", "==", "true"),
+				jp("$.missing", "==", "null"),
+				jp("$.deleted", "==", "null"),
+				jp("$.items[1]", "==", "b"),
+				jp("$.count", "<", "3"),
+			},
+		},
+	}
+	resp := &rts.Resp{
+		Code: 200,
+		Body: []byte(`{"count":5,"id":"42","name":"alice","active":true,"deleted":null,` +
+			`"items":["a","b"]}`),
+	}
+
+	results, err := model.runAsserts(
+		context.Background(),
+		doc,
+		req,
+		"",
+		"",
+		map[string]string{},
+		nil,
+		resp,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("run asserts: %v", err)
+	}
+	want := []bool{true, true, true, true, true, false, true, true, false}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(results))
+	}
+	for i, passed := range want {
+		if results[i].Passed != passed {
+			t.Fatalf("assert %q: expected passed=%v, got %+v", results[i].Name, passed, results[i])
+		}
+	}
+	if results[5].Message != "$.missing not found" {
+		t.Fatalf("unexpected missing-path message: %q", results[5].Message)
+	}
+	if results[8].Message != "actual 5" {
+		t.Fatalf("expected actual value in failure message, got %q", results[8].Message)
+	}
+}
+
+func TestEvalJSONPathQuantifiers(t *testing.T) {
+	body := []byte(`{"items":[{"status":"active","n":1},{"status":"pending","n":2},` +
+		`{"n":3}]}`)
+	cases := []struct {
+		quant, path, op, want string
+		passed                bool
+		detail                string
+	}{
+		{"all", "$.items[*].n", ">", "0", true, "3 of 3 values matched"},
+		{"all", "$.items[*].status", "==", `"active"`, false,
+			`1 of 2 values matched; #2 is "pending"`},
+		{"any", "$.items[*].status", "==", `"pending"`, true, "1 of 2 values matched"},
+		{"none", "$.items[*].status", "==", `"pending"`, false,
+			`1 of 2 values matched; #2 is "pending"`},
+		{"none", "$.items[*].status", "==", `"deleted"`, true, "0 of 2 values matched"},
+		{"any", "$.missing[*].id", "==", "1", false, "$.missing[*].id matched no values"},
+		{"none", "$.missing[*].id", "==", "1", true, "$.missing[*].id matched no values"},
+	}
+	for _, tc := range cases {
+		spec := &restfile.JSONPathAssert{
+			Path:       tc.path,
+			Quantifier: tc.quant,
+			Op:         tc.op,
+			Expected:   tc.want,
+		}
+		passed, detail := evalJSONPathAssert(spec, body)
+		if passed != tc.passed || detail != tc.detail {
+			t.Fatalf("%s %s %s %s: got %v %q", tc.path, tc.quant, tc.op, tc.want, passed, detail)
+		}
+	}
+}
+
+func TestRunAssertsStatusIn(t *testing.T) {
+	model := New(Config{})
+	doc := &restfile.Document{Path: "assert.http"}
+	set := &restfile.StatusAssert{Ranges: []restfile.StatusRange{
+		{Min: 200, Max: 201},
+		{Min: 204, Max: 204},
+	}}
+	ranged := &restfile.StatusAssert{Ranges: []restfile.StatusRange{{Min: 200, Max: 299}}}
+	req := &restfile.Request{
+		Metadata: restfile.RequestMetadata{
+			Asserts: []restfile.AssertSpec{
+				{Expression: "status in 200,201,204", Status: set},
+				{Expression: "status in 200-299", Status: ranged},
+			},
+		},
+	}
+
+	run := func(code int) []bool {
+		t.Helper()
+		results, err := model.runAsserts(
+			context.Background(),
+			doc,
+			req,
+			"",
+			"",
+			map[string]string{},
+			nil,
+			&rts.Resp{Code: code},
+			nil,
+			nil,
+		)
+		if err != nil {
+			t.Fatalf("run asserts: %v", err)
+		}
+		out := make([]bool, len(results))
+		for i, r := range results {
+			out[i] = r.Passed
+		}
+		if code == 404 && results[0].Message != "expected status in 200-201, 204, got 404" {
+			t.Fatalf("unexpected failure message: %q", results[0].Message)
+		}
+		return out
+	}
+	if got := run(204); !got[0] || !got[1] {
+		t.Fatalf("expected 204 to pass both asserts, got %v", got)
+	}
+	if got := run(202); got[0] || !got[1] {
+		t.Fatalf("expected 202 to pass only the range, got %v", got)
+	}
+	if got := run(404); got[0] || got[1] {
+		t.Fatalf("expected 404 to fail both asserts, got %v", got)
+	}
+}
+
+func TestRunAssertsNegated(t *testing.T) {
+	model := New(Config{})
+	doc := &restfile.Document{Path: "assert.http"}
+	req := &restfile.Request{
+		Metadata: restfile.RequestMetadata{
+			Asserts: []restfile.AssertSpec{
+				{Expression: "status == 500", Negate: true},
+				{
+					Expression: "status in 200-299",
+					Negate:     true,
+					Status: &restfile.StatusAssert{
+						Ranges: []restfile.StatusRange{{Min: 200, Max: 299}},
+					},
+				},
+				{
+					Expression: "jsonpath $.error",
+					Negate:     true,
+					JSONPath:   &restfile.JSONPathAssert{Path: "$.error"},
+				},
+				{
+					Expression: `contains(header("Content-Type"), "json")`,
+					Negate:     true,
+					Message:    "no json",
+				},
+			},
+		},
+	}
+	resp := &rts.Resp{
+		Code: 200,
+		H:    map[string][]string{"Content-Type": {"application/json"}},
+		Body: []byte(`{"ok":true}`),
+	}
+	results, err := model.runAsserts(
+		context.Background(),
+		doc,
+		req,
+		"",
+		"",
+		map[string]string{},
+		nil,
+		resp,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("run asserts: %v", err)
+	}
+	want := []bool{true, false, true, false}
+	for i, ok := range want {
+		if results[i].Passed != ok {
+			t.Fatalf("assert %d: expected passed=%v, got %+v", i, ok, results[i])
+		}
+	}
+	if results[0].Name != "not status == 500" {
+		t.Fatalf("unexpected assert name: %q", results[0].Name)
+	}
+	if results[1].Message != "expected NOT status in 200-299" {
+		t.Fatalf("unexpected failure message: %q", results[1].Message)
+	}
+	if results[3].Message != `no json (expected NOT contains(header("Content-Type"), "json"))` {
+		t.Fatalf("unexpected failure message: %q", results[3].Message)
+	}
+}
+
+func TestRunAssertsMetrics(t *testing.T) {
+	model := New(Config{})
+	doc := &restfile.Document{Path: "assert.http"}
+	req := &restfile.Request{
+		Metadata: restfile.RequestMetadata{
+			Asserts: []restfile.AssertSpec{
+				{
+					Expression: "header-count >= 2",
+					Metric:     &restfile.MetricAssert{Metric: "header-count", Op: ">=", Value: 2},
+				},
+				{
+					Expression: "header-count > 5",
+					Metric:     &restfile.MetricAssert{Metric: "header-count", Op: ">", Value: 5},
+				},
+				{
+					Expression: "body-size < 1KB",
+					Metric:     &restfile.MetricAssert{Metric: "body-size", Op: "<", Value: 1024},
+				},
+				{
+					Expression: "body-size > 10",
+					Metric:     &restfile.MetricAssert{Metric: "body-size", Op: ">", Value: 10},
+				},
+				{
+					Expression: "response-time < 500ms",
+					Metric: &restfile.MetricAssert{
+						Metric: "response-time",
+						Op:     "<",
+						Value:  int64(500 * time.Millisecond),
+					},
+				},
+			},
+		},
+	}
+	resp := &rts.Resp{
+		Code: 200,
+		H: map[string][]string{
+			"Content-Type": {"application/json"},
+			"Set-Cookie":   {"a=1", "b=2"},
+		},
+		Body:     []byte(`{"ok":true}`),
+		Duration: 812345678 * time.Nanosecond,
+	}
+	results, err := model.runAsserts(
+		context.Background(),
+		doc,
+		req,
+		"",
+		"",
+		map[string]string{},
+		nil,
+		resp,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("run asserts: %v", err)
+	}
+	want := []bool{true, false, true, true, false}
+	for i, ok := range want {
+		if results[i].Passed != ok {
+			t.Fatalf("assert %d: expected passed=%v, got %+v", i, ok, results[i])
+		}
+	}
+	if results[1].Message != "expected header-count > 5, got 2" {
+		t.Fatalf("unexpected failure message: %q", results[1].Message)
+	}
+	_, detail := evalMetricAssert(req.Metadata.Asserts[2].Metric, resp)
+	if detail != "expected body-size < 1024 bytes, got 11 bytes (11 B)" {
+		t.Fatalf("unexpected body-size detail: %q", detail)
+	}
+	if results[4].Message != "expected response-time < 500ms, got 812.346ms" {
+		t.Fatalf("unexpected response-time message: %q", results[4].Message)
+	}
+}
+
+func TestRunAssertsCookie(t *testing.T) {
+	model := New(Config{})
+	doc := &restfile.Document{Path: "assert.http"}
+	cookie := func(expr, name, op, want string) restfile.AssertSpec {
+		return restfile.AssertSpec{
+			Expression: expr,
+			Cookie:     &restfile.CookieAssert{Name: name, Op: op, Expected: want},
+		}
+	}
+	req := &restfile.Request{
+		Metadata: restfile.RequestMetadata{
+			Asserts: []restfile.AssertSpec{
+				cookie(`cookie sessionid != ""`, "sessionid", "!=", ""),
+				cookie("cookie theme == dark", "theme", "==", "dark"),
+				cookie("cookie theme == light", "theme", "==", "light"),
+				cookie("cookie sessionid == abc", "sessionid", "==", "abc"),
+				cookie("cookie missing", "missing", "", ""),
+			},
+		},
+	}
+	resp := &rts.Resp{
+		Code: 200,
+		H: map[string][]string{
+			"set-cookie": {
+				"theme=light; Path=/",
+				"sessionid=xyz789; HttpOnly; Secure",
+				"theme=dark; Max-Age=3600",
+			},
+		},
+	}
+	results, err := model.runAsserts(
+		context.Background(),
+		doc,
+		req,
+		"",
+		"",
+		map[string]string{},
+		nil,
+		resp,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("run asserts: %v", err)
+	}
+	want := []bool{true, true, false, false, false}
+	for i, ok := range want {
+		if results[i].Passed != ok {
+			t.Fatalf("assert %d: expected passed=%v, got %+v", i, ok, results[i])
+		}
+	}
+	if results[2].Message != `actual "dark"` {
+		t.Fatalf("unexpected failure message: %q", results[2].Message)
+	}
+	if results[3].Message != "actual •••" {
+		t.Fatalf("expected masked session cookie, got %q", results[3].Message)
+	}
+	if results[4].Message != "cookie missing not set" {
+		t.Fatalf("unexpected missing cookie message: %q", results[4].Message)
+	}
+}
+
+func TestRunAssertsXPath(t *testing.T) {
+	model := New(Config{})
+	doc := &restfile.Document{Path: "assert.http"}
+	xp := func(expr, op, want string) restfile.AssertSpec {
+		return restfile.AssertSpec{
+			Expression: "xpath " + expr,
+			XPath:      &restfile.XPathAssert{Expr: expr, Op: op, Expected: want},
+		}
+	}
+	req := &restfile.Request{
+		Metadata: restfile.RequestMetadata{
+			XMLNS: map[string]string{"s": "http://schemas.xmlsoap.org/soap/envelope/"},
+			Asserts: []restfile.AssertSpec{
+				xp("//status/text()", "==", `"OK"`),
+				xp("//s:Body/result/code", ">", "200"),
+				xp("count(//item)", "==", "2"),
+				xp("//status", "==", "FAILED"),
+				xp("//missing", "", ""),
+			},
+		},
+	}
+	body := `<?xml version="1.0"?>
+<env:Envelope xmlns:env="http://schemas.xmlsoap.org/soap/envelope/">
+  <env:Body>
+    <result><status>OK</status><code>201</code><item/><item/></result>
+  </env:Body>
+</env:Envelope>`
+	resp := &rts.Resp{Code: 200, Body: []byte(body)}
+	results, err := model.runAsserts(
+		context.Background(),
+		doc,
+		req,
+		"",
+		"",
+		map[string]string{},
+		nil,
+		resp,
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("run asserts: %v", err)
+	}
+	want := []bool{true, true, true, false, false}
+	for i, ok := range want {
+		if results[i].Passed != ok {
+			t.Fatalf("assert %d: expected passed=%v, got %+v", i, ok, results[i])
+		}
+	}
+	if results[3].Message != `actual "OK"` {
+		t.Fatalf("unexpected failure message: %q", results[3].Message)
+	}
+	if results[4].Message != "//missing selected no nodes" {
+		t.Fatalf("unexpected missing node message: %q", results[4].Message)
+	}
+
+	passed, detail := evalXPathAssert(&restfile.XPathAssert{Expr: "//a"}, []byte(`{"a":1}`), nil)
+	if passed || detail != "response body is not XML" {
+		t.Fatalf("expected non-XML failure, got %v %q", passed, detail)
+	}
+}
+
+func TestRunAssertsKeepsRTSNotOperator(t *testing.T) {
+	model := New(Config{})
+	src := "# @assert not (status == 500)\n" +
+		"# @assert not (status == 200)\n" +
+		"# @assert not status == 500\n" +
+		"GET https://example.com\n"
+	doc := parser.Parse("assert.http", []byte(src))
+	req := doc.Requests[0]
+	for _, as := range req.Metadata.Asserts {
+		if as.Negate {
+			t.Fatalf("expected RTS not to stay in the expression, got %+v", as)
+		}
+	}
+	results, err := model.runAsserts(
+		context.Background(),
+		doc,
+		req,
+		"",
+		"",
+		map[string]string{},
+		nil,
+		&rts.Resp{Code: 200},
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("run asserts: %v", err)
+	}
+	// RTS binds not tighter than ==, so the last assert is (not status) == 500.
+	want := []bool{true, false, false}
+	for i, ok := range want {
+		if results[i].Passed != ok {
+			t.Fatalf("assert %d: expected passed=%v, got %+v", i, ok, results[i])
+		}
+	}
+	if results[1].Name != "not (status == 200)" || results[1].Message != "" {
+		t.Fatalf("expected plain RTS failure, got %+v", results[1])
+	}
+}
@@ -239,14 +239,27 @@ func rtsHTTP(resp *httpclient.Response) *rts.Resp {
 		h[k] = vv
 	}
 	return &rts.Resp{
-		Status: resp.Status,
-		Code:   resp.StatusCode,
-		H:      h,
-		Body:   resp.Body,
-		URL:    resp.EffectiveURL,
+		Status:    resp.Status,
+		Code:      resp.StatusCode,
+		H:         h,
+		Body:      resp.Body,
+		URL:       resp.EffectiveURL,
+		Redirects: rtsHops(resp.Redirects),
+		Duration:  resp.Duration,
 	}
 }
 
+func rtsHops(in []httpclient.Redirect) []rts.Hop {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]rts.Hop, 0, len(in))
+	for _, r := range in {
+		out = append(out, rts.Hop{URL: r.URL, Code: r.StatusCode, Location: r.Location})
+	}
+	return out
+}
+
 func rtsTrace(resp *httpclient.Response) *rts.Trace {
 	if resp == nil || resp.TraceReport == nil {
 		return nil
@@ -267,7 +280,13 @@ func rtsGRPC(resp *grpcclient.Response) *rts.Resp {
 		vv := append([]string(nil), v...)
 		h[k] = vv
 	}
-	return &rts.Resp{Status: resp.StatusMessage, Code: int(resp.StatusCode), H: h, Body: resp.Body}
+	return &rts.Resp{
+		Status:   resp.StatusMessage,
+		Code:     int(resp.StatusCode),
+		H:        h,
+		Body:     resp.Body,
+		Duration: resp.Duration,
+	}
 }
 
 func rtsScriptResp(resp *scripts.Response) *rts.Resp {
@@ -281,11 +300,13 @@ func rtsScriptResp(resp *scripts.Response) *rts.Resp {
 	}
 	b := append([]byte(nil), resp.Body...)
 	return &rts.Resp{
-		Status: resp.Status,
-		Code:   resp.Code,
-		H:      h,
-		Body:   b,
-		URL:    resp.URL,
+		Status:    resp.Status,
+		Code:      resp.Code,
+		H:         h,
+		Body:      b,
+		URL:       resp.URL,
+		Redirects: rtsHops(resp.Redirects),
+		Duration:  resp.Time,
 	}
 }
 
@@ -321,6 +342,26 @@ type rtsRTIn struct {
 	st   *rts.Stream
 }
 
+// scriptReadFile is the file reader handed to RTS. With sandbox_scripts on
+// it only serves files under the request file's directory.
+func (m *Model) scriptReadFile(base string) func(string) ([]byte, error) {
+	if m.cfg.Settings.SandboxScripts {
+		return scripts.SandboxReadFile(base, os.ReadFile)
+	}
+	return os.ReadFile
+}
+
+// scriptUsePath keeps @use modules under the request file's directory when
+// sandbox_scripts is on.
+func (m *Model) scriptUsePath(base string) func(string) (string, error) {
+	if !m.cfg.Settings.SandboxScripts {
+		return nil
+	}
+	return func(path string) (string, error) {
+		return scripts.SandboxPath(base, path)
+	}
+}
+
 func (m *Model) rtsRT(in rtsRTIn) rts.RT {
 	base := m.rtsBase(in.doc, in.base)
 	resp := in.resp
@@ -345,10 +386,11 @@ func (m *Model) rtsRT(in rtsRTIn) rts.RT {
 		Stream:      in.st,
 		Req:         m.rtsReq(in.req),
 		BaseDir:     base,
-		ReadFile:    os.ReadFile,
+		ReadFile:    m.scriptReadFile(base),
 		AllowRandom: true,
 		Site:        in.site,
 		Uses:        m.rtsUses(in.doc, in.req),
+		UsePath:     m.scriptUsePath(base),
 		Extra:       in.x,
 	}
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"os"
 	"path/filepath"
 	"strings"
 
@@ -61,7 +60,7 @@ func (m *Model) runRTSPreRequest(
 		if err := ctx.Err(); err != nil {
 			return out, err
 		}
-		src, path, err := loadRTSScript(block, baseDir)
+		src, path, err := loadRTSScript(block, baseDir, m.scriptReadFile(baseDir))
 		if err != nil {
 			return out, fmt.Errorf("rts pre-request script %d: %w", idx+1, err)
 		}
@@ -86,8 +85,9 @@ func (m *Model) runRTSPreRequest(
 			VarsMut:     mut,
 			GlobalMut:   mut,
 			Uses:        uses,
+			UsePath:     m.scriptUsePath(baseDir),
 			BaseDir:     baseDir,
-			ReadFile:    os.ReadFile,
+			ReadFile:    m.scriptReadFile(baseDir),
 			AllowRandom: true,
 			Site:        "@script pre-request",
 		}
@@ -119,7 +119,11 @@ func scriptLang(lang string) string {
 	}
 }
 
-func loadRTSScript(block restfile.ScriptBlock, base string) (string, string, error) {
+func loadRTSScript(
+	block restfile.ScriptBlock,
+	base string,
+	read func(string) ([]byte, error),
+) (string, string, error) {
 	if block.FilePath == "" {
 		return block.Body, "", nil
 	}
@@ -130,7 +134,7 @@ func loadRTSScript(block restfile.ScriptBlock, base string) (string, string, err
 	if !filepath.IsAbs(path) && base != "" {
 		path = filepath.Join(base, path)
 	}
-	data, err := os.ReadFile(path)
+	data, err := read(path)
 	if err != nil {
 		return "", "", err
 	}
@@ -3,9 +3,12 @@ package ui
 import (
 	"context"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/unkn0wn-root/resterm/internal/config"
 	"github.com/unkn0wn-root/resterm/internal/restfile"
 	"github.com/unkn0wn-root/resterm/internal/scripts"
 )
@@ -105,3 +108,39 @@ request.setQueryParam("mutated", "true")`,
 		t.Fatalf("expected merged query params, got %q", req.URL)
 	}
 }
+
+func TestRunRTSPreRequestSandboxBlocksUseOutsideWorkspace(t *testing.T) {
+	root := t.TempDir()
+	base := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(base, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	outside := filepath.Join(root, "passwd.rts")
+	if err := os.WriteFile(outside, []byte("module leak\nexport let x = 1\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	req := &restfile.Request{
+		Method:    "GET",
+		URL:       "https://example.com",
+		LineRange: restfile.LineRange{Start: 1, End: 3},
+		Metadata: restfile.RequestMetadata{
+			Uses: []restfile.UseSpec{{Path: "../../passwd.rts"}},
+			Scripts: []restfile.ScriptBlock{{
+				Kind: "pre-request",
+				Lang: "rts",
+				Body: `vars.set("x", str(leak.x))`,
+			}},
+		},
+	}
+
+	open := New(Config{})
+	if _, err := open.runRTSPreRequest(context.Background(), nil, req, "", base, map[string]string{}, nil); err != nil {
+		t.Fatalf("expected @use to load without the sandbox, got %v", err)
+	}
+
+	sandboxed := New(Config{Settings: config.Settings{SandboxScripts: true}})
+	_, err := sandboxed.runRTSPreRequest(context.Background(), nil, req, "", base, map[string]string{}, nil)
+	if err == nil || !strings.Contains(err.Error(), "operation blocked in sandbox") {
+		t.Fatalf("expected sandbox error for @use outside the workspace, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"net/http"
+	"strings"
+)
+
+// statusMeanings explains status codes in a few words. The summary shows
+// the entry next to the status line unless hide_status_meaning is set.
+var statusMeanings = map[int]string{
+	100: "send the request body",
+	101: "protocol switch accepted",
+	103: "preload hints before the final response",
+	200: "request succeeded",
+	201: "resource created",
+	202: "accepted for processing, not finished yet",
+	203: "payload modified by a proxy",
+	204: "success with no body",
+	205: "success; reset the form or view",
+	206: "partial body for a Range request",
+	207: "multiple results in the body (WebDAV)",
+	300: "several representations to choose from",
+	301: "moved permanently; update links",
+	302: "temporarily at the Location URL",
+	303: "fetch the result from Location with GET",
+	304: "cached copy is still valid",
+	307: "temporarily moved; repeat with the same method",
+	308: "moved permanently; repeat with the same method",
+	400: "server could not understand the request",
+	401: "authentication missing or rejected",
+	402: "reserved; some APIs use it for billing limits",
+	403: "authenticated but not allowed",
+	404: "nothing at this URL",
+	405: "method not allowed for this resource",
+	406: "no representation matches the Accept headers",
+	407: "log in to the proxy first",
+	408: "server timed out waiting for the request",
+	409: "conflicts with the current state of the resource",
+	410: "resource removed for good",
+	411: "Content-Length header required",
+	412: "a conditional header did not hold",
+	413: "request body too large",
+	414: "URL too long",
+	415: "body media type not supported",
+	416: "requested range cannot be served",
+	417: "Expect header cannot be met",
+	418: "a teapot refuses to brew coffee (RFC 2324)",
+	421: "sent to a server that cannot answer for this host",
+	422: "well-formed but semantically invalid",
+	423: "resource is locked",
+	424: "a request this depended on failed",
+	425: "server will not risk replaying early data",
+	426: "client must switch protocols",
+	428: "request must be conditional",
+	429: "client is rate limited",
+	431: "header fields too large",
+	451: "blocked by a legal demand",
+	500: "unexpected server error",
+	501: "server does not support this functionality",
+	502: "invalid response from an upstream server",
+	503: "server overloaded or down for maintenance",
+	504: "upstream server timed out",
+	505: "server rejects this HTTP major version",
+	506: "content negotiation loop",
+	507: "server out of storage",
+	508: "server detected an infinite loop",
+	510: "further extensions required",
+	511: "network login required, e.g. a captive portal",
+}
+
+// statusMeaning returns the text shown after the status line: the
+// canonical reason phrase when the server sent a different one, then the
+// meaning of the code. Unknown codes return "".
+func statusMeaning(status string, code int) string {
+	var parts []string
+	if reason := http.StatusText(code); reason != "" &&
+		!strings.Contains(strings.ToLower(status), strings.ToLower(reason)) {
+		parts = append(parts, reason)
+	}
+	if meaning := statusMeanings[code]; meaning != "" {
+		parts = append(parts, meaning)
+	}
+	return strings.Join(parts, ": ")
+}
@@ -30,7 +30,7 @@ func (m *Model) statusResolver(
 	extras ...map[string]string,
 ) *vars.Resolver {
 	base := m.rtsBase(doc, "")
-	return m.buildDisplayResolver(context.Background(), doc, req, env, base, nil, extras...)
+	return m.buildDisplayResolver(context.Background(), doc, req, env, base, false, nil, extras...)
 }
 
 func (m *Model) statusRequestTarget(
@@ -19,6 +19,14 @@ type liveSession struct {
 	pausedIndex int
 	bookmarks   []streamBookmark
 	bookmarkIdx int
+	// dropNewest discards incoming events once the buffer is full instead
+	// of trimming the oldest ones. dropped counts events lost either way.
+	dropNewest bool
+	dropped    int
+	// sent and received count messages as they arrive, including any the
+	// buffer later drops, so the gRPC header shows the true totals.
+	sent     int
+	received int
 }
 
 func newLiveSession(id string, max int) *liveSession {
@@ -32,9 +40,14 @@ func (ls *liveSession) append(events []*stream.Event) {
 	if len(events) == 0 {
 		return
 	}
+	ls.count(events)
+	if ls.dropNewest {
+		events = ls.admit(events)
+	}
 	ls.events = append(ls.events, cloneEventSlice(events)...)
-	if len(ls.events) > ls.maxEvents {
+	if !ls.dropNewest && len(ls.events) > ls.maxEvents {
 		trim := len(ls.events) - ls.maxEvents
+		ls.dropped += trim
 		ls.events = append([]*stream.Event(nil), ls.events[trim:]...)
 		if ls.paused && ls.pausedIndex >= 0 {
 			ls.pausedIndex -= trim
@@ -71,6 +84,26 @@ func (ls *liveSession) append(events []*stream.Event) {
 	}
 }
 
+// admit keeps the events that still fit. Summary events carry no payload
+// and are always kept so the transcript footer survives a full buffer.
+func (ls *liveSession) admit(events []*stream.Event) []*stream.Event {
+	room := ls.maxEvents - len(ls.events)
+	kept := make([]*stream.Event, 0, len(events))
+	for _, evt := range events {
+		if evt != nil && evt.Direction == stream.DirNA {
+			kept = append(kept, evt)
+			continue
+		}
+		if room <= 0 {
+			ls.dropped++
+			continue
+		}
+		kept = append(kept, evt)
+		room--
+	}
+	return kept
+}
+
 func (ls *liveSession) setState(state stream.State, err error) {
 	ls.state = state
 	ls.err = err
@@ -143,3 +176,17 @@ func cloneEventSlice(events []*stream.Event) []*stream.Event {
 	copy(out, events)
 	return out
 }
+
+func (ls *liveSession) count(events []*stream.Event) {
+	for _, evt := range events {
+		if evt == nil {
+			continue
+		}
+		switch evt.Direction {
+		case stream.DirSend:
+			ls.sent++
+		case stream.DirReceive:
+			ls.received++
+		}
+	}
+}
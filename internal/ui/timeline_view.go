@@ -522,6 +522,9 @@ func renderPhaseMeta(meta nettrace.PhaseMeta) string {
 	if meta.Cached {
 		parts = append(parts, "cached")
 	}
+	if meta.Expect != "" {
+		parts = append(parts, "expect="+meta.Expect)
+	}
 	return strings.Join(parts, " ")
 }
 
@@ -562,6 +565,10 @@ func cloneTraceSpec(spec *restfile.TraceSpec) *restfile.TraceSpec {
 		}
 		clone.Budgets.Phases = phases
 	}
+	if spec.Export != nil {
+		export := *spec.Export
+		clone.Export = &export
+	}
 	return clone
 }
 
@@ -0,0 +1,47 @@
+package update
+
+import "strings"
+
+// FormatNotes turns markdown release notes into terminal-friendly lines,
+// rendering list markers as bullets and keeping nested indentation.
+func FormatNotes(raw string) []string {
+	normalized := strings.ReplaceAll(raw, "\r\n", "\n")
+	lines := strings.Split(normalized, "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		trimmedRight := strings.TrimRight(line, " \t")
+		if strings.TrimSpace(trimmedRight) == "" {
+			out = append(out, "")
+			continue
+		}
+
+		leading := countLeadingSpaces(line)
+		token := strings.TrimSpace(trimmedRight)
+		switch {
+		case strings.HasPrefix(token, "- ") || strings.HasPrefix(token, "* "):
+			item := strings.TrimSpace(token[2:])
+			out = append(out, strings.Repeat(" ", leading)+"• "+item)
+		default:
+			out = append(out, trimmedRight)
+		}
+	}
+	return out
+}
+
+// Tabs count as 4 spaces for changelog indentation calculation
+// so markdown nested lists look reasonable in the terminal.
+func countLeadingSpaces(s string) int {
+	count := 0
+	for _, r := range s {
+		if r == ' ' || r == '\t' {
+			if r == '\t' {
+				count += 4
+			} else {
+				count++
+			}
+			continue
+		}
+		break
+	}
+	return count
+}
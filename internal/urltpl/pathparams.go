@@ -0,0 +1,72 @@
+package urltpl
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ExpandPathParams replaces single-brace {name} placeholders in the path of
+// raw with path-escaped values from params. {{templates}}, the query string
+// and the fragment are left untouched. Placeholders without a value are
+// reported as an error.
+func ExpandPathParams(raw string, params map[string]string) (string, error) {
+	if !strings.Contains(raw, "{") {
+		return raw, nil
+	}
+	var (
+		b       strings.Builder
+		missing []string
+	)
+	b.Grow(len(raw))
+	for i := 0; i < len(raw); {
+		rest := raw[i:]
+		if strings.HasPrefix(rest, "{{") {
+			end := strings.Index(rest[2:], "}}")
+			if end < 0 {
+				b.WriteString(rest)
+				break
+			}
+			b.WriteString(rest[:end+4])
+			i += end + 4
+			continue
+		}
+		c := raw[i]
+		if c == '?' || c == '#' {
+			b.WriteString(rest)
+			break
+		}
+		if c == '{' {
+			if end := strings.IndexByte(rest, '}'); end > 1 && IsPathParamName(rest[1:end]) {
+				name := rest[1:end]
+				if val, ok := params[name]; ok {
+					b.WriteString(url.PathEscape(val))
+				} else {
+					missing = append(missing, "{"+name+"}")
+					b.WriteString(rest[:end+1])
+				}
+				i += end + 1
+				continue
+			}
+		}
+		b.WriteByte(c)
+		i++
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("unresolved path params: %s", strings.Join(missing, ", "))
+	}
+	return b.String(), nil
+}
+
+// IsPathParamName reports whether name can be used as a {name} placeholder.
+func IsPathParamName(name string) bool {
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case i > 0 && (r >= '0' && r <= '9' || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
@@ -0,0 +1,39 @@
+package urltpl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpandPathParams(t *testing.T) {
+	params := map[string]string{"id": "42", "name": "a b/c"}
+	got, err := ExpandPathParams("{{base}}/users/{id}/files/{name}?q={id}#{id}", params)
+	if err != nil {
+		t.Fatalf("ExpandPathParams: %v", err)
+	}
+	want := "{{base}}/users/42/files/a%20b%2Fc?q={id}#{id}"
+	if got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestExpandPathParamsKeepsTemplatesAndLiterals(t *testing.T) {
+	raw := "https://x.test/{{ user.id }}/{ not a param }/{}"
+	got, err := ExpandPathParams(raw, nil)
+	if err != nil {
+		t.Fatalf("ExpandPathParams: %v", err)
+	}
+	if got != raw {
+		t.Fatalf("expected %q unchanged, got %q", raw, got)
+	}
+}
+
+func TestExpandPathParamsUnresolved(t *testing.T) {
+	_, err := ExpandPathParams("/orgs/{org}/users/{id}", map[string]string{"id": "1"})
+	if err == nil {
+		t.Fatalf("expected error for unresolved param")
+	}
+	if !strings.Contains(err.Error(), "{org}") || strings.Contains(err.Error(), "{id}") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,424 @@
+package vars
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Block templates are a small handlebars-like layer used for request bodies:
+//
+//	{{#each items}}...{{this.name}}...{{else}}...{{/each}}
+//	{{#if flag}}...{{else}}...{{/if}}
+//	{{#unless @last}},{{/unless}}
+//
+// #each walks a variable holding a JSON array. Inside the block {{this}},
+// {{this.field}}, {{field}}, {{@index}}, {{@first}} and {{@last}} refer to
+// the current item. Every other tag is left untouched for ExpandTemplates.
+
+type blockKind int
+
+const (
+	blockText blockKind = iota
+	blockTag
+	blockEach
+	blockIf
+	blockUnless
+)
+
+type blockNode struct {
+	kind   blockKind
+	text   string
+	helper string
+	arg    string
+	line   int
+	body   []blockNode
+	alt    []blockNode
+}
+
+type blockToken struct {
+	start int
+	end   int
+	inner string
+	line  int
+}
+
+type blockScope struct {
+	item   any
+	index  int
+	count  int
+	parent *blockScope
+}
+
+// ExpandBlocks renders #each, #if and #unless blocks. Input without block
+// tags is returned unchanged.
+func (r *Resolver) ExpandBlocks(input string) (string, error) {
+	return r.expandBlocks(input, false)
+}
+
+// ExpandBlockTemplates renders blocks and expands the remaining tags in the
+// same pass. Loop values are inserted as data, so an item holding
+// "{{token}}" stays literal instead of pulling in another variable.
+func (r *Resolver) ExpandBlockTemplates(input string) (string, error) {
+	return r.expandBlocks(input, true)
+}
+
+func (r *Resolver) expandBlocks(input string, expand bool) (string, error) {
+	if !strings.Contains(input, "{{#") && !strings.Contains(input, "{{/") {
+		if expand {
+			return r.ExpandTemplates(input)
+		}
+		return input, nil
+	}
+	nodes, err := parseBlocks(input)
+	if err != nil {
+		return input, err
+	}
+	var out strings.Builder
+	if err := r.renderBlocks(&out, nodes, nil, expand); err != nil {
+		return input, err
+	}
+	return out.String(), nil
+}
+
+func parseBlocks(input string) ([]blockNode, error) {
+	tokens := blockTokens(input)
+	p := blockParser{input: input, tokens: tokens}
+	nodes, closer, err := p.parse(nil)
+	if err != nil {
+		return nil, err
+	}
+	if closer != nil {
+		return nil, fmt.Errorf("unexpected {{%s}} (line %d)", closer.inner, closer.line)
+	}
+	return nodes, nil
+}
+
+// blockTokens finds every {{...}} tag. Block tags that sit alone on a line
+// swallow that line so loops do not leave blank lines behind.
+func blockTokens(input string) []blockToken {
+	matches := templateVarPattern.FindAllStringSubmatchIndex(input, -1)
+	tokens := make([]blockToken, 0, len(matches))
+	for _, m := range matches {
+		inner := strings.TrimSpace(input[m[2]:m[3]])
+		tok := blockToken{
+			start: m[0],
+			end:   m[1],
+			inner: inner,
+			line:  strings.Count(input[:m[0]], "\n") + 1,
+		}
+		if isBlockTag(inner) {
+			tok.start, tok.end = standaloneSpan(input, tok.start, tok.end)
+		}
+		tokens = append(tokens, tok)
+	}
+	return tokens
+}
+
+func isBlockTag(inner string) bool {
+	return strings.HasPrefix(inner, "#") || strings.HasPrefix(inner, "/") || inner == "else"
+}
+
+func standaloneSpan(input string, start, end int) (int, int) {
+	lineStart := strings.LastIndexByte(input[:start], '\n') + 1
+	if strings.TrimSpace(input[lineStart:start]) != "" {
+		return start, end
+	}
+	lineEnd := len(input)
+	if idx := strings.IndexByte(input[end:], '\n'); idx >= 0 {
+		lineEnd = end + idx + 1
+	}
+	if strings.TrimSpace(input[end:lineEnd]) != "" {
+		return start, end
+	}
+	return lineStart, lineEnd
+}
+
+type blockParser struct {
+	input  string
+	tokens []blockToken
+	pos    int
+	offset int
+}
+
+// parse collects nodes until a closing or else tag, which it returns to the
+// caller so the enclosing block can validate it.
+func (p *blockParser) parse(open *blockToken) ([]blockNode, *blockToken, error) {
+	var nodes []blockNode
+	for p.pos < len(p.tokens) {
+		tok := p.tokens[p.pos]
+		p.pos++
+		if tok.start > p.offset {
+			nodes = append(nodes, blockNode{kind: blockText, text: p.input[p.offset:tok.start]})
+		}
+		p.offset = tok.end
+
+		switch {
+		case strings.HasPrefix(tok.inner, "/") || tok.inner == "else":
+			if open == nil {
+				return nil, nil, fmt.Errorf("unexpected {{%s}} (line %d)", tok.inner, tok.line)
+			}
+			return nodes, &tok, nil
+		case strings.HasPrefix(tok.inner, "#"):
+			node, err := p.parseBlock(tok)
+			if err != nil {
+				return nil, nil, err
+			}
+			nodes = append(nodes, node)
+		default:
+			nodes = append(nodes, blockNode{kind: blockTag, text: p.input[tok.start:tok.end]})
+		}
+	}
+	if p.offset < len(p.input) {
+		nodes = append(nodes, blockNode{kind: blockText, text: p.input[p.offset:]})
+		p.offset = len(p.input)
+	}
+	if open != nil {
+		return nil, nil, fmt.Errorf("unclosed {{%s}} (line %d)", open.inner, open.line)
+	}
+	return nodes, nil, nil
+}
+
+func (p *blockParser) parseBlock(tok blockToken) (blockNode, error) {
+	helper, arg, _ := strings.Cut(strings.TrimSpace(tok.inner[1:]), " ")
+	arg = strings.TrimSpace(arg)
+	node := blockNode{helper: helper, arg: arg, line: tok.line}
+	switch helper {
+	case "each":
+		node.kind = blockEach
+	case "if":
+		node.kind = blockIf
+	case "unless":
+		node.kind = blockUnless
+	default:
+		return blockNode{}, fmt.Errorf("unknown block {{%s}} (line %d)", tok.inner, tok.line)
+	}
+	if arg == "" {
+		return blockNode{}, fmt.Errorf("{{#%s}} requires a variable (line %d)", helper, tok.line)
+	}
+
+	body, closer, err := p.parse(&tok)
+	if err != nil {
+		return blockNode{}, err
+	}
+	node.body = body
+	if closer.inner == "else" {
+		alt, next, err := p.parse(&tok)
+		if err != nil {
+			return blockNode{}, err
+		}
+		if next.inner == "else" {
+			return blockNode{}, fmt.Errorf("duplicate {{else}} (line %d)", next.line)
+		}
+		node.alt = alt
+		closer = next
+	}
+	if name := strings.TrimSpace(closer.inner[1:]); name != helper {
+		return blockNode{}, fmt.Errorf(
+			"{{%s}} does not close {{%s}} (line %d)",
+			closer.inner,
+			tok.inner,
+			closer.line,
+		)
+	}
+	return node, nil
+}
+
+func (r *Resolver) renderBlocks(
+	out *strings.Builder,
+	nodes []blockNode,
+	scope *blockScope,
+	expand bool,
+) error {
+	for _, node := range nodes {
+		switch node.kind {
+		case blockText:
+			out.WriteString(node.text)
+		case blockTag:
+			name := strings.TrimSpace(node.text[2 : len(node.text)-2])
+			if value, ok := scope.lookup(name); ok {
+				out.WriteString(blockString(value))
+				continue
+			}
+			if !expand {
+				out.WriteString(node.text)
+				continue
+			}
+			expanded, err := r.ExpandTemplates(node.text)
+			if err != nil {
+				return err
+			}
+			out.WriteString(expanded)
+		case blockIf, blockUnless:
+			value, _, err := r.blockValue(node.arg, scope)
+			if err != nil {
+				return fmt.Errorf(
+					"{{#%s %s}} (line %d): %w",
+					node.helper,
+					node.arg,
+					node.line,
+					err,
+				)
+			}
+			branch := node.body
+			if blockTruthy(value) == (node.kind == blockUnless) {
+				branch = node.alt
+			}
+			if err := r.renderBlocks(out, branch, scope, expand); err != nil {
+				return err
+			}
+		case blockEach:
+			if err := r.renderEach(out, node, scope, expand); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Resolver) renderEach(
+	out *strings.Builder,
+	node blockNode,
+	scope *blockScope,
+	expand bool,
+) error {
+	value, ok, err := r.blockValue(node.arg, scope)
+	if err != nil {
+		return fmt.Errorf("{{#each %s}} (line %d): %w", node.arg, node.line, err)
+	}
+	if !ok {
+		return fmt.Errorf("{{#each %s}} (line %d): undefined variable", node.arg, node.line)
+	}
+	items, isArray := value.([]any)
+	if !isArray {
+		return fmt.Errorf(
+			"{{#each %s}} (line %d): value is not a JSON array",
+			node.arg,
+			node.line,
+		)
+	}
+	if len(items) == 0 {
+		return r.renderBlocks(out, node.alt, scope, expand)
+	}
+	for i, item := range items {
+		inner := &blockScope{item: item, index: i, count: len(items), parent: scope}
+		if err := r.renderBlocks(out, node.body, inner, expand); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockValue resolves a block argument against the loop scope first and then
+// the resolver. Resolver values are decoded as JSON when possible.
+func (r *Resolver) blockValue(name string, scope *blockScope) (any, bool, error) {
+	if value, ok := scope.lookup(name); ok {
+		return value, true, nil
+	}
+	raw, ok, err := r.lookup(name)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return decodeBlockValue(raw), true, nil
+}
+
+func (s *blockScope) lookup(name string) (any, bool) {
+	if s == nil || name == "" {
+		return nil, false
+	}
+	switch name {
+	case "this":
+		return s.item, true
+	case "@index":
+		return json.Number(strconv.Itoa(s.index)), true
+	case "@first":
+		return s.index == 0, true
+	case "@last":
+		return s.index == s.count-1, true
+	}
+	if path, ok := strings.CutPrefix(name, "this."); ok {
+		return blockField(s.item, path)
+	}
+	if strings.HasPrefix(name, "=") || strings.HasPrefix(name, "$") {
+		return nil, false
+	}
+	for cur := s; cur != nil; cur = cur.parent {
+		if value, ok := blockField(cur.item, name); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func blockField(value any, path string) (any, bool) {
+	cur := value
+	for _, seg := range strings.Split(path, ".") {
+		switch v := cur.(type) {
+		case map[string]any:
+			next, ok := v[seg]
+			if !ok {
+				return nil, false
+			}
+			cur = next
+		case []any:
+			idx, err := strconv.Atoi(seg)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, false
+			}
+			cur = v[idx]
+		default:
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func decodeBlockValue(raw string) any {
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil || dec.More() {
+		return raw
+	}
+	return value
+}
+
+func blockTruthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case json.Number:
+		f, err := v.Float64()
+		return err != nil || f != 0
+	case []any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+// blockString renders strings raw and everything else as compact JSON so
+// values drop straight into JSON payloads.
+func blockString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case nil:
+		return "null"
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return fmt.Sprint(value)
+	}
+	return strings.TrimRight(buf.String(), "\n")
+}
@@ -0,0 +1,148 @@
+package vars
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpandBlocksEachOverArray(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(NewMapProvider("const", map[string]string{
+		"items":  `[{"name":"a","qty":1},{"name":"b","qty":2}]`,
+		"tenant": "acme",
+	}))
+	input := strings.Join([]string{
+		"[",
+		"{{#each items}}",
+		`  {"tenant":"{{tenant}}","name":"{{this.name}}","qty":{{qty}},"i":{{@index}}}` +
+			`{{#unless @last}},{{/unless}}`,
+		"{{/each}}",
+		"]",
+	}, "\n")
+	blocks, err := resolver.ExpandBlocks(input)
+	if err != nil {
+		t.Fatalf("ExpandBlocks: %v", err)
+	}
+	out, err := resolver.ExpandTemplates(blocks)
+	if err != nil {
+		t.Fatalf("ExpandTemplates: %v", err)
+	}
+	want := strings.Join([]string{
+		"[",
+		`  {"tenant":"acme","name":"a","qty":1,"i":0},`,
+		`  {"tenant":"acme","name":"b","qty":2,"i":1}`,
+		"]",
+	}, "\n")
+	if out != want {
+		t.Fatalf("unexpected output:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestExpandBlocksIfElseAndEmptyEach(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(NewMapProvider("const", map[string]string{
+		"items": `[]`,
+		"debug": "false",
+		"tags":  `["x","y"]`,
+	}))
+	input := `{{#each items}}item{{else}}none{{/each}}|` +
+		`{{#if debug}}on{{else}}off{{/if}}|` +
+		`{{#each tags}}{{this}}{{#if @first}}!{{/if}}{{/each}}|` +
+		`{{#if missing}}yes{{else}}no{{/if}}`
+	out, err := resolver.ExpandBlocks(input)
+	if err != nil {
+		t.Fatalf("ExpandBlocks: %v", err)
+	}
+	if out != "none|off|x!y|no" {
+		t.Fatalf("unexpected output %q", out)
+	}
+}
+
+func TestExpandBlocksNestedEach(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(NewMapProvider("const", map[string]string{
+		"users": `[{"id":1,"roles":["a","b"]},{"id":2,"roles":[]}]`,
+	}))
+	input := `{{#each users}}{{id}}:{{#each roles}}{{this}}{{@index}}{{/each}};{{/each}}`
+	out, err := resolver.ExpandBlocks(input)
+	if err != nil {
+		t.Fatalf("ExpandBlocks: %v", err)
+	}
+	if out != "1:a0b1;2:;" {
+		t.Fatalf("unexpected output %q", out)
+	}
+}
+
+func TestExpandBlocksLeavesPlainTemplates(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver()
+	input := `{"id":"{{$uuid}}","user":"{{user}}"}`
+	out, err := resolver.ExpandBlocks(input)
+	if err != nil {
+		t.Fatalf("ExpandBlocks: %v", err)
+	}
+	if out != input {
+		t.Fatalf("expected input unchanged, got %q", out)
+	}
+}
+
+func TestExpandBlocksErrors(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(NewMapProvider("const", map[string]string{
+		"name":  "bob",
+		"items": `[1]`,
+	}))
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{"{{#each items}}x", "unclosed {{#each items}} (line 1)"},
+		{"x\n{{/each}}", "unexpected {{/each}} (line 2)"},
+		{"{{#each items}}x{{/if}}", "{{/if}} does not close {{#each items}} (line 1)"},
+		{"{{#each}}x{{/each}}", "{{#each}} requires a variable (line 1)"},
+		{"{{#with items}}x{{/with}}", "unknown block {{#with items}} (line 1)"},
+		{"{{#if name}}a{{else}}b{{else}}c{{/if}}", "duplicate {{else}} (line 1)"},
+		{"{{#each name}}x{{/each}}", "{{#each name}} (line 1): value is not a JSON array"},
+		{"{{#each nope}}x{{/each}}", "{{#each nope}} (line 1): undefined variable"},
+	}
+	for _, tc := range cases {
+		_, err := resolver.ExpandBlocks(tc.input)
+		if err == nil {
+			t.Fatalf("%q: expected error", tc.input)
+		}
+		if err.Error() != tc.want {
+			t.Fatalf("%q: expected error %q, got %q", tc.input, tc.want, err.Error())
+		}
+	}
+}
+
+func TestExpandBlockTemplatesKeepsItemTemplatesLiteral(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(NewMapProvider("const", map[string]string{
+		"items":  `[{"name":"{{token}}"},{"name":"{{$uuid}}"}]`,
+		"token":  "s3cr3t",
+		"tenant": "acme",
+	}))
+	input := `{{#each items}}{{tenant}}:{{this.name}};{{/each}}`
+	out, err := resolver.ExpandBlockTemplates(input)
+	if err != nil {
+		t.Fatalf("ExpandBlockTemplates: %v", err)
+	}
+	if want := "acme:{{token}};acme:{{$uuid}};"; out != want {
+		t.Fatalf("expected item values inserted literally, got %q want %q", out, want)
+	}
+
+	out, err = resolver.ExpandBlockTemplates(`{"tenant":"{{tenant}}"}`)
+	if err != nil || out != `{"tenant":"acme"}` {
+		t.Fatalf("expected plain templates expanded, got %q, %v", out, err)
+	}
+	if _, err := resolver.ExpandBlockTemplates(`{{#if tenant}}{{missing}}{{/if}}`); err == nil {
+		t.Fatalf("expected undefined variable error")
+	}
+}
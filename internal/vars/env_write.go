@@ -0,0 +1,204 @@
+package vars
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+)
+
+// EnvEntry is one top-level key of an environment as written in the file.
+// String values are kept verbatim; numbers, booleans, objects and arrays are
+// carried as compact JSON with Raw set.
+type EnvEntry struct {
+	Key   string
+	Value string
+	Raw   bool
+}
+
+type jsonField struct {
+	key string
+	val json.RawMessage
+}
+
+// ReadEnvironmentEntries returns the keys of env in file order, without
+// $shared defaults or flattening, so they can be edited and written back.
+func ReadEnvironmentEntries(path, env string) ([]EnvEntry, error) {
+	if IsDotEnvPath(path) {
+		return nil, errdef.New(errdef.CodeFilesystem, "editing .env files is not supported")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errdef.Wrap(errdef.CodeFilesystem, err, "read env file %s", path)
+	}
+	root, err := decodeObject(data)
+	if err != nil {
+		return nil, errdef.Wrap(errdef.CodeParse, err, "parse env file %s", path)
+	}
+	var entries []EnvEntry
+	for _, f := range root {
+		if f.key != env {
+			continue
+		}
+		fields, err := decodeObject(f.val)
+		if err != nil {
+			return nil, errdef.Wrap(errdef.CodeParse, err, "parse environment %s", env)
+		}
+		for _, kv := range fields {
+			entries = append(entries, entryFromJSON(kv))
+		}
+	}
+	return entries, nil
+}
+
+// WriteEnvironmentEntries replaces the keys of env with entries and rewrites
+// the file atomically. Other environments keep their order and values. Raw
+// entries must be valid JSON; nothing is written when one is not.
+func WriteEnvironmentEntries(path, env string, entries []EnvEntry) error {
+	if IsDotEnvPath(path) {
+		return errdef.New(errdef.CodeFilesystem, "editing .env files is not supported")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errdef.Wrap(errdef.CodeFilesystem, err, "read env file %s", path)
+	}
+	root, err := decodeObject(data)
+	if err != nil {
+		return errdef.Wrap(errdef.CodeParse, err, "parse env file %s", path)
+	}
+
+	fields := make([]jsonField, 0, len(entries))
+	for _, e := range entries {
+		val, err := entryJSON(e)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, jsonField{key: e.Key, val: val})
+	}
+	obj := encodeObject(fields)
+
+	replaced := false
+	for i := range root {
+		if root[i].key == env {
+			root[i].val = obj
+			replaced = true
+		}
+	}
+	if !replaced {
+		root = append(root, jsonField{key: env, val: obj})
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, encodeObject(root), "", "  "); err != nil {
+		return errdef.Wrap(errdef.CodeParse, err, "format env file %s", path)
+	}
+	out.WriteByte('\n')
+
+	perm := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+	if err := writeEnvFileAtomic(path, out.Bytes(), perm); err != nil {
+		return errdef.Wrap(errdef.CodeFilesystem, err, "write env file %s", path)
+	}
+	return nil
+}
+
+func entryFromJSON(f jsonField) EnvEntry {
+	var s string
+	if err := json.Unmarshal(f.val, &s); err == nil {
+		return EnvEntry{Key: f.key, Value: s}
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, f.val); err != nil {
+		return EnvEntry{Key: f.key, Value: string(f.val), Raw: true}
+	}
+	return EnvEntry{Key: f.key, Value: buf.String(), Raw: true}
+}
+
+func entryJSON(e EnvEntry) (json.RawMessage, error) {
+	if e.Raw {
+		if !json.Valid([]byte(e.Value)) {
+			return nil, errdef.New(errdef.CodeParse, "invalid JSON value for %s", e.Key)
+		}
+		return json.RawMessage(e.Value), nil
+	}
+	return marshalJSONString(e.Value), nil
+}
+
+// decodeObject reads a JSON object keeping its key order, which a map
+// would lose on the way back out.
+func decodeObject(data []byte) ([]jsonField, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return nil, errdef.New(errdef.CodeParse, "expected JSON object")
+	}
+	var fields []jsonField
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		var val json.RawMessage
+		if err := dec.Decode(&val); err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{key: key, val: val})
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func encodeObject(fields []jsonField) json.RawMessage {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.Write(marshalJSONString(f.key))
+		b.WriteByte(':')
+		b.Write(f.val)
+	}
+	b.WriteByte('}')
+	return b.Bytes()
+}
+
+func marshalJSONString(s string) json.RawMessage {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return bytes.TrimRight(b.Bytes(), "\n")
+}
+
+func writeEnvFileAtomic(path string, data []byte, perm os.FileMode) error {
+	f, err := os.CreateTemp(filepath.Dir(path), ".resterm-env-*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer func() { _ = os.Remove(tmp) }()
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,89 @@
+package vars
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteEnvironmentEntriesKeepsOrderAndOtherEnvs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resterm.env.json")
+	data := `{
+  "$shared": {"region": "eu"},
+  "dev": {"zeta": "1", "alpha": "<a&b>", "port": 8080, "tags": ["x"]},
+  "prod": {"host": "prod.example"}
+}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("write env file: %v", err)
+	}
+
+	entries, err := ReadEnvironmentEntries(path, "dev")
+	if err != nil {
+		t.Fatalf("read entries: %v", err)
+	}
+	want := []EnvEntry{
+		{Key: "zeta", Value: "1"},
+		{Key: "alpha", Value: "<a&b>"},
+		{Key: "port", Value: "8080", Raw: true},
+		{Key: "tags", Value: `["x"]`, Raw: true},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %+v", len(want), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Fatalf("entry %d: expected %+v, got %+v", i, want[i], entries[i])
+		}
+	}
+
+	entries[0].Value = "2"
+	entries[2].Value = "9090"
+	entries = append(entries, EnvEntry{Key: "token", Value: "s3cr3t"})
+	if err := WriteEnvironmentEntries(path, "dev", entries); err != nil {
+		t.Fatalf("write entries: %v", err)
+	}
+
+	envs, err := LoadEnvironmentFile(path)
+	if err != nil {
+		t.Fatalf("reload env: %v", err)
+	}
+	dev := envs["dev"]
+	if dev["zeta"] != "2" || dev["port"] != "9090" || dev["token"] != "s3cr3t" {
+		t.Fatalf("unexpected dev values: %+v", dev)
+	}
+	if dev["region"] != "eu" || envs["prod"]["host"] != "prod.example" {
+		t.Fatalf("expected shared and prod values to survive, got %+v", envs)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read env file: %v", err)
+	}
+	text := string(raw)
+	if strings.Index(text, `"zeta"`) > strings.Index(text, `"alpha"`) {
+		t.Fatalf("expected key order to be kept:\n%s", text)
+	}
+	if !strings.Contains(text, `"<a&b>"`) {
+		t.Fatalf("expected html characters to stay unescaped:\n%s", text)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected file mode to be kept, got %v (%v)", info.Mode(), err)
+	}
+}
+
+func TestWriteEnvironmentEntriesRejectsInvalidJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resterm.env.json")
+	data := `{"dev": {"port": 8080}}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("write env file: %v", err)
+	}
+	err := WriteEnvironmentEntries(path, "dev", []EnvEntry{{Key: "port", Value: "80x", Raw: true}})
+	if err == nil {
+		t.Fatalf("expected invalid JSON value to be rejected")
+	}
+	raw, _ := os.ReadFile(path)
+	if string(raw) != data {
+		t.Fatalf("expected env file to be untouched, got %s", raw)
+	}
+}
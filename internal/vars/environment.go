@@ -211,7 +211,13 @@ func SelectEnv(set EnvironmentSet, override, current string) string {
 	return ""
 }
 
+// maxEnvRefDepth bounds nested env-to-env references so long chains and
+// cycles terminate instead of recursing forever.
+const maxEnvRefDepth = 8
+
 // EnvValues returns the flattened key/value map for the requested environment.
+// Values referencing other keys of the same environment ({{host}}) are expanded;
+// unknown names, dynamic helpers and cyclic references are left untouched.
 func EnvValues(set EnvironmentSet, name string) map[string]string {
 	if set == nil {
 		return nil
@@ -221,7 +227,41 @@ func EnvValues(set EnvironmentSet, name string) map[string]string {
 		return nil
 	}
 	if env, ok := set[key]; ok {
-		return env
+		return expandEnvRefs(env)
 	}
 	return nil
 }
+
+func expandEnvRefs(env map[string]string) map[string]string {
+	hasRefs := false
+	for _, v := range env {
+		if strings.Contains(v, "{{") {
+			hasRefs = true
+			break
+		}
+	}
+	if !hasRefs {
+		return env
+	}
+
+	out := make(map[string]string, len(env))
+	for k, v := range env {
+		out[k] = expandEnvValue(env, v, 0, map[string]bool{k: true})
+	}
+	return out
+}
+
+func expandEnvValue(env map[string]string, value string, depth int, seen map[string]bool) string {
+	if depth >= maxEnvRefDepth || !strings.Contains(value, "{{") {
+		return value
+	}
+	return ReplaceTemplateVars(value, func(match, name string) string {
+		ref, ok := env[name]
+		if !ok || seen[name] {
+			return match
+		}
+		seen[name] = true
+		defer delete(seen, name)
+		return expandEnvValue(env, ref, depth+1, seen)
+	})
+}
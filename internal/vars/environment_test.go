@@ -126,3 +126,45 @@ func TestLoadEnvironmentFileOnlySharedReturnsError(t *testing.T) {
 		t.Fatalf("expected only-shared parse error, got %v", err)
 	}
 }
+
+func TestEnvValuesExpandsIntraEnvironmentRefs(t *testing.T) {
+	set := EnvironmentSet{
+		"dev": {
+			"host":    "api.dev",
+			"base":    "https://{{host}}",
+			"users":   "{{base}}/users",
+			"token":   "{{$uuid}}",
+			"missing": "{{nope}}/x",
+			"loopA":   "a-{{loopB}}",
+			"loopB":   "b-{{loopA}}",
+			"self":    "{{self}}",
+			"literal": "plain",
+		},
+	}
+
+	env := EnvValues(set, "dev")
+	if env["base"] != "https://api.dev" {
+		t.Fatalf("expected base to expand host, got %q", env["base"])
+	}
+	if env["users"] != "https://api.dev/users" {
+		t.Fatalf("expected nested refs to expand, got %q", env["users"])
+	}
+	if env["token"] != "{{$uuid}}" {
+		t.Fatalf("expected dynamic helpers to stay for request-time expansion, got %q", env["token"])
+	}
+	if env["missing"] != "{{nope}}/x" {
+		t.Fatalf("expected unknown refs to stay verbatim, got %q", env["missing"])
+	}
+	if env["loopA"] != "a-b-{{loopA}}" || env["loopB"] != "b-a-{{loopB}}" {
+		t.Fatalf("expected cycles to stop, got %q and %q", env["loopA"], env["loopB"])
+	}
+	if env["self"] != "{{self}}" {
+		t.Fatalf("expected self reference to stay verbatim, got %q", env["self"])
+	}
+	if env["literal"] != "plain" {
+		t.Fatalf("expected literal value unchanged, got %q", env["literal"])
+	}
+	if set["dev"]["base"] != "https://{{host}}" {
+		t.Fatalf("expected source set to stay untouched, got %q", set["dev"]["base"])
+	}
+}
@@ -0,0 +1,114 @@
+package vars
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+)
+
+const DefaultExecTimeout = 10 * time.Second
+
+// ExecFunc runs the command behind an exec("...") value and returns its output.
+type ExecFunc func(command string) (string, error)
+
+// ParseExecRef reports whether raw is an exec("command") value and returns
+// the unquoted command.
+func ParseExecRef(raw string) (string, bool) {
+	trimmed := strings.TrimSpace(raw)
+	if len(trimmed) < 6 || !strings.EqualFold(trimmed[:5], "exec(") ||
+		!strings.HasSuffix(trimmed, ")") {
+		return "", false
+	}
+	arg := strings.TrimSpace(trimmed[5 : len(trimmed)-1])
+	cmd, err := strconv.Unquote(arg)
+	if err != nil {
+		if len(arg) < 2 || arg[0] != '\'' || arg[len(arg)-1] != '\'' {
+			return "", false
+		}
+		cmd = arg[1 : len(arg)-1]
+	}
+	cmd = strings.TrimSpace(cmd)
+	if cmd == "" {
+		return "", false
+	}
+	return cmd, true
+}
+
+// ExecRunner runs exec() commands through the system shell. Each command runs
+// once per working directory; later lookups reuse the trimmed stdout for the
+// rest of the session. Failures are not cached so a fixed script can be retried.
+type ExecRunner struct {
+	timeout time.Duration
+	mu      sync.Mutex
+	cache   map[string]string
+}
+
+func NewExecRunner(timeout time.Duration) *ExecRunner {
+	if timeout <= 0 {
+		timeout = DefaultExecTimeout
+	}
+	return &ExecRunner{timeout: timeout, cache: make(map[string]string)}
+}
+
+func (r *ExecRunner) Run(ctx context.Context, dir, command string) (string, error) {
+	key := dir + "\x00" + command
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if out, ok := r.cache[key]; ok {
+		return out, nil
+	}
+	out, err := RunCommand(ctx, dir, command, r.timeout)
+	if err != nil {
+		return "", err
+	}
+	r.cache[key] = out
+	return out, nil
+}
+
+// RunCommand runs command through the system shell in dir and returns its
+// trimmed stdout. A non-positive timeout falls back to DefaultExecTimeout.
+func RunCommand(ctx context.Context, dir, command string, timeout time.Duration) (string, error) {
+	if timeout <= 0 {
+		timeout = DefaultExecTimeout
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := shellCommand(ctx, command)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", errdef.New(
+				errdef.CodeScript,
+				"exec %q: timed out after %s",
+				command,
+				timeout,
+			)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", errdef.Wrap(errdef.CodeScript, err, "exec %q: %s", command, msg)
+		}
+		return "", errdef.Wrap(errdef.CodeScript, err, "exec %q", command)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
@@ -0,0 +1,121 @@
+package vars
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestParseExecRef(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		raw  string
+		cmd  string
+		want bool
+	}{
+		{raw: `exec("./get-token.sh")`, cmd: "./get-token.sh", want: true},
+		{raw: ` EXEC( "echo hi" ) `, cmd: "echo hi", want: true},
+		{raw: `exec('printf x')`, cmd: "printf x", want: true},
+		{raw: `exec("")`, want: false},
+		{raw: `exec(./script.sh)`, want: false},
+		{raw: `execute("x")`, want: false},
+		{raw: `plain value`, want: false},
+	}
+	for _, tc := range cases {
+		cmd, ok := ParseExecRef(tc.raw)
+		if ok != tc.want || cmd != tc.cmd {
+			t.Fatalf("ParseExecRef(%q) = %q, %v; want %q, %v", tc.raw, cmd, ok, tc.cmd, tc.want)
+		}
+	}
+}
+
+func TestExecRunnerCachesOutput(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "count")
+	script := "echo x >> count; printf '  tok-123\\n'"
+
+	r := NewExecRunner(0)
+	for i := 0; i < 2; i++ {
+		out, err := r.Run(context.Background(), dir, script)
+		if err != nil {
+			t.Fatalf("run %d: %v", i, err)
+		}
+		if out != "tok-123" {
+			t.Fatalf("expected trimmed output, got %q", out)
+		}
+	}
+	data, err := os.ReadFile(counter)
+	if err != nil {
+		t.Fatalf("read counter: %v", err)
+	}
+	if n := strings.Count(string(data), "x"); n != 1 {
+		t.Fatalf("expected command to run once, ran %d times", n)
+	}
+}
+
+func TestExecRunnerNonZeroExit(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	r := NewExecRunner(0)
+	_, err := r.Run(context.Background(), t.TempDir(), "echo boom >&2; exit 3")
+	if err == nil {
+		t.Fatalf("expected error for non-zero exit")
+	}
+	if !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected stderr in error, got %v", err)
+	}
+}
+
+func TestExpandTemplatesExecValue(t *testing.T) {
+	t.Parallel()
+
+	provider := NewExecProvider("request", map[string]string{"token": `exec("get-token")`})
+	resolver := NewResolver(provider)
+	if _, err := resolver.ExpandTemplates("Bearer {{token}}"); err == nil ||
+		!strings.Contains(err.Error(), "allow_exec_vars") {
+		t.Fatalf("expected disabled exec error, got %v", err)
+	}
+
+	var got string
+	resolver.SetExec(func(cmd string) (string, error) {
+		got = cmd
+		return "secret", nil
+	})
+	out, err := resolver.ExpandTemplates("Bearer {{token}}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "get-token" || out != "Bearer secret" {
+		t.Fatalf("unexpected exec %q / output %q", got, out)
+	}
+}
+
+func TestExpandTemplatesCapturedExecIsLiteral(t *testing.T) {
+	t.Parallel()
+
+	captured := NewMapProvider("file", map[string]string{"token": `exec("rm -rf ~")`})
+	resolver := NewResolver(captured)
+	ran := false
+	resolver.SetExec(func(string) (string, error) {
+		ran = true
+		return "ran", nil
+	})
+	out, err := resolver.ExpandTemplates("Bearer {{token}}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ran {
+		t.Fatalf("expected captured exec value not to run")
+	}
+	if out != `Bearer exec("rm -rf ~")` {
+		t.Fatalf("expected literal value, got %q", out)
+	}
+}
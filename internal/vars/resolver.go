@@ -32,6 +32,7 @@ type Resolver struct {
 	refs      []RefResolver
 	expr      ExprEval
 	exprPos   ExprPos
+	exec      ExecFunc
 }
 
 func NewResolver(providers ...Provider) *Resolver {
@@ -42,17 +43,22 @@ func NewResolver(providers ...Provider) *Resolver {
 // If that fails and the name has a dot, tries to match a provider prefix -
 // so "production.api_key" looks for a provider labeled "production" then asks for "api_key".
 func (r *Resolver) Resolve(name string) (string, bool) {
+	value, ok, _ := r.lookup(name)
+	return value, ok
+}
+
+func (r *Resolver) lookup(name string) (string, bool, error) {
 	trimmed := strings.TrimSpace(name)
 	if trimmed == "" {
-		return "", false
+		return "", false, nil
 	}
 	for _, provider := range r.providers {
 		if value, ok := provider.Resolve(trimmed); ok {
-			return r.applyRefs(value)
+			return r.applyRefs(provider, value)
 		}
 	}
 	if !strings.Contains(trimmed, ".") {
-		return "", false
+		return "", false, nil
 	}
 	lowered := strings.ToLower(trimmed)
 	for _, provider := range r.providers {
@@ -73,24 +79,38 @@ func (r *Resolver) Resolve(name string) (string, bool) {
 				continue
 			}
 			if value, ok := provider.Resolve(subject); ok {
-				return r.applyRefs(value)
+				return r.applyRefs(provider, value)
 			}
 		}
 	}
-	return "", false
+	return "", false, nil
 }
 
-// applyRefs runs the value through registered ref resolvers. The first
-// resolver that claims the value (handled==true) wins. If no resolver
-// handles the value it is returned as-is.
-func (r *Resolver) applyRefs(value string) (string, bool) {
+// applyRefs runs the value through registered ref resolvers. exec("...")
+// values are run first, but only when they come from a provider made with
+// NewExecProvider; elsewhere they are plain text. The first resolver that
+// claims the value (handled==true) wins. If no resolver handles the value it
+// is returned as-is.
+func (r *Resolver) applyRefs(provider Provider, value string) (string, bool, error) {
+	if cmd, ok := ParseExecRef(value); ok && allowsExec(provider) {
+		if r.exec == nil {
+			return "", false, fmt.Errorf(
+				"exec() values are disabled; set allow_exec_vars = true in settings",
+			)
+		}
+		out, err := r.exec(cmd)
+		if err != nil {
+			return "", false, err
+		}
+		return out, true, nil
+	}
 	for _, ref := range r.refs {
 		resolved, handled, found := ref(value)
 		if handled {
-			return resolved, found
+			return resolved, found, nil
 		}
 	}
-	return value, true
+	return value, true, nil
 }
 
 func (r *Resolver) ExpandTemplates(input string) (string, error) {
@@ -109,6 +129,11 @@ func (r *Resolver) AddRefResolver(fn RefResolver) {
 	r.refs = append(r.refs, fn)
 }
 
+// SetExec enables exec("...") values. Without it such values fail to resolve.
+func (r *Resolver) SetExec(fn ExecFunc) {
+	r.exec = fn
+}
+
 func (r *Resolver) SetExprEval(fn ExprEval) {
 	r.expr = fn
 }
@@ -156,17 +181,21 @@ func (r *Resolver) expandTemplates(
 			}
 			return val
 		}
-		if allowDynamic && strings.HasPrefix(name, "$") {
-			if value, ok := r.Resolve(name); ok {
-				return value
+		value, ok, err := r.lookup(name)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s: %w", name, err)
 			}
+			return match
+		}
+		if ok {
+			return value
+		}
+		if allowDynamic && strings.HasPrefix(name, "$") {
 			if dynamic, ok := resolveDynamic(name); ok {
 				return dynamic
 			}
 		}
-		if value, ok := r.Resolve(name); ok {
-			return value
-		}
 		if firstErr == nil {
 			firstErr = fmt.Errorf("undefined variable: %s", name)
 		}
@@ -246,6 +275,7 @@ func splitDynamicOffset(name string) (string, time.Duration, bool) {
 type MapProvider struct {
 	values map[string]string
 	label  string
+	exec   bool
 }
 
 // Keys get lowercased so lookups are case-insensitive
@@ -257,6 +287,20 @@ func NewMapProvider(label string, values map[string]string) Provider {
 	return &MapProvider{values: normalized, label: label}
 }
 
+// NewExecProvider is NewMapProvider for values written by the user, such as
+// @var and file variables, whose exec("...") values may run commands.
+// Captured, global and environment values must never use it.
+func NewExecProvider(label string, values map[string]string) Provider {
+	p := NewMapProvider(label, values).(*MapProvider)
+	p.exec = true
+	return p
+}
+
+func allowsExec(p Provider) bool {
+	mp, ok := p.(*MapProvider)
+	return ok && mp.exec
+}
+
 func (p *MapProvider) Resolve(name string) (string, bool) {
 	value, ok := p.values[strings.ToLower(name)]
 	return value, ok
@@ -0,0 +1,153 @@
+// Package webhook runs a short-lived local HTTP listener that records the
+// first callback an API sends to it.
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/unkn0wn-root/resterm/internal/errdef"
+)
+
+const (
+	DefaultAddr    = "127.0.0.1:0"
+	DefaultTimeout = 30 * time.Second
+
+	maxBodyBytes = 10 << 20
+)
+
+// Callback is the request a Listener received. Target is the path and
+// query as sent by the caller.
+type Callback struct {
+	Method     string
+	Target     string
+	Proto      string
+	Host       string
+	RemoteAddr string
+	Headers    http.Header
+	Body       []byte
+	Truncated  bool
+	ReceivedAt time.Time
+}
+
+// Listener accepts callbacks on any path and keeps the first one. Later
+// callbacks are acknowledged and dropped.
+type Listener struct {
+	url     string
+	srv     *http.Server
+	started time.Time
+	cbCh    chan *Callback
+	errCh   chan error
+	once    sync.Once
+}
+
+// Listen starts a listener on addr (DefaultAddr when empty). A port of 0
+// picks a free one; an unspecified host is advertised as 127.0.0.1.
+func Listen(addr string) (*Listener, error) {
+	if addr == "" {
+		addr = DefaultAddr
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, errdef.Wrap(errdef.CodeHTTP, err, "listen for webhook")
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		_ = ln.Close()
+		return nil, errdef.Wrap(errdef.CodeHTTP, err, "webhook listen address")
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "127.0.0.1"
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+
+	l := &Listener{
+		url:     "http://" + net.JoinHostPort(host, strconv.Itoa(port)),
+		started: time.Now(),
+		cbCh:    make(chan *Callback, 1),
+		errCh:   make(chan error, 1),
+	}
+	l.srv = &http.Server{
+		Handler:           http.HandlerFunc(l.handle),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		if err := l.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			l.errCh <- err
+		}
+	}()
+	return l, nil
+}
+
+// URL is the base URL callers should post to, without a trailing slash.
+func (l *Listener) URL() string {
+	return l.url
+}
+
+// Started is when the listener began accepting callbacks.
+func (l *Listener) Started() time.Time {
+	return l.started
+}
+
+// Wait blocks until the first callback arrives or ctx is done, then shuts
+// the listener down.
+func (l *Listener) Wait(ctx context.Context) (*Callback, error) {
+	defer l.Close()
+	select {
+	case cb := <-l.cbCh:
+		return cb, nil
+	case err := <-l.errCh:
+		return nil, errdef.Wrap(errdef.CodeHTTP, err, "webhook listener")
+	case <-ctx.Done():
+		return nil, errdef.Wrap(errdef.CodeHTTP, ctx.Err(), "waiting for webhook callback")
+	}
+}
+
+// Close stops the listener. It is safe to call more than once.
+func (l *Listener) Close() {
+	l.once.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = l.srv.Shutdown(ctx)
+	})
+}
+
+func (l *Listener) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes+1))
+	if err != nil {
+		http.Error(w, "read body", http.StatusBadRequest)
+		return
+	}
+	truncated := len(body) > maxBodyBytes
+	if truncated {
+		body = body[:maxBodyBytes]
+	}
+
+	headers := r.Header.Clone()
+	if headers == nil {
+		headers = make(http.Header)
+	}
+	cb := &Callback{
+		Method:     r.Method,
+		Target:     r.URL.RequestURI(),
+		Proto:      r.Proto,
+		Host:       r.Host,
+		RemoteAddr: r.RemoteAddr,
+		Headers:    headers,
+		Body:       body,
+		Truncated:  truncated,
+		ReceivedAt: time.Now(),
+	}
+	select {
+	case l.cbCh <- cb:
+	default:
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
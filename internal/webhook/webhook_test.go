@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListenerReturnsFirstCallback(t *testing.T) {
+	l, err := Listen("")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	if !strings.HasPrefix(l.URL(), "http://127.0.0.1:") {
+		t.Fatalf("unexpected url %q", l.URL())
+	}
+
+	for _, body := range []string{`{"status":"done"}`, `{"status":"again"}`} {
+		resp, err := http.Post(l.URL()+"/orders?id=7", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("post: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected 204, got %d", resp.StatusCode)
+		}
+	}
+
+	cb, err := l.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if cb.Method != http.MethodPost || cb.Target != "/orders?id=7" {
+		t.Fatalf("unexpected callback %s %s", cb.Method, cb.Target)
+	}
+	if string(cb.Body) != `{"status":"done"}` {
+		t.Fatalf("expected first body, got %q", cb.Body)
+	}
+	if cb.Headers.Get("Content-Type") != "application/json" {
+		t.Fatalf("unexpected headers %v", cb.Headers)
+	}
+	if _, err := http.Get(l.URL()); err == nil {
+		t.Fatalf("expected listener to be closed after Wait")
+	}
+}
+
+func TestListenerWaitTimesOut(t *testing.T) {
+	l, err := Listen("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = l.Wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline error, got %v", err)
+	}
+}